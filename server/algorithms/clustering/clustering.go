@@ -0,0 +1,85 @@
+package clustering
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"math"
+)
+
+// maxClusterPoints 聚类算法的点数上限，每一步都记录全部点的归属
+const maxClusterPoints = 2000
+
+// 噪声与未分类点在 assignments 中的取值
+const (
+	labelNoise      = -1 // DBSCAN 的噪声点
+	labelUnassigned = -2 // 尚未分配
+)
+
+// DBSCAN 中点的角色
+const (
+	RoleCore   = "core"   // 核心点：邻域内至少有 min_points 个点
+	RoleBorder = "border" // 边界点：不是核心点，但位于某个核心点的邻域内
+	RoleNoise  = "noise"  // 噪声点：不属于任何簇
+)
+
+// clusterState 聚类的步骤快照
+type clusterState struct {
+	Points      []models.Point2D `json:"points,omitempty"`    // 原始点集，只在首尾两步记录
+	Centroids   []models.Point2D `json:"centroids"`           // 各簇中心
+	Assignments []int            `json:"assignments"`         // 每个点所属的簇，-1 为噪声，-2 为尚未分配
+	Inertia     float64          `json:"inertia"`             // 各点到所属簇中心的距离平方和
+	Iteration   int              `json:"iteration"`           // k-means 的迭代轮次，从1开始
+	Shift       float64          `json:"shift"`               // 本轮簇中心的最大移动距离
+	Changed     []int            `json:"changed,omitempty"`   // 本轮改变归属的点
+	Roles       []string         `json:"roles,omitempty"`     // DBSCAN 中各点的角色，未访问为空串
+	Current     int              `json:"current"`             // 当前处理的点，-1 表示无
+	Neighbors   []int            `json:"neighbors,omitempty"` // 当前点 eps 邻域内的点
+	Eps         float64          `json:"eps,omitempty"`       // DBSCAN 的邻域半径
+}
+
+// validateClusterInput 聚类算法的输入验证
+func validateClusterInput(data interface{}) error {
+	_, err := algorithms.ValidatePointSet(data, maxClusterPoints)
+	return err
+}
+
+// centroidPoint 第 c 个簇中心
+func centroidPoint(c int, x, y float64) models.Point2D {
+	id := fmt.Sprintf("c%d", c)
+	return models.Point2D{ID: id, Label: fmt.Sprintf("C%d", c), X: x, Y: y}
+}
+
+// computeCentroids 按归属计算各簇的均值中心与点数，空簇的中心为 NaN
+func computeCentroids(points []models.Point2D, assignments []int, k int) ([]models.Point2D, []int) {
+	sumX, sumY := make([]float64, k), make([]float64, k)
+	sizes := make([]int, k)
+	for i, c := range assignments {
+		if c < 0 {
+			continue
+		}
+		sumX[c] += points[i].X
+		sumY[c] += points[i].Y
+		sizes[c]++
+	}
+	centroids := make([]models.Point2D, k)
+	for c := range centroids {
+		x, y := math.NaN(), math.NaN()
+		if sizes[c] > 0 {
+			x, y = sumX[c]/float64(sizes[c]), sumY[c]/float64(sizes[c])
+		}
+		centroids[c] = centroidPoint(c, x, y)
+	}
+	return centroids, sizes
+}
+
+// inertia 各点到所属簇中心的距离平方和，噪声与未分配的点不计入
+func inertia(points []models.Point2D, assignments []int, centroids []models.Point2D) float64 {
+	total := 0.0
+	for i, c := range assignments {
+		if c >= 0 {
+			total += algorithms.DistanceSquared(points[i], centroids[c])
+		}
+	}
+	return total
+}
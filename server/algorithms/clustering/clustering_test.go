@@ -0,0 +1,238 @@
+package clustering
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// blobPoints 围绕给定中心各生成 perBlob 个点，偏移不超过 spread
+func blobPoints(rng *rand.Rand, centers [][2]float64, perBlob int, spread float64) *models.PointSetData {
+	points := make([]models.Point2D, 0, len(centers)*perBlob)
+	for _, c := range centers {
+		for j := 0; j < perBlob; j++ {
+			id := fmt.Sprintf("p%d", len(points))
+			points = append(points, models.Point2D{ID: id, Label: id,
+				X: c[0] + (rng.Float64()*2-1)*spread, Y: c[1] + (rng.Float64()*2-1)*spread})
+		}
+	}
+	return &models.PointSetData{Points: points}
+}
+
+// sameClusters 两种标注把点划分成相同的组（忽略簇编号）
+func sameClusters(a, b []int) bool {
+	mapping := map[int]int{}
+	reverse := map[int]int{}
+	for i := range a {
+		if m, ok := mapping[a[i]]; ok && m != b[i] {
+			return false
+		}
+		if m, ok := reverse[b[i]]; ok && m != a[i] {
+			return false
+		}
+		mapping[a[i]], reverse[b[i]] = b[i], a[i]
+	}
+	return true
+}
+
+func TestKMeans_SeparatedBlobs(t *testing.T) {
+	centers := [][2]float64{{10, 10}, {80, 15}, {45, 85}}
+	points := blobPoints(rand.New(rand.NewSource(1)), centers, 40, 5)
+	expected := make([]int, len(points.Points))
+	for i := range expected {
+		expected[i] = i / 40
+	}
+
+	for _, init := range []string{InitKMeansPlusPlus, InitRandom} {
+		t.Run(init, func(t *testing.T) {
+			tracker := models.NewStepTracker()
+			result, err := NewKMeans().ExecuteWithParams(points, map[string]interface{}{"k": 3, "init": init, "seed": 11}, tracker)
+			if err != nil {
+				t.Fatalf("ExecuteWithParams() error = %v", err)
+			}
+			output := result.(map[string]interface{})
+			if !output["converged"].(bool) {
+				t.Fatalf("未收敛，iterations = %v", output["iterations"])
+			}
+			// 随机初始化可能陷入局部最优，只对 k-means++ 检查划分
+			if init == InitKMeansPlusPlus && !sameClusters(output["assignments"].([]int), expected) {
+				t.Errorf("assignments = %v", output["assignments"])
+			}
+
+			// 惯性单调不增，每轮有一个分配步骤和一个更新步骤
+			history := output["inertiaHistory"].([]float64)
+			for i := 1; i < len(history); i++ {
+				if history[i] > history[i-1]+1e-6 {
+					t.Errorf("惯性在第 %d 轮增大：%v", i+1, history)
+				}
+			}
+			iterations := output["iterations"].(int)
+			if len(history) != iterations || len(output["shiftHistory"].([]float64)) != iterations {
+				t.Errorf("历史长度与迭代轮数 %d 不一致", iterations)
+			}
+			if steps := len(tracker.GetSteps()); steps != 1+3+2*iterations+1 {
+				t.Errorf("steps = %d, iterations = %d", steps, iterations)
+			}
+			if last := history[len(history)-1]; math.Abs(last-output["inertia"].(float64)) > 1e-6 {
+				t.Errorf("最终惯性 %v 与历史 %v 不一致", output["inertia"], last)
+			}
+
+			sizes := output["clusterSizes"].([]int)
+			total := 0
+			for _, size := range sizes {
+				total += size
+			}
+			if total != len(points.Points) {
+				t.Errorf("clusterSizes = %v", sizes)
+			}
+		})
+	}
+}
+
+func TestKMeans_StepsRecordState(t *testing.T) {
+	points := blobPoints(rand.New(rand.NewSource(2)), [][2]float64{{0, 0}, {50, 50}}, 10, 3)
+	tracker := models.NewStepTracker()
+	if _, err := NewKMeans().ExecuteWithParams(points, map[string]interface{}{"k": 2, "seed": 5}, tracker); err != nil {
+		t.Fatalf("ExecuteWithParams() error = %v", err)
+	}
+	for _, step := range tracker.GetSteps() {
+		if step.Metadata.Phase != "Lloyd迭代" {
+			continue
+		}
+		state := step.Data.(clusterState)
+		if len(state.Centroids) != 2 || len(state.Assignments) != len(points.Points) || state.Iteration < 1 {
+			t.Fatalf("step %d: %+v", step.StepID, state)
+		}
+		centroids := state.Centroids
+		if got := algorithms.Round4(inertia(points.Points, state.Assignments, centroids)); got != state.Inertia {
+			t.Errorf("step %d: inertia = %v, expected %v", step.StepID, state.Inertia, got)
+		}
+	}
+}
+
+func TestKMeans_SeedIsReproducible(t *testing.T) {
+	points := blobPoints(rand.New(rand.NewSource(3)), [][2]float64{{0, 0}, {30, 0}, {0, 30}, {30, 30}}, 25, 12)
+	run := func(seed int) map[string]interface{} {
+		result, err := NewKMeans().ExecuteWithParams(points, map[string]interface{}{"k": 4, "seed": seed}, models.NewStepTracker())
+		if err != nil {
+			t.Fatalf("ExecuteWithParams() error = %v", err)
+		}
+		return result.(map[string]interface{})
+	}
+	a, b := run(42), run(42)
+	for _, key := range []string{"assignments", "centroids", "inertiaHistory", "iterations", "seed"} {
+		if !reflect.DeepEqual(a[key], b[key]) {
+			t.Errorf("相同种子的 %s 不同：%v vs %v", key, a[key], b[key])
+		}
+	}
+	if a["seed"] != int64(42) {
+		t.Errorf("seed = %v", a["seed"])
+	}
+	// 种子为0时使用当前时间，并在结果中返回实际使用的种子
+	if seed := run(0)["seed"].(int64); seed == 0 {
+		t.Error("种子为0时应返回实际使用的种子")
+	}
+}
+
+func TestKMeans_DuplicatePoints(t *testing.T) {
+	// 不同的坐标少于 k 个时，空簇由离中心最远的点重新初始化，仍然得到 k 个非空簇
+	points := &models.PointSetData{Points: []models.Point2D{
+		{Label: "A", X: 1, Y: 1}, {Label: "B", X: 1, Y: 1}, {Label: "C", X: 1, Y: 1}, {Label: "D", X: 5, Y: 5},
+	}}
+	for seed := 1; seed <= 20; seed++ {
+		result, err := NewKMeans().ExecuteWithParams(points, map[string]interface{}{"k": 3, "seed": seed}, models.NewStepTracker())
+		if err != nil {
+			t.Fatalf("ExecuteWithParams() error = %v", err)
+		}
+		output := result.(map[string]interface{})
+		for c, size := range output["clusterSizes"].([]int) {
+			if size == 0 {
+				t.Fatalf("seed %d: 簇 %d 为空", seed, c)
+			}
+		}
+		if output["inertia"] != 0.0 {
+			t.Errorf("seed %d: inertia = %v", seed, output["inertia"])
+		}
+	}
+}
+
+func TestDBSCAN_ClustersAndNoise(t *testing.T) {
+	points := blobPoints(rand.New(rand.NewSource(4)), [][2]float64{{10, 10}, {60, 60}}, 20, 3)
+	// 两个离群点
+	points.Points = append(points.Points, models.Point2D{Label: "far1", X: 100, Y: 0}, models.Point2D{Label: "far2", X: 0, Y: 100})
+
+	tracker := models.NewStepTracker()
+	result, err := NewDBSCAN().ExecuteWithParams(points, map[string]interface{}{"eps": 5, "min_points": 3}, tracker)
+	if err != nil {
+		t.Fatalf("ExecuteWithParams() error = %v", err)
+	}
+	output := result.(map[string]interface{})
+	if output["clusters"] != 2 || output["noisePoints"] != 2 {
+		t.Fatalf("clusters = %v, noisePoints = %v", output["clusters"], output["noisePoints"])
+	}
+	assignments := output["assignments"].([]int)
+	expected := make([]int, len(assignments))
+	for i := range expected {
+		expected[i] = i / 20
+	}
+	expected[40], expected[41] = labelNoise, labelNoise
+	if !reflect.DeepEqual(assignments, expected) {
+		t.Errorf("assignments = %v", assignments)
+	}
+	if output["corePoints"].(int)+output["borderPoints"].(int)+output["noisePoints"].(int) != len(assignments) {
+		t.Errorf("角色计数之和不等于点数")
+	}
+	// 每个点恰好做一次邻域查询
+	if output["regionQueries"] != len(assignments) {
+		t.Errorf("regionQueries = %v", output["regionQueries"])
+	}
+
+	steps := tracker.GetSteps()
+	state := steps[len(steps)-1].Data.(clusterState)
+	if len(state.Centroids) != 2 || state.Inertia != output["inertia"] {
+		t.Errorf("最后一步：centroids = %v, inertia = %v", state.Centroids, state.Inertia)
+	}
+}
+
+func TestDBSCAN_BorderPoints(t *testing.T) {
+	// A、B、C 相距1，min_points=3 时只有 B 是核心点；D 先被访问并记为噪声，之后被 B 覆盖改为边界点
+	points := &models.PointSetData{Points: []models.Point2D{
+		{Label: "D", X: 0, Y: 0}, {Label: "B", X: 1, Y: 0}, {Label: "C", X: 2, Y: 0}, {Label: "E", X: 10, Y: 0},
+	}}
+	result, err := NewDBSCAN().ExecuteWithParams(points, map[string]interface{}{"eps": 1, "min_points": 3}, models.NewStepTracker())
+	if err != nil {
+		t.Fatalf("ExecuteWithParams() error = %v", err)
+	}
+	output := result.(map[string]interface{})
+	roles := output["roles"].([]string)
+	if !reflect.DeepEqual(roles, []string{RoleBorder, RoleCore, RoleBorder, RoleNoise}) {
+		t.Errorf("roles = %v", roles)
+	}
+	if !reflect.DeepEqual(output["assignments"], []int{0, 0, 0, labelNoise}) {
+		t.Errorf("assignments = %v", output["assignments"])
+	}
+}
+
+func TestClustering_InvalidInput(t *testing.T) {
+	kmeans, dbscan := NewKMeans(), NewDBSCAN()
+	for _, data := range []interface{}{nil, &models.PointSetData{}, []int{1, 2}} {
+		if kmeans.ValidateInput(data) == nil || dbscan.ValidateInput(data) == nil {
+			t.Errorf("ValidateInput(%v) 应返回错误", data)
+		}
+	}
+	points := blobPoints(rand.New(rand.NewSource(5)), [][2]float64{{0, 0}}, 3, 1)
+	for _, params := range []map[string]interface{}{{"k": 4}, {"k": 0}, {"max_iterations": 0}, {"tolerance": -1}} {
+		if _, err := kmeans.ExecuteWithParams(points, params, models.NewStepTracker()); err == nil {
+			t.Errorf("k-means 参数 %v 应返回错误", params)
+		}
+	}
+	for _, params := range []map[string]interface{}{{"eps": 0}, {"min_points": 0}} {
+		if _, err := dbscan.ExecuteWithParams(points, params, models.NewStepTracker()); err == nil {
+			t.Errorf("DBSCAN 参数 %v 应返回错误", params)
+		}
+	}
+}
@@ -0,0 +1,258 @@
+package clustering
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"math"
+)
+
+// DBSCAN 基于密度的聚类
+type DBSCAN struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewDBSCAN 创建DBSCAN实例
+func NewDBSCAN() *DBSCAN {
+	return &DBSCAN{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "dbscan",
+			Name:            "DBSCAN聚类",
+			Category:        models.CategoryClustering,
+			Description:     "邻域半径 eps 内（含自身）至少有 min_points 个点的点是核心点。按顺序访问未分类的点：非核心点暂记为噪声；核心点创建新簇，并以广度优先的方式把邻域内的点加入簇中，遇到核心点继续扩展。之后被某个核心点覆盖的噪声点改为该簇的边界点。无需预先指定簇数，能发现任意形状的簇并识别离群点。",
+			TimeComplexity:  "O(n²)",
+			SpaceComplexity: "O(n)",
+			Parameters: []models.Parameter{
+				{
+					Name:         "eps",
+					Type:         "float",
+					Description:  "邻域半径",
+					DefaultValue: 10.0,
+					Required:     false,
+					Min:          0,
+				},
+				{
+					Name:         "min_points",
+					Type:         "int",
+					Description:  "成为核心点所需的邻域点数（含自身）",
+					DefaultValue: 4,
+					Required:     false,
+					Min:          1,
+					Max:          100,
+				},
+			},
+		},
+	}
+}
+
+// dbscanRun 一次DBSCAN的执行上下文
+type dbscanRun struct {
+	points      []models.Point2D
+	eps         float64
+	minPoints   int
+	tracker     models.StepTracker
+	assignments []int
+	roles       []string
+	clusters    int
+	queries     int // 邻域查询次数
+	distances   int // 距离计算次数
+}
+
+// Execute 使用默认参数执行DBSCAN
+func (db *DBSCAN) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return db.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 按参数执行DBSCAN
+func (db *DBSCAN) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	if err := db.ValidateInput(data); err != nil {
+		return nil, err
+	}
+	points, err := algorithms.ToPointSet(data)
+	if err != nil {
+		return nil, err
+	}
+	pts := points.Points
+
+	eps := algorithms.FloatParam(params, "eps", 10)
+	if !(eps > 0) || math.IsInf(eps, 0) {
+		return nil, fmt.Errorf("邻域半径 eps 必须为正数")
+	}
+	minPoints := algorithms.IntParam(params, "min_points", 4)
+	if minPoints < 1 || minPoints > 100 {
+		return nil, fmt.Errorf("min_points 必须在1到100之间")
+	}
+
+	run := &dbscanRun{points: pts, eps: eps, minPoints: minPoints, tracker: tracker,
+		assignments: make([]int, len(pts)), roles: make([]string, len(pts))}
+	for i := range run.assignments {
+		run.assignments[i] = labelUnassigned
+	}
+
+	tracker.SetPhase("聚类")
+	tracker.AddStep(fmt.Sprintf("对 %d 个点做 DBSCAN 聚类，eps=%.4g，min_points=%d", len(pts), eps, minPoints),
+		run.state(-1, nil, pts), []int{})
+	for i := range pts {
+		if run.assignments[i] != labelUnassigned {
+			continue
+		}
+		neighbors := run.regionQuery(i)
+		if len(neighbors) < minPoints {
+			run.assignments[i] = labelNoise
+			run.roles[i] = RoleNoise
+			tracker.AddStep(fmt.Sprintf("点 %s 的邻域内只有 %d 个点，少于 %d，暂记为噪声", algorithms.PointName(pts, i), len(neighbors), minPoints),
+				run.state(i, neighbors, nil), []int{i})
+			tracker.AddOperation(models.OpTypeAssign, []int{i}, []interface{}{labelNoise}, "标记为噪声")
+			continue
+		}
+		run.expand(i, neighbors)
+	}
+
+	centroids, sizes := computeCentroids(pts, run.assignments, run.clusters)
+	total := inertia(pts, run.assignments, centroids)
+	noise, core, border := 0, 0, 0
+	for _, role := range run.roles {
+		switch role {
+		case RoleNoise:
+			noise++
+		case RoleCore:
+			core++
+		case RoleBorder:
+			border++
+		}
+	}
+	tracker.SetPhase("完成")
+	tracker.AddStep(fmt.Sprintf("DBSCAN 结束：共 %d 个簇，核心点 %d 个，边界点 %d 个，噪声点 %d 个", run.clusters, core, border, noise),
+		clusterState{Points: pts, Centroids: centroids, Assignments: run.assignments, Inertia: algorithms.Round4(total),
+			Roles: run.roles, Current: -1, Eps: eps}, []int{})
+
+	return map[string]interface{}{
+		"assignments":          run.assignments,
+		"roles":                run.roles,
+		"clusters":             run.clusters,
+		"clusterSizes":         sizes,
+		"centroids":            centroids,
+		"inertia":              algorithms.Round4(total),
+		"corePoints":           core,
+		"borderPoints":         border,
+		"noisePoints":          noise,
+		"eps":                  eps,
+		"minPoints":            minPoints,
+		"regionQueries":        run.queries,
+		"distanceComputations": run.distances,
+		"pointCount":           len(pts),
+	}, nil
+}
+
+// ProcessPoints 处理点集
+func (db *DBSCAN) ProcessPoints(points *models.PointSetData, tracker models.StepTracker) (interface{}, error) {
+	return db.Execute(points, tracker)
+}
+
+// expand 以核心点 seed 创建新簇，广度优先地加入密度可达的点
+func (r *dbscanRun) expand(seed int, neighbors []int) {
+	cluster := r.clusters
+	r.clusters++
+	r.assignments[seed] = cluster
+	r.roles[seed] = RoleCore
+	r.tracker.AddStep(fmt.Sprintf("点 %s 的邻域内有 %d 个点，是核心点，创建簇 C%d", algorithms.PointName(r.points, seed), len(neighbors), cluster),
+		r.state(seed, neighbors, nil), []int{seed})
+	r.tracker.AddOperation(models.OpTypeAssign, []int{seed}, []interface{}{cluster}, "创建新簇")
+
+	queue := append([]int{}, neighbors...)
+	for head := 0; head < len(queue); head++ {
+		q := queue[head]
+		switch r.assignments[q] {
+		case labelNoise:
+			// 此前记为噪声的点不是核心点，只能作为边界点加入
+			r.assignments[q] = cluster
+			r.roles[q] = RoleBorder
+			r.tracker.AddStep(fmt.Sprintf("噪声点 %s 位于簇 C%d 的核心点邻域内，改为边界点", algorithms.PointName(r.points, q), cluster),
+				r.state(q, nil, nil), []int{q})
+			r.tracker.AddOperation(models.OpTypeAssign, []int{q}, []interface{}{cluster}, "噪声改为边界点")
+			continue
+		case labelUnassigned:
+		default:
+			continue
+		}
+
+		r.assignments[q] = cluster
+		reach := r.regionQuery(q)
+		if len(reach) >= r.minPoints {
+			r.roles[q] = RoleCore
+			added := 0
+			for _, p := range reach {
+				if r.assignments[p] == labelUnassigned || r.assignments[p] == labelNoise {
+					queue = append(queue, p)
+					added++
+				}
+			}
+			r.tracker.AddStep(fmt.Sprintf("点 %s 加入簇 C%d，邻域内有 %d 个点，是核心点，继续扩展 %d 个点",
+				algorithms.PointName(r.points, q), cluster, len(reach), added), r.state(q, reach, nil), []int{q})
+		} else {
+			r.roles[q] = RoleBorder
+			r.tracker.AddStep(fmt.Sprintf("点 %s 加入簇 C%d，邻域内只有 %d 个点，是边界点", algorithms.PointName(r.points, q), cluster, len(reach)),
+				r.state(q, reach, nil), []int{q})
+		}
+		r.tracker.AddOperation(models.OpTypeAssign, []int{q}, []interface{}{cluster}, "加入簇")
+	}
+
+	size := 0
+	for _, c := range r.assignments {
+		if c == cluster {
+			size++
+		}
+	}
+	state := r.state(-1, nil, nil)
+	r.tracker.AddStep(fmt.Sprintf("簇 C%d 扩展完成，共 %d 个点，当前惯性 %.4f", cluster, size, state.Inertia), state, []int{})
+}
+
+// regionQuery 返回点 i 的 eps 邻域（含自身），按下标升序
+func (r *dbscanRun) regionQuery(i int) []int {
+	r.queries++
+	eps2 := r.eps * r.eps
+	neighbors := make([]int, 0)
+	for j := range r.points {
+		r.distances++
+		if algorithms.DistanceSquared(r.points[i], r.points[j]) <= eps2 {
+			neighbors = append(neighbors, j)
+		}
+	}
+	return neighbors
+}
+
+// state 当前的步骤快照，簇中心与惯性只统计已形成的簇
+func (r *dbscanRun) state(current int, neighbors []int, points []models.Point2D) clusterState {
+	centroids, _ := computeCentroids(r.points, r.assignments, r.clusters)
+	return clusterState{
+		Points:      points,
+		Centroids:   centroids,
+		Assignments: append([]int{}, r.assignments...),
+		Inertia:     algorithms.Round4(inertia(r.points, r.assignments, centroids)),
+		Roles:       append([]string{}, r.roles...),
+		Current:     current,
+		Neighbors:   neighbors,
+		Eps:         r.eps,
+	}
+}
+
+// ValidateInput 验证点集输入
+func (db *DBSCAN) ValidateInput(data interface{}) error {
+	return validateClusterInput(data)
+}
+
+// GetComplexity 获取复杂度信息
+func (db *DBSCAN) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(n²)", // 每个点做一次线性扫描的邻域查询；使用空间索引可降到 O(n log n)
+			Average: "O(n²)",
+			Worst:   "O(n²)",
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(n)",
+			Average: "O(n)",
+			Worst:   "O(n)",
+		},
+	}
+}
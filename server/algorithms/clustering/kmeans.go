@@ -0,0 +1,394 @@
+package clustering
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// k-means 的初始化方式
+const (
+	InitKMeansPlusPlus = "kmeans++"
+	InitRandom         = "random"
+)
+
+// KMeans k-means 聚类（Lloyd 迭代）
+type KMeans struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewKMeans 创建k-means实例
+func NewKMeans() *KMeans {
+	return &KMeans{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "kmeans",
+			Name:            "k-means聚类",
+			Category:        models.CategoryClustering,
+			Description:     "Lloyd 迭代：分配步骤将每个点归入最近的簇中心，更新步骤将簇中心移到簇内点的均值，两步交替直到簇中心的最大移动不超过容差。k-means++ 初始化依次以与已选中心距离平方成正比的概率选取新中心，通常比随机选点收敛更快、结果更好。惯性（距离平方和）在每一轮都不会增大。",
+			TimeComplexity:  "O(n·k·t)",
+			SpaceComplexity: "O(n + k)",
+			Parameters: []models.Parameter{
+				{
+					Name:         "k",
+					Type:         "int",
+					Description:  "簇的个数，不能超过点数",
+					DefaultValue: 3,
+					Required:     false,
+					Min:          1,
+					Max:          50,
+				},
+				{
+					Name:         "init",
+					Type:         "string",
+					Description:  "初始化方式 (kmeans++: 按距离平方加权选取, random: 随机选取k个点)",
+					DefaultValue: InitKMeansPlusPlus,
+					Required:     false,
+					Options:      []string{InitKMeansPlusPlus, InitRandom},
+				},
+				{
+					Name:         "max_iterations",
+					Type:         "int",
+					Description:  "最大迭代轮数",
+					DefaultValue: 100,
+					Required:     false,
+					Min:          1,
+					Max:          1000,
+				},
+				{
+					Name:         "tolerance",
+					Type:         "float",
+					Description:  "收敛容差，簇中心的最大移动不超过该值时停止",
+					DefaultValue: 1e-4,
+					Required:     false,
+					Min:          0,
+				},
+				{
+					Name:         "seed",
+					Type:         "int",
+					Description:  "随机种子，相同的种子得到相同的结果；为0时使用当前时间",
+					DefaultValue: 0,
+					Required:     false,
+				},
+			},
+		},
+	}
+}
+
+// kmeansRun 一次k-means的执行上下文
+type kmeansRun struct {
+	points      []models.Point2D
+	k           int
+	rng         *rand.Rand
+	tracker     models.StepTracker
+	centroids   []models.Point2D
+	assignments []int
+	distances   int // 距离计算次数
+}
+
+// Execute 使用默认参数执行k-means
+func (km *KMeans) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return km.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 按参数执行k-means
+func (km *KMeans) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	if err := km.ValidateInput(data); err != nil {
+		return nil, err
+	}
+	points, err := algorithms.ToPointSet(data)
+	if err != nil {
+		return nil, err
+	}
+	pts := points.Points
+
+	k := algorithms.IntParam(params, "k", 3)
+	if k < 1 || k > 50 {
+		return nil, fmt.Errorf("k 必须在1到50之间")
+	}
+	if k > len(pts) {
+		return nil, fmt.Errorf("k=%d 超过了点数 %d", k, len(pts))
+	}
+	init := algorithms.OptionParam(params, "init", []string{InitKMeansPlusPlus, InitRandom}, InitKMeansPlusPlus)
+	maxIterations := algorithms.IntParam(params, "max_iterations", 100)
+	if maxIterations < 1 || maxIterations > 1000 {
+		return nil, fmt.Errorf("最大迭代轮数必须在1到1000之间")
+	}
+	tolerance := algorithms.FloatParam(params, "tolerance", 1e-4)
+	if tolerance < 0 || math.IsNaN(tolerance) {
+		return nil, fmt.Errorf("收敛容差不能为负数")
+	}
+	seed := int64(algorithms.IntParam(params, "seed", 0))
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+
+	run := &kmeansRun{points: pts, k: k, rng: rand.New(rand.NewSource(seed)), tracker: tracker}
+	run.assignments = make([]int, len(pts))
+	for i := range run.assignments {
+		run.assignments[i] = labelUnassigned
+	}
+
+	tracker.SetPhase("初始化")
+	tracker.AddStep(fmt.Sprintf("对 %d 个点做 k-means 聚类，k=%d，初始化方式 %s，随机种子 %d", len(pts), k, init, seed),
+		clusterState{Points: pts, Centroids: []models.Point2D{}, Assignments: run.assignments, Current: -1}, []int{})
+	if init == InitRandom {
+		run.initRandom()
+	} else {
+		run.initPlusPlus()
+	}
+
+	inertiaHistory := make([]float64, 0)
+	shiftHistory := make([]float64, 0)
+	changedHistory := make([]int, 0)
+	converged := false
+	iterations := 0
+	tracker.SetPhase("Lloyd迭代")
+	for iterations < maxIterations && !converged {
+		iterations++
+		changed := run.assign(iterations)
+		shift := run.update(iterations, changed)
+		inertiaHistory = append(inertiaHistory, algorithms.Round4(inertia(pts, run.assignments, run.centroids)))
+		shiftHistory = append(shiftHistory, algorithms.Round4(shift))
+		changedHistory = append(changedHistory, len(changed))
+		converged = shift <= tolerance
+	}
+
+	_, sizes := computeCentroids(pts, run.assignments, k)
+	finalInertia := inertia(pts, run.assignments, run.centroids)
+	tracker.SetPhase("完成")
+	status := "已收敛"
+	if !converged {
+		status = fmt.Sprintf("达到最大迭代轮数 %d 仍未收敛", maxIterations)
+	}
+	tracker.AddStep(fmt.Sprintf("k-means 结束：共迭代 %d 轮，%s，惯性 %.4f", iterations, status, finalInertia),
+		clusterState{Points: pts, Centroids: run.centroids, Assignments: run.assignments, Inertia: algorithms.Round4(finalInertia),
+			Iteration: iterations, Shift: shiftHistory[len(shiftHistory)-1], Current: -1}, []int{})
+
+	return map[string]interface{}{
+		"centroids":            run.centroids,
+		"assignments":          run.assignments,
+		"clusterSizes":         sizes,
+		"k":                    k,
+		"init":                 init,
+		"seed":                 seed,
+		"inertia":              algorithms.Round4(finalInertia),
+		"iterations":           iterations,
+		"converged":            converged,
+		"tolerance":            tolerance,
+		"inertiaHistory":       inertiaHistory,
+		"shiftHistory":         shiftHistory,
+		"changedHistory":       changedHistory,
+		"distanceComputations": run.distances,
+		"pointCount":           len(pts),
+	}, nil
+}
+
+// ProcessPoints 处理点集
+func (km *KMeans) ProcessPoints(points *models.PointSetData, tracker models.StepTracker) (interface{}, error) {
+	return km.Execute(points, tracker)
+}
+
+// initRandom 不放回地随机选取 k 个点作为初始中心
+func (r *kmeansRun) initRandom() {
+	chosen := r.rng.Perm(len(r.points))[:r.k]
+	for c, i := range chosen {
+		r.centroids = append(r.centroids, centroidPoint(c, r.points[i].X, r.points[i].Y))
+		r.tracker.AddStep(fmt.Sprintf("随机选取点 %s 作为簇 C%d 的初始中心", algorithms.PointName(r.points, i), c),
+			r.state(0, 0, nil, i), []int{i})
+		r.tracker.AddOperation(models.OpTypeInsert, []int{i}, []interface{}{c}, "选取初始中心")
+	}
+}
+
+// initPlusPlus k-means++：第一个中心均匀选取，之后按到最近已选中心的距离平方加权抽样
+func (r *kmeansRun) initPlusPlus() {
+	first := r.rng.Intn(len(r.points))
+	r.centroids = append(r.centroids, centroidPoint(0, r.points[first].X, r.points[first].Y))
+	r.tracker.AddStep(fmt.Sprintf("k-means++：均匀随机选取点 %s 作为簇 C0 的初始中心", algorithms.PointName(r.points, first)),
+		r.state(0, 0, nil, first), []int{first})
+	r.tracker.AddOperation(models.OpTypeInsert, []int{first}, []interface{}{0}, "选取初始中心")
+
+	// nearest[i] 为点 i 到最近已选中心的距离平方
+	nearest := make([]float64, len(r.points))
+	for i := range nearest {
+		nearest[i] = r.distance(i, r.centroids[0])
+	}
+	for c := 1; c < r.k; c++ {
+		total := 0.0
+		for _, d := range nearest {
+			total += d
+		}
+		chosen := -1
+		if total > 0 {
+			target := r.rng.Float64() * total
+			for i, d := range nearest {
+				target -= d
+				if d > 0 && target < 0 {
+					chosen = i
+					break
+				}
+			}
+			if chosen < 0 {
+				// 浮点误差导致没有选中时取最后一个距离为正的点
+				for i := len(nearest) - 1; i >= 0 && chosen < 0; i-- {
+					if nearest[i] > 0 {
+						chosen = i
+					}
+				}
+			}
+		} else {
+			// 剩余的点都与已选中心重合，只能随机选取
+			chosen = r.rng.Intn(len(r.points))
+		}
+
+		probability := 0.0
+		if total > 0 {
+			probability = nearest[chosen] / total
+		}
+		r.centroids = append(r.centroids, centroidPoint(c, r.points[chosen].X, r.points[chosen].Y))
+		r.tracker.AddStep(fmt.Sprintf("k-means++：点 %s 到最近中心的距离平方为 %.4g，以概率 %.2f%% 被选为簇 C%d 的初始中心",
+			algorithms.PointName(r.points, chosen), nearest[chosen], probability*100, c), r.state(0, 0, nil, chosen), []int{chosen})
+		r.tracker.AddOperation(models.OpTypeInsert, []int{chosen}, []interface{}{c, algorithms.Round4(probability)}, "选取初始中心")
+
+		for i := range nearest {
+			if d := r.distance(i, r.centroids[c]); d < nearest[i] {
+				nearest[i] = d
+			}
+		}
+	}
+}
+
+// assign 分配步骤：每个点归入最近的簇中心（距离相同时取编号较小的簇），返回改变归属的点
+func (r *kmeansRun) assign(iteration int) []int {
+	changed := make([]int, 0)
+	next := make([]int, len(r.points))
+	for i := range r.points {
+		best, bestDist := 0, r.distance(i, r.centroids[0])
+		for c := 1; c < r.k; c++ {
+			if d := r.distance(i, r.centroids[c]); d < bestDist {
+				best, bestDist = c, d
+			}
+		}
+		next[i] = best
+		if r.assignments[i] != best {
+			changed = append(changed, i)
+		}
+	}
+	r.assignments = next
+
+	r.tracker.AddStep(fmt.Sprintf("第 %d 轮分配：每个点归入最近的簇中心，%d 个点改变了所属簇，惯性 %.4f",
+		iteration, len(changed), inertia(r.points, r.assignments, r.centroids)), r.state(iteration, 0, changed, -1), changed)
+	if len(changed) > 0 {
+		values := make([]interface{}, len(changed))
+		for j, i := range changed {
+			values[j] = r.assignments[i]
+		}
+		r.tracker.AddOperation(models.OpTypeAssign, changed, values, "改变所属簇")
+	}
+	return changed
+}
+
+// update 更新步骤：簇中心移到簇内点的均值，返回簇中心的最大移动距离
+func (r *kmeansRun) update(iteration int, changed []int) float64 {
+	next, sizes := computeCentroids(r.points, r.assignments, r.k)
+	relocated := make([]int, 0)
+	for c := range next {
+		if sizes[c] > 0 {
+			continue
+		}
+		// 空簇：把离所属中心最远的点移入该簇，保证始终有 k 个非空簇
+		far, farDist := -1, -1.0
+		for i, owner := range r.assignments {
+			if sizes[owner] > 1 {
+				if d := algorithms.DistanceSquared(r.points[i], next[owner]); d > farDist {
+					far, farDist = i, d
+				}
+			}
+		}
+		owner := r.assignments[far]
+		sizes[owner]--
+		sizes[c] = 1
+		r.assignments[far] = c
+		relocated = append(relocated, far)
+		next[c] = centroidPoint(c, r.points[far].X, r.points[far].Y)
+		next[owner] = r.mean(owner)
+	}
+
+	shift := 0.0
+	for c := range next {
+		if d := math.Sqrt(algorithms.DistanceSquared(next[c], r.centroids[c])); d > shift {
+			shift = d
+		}
+	}
+	r.centroids = next
+
+	description := fmt.Sprintf("第 %d 轮更新：簇中心移到簇内点的均值，最大移动 %.4f，惯性 %.4f",
+		iteration, shift, inertia(r.points, r.assignments, r.centroids))
+	if len(relocated) > 0 {
+		description += fmt.Sprintf("；%d 个空簇以离中心最远的点重新初始化", len(relocated))
+	}
+	r.tracker.AddStep(description, r.state(iteration, shift, changed, -1), relocated)
+	moved := make([]interface{}, len(next))
+	for c, p := range next {
+		moved[c] = []float64{algorithms.Round4(p.X), algorithms.Round4(p.Y)}
+	}
+	r.tracker.AddOperation(models.OpTypeUpdate, []int{}, moved, "更新簇中心")
+	return shift
+}
+
+// mean 簇 c 中点的均值
+func (r *kmeansRun) mean(c int) models.Point2D {
+	x, y, n := 0.0, 0.0, 0
+	for i, owner := range r.assignments {
+		if owner == c {
+			x += r.points[i].X
+			y += r.points[i].Y
+			n++
+		}
+	}
+	return centroidPoint(c, x/float64(n), y/float64(n))
+}
+
+// distance 点 i 到中心的距离平方
+func (r *kmeansRun) distance(i int, centroid models.Point2D) float64 {
+	r.distances++
+	return algorithms.DistanceSquared(r.points[i], centroid)
+}
+
+// state 当前的步骤快照
+func (r *kmeansRun) state(iteration int, shift float64, changed []int, current int) clusterState {
+	centroids := append([]models.Point2D{}, r.centroids...)
+	assignments := append([]int{}, r.assignments...)
+	return clusterState{
+		Centroids:   centroids,
+		Assignments: assignments,
+		Inertia:     algorithms.Round4(inertia(r.points, r.assignments, r.centroids)),
+		Iteration:   iteration,
+		Shift:       algorithms.Round4(shift),
+		Changed:     changed,
+		Current:     current,
+	}
+}
+
+// ValidateInput 验证点集输入
+func (km *KMeans) ValidateInput(data interface{}) error {
+	return validateClusterInput(data)
+}
+
+// GetComplexity 获取复杂度信息
+func (km *KMeans) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(n·k)", // 一轮即收敛
+			Average: "O(n·k·t)",
+			Worst:   "2^Ω(√n)", // 最坏情况下 Lloyd 迭代轮数可达超多项式
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(n + k)",
+			Average: "O(n + k)",
+			Worst:   "O(n + k)",
+		},
+	}
+}
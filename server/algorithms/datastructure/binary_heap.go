@@ -0,0 +1,424 @@
+package datastructure
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"strconv"
+	"strings"
+)
+
+// maxHeapSize 堆中元素数量上限，每个步骤都保存数组和树的快照
+const maxHeapSize = 512
+
+// BinaryHeap 二叉堆/优先队列算法
+type BinaryHeap struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewBinaryHeap 创建二叉堆算法实例
+func NewBinaryHeap() *BinaryHeap {
+	return &BinaryHeap{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "binary_heap",
+			Name:            "二叉堆（优先队列）",
+			Category:        models.CategoryDataStructure,
+			Description:     "按脚本依次执行 insert、extract、peek、decrease_key、build_heap 等优先队列操作。每次上浮或下沉的比较都会同时给出数组视图和完全二叉树视图。",
+			TimeComplexity:  "O(log n) 每次操作",
+			SpaceComplexity: "O(n)",
+			Parameters: []models.Parameter{
+				{
+					Name:         "heap_type",
+					Type:         "string",
+					Description:  "堆类型：min 为最小堆，max 为最大堆",
+					DefaultValue: "min",
+					Required:     false,
+					Options:      []string{"min", "max"},
+				},
+			},
+		},
+	}
+}
+
+// heapRun 一次堆脚本执行
+type heapRun struct {
+	items   []HeapItem
+	kind    string
+	nextID  int
+	tracker models.StepTracker
+
+	comparisons int
+	swaps       int
+}
+
+// heapResult 单个操作的结果
+type heapResult struct {
+	Operation string      `json:"operation"`
+	Result    interface{} `json:"result"`
+	Size      int         `json:"size"` // 操作后堆的大小
+}
+
+// Execute 使用默认参数执行堆脚本
+func (bh *BinaryHeap) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return bh.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 按参数执行堆脚本
+func (bh *BinaryHeap) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	s, err := parseScript(data)
+	if err != nil {
+		return nil, err
+	}
+
+	run := &heapRun{
+		items:   make([]HeapItem, 0),
+		kind:    algorithms.OptionParam(params, "heap_type", []string{"min", "max"}, "min"),
+		nextID:  1,
+		tracker: tracker,
+	}
+
+	tracker.SetPhase("初始化")
+	tracker.AddStep("创建空的"+run.kindName(), run.snapshot(), []int{})
+
+	// 附加字段 values 作为初始元素，自底向上建堆
+	if values, ok := s.fields["values"].([]interface{}); ok && len(values) > 0 {
+		if err := run.buildHeap(values); err != nil {
+			return nil, err
+		}
+	}
+
+	results := make([]heapResult, 0, len(s.commands))
+	for _, cmd := range s.commands {
+		result, err := run.apply(cmd)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, heapResult{Operation: cmd.String(), Result: result, Size: len(run.items)})
+	}
+
+	tracker.SetPhase("完成")
+	tracker.AddStep(fmt.Sprintf("脚本执行完成，堆中剩余 %d 个元素", len(run.items)), run.snapshot(), []int{})
+
+	final := make([]HeapItem, len(run.items))
+	copy(final, run.items)
+	return map[string]interface{}{
+		"results":     results,
+		"heap":        final,
+		"size":        len(final),
+		"heapType":    run.kind,
+		"comparisons": run.comparisons,
+		"swaps":       run.swaps,
+	}, nil
+}
+
+// apply 执行一条命令
+func (h *heapRun) apply(cmd command) (interface{}, error) {
+	op := strings.ReplaceAll(cmd.Op, "-", "_")
+	h.tracker.SetPhase(cmd.String())
+
+	switch op {
+	case "insert", "push":
+		if len(cmd.Args) != 1 && len(cmd.Args) != 2 {
+			return nil, fmt.Errorf("%s 需要键值和可选的元素标识", cmd.Op)
+		}
+		id := ""
+		if len(cmd.Args) == 2 {
+			id = fmt.Sprintf("%v", cmd.Args[1])
+		}
+		item, err := h.insert(cmd.Args[0], id)
+		if err != nil {
+			return nil, err
+		}
+		return item, nil
+
+	case "extract", "pop", "extract_min", "extract_max":
+		if op != "extract" && op != "pop" && op != "extract_"+h.kind {
+			return nil, fmt.Errorf("%s 不适用于%s", cmd.Op, h.kindName())
+		}
+		return h.extract(), nil
+
+	case "peek", "top", "min", "max":
+		if op != "peek" && op != "top" && op != h.kind {
+			return nil, fmt.Errorf("%s 不适用于%s", cmd.Op, h.kindName())
+		}
+		if len(h.items) == 0 {
+			h.tracker.AddStep("堆为空，没有堆顶元素", h.snapshot(), []int{})
+			return nil, nil
+		}
+		h.tracker.AddStep(fmt.Sprintf("堆顶元素为 %s（键值 %v）", h.items[0].ID, h.items[0].Key), h.snapshot(0), []int{0})
+		h.tracker.AddOperation(models.OpTypeAccess, []int{0}, []interface{}{h.items[0].Key}, "读取堆顶")
+		return h.items[0], nil
+
+	case "decrease_key", "increase_key", "update_key":
+		if err := cmd.expect(2); err != nil {
+			return nil, err
+		}
+		return h.changeKey(op, fmt.Sprintf("%v", cmd.Args[0]), cmd.Args[1])
+
+	case "build_heap", "build", "heapify":
+		if err := h.buildHeap(cmd.Args); err != nil {
+			return nil, err
+		}
+		return len(h.items), nil
+	}
+
+	return nil, fmt.Errorf("不支持的操作: %s", cmd.Op)
+}
+
+// insert 在末尾插入元素后上浮
+func (h *heapRun) insert(key interface{}, id string) (HeapItem, error) {
+	if len(h.items) >= maxHeapSize {
+		return HeapItem{}, fmt.Errorf("堆中元素不能超过%d个", maxHeapSize)
+	}
+	if id == "" {
+		id = h.newID()
+	} else if h.indexOf(id) >= 0 {
+		return HeapItem{}, fmt.Errorf("元素标识 %s 已存在", id)
+	}
+
+	item := HeapItem{ID: id, Key: key}
+	h.items = append(h.items, item)
+	last := len(h.items) - 1
+	h.tracker.AddStep(fmt.Sprintf("插入 %s（键值 %v）到末尾位置 %d", id, key, last), h.snapshot(last), []int{last})
+	h.tracker.AddOperation(models.OpTypeInsert, []int{last}, []interface{}{key}, "追加到数组末尾")
+
+	h.siftUp(last)
+	return item, nil
+}
+
+// extract 取出堆顶：与末尾元素交换后删除，再从根下沉
+func (h *heapRun) extract() interface{} {
+	if len(h.items) == 0 {
+		h.tracker.AddStep("堆为空，无法取出元素", h.snapshot(), []int{})
+		return nil
+	}
+
+	top := h.items[0]
+	last := len(h.items) - 1
+	h.tracker.AddStep(fmt.Sprintf("取出堆顶 %s（键值 %v），用末尾元素 %s 替换根", top.ID, top.Key, h.items[last].ID),
+		h.snapshot(0, last), []int{0, last})
+	if last > 0 {
+		h.swap(0, last)
+	}
+	h.items = h.items[:last]
+	h.tracker.AddOperation(models.OpTypeDelete, []int{last}, []interface{}{top.Key}, "删除原堆顶")
+
+	if len(h.items) > 0 {
+		h.siftDown(0)
+	}
+	return top
+}
+
+// changeKey 修改元素的键值，根据新键值上浮或下沉
+func (h *heapRun) changeKey(op, id string, key interface{}) (interface{}, error) {
+	i := h.indexOf(id)
+	if i < 0 {
+		return nil, fmt.Errorf("元素 %s 不在堆中", id)
+	}
+
+	order := compareKeys(key, h.items[i].Key)
+	switch {
+	case op == "decrease_key" && order > 0:
+		return nil, fmt.Errorf("decrease_key 的新键值 %v 大于当前键值 %v", key, h.items[i].Key)
+	case op == "increase_key" && order < 0:
+		return nil, fmt.Errorf("increase_key 的新键值 %v 小于当前键值 %v", key, h.items[i].Key)
+	}
+
+	old := h.items[i].Key
+	h.items[i].Key = key
+	h.tracker.AddStep(fmt.Sprintf("将 %s 的键值从 %v 改为 %v", id, old, key), h.snapshot(i), []int{i})
+	h.tracker.AddOperation(models.OpTypeUpdate, []int{i}, []interface{}{old, key}, "修改键值")
+
+	// 优先级提高则上浮，否则下沉
+	if (h.kind == "min") == (order < 0) {
+		i = h.siftUp(i)
+	} else {
+		i = h.siftDown(i)
+	}
+	return h.items[i], nil
+}
+
+// buildHeap 用给定键值替换堆内容，从最后一个非叶子节点开始依次下沉（Floyd建堆）
+func (h *heapRun) buildHeap(keys []interface{}) error {
+	if len(keys) > maxHeapSize {
+		return fmt.Errorf("堆中元素不能超过%d个", maxHeapSize)
+	}
+
+	h.items = make([]HeapItem, len(keys))
+	for i, key := range keys {
+		h.items[i] = HeapItem{ID: h.newID(), Key: key}
+	}
+
+	h.tracker.SetPhase("建堆")
+	h.tracker.AddStep(fmt.Sprintf("按原顺序放入 %d 个元素，从最后一个非叶子节点开始自底向上下沉", len(keys)), h.snapshot(), []int{})
+	for i := len(h.items)/2 - 1; i >= 0; i-- {
+		h.siftDown(i)
+	}
+	h.tracker.AddStep("建堆完成", h.snapshot(), []int{})
+	return nil
+}
+
+// siftUp 上浮，返回元素最终位置
+func (h *heapRun) siftUp(i int) int {
+	for i > 0 {
+		parent := (i - 1) / 2
+		h.tracker.AddStep(fmt.Sprintf("上浮: 比较位置 %d（%v）与父节点 %d（%v）", i, h.items[i].Key, parent, h.items[parent].Key),
+			h.snapshot(i, parent), []int{i, parent})
+		if !h.before(i, parent) {
+			h.tracker.AddNote("父节点优先级不低于当前元素，上浮结束")
+			break
+		}
+		h.swap(i, parent)
+		i = parent
+	}
+	return i
+}
+
+// siftDown 下沉，返回元素最终位置
+func (h *heapRun) siftDown(i int) int {
+	n := len(h.items)
+	for {
+		best := i
+		left, right := 2*i+1, 2*i+2
+		if left >= n {
+			break
+		}
+
+		h.tracker.AddStep(fmt.Sprintf("下沉: 在位置 %d（%v）及其子节点中选出优先级最高者", i, h.items[i].Key),
+			h.snapshot(childrenOf(i, n)...), childrenOf(i, n))
+		if h.before(left, best) {
+			best = left
+		}
+		if right < n && h.before(right, best) {
+			best = right
+		}
+		if best == i {
+			h.tracker.AddNote("当前元素优先级不低于子节点，下沉结束")
+			break
+		}
+		h.swap(i, best)
+		i = best
+	}
+	return i
+}
+
+// before 下标i的元素是否应该排在下标j的元素之前，记录比较
+func (h *heapRun) before(i, j int) bool {
+	result := compareKeys(h.items[i].Key, h.items[j].Key)
+	h.tracker.AddComparison(i, j, result)
+	h.comparisons++
+	if h.kind == "max" {
+		return result > 0
+	}
+	return result < 0
+}
+
+// swap 交换两个位置的元素
+func (h *heapRun) swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+	h.swaps++
+	h.tracker.AddOperation(models.OpTypeSwap, []int{i, j}, []interface{}{h.items[i].Key, h.items[j].Key}, "交换")
+}
+
+// indexOf 按标识查找元素位置
+func (h *heapRun) indexOf(id string) int {
+	for i, item := range h.items {
+		if item.ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// newID 生成未被占用的元素标识
+func (h *heapRun) newID() string {
+	for {
+		id := strconv.Itoa(h.nextID)
+		h.nextID++
+		if h.indexOf(id) < 0 {
+			return id
+		}
+	}
+}
+
+// snapshot 当前堆的快照
+func (h *heapRun) snapshot(active ...int) *HeapSnapshot {
+	return NewHeapSnapshot(h.kind, h.items, active...)
+}
+
+// kindName 堆类型名称
+func (h *heapRun) kindName() string {
+	if h.kind == "max" {
+		return "最大堆"
+	}
+	return "最小堆"
+}
+
+// childrenOf 节点及其存在的子节点下标
+func childrenOf(i, n int) []int {
+	indices := []int{i}
+	for _, child := range []int{2*i + 1, 2*i + 2} {
+		if child < n {
+			indices = append(indices, child)
+		}
+	}
+	return indices
+}
+
+// compareKeys 比较两个键值，数值按大小比较，其余按字符串比较
+func compareKeys(a, b interface{}) int {
+	x, okA := numericKey(a)
+	y, okB := numericKey(b)
+	if !okA || !okB {
+		return strings.Compare(fmt.Sprintf("%v", a), fmt.Sprintf("%v", b))
+	}
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
+
+// numericKey 将数值类型的键转换为float64
+func numericKey(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	}
+	return 0, false
+}
+
+// ValidateInput 验证输入数据
+func (bh *BinaryHeap) ValidateInput(data interface{}) error {
+	if data == nil {
+		return algorithms.ErrInvalidInput
+	}
+	if _, err := parseScript(data); err != nil {
+		return algorithms.ErrInvalidInput
+	}
+	return nil
+}
+
+// GetComplexity 获取复杂度信息
+func (bh *BinaryHeap) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(1)",     // peek，或插入的元素不需要上浮
+			Average: "O(log n)", // build_heap 为 O(n)
+			Worst:   "O(log n)",
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(n)",
+			Average: "O(n)",
+			Worst:   "O(n)",
+		},
+	}
+}
@@ -0,0 +1,104 @@
+package datastructure
+
+import (
+	"gin/models"
+	"testing"
+)
+
+func TestBinaryHeap_Script(t *testing.T) {
+	script := []interface{}{
+		"insert 5 A", "insert 3 B", "insert 8 C", "insert 1 D",
+		"peek",
+		"decrease_key C 0",
+		"extract_min",
+		"extract_min",
+		"update_key A 9",
+		"extract",
+	}
+
+	tracker := models.NewStepTracker()
+	result, err := NewBinaryHeap().Execute(script, tracker)
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	output := result.(map[string]interface{})
+	results := output["results"].([]heapResult)
+	expected := map[int]string{4: "D", 6: "C", 7: "D", 9: "B"}
+	for i, id := range expected {
+		item, ok := results[i].Result.(HeapItem)
+		if !ok || item.ID != id {
+			t.Errorf("%s = %v, expected %s", results[i].Operation, results[i].Result, id)
+		}
+	}
+
+	heapArray := output["heap"].([]HeapItem)
+	if len(heapArray) != 1 || heapArray[0].ID != "A" {
+		t.Errorf("remaining heap = %v, expected [A]", heapArray)
+	}
+
+	// 每个步骤同时提供数组视图和树视图
+	for _, step := range tracker.GetSteps() {
+		snapshot, ok := step.Data.(*HeapSnapshot)
+		if !ok {
+			t.Fatalf("step %d data is %T", step.StepID, step.Data)
+		}
+		if len(snapshot.Array) > 0 && (snapshot.Tree.Root == nil || snapshot.Tree.Root.ID != "0") {
+			t.Fatalf("step %d tree root does not match array", step.StepID)
+		}
+	}
+}
+
+func TestBinaryHeap_BuildHeap(t *testing.T) {
+	input := map[string]interface{}{
+		"values":     []interface{}{3.0, 9.0, 2.0, 7.0, 5.0, 1.0, 8.0},
+		"operations": []interface{}{"extract_max", "extract_max", "insert 10", "extract_max"},
+	}
+	result, err := NewBinaryHeap().ExecuteWithParams(input, map[string]interface{}{"heap_type": "max"}, models.NewStepTracker())
+	if err != nil {
+		t.Fatalf("ExecuteWithParams() error = %v", err)
+	}
+
+	output := result.(map[string]interface{})
+	results := output["results"].([]heapResult)
+	for i, key := range map[int]float64{0: 9, 1: 8, 3: 10} {
+		if item := results[i].Result.(HeapItem); item.Key != key {
+			t.Errorf("%s = %v, expected %v", results[i].Operation, item.Key, key)
+		}
+	}
+
+	// 剩余元素满足最大堆性质
+	heapArray := output["heap"].([]HeapItem)
+	for i := 1; i < len(heapArray); i++ {
+		if compareKeys(heapArray[(i-1)/2].Key, heapArray[i].Key) < 0 {
+			t.Fatalf("heap property violated at %d: %v", i, heapArray)
+		}
+	}
+}
+
+func TestBinaryHeap_InvalidOperations(t *testing.T) {
+	bh := NewBinaryHeap()
+	tests := map[string][]interface{}{
+		"Wrong extract kind":    {"insert 1", "extract_max"},
+		"Decrease to larger":    {"insert 1 A", "decrease_key A 5"},
+		"Unknown element":       {"decrease_key X 1"},
+		"Duplicate identifier":  {"insert 1 A", "insert 2 A"},
+		"Unsupported operation": {"merge"},
+	}
+	for name, script := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := bh.Execute(script, models.NewStepTracker()); err == nil {
+				t.Error("Execute() should fail")
+			}
+		})
+	}
+
+	// 空堆取出返回nil而不是错误
+	result, err := bh.Execute([]interface{}{"extract"}, models.NewStepTracker())
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if r := result.(map[string]interface{})["results"].([]heapResult)[0].Result; r != nil {
+		t.Errorf("extract on empty heap = %v, expected nil", r)
+	}
+}
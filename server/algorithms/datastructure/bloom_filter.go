@@ -0,0 +1,250 @@
+package datastructure
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"math"
+)
+
+// 布隆过滤器位数组大小与哈希函数个数上限
+const (
+	maxBloomBits   = 2048
+	maxBloomHashes = 16
+	bloomProbes    = 1000 // 没有不存在元素的查询时，用于估计假阳性率的探测元素个数
+)
+
+// BloomFilter 布隆过滤器
+type BloomFilter struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewBloomFilter 创建布隆过滤器实例
+func NewBloomFilter() *BloomFilter {
+	return &BloomFilter{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "bloom_filter",
+			Name:            "布隆过滤器",
+			Category:        models.CategoryDataStructure,
+			Description:     "用 m 位的位数组和 k 个哈希函数表示集合：插入时把 k 个位置置1，查询时只要有一个位置为0就一定不存在，全部为1则可能存在。没有假阴性，但可能出现假阳性。查询结果与精确集合对照，统计实际假阳性率并与理论值 (1-e^(-kn/m))^k 比较；查询中没有不存在的元素时，另取未插入的探测元素估计实际假阳性率。",
+			TimeComplexity:  "O(k) 每次操作",
+			SpaceComplexity: "O(m)",
+			Parameters: []models.Parameter{
+				{
+					Name:         "size",
+					Type:         "int",
+					Description:  "位数组大小 m",
+					DefaultValue: 64,
+					Required:     false,
+					Min:          8,
+					Max:          maxBloomBits,
+				},
+				{
+					Name:         "hashes",
+					Type:         "int",
+					Description:  "哈希函数个数 k",
+					DefaultValue: 3,
+					Required:     false,
+					Min:          1,
+					Max:          maxBloomHashes,
+				},
+				streamQueriesParameter("插入完成后要查询是否存在的元素，多个元素以逗号分隔"),
+			},
+		},
+	}
+}
+
+// bloomState 布隆过滤器步骤快照
+type bloomState struct {
+	Bits      string `json:"bits"`             // 位数组，'1' 表示已置位
+	Item      string `json:"item,omitempty"`   // 当前插入或查询的元素
+	Positions []int  `json:"positions"`        // 当前元素的 k 个哈希位置
+	Inserted  int    `json:"inserted"`         // 已插入的元素个数（含重复）
+	SetBits   int    `json:"setBits"`          // 已置位的位数
+	Answer    string `json:"answer,omitempty"` // 查询结果
+}
+
+// BloomQuery 布隆过滤器的一次成员查询
+type BloomQuery struct {
+	Item          string `json:"item"`
+	Positions     []int  `json:"positions"`
+	MaybePresent  bool   `json:"maybePresent"`  // 过滤器的回答
+	Present       bool   `json:"present"`       // 精确集合中是否存在
+	FalsePositive bool   `json:"falsePositive"` // 是否为假阳性
+}
+
+// Execute 使用默认参数执行布隆过滤器
+func (bf *BloomFilter) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return bf.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 依次插入元素流，再回答成员查询
+func (bf *BloomFilter) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	input, err := parseStreamInput(data, params)
+	if err != nil {
+		return nil, err
+	}
+	m := algorithms.IntParam(params, "size", 64)
+	k := algorithms.IntParam(params, "hashes", 3)
+	if m < 8 || m > maxBloomBits {
+		return nil, fmt.Errorf("位数组大小必须在8到%d之间", maxBloomBits)
+	}
+	if k < 1 || k > maxBloomHashes {
+		return nil, fmt.Errorf("哈希函数个数必须在1到%d之间", maxBloomHashes)
+	}
+
+	bits := make([]byte, m)
+	for i := range bits {
+		bits[i] = '0'
+	}
+	setBits := 0
+	state := func(item string, positions []int, inserted int, answer string) bloomState {
+		return bloomState{Bits: string(bits), Item: item, Positions: positions, Inserted: inserted, SetBits: setBits, Answer: answer}
+	}
+
+	tracker.SetPhase("初始化")
+	tracker.AddStep(fmt.Sprintf("创建 %d 位的位数组，使用 %d 个哈希函数", m, k), state("", []int{}, 0, ""), []int{})
+
+	tracker.SetPhase("插入")
+	for i, item := range input.items {
+		positions := hashPositions(item, k, m)
+		changed := make([]int, 0, k)
+		for _, p := range positions {
+			if bits[p] == '0' {
+				bits[p] = '1'
+				setBits++
+				changed = append(changed, p)
+			}
+		}
+		description := fmt.Sprintf("插入 %q：哈希位置 %v，新置位 %d 个", item, positions, len(changed))
+		if len(changed) == 0 {
+			description = fmt.Sprintf("插入 %q：哈希位置 %v 都已为1，位数组不变", item, positions)
+		}
+		tracker.AddStep(description, state(item, positions, i+1, ""), positions)
+		if len(changed) > 0 {
+			tracker.AddOperation(models.OpTypeUpdate, changed, []interface{}{item}, "置位")
+		}
+	}
+
+	present := exactCounts(input.items)
+	queries := make([]BloomQuery, 0, len(input.queries))
+	negatives, falsePositives := 0, 0
+	if len(input.queries) > 0 {
+		tracker.SetPhase("查询")
+	}
+	for _, item := range input.queries {
+		positions := hashPositions(item, k, m)
+		zero := -1
+		for _, p := range positions {
+			if bits[p] == '0' {
+				zero = p
+				break
+			}
+		}
+		q := BloomQuery{Item: item, Positions: positions, MaybePresent: zero < 0, Present: present[item] > 0}
+		q.FalsePositive = q.MaybePresent && !q.Present
+		if !q.Present {
+			negatives++
+			if q.FalsePositive {
+				falsePositives++
+			}
+		}
+		queries = append(queries, q)
+
+		var description, answer string
+		switch {
+		case zero >= 0:
+			answer = "一定不存在"
+			description = fmt.Sprintf("查询 %q：位置 %d 为0，一定不存在", item, zero)
+		case q.FalsePositive:
+			answer = "可能存在（假阳性）"
+			description = fmt.Sprintf("查询 %q：位置 %v 全为1，回答可能存在，但该元素从未插入，是假阳性", item, positions)
+		default:
+			answer = "可能存在"
+			description = fmt.Sprintf("查询 %q：位置 %v 全为1，可能存在（确实已插入）", item, positions)
+		}
+		tracker.AddStep(description, state(item, positions, len(input.items), answer), positions)
+		tracker.AddOperation(models.OpTypeProbe, positions, []interface{}{item}, answer)
+	}
+
+	// 查询中没有不存在的元素时，用未插入的探测元素估计实际假阳性率
+	trials, hits, probes := negatives, falsePositives, 0
+	if negatives == 0 {
+		tracker.SetPhase("探测")
+		probes, hits = probeFalsePositives(bits, present, k)
+		trials = probes
+		tracker.AddStep(fmt.Sprintf("查询中没有不存在的元素，另取 %d 个未插入的探测元素估计假阳性率：假阳性 %d 次", probes, hits),
+			state("", []int{}, len(input.items), ""), []int{})
+	}
+
+	n := len(present)
+	fillRatio := float64(setBits) / float64(m)
+	expected := math.Pow(1-math.Exp(-float64(k)*float64(n)/float64(m)), float64(k))
+	observed := float64(hits) / float64(trials)
+	optimal := int(math.Max(1, math.Round(float64(m)/float64(n)*math.Ln2)))
+
+	tracker.SetPhase("完成")
+	tracker.AddStep(fmt.Sprintf("插入 %d 个不同元素，%d/%d 位为1；%d 个不存在的元素中假阳性 %d 个，实际假阳性率 %.2f%%，理论假阳性率 %.2f%%",
+		n, setBits, m, trials, hits, observed*100, expected*100), state("", []int{}, len(input.items), ""), []int{})
+
+	return map[string]interface{}{
+		"bits":                      string(bits),
+		"size":                      m,
+		"hashes":                    k,
+		"inserted":                  len(input.items),
+		"distinct":                  n,
+		"setBits":                   setBits,
+		"fillRatio":                 algorithms.Round4(fillRatio),
+		"queries":                   queries,
+		"negativeQueries":           negatives,
+		"falsePositives":            falsePositives,
+		"heldOutProbes":             probes,
+		"falsePositiveRate":         algorithms.Round4(observed),
+		"expectedFalsePositiveRate": algorithms.Round4(expected),
+		"optimalHashes":             optimal,
+	}, nil
+}
+
+// probeFalsePositives 查询 bloomProbes 个未插入的元素，返回探测数与其中的假阳性数
+func probeFalsePositives(bits []byte, present map[string]int, k int) (int, int) {
+	probes, hits := 0, 0
+	for i := 0; probes < bloomProbes; i++ {
+		item := fmt.Sprintf("probe#%d", i)
+		if present[item] > 0 {
+			continue
+		}
+		probes++
+		hit := true
+		for _, p := range hashPositions(item, k, len(bits)) {
+			if bits[p] == '0' {
+				hit = false
+				break
+			}
+		}
+		if hit {
+			hits++
+		}
+	}
+	return probes, hits
+}
+
+// ValidateInput 验证输入数据
+func (bf *BloomFilter) ValidateInput(data interface{}) error {
+	return validateStreamInput(data)
+}
+
+// GetComplexity 获取复杂度信息
+func (bf *BloomFilter) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(k)", // 每次插入或查询计算 k 个哈希位置
+			Average: "O(k)",
+			Worst:   "O(k)",
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(m)",
+			Average: "O(m)",
+			Worst:   "O(m)",
+		},
+	}
+}
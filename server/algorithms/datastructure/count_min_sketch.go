@@ -0,0 +1,219 @@
+package datastructure
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"math"
+)
+
+// Count-Min Sketch 计数器表的宽度与深度上限
+const (
+	maxSketchWidth = 128
+	maxSketchDepth = 8
+)
+
+// CountMinSketch Count-Min Sketch 频率估计
+type CountMinSketch struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewCountMinSketch 创建Count-Min Sketch实例
+func NewCountMinSketch() *CountMinSketch {
+	return &CountMinSketch{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "count_min_sketch",
+			Name:            "Count-Min Sketch",
+			Category:        models.CategoryDataStructure,
+			Description:     "用 d 行 w 列的计数器表估计元素出现次数：每行一个哈希函数，插入时每行对应的计数器加1，查询时取 d 个计数器的最小值。估计值不会小于真实值，且以 1-e^(-d) 的概率误差不超过 (e/w)·N。结果与精确计数对照，统计各元素的估计误差。",
+			TimeComplexity:  "O(d) 每次操作",
+			SpaceComplexity: "O(w·d)",
+			Parameters: []models.Parameter{
+				{
+					Name:         "width",
+					Type:         "int",
+					Description:  "每行计数器个数 w",
+					DefaultValue: 16,
+					Required:     false,
+					Min:          2,
+					Max:          maxSketchWidth,
+				},
+				{
+					Name:         "depth",
+					Type:         "int",
+					Description:  "行数 d，即哈希函数个数",
+					DefaultValue: 4,
+					Required:     false,
+					Min:          1,
+					Max:          maxSketchDepth,
+				},
+				streamQueriesParameter("插入完成后要估计出现次数的元素，多个元素以逗号分隔"),
+			},
+		},
+	}
+}
+
+// countMinState Count-Min Sketch 步骤快照
+type countMinState struct {
+	Counters [][]int `json:"counters"`       // 计数器表，counters[r][c]
+	Item     string  `json:"item,omitempty"` // 当前插入或查询的元素
+	Cells    []int   `json:"cells"`          // 当前元素在每一行对应的列
+	Total    int     `json:"total"`          // 已插入的元素个数 N
+	Estimate int     `json:"estimate"`       // 当前元素的估计次数
+	Exact    int     `json:"exact"`          // 当前元素的精确次数
+}
+
+// CountMinQuery Count-Min Sketch 的一次频率查询
+type CountMinQuery struct {
+	Item     string `json:"item"`
+	Cells    []int  `json:"cells"`
+	Estimate int    `json:"estimate"` // 各行计数器的最小值
+	Exact    int    `json:"exact"`    // 精确出现次数
+	Error    int    `json:"error"`    // 估计值 - 精确值
+}
+
+// Execute 使用默认参数执行Count-Min Sketch
+func (cm *CountMinSketch) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return cm.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 依次插入元素流，再回答频率查询
+func (cm *CountMinSketch) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	input, err := parseStreamInput(data, params)
+	if err != nil {
+		return nil, err
+	}
+	w := algorithms.IntParam(params, "width", 16)
+	d := algorithms.IntParam(params, "depth", 4)
+	if w < 2 || w > maxSketchWidth {
+		return nil, fmt.Errorf("宽度必须在2到%d之间", maxSketchWidth)
+	}
+	if d < 1 || d > maxSketchDepth {
+		return nil, fmt.Errorf("深度必须在1到%d之间", maxSketchDepth)
+	}
+
+	counters := make([][]int, d)
+	for r := range counters {
+		counters[r] = make([]int, w)
+	}
+	estimate := func(cells []int) int {
+		least := math.MaxInt32
+		for r, c := range cells {
+			if counters[r][c] < least {
+				least = counters[r][c]
+			}
+		}
+		return least
+	}
+	state := func(item string, cells []int, total, est, exact int) countMinState {
+		snapshot := make([][]int, d)
+		for r := range counters {
+			snapshot[r] = append([]int(nil), counters[r]...)
+		}
+		return countMinState{Counters: snapshot, Item: item, Cells: cells, Total: total, Estimate: est, Exact: exact}
+	}
+	// 高亮下标按行优先展开为 r*w+c
+	flatten := func(cells []int) []int {
+		flat := make([]int, len(cells))
+		for r, c := range cells {
+			flat[r] = r*w + c
+		}
+		return flat
+	}
+
+	tracker.SetPhase("初始化")
+	tracker.AddStep(fmt.Sprintf("创建 %d×%d 的计数器表，每行一个哈希函数", d, w), state("", []int{}, 0, 0, 0), []int{})
+
+	tracker.SetPhase("插入")
+	seen := make(map[string]int)
+	for i, item := range input.items {
+		cells := rowPositions(item, d, w)
+		for r, c := range cells {
+			counters[r][c]++
+		}
+		seen[item]++
+		est := estimate(cells)
+		tracker.AddStep(fmt.Sprintf("插入 %q：各行第 %v 列的计数器加1，当前估计 %d，真实 %d", item, cells, est, seen[item]),
+			state(item, cells, i+1, est, seen[item]), flatten(cells))
+		tracker.AddOperation(models.OpTypeUpdate, flatten(cells), []interface{}{item}, "计数器加1")
+	}
+
+	exact := exactCounts(input.items)
+	queries := make([]CountMinQuery, 0, len(input.queries))
+	if len(input.queries) > 0 {
+		tracker.SetPhase("查询")
+	}
+	for _, item := range input.queries {
+		cells := rowPositions(item, d, w)
+		q := CountMinQuery{Item: item, Cells: cells, Estimate: estimate(cells), Exact: exact[item]}
+		q.Error = q.Estimate - q.Exact
+		queries = append(queries, q)
+
+		description := fmt.Sprintf("查询 %q：取各行计数器的最小值 %d，真实 %d", item, q.Estimate, q.Exact)
+		if q.Error > 0 {
+			description += fmt.Sprintf("，因哈希冲突高估 %d", q.Error)
+		}
+		tracker.AddStep(description, state(item, cells, len(input.items), q.Estimate, q.Exact), flatten(cells))
+		tracker.AddOperation(models.OpTypeProbe, flatten(cells), []interface{}{item}, "取最小值")
+	}
+
+	// 对所有不同元素统计误差，与 (e/w)·N 的误差界比较
+	total := len(input.items)
+	bound := math.E / float64(w) * float64(total)
+	totalError, maxError, within, exactHits := 0, 0, 0, 0
+	for item, count := range exact {
+		e := estimate(rowPositions(item, d, w)) - count
+		totalError += e
+		if e > maxError {
+			maxError = e
+		}
+		if float64(e) <= bound {
+			within++
+		}
+		if e == 0 {
+			exactHits++
+		}
+	}
+	distinct := len(exact)
+	averageError := float64(totalError) / float64(distinct)
+
+	tracker.SetPhase("完成")
+	tracker.AddStep(fmt.Sprintf("插入 %d 个元素（%d 个不同），平均高估 %.2f，最大高估 %d，误差界 (e/w)·N = %.2f",
+		total, distinct, averageError, maxError, bound), state("", []int{}, total, 0, 0), []int{})
+
+	return map[string]interface{}{
+		"counters":        state("", nil, total, 0, 0).Counters,
+		"width":           w,
+		"depth":           d,
+		"total":           total,
+		"distinct":        distinct,
+		"queries":         queries,
+		"averageError":    algorithms.Round4(averageError),
+		"maxError":        maxError,
+		"exactEstimates":  exactHits,
+		"errorBound":      algorithms.Round4(bound),
+		"withinBoundRate": algorithms.Round4(float64(within) / float64(distinct)),
+		"confidence":      algorithms.Round4(1 - math.Exp(-float64(d))),
+	}, nil
+}
+
+// ValidateInput 验证输入数据
+func (cm *CountMinSketch) ValidateInput(data interface{}) error {
+	return validateStreamInput(data)
+}
+
+// GetComplexity 获取复杂度信息
+func (cm *CountMinSketch) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(d)", // 每次插入或查询访问每行一个计数器
+			Average: "O(d)",
+			Worst:   "O(d)",
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(w·d)",
+			Average: "O(w·d)",
+			Worst:   "O(w·d)",
+		},
+	}
+}
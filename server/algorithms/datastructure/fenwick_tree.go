@@ -0,0 +1,276 @@
+package datastructure
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"strconv"
+	"strings"
+)
+
+// maxFenwickTreeSize 树状数组的数组长度上限
+const maxFenwickTreeSize = 512
+
+// FenwickTree 树状数组算法
+type FenwickTree struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewFenwickTree 创建树状数组算法实例
+func NewFenwickTree() *FenwickTree {
+	return &FenwickTree{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "fenwick_tree",
+			Name:            "树状数组",
+			Category:        models.CategoryDataStructure,
+			Description:     "由数组构建树状数组（Fenwick树），然后按脚本执行单点加、单点赋值、前缀和与区间和查询。tree[i] 保存区间 (i-lowbit(i), i] 的和，更新沿 i += lowbit(i) 向上，查询沿 i -= lowbit(i) 向下，每个操作高亮经过的下标。",
+			TimeComplexity:  "O(log n) 每次操作",
+			SpaceComplexity: "O(n)",
+			Parameters: []models.Parameter{
+				operationsParameter("add 2 5; prefix 4; query 1 3; set 0 7"),
+			},
+		},
+	}
+}
+
+// fenwickState 树状数组步骤快照
+type fenwickState struct {
+	Values  []float64        `json:"values"`  // 当前数组（下标从0开始）
+	Tree    []float64        `json:"tree"`    // 树状数组（下标从1开始，tree[0] 不使用）
+	View    *models.TreeData `json:"view"`    // 查询树视图：i 的父节点为 i-lowbit(i)，根为虚拟节点0
+	Touched []int            `json:"touched"` // 当前操作经过的树状数组下标
+}
+
+// fenwickRun 一次树状数组脚本执行
+type fenwickRun struct {
+	values  []float64
+	tree    []float64
+	touched []int
+	pending []models.Operation
+	tracker models.StepTracker
+}
+
+// Execute 使用默认参数执行树状数组脚本
+func (ft *FenwickTree) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return ft.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 按参数构建树状数组并执行脚本
+func (ft *FenwickTree) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	input, err := parseRangeInput(data, params, maxFenwickTreeSize)
+	if err != nil {
+		return nil, err
+	}
+
+	n := len(input.values)
+	run := &fenwickRun{
+		values:  append([]float64{}, input.values...),
+		tree:    make([]float64, n+1),
+		touched: make([]int, 0),
+		tracker: tracker,
+	}
+
+	// 线性建树：每个位置把自己的和累加给 i+lowbit(i)
+	tracker.SetPhase("构建")
+	for i := 1; i <= n; i++ {
+		run.tree[i] += input.values[i-1]
+		if parent := i + lowbit(i); parent <= n {
+			run.tree[parent] += run.tree[i]
+			run.record(models.OpTypeUpdate, parent, run.tree[parent], fmt.Sprintf("tree[%d] 累加到 tree[%d]", i, parent))
+		}
+	}
+	run.flush(fmt.Sprintf("由 %d 个元素线性构建树状数组", n))
+
+	results := make([]rangeResult, 0, len(input.commands))
+	for _, cmd := range input.commands {
+		result, err := run.apply(cmd)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, rangeResult{Operation: cmd.String(), Result: result, Touched: run.touched})
+	}
+
+	tracker.SetPhase("完成")
+	final := run.state()
+	tracker.AddStep(fmt.Sprintf("脚本执行完成，共 %d 个操作", len(results)), final, []int{})
+
+	return map[string]interface{}{
+		"results": results,
+		"values":  final.Values,
+		"tree":    final.Tree,
+	}, nil
+}
+
+// apply 执行一条命令
+func (r *fenwickRun) apply(cmd command) (interface{}, error) {
+	op := strings.ReplaceAll(cmd.Op, "-", "_")
+	n := len(r.values)
+	r.touched = make([]int, 0)
+	r.tracker.SetPhase(cmd.String())
+
+	switch op {
+	case "add", "update":
+		if len(cmd.Args) == 3 {
+			return nil, fmt.Errorf("树状数组只支持单点加，区间加请使用线段树")
+		}
+		if err := cmd.expect(2); err != nil {
+			return nil, err
+		}
+		i, err := cmd.indexArg(0, n)
+		if err != nil {
+			return nil, err
+		}
+		delta, err := cmd.floatArg(1)
+		if err != nil {
+			return nil, err
+		}
+		r.add(i, delta)
+		r.flush(fmt.Sprintf("位置 %d 加 %s，沿 i += lowbit(i) 更新 %d 个下标", i, formatNumber(delta), len(r.touched)))
+		return nil, nil
+
+	case "set", "assign":
+		if err := cmd.expect(2); err != nil {
+			return nil, err
+		}
+		i, err := cmd.indexArg(0, n)
+		if err != nil {
+			return nil, err
+		}
+		v, err := cmd.floatArg(1)
+		if err != nil {
+			return nil, err
+		}
+		delta := v - r.values[i]
+		r.add(i, delta)
+		r.flush(fmt.Sprintf("将位置 %d 赋值为 %s，相当于加 %s", i, formatNumber(v), formatNumber(delta)))
+		return nil, nil
+
+	case "prefix", "prefix_sum":
+		if err := cmd.expect(1); err != nil {
+			return nil, err
+		}
+		i, err := cmd.indexArg(0, n)
+		if err != nil {
+			return nil, err
+		}
+		answer := r.prefix(i + 1)
+		r.flush(fmt.Sprintf("前缀 [0, %d] 的和 = %s，沿 i -= lowbit(i) 访问 %d 个下标", i, formatNumber(answer), len(r.touched)))
+		return answer, nil
+
+	case "query", "sum", "range_sum":
+		if err := cmd.expect(2); err != nil {
+			return nil, err
+		}
+		l, rr, err := cmd.rangeArgs(n)
+		if err != nil {
+			return nil, err
+		}
+		answer := r.prefix(rr+1) - r.prefix(l)
+		r.flush(fmt.Sprintf("区间 [%d, %d] 的和 = prefix(%d) - prefix(%d) = %s，访问 %d 个下标",
+			l, rr, rr, l-1, formatNumber(answer), len(r.touched)))
+		return answer, nil
+	}
+
+	return nil, fmt.Errorf("不支持的操作: %s", cmd.Op)
+}
+
+// add 单点加：从 i+1 开始沿 i += lowbit(i) 向上更新
+func (r *fenwickRun) add(i int, delta float64) {
+	r.values[i] += delta
+	for j := i + 1; j < len(r.tree); j += lowbit(j) {
+		r.tree[j] += delta
+		r.touched = append(r.touched, j)
+		r.record(models.OpTypeUpdate, j, r.tree[j], fmt.Sprintf("tree[%d] 覆盖 (%d, %d]，加 %s", j, j-lowbit(j), j, formatNumber(delta)))
+	}
+}
+
+// prefix 前 count 个元素的和：沿 i -= lowbit(i) 向下累加
+func (r *fenwickRun) prefix(count int) float64 {
+	sum := 0.0
+	for j := count; j > 0; j -= lowbit(j) {
+		sum += r.tree[j]
+		r.touched = append(r.touched, j)
+		r.record(models.OpTypeAccess, j, r.tree[j], fmt.Sprintf("累加 tree[%d]，覆盖 (%d, %d]", j, j-lowbit(j), j))
+	}
+	return sum
+}
+
+// record 记录一个下标操作，在下一个步骤生成后附加
+func (r *fenwickRun) record(opType string, index int, value float64, description string) {
+	r.pending = append(r.pending, models.Operation{
+		Type: opType, Indices: []int{index}, Values: []interface{}{value}, Description: description,
+	})
+}
+
+// flush 生成步骤并附加累积的操作
+func (r *fenwickRun) flush(description string) {
+	r.tracker.AddStep(description, r.state(), r.touched)
+	for _, op := range r.pending {
+		r.tracker.AddOperation(op.Type, op.Indices, op.Values, op.Description)
+	}
+	r.pending = r.pending[:0]
+}
+
+// state 生成当前树状数组的快照
+func (r *fenwickRun) state() *fenwickState {
+	n := len(r.tree) - 1
+	nodes := make([]*models.TreeNode, n+1)
+	nodes[0] = &models.TreeNode{ID: "0", Value: "root", Children: make([]*models.TreeNode, 0)}
+	for i := 1; i <= n; i++ {
+		nodes[i] = &models.TreeNode{
+			ID:       strconv.Itoa(i),
+			Value:    fmt.Sprintf("%d (%d,%d] %s", i, i-lowbit(i), i, formatNumber(r.tree[i])),
+			Children: make([]*models.TreeNode, 0),
+			X:        float64(i),
+		}
+		parent := nodes[i-lowbit(i)]
+		parent.Children = append(parent.Children, nodes[i])
+	}
+
+	// 层数为下标二进制中1的个数
+	var setLevel func(node *models.TreeNode, level int)
+	setLevel = func(node *models.TreeNode, level int) {
+		node.Level = level
+		node.Y = float64(level)
+		for _, child := range node.Children {
+			setLevel(child, level+1)
+		}
+	}
+	setLevel(nodes[0], 0)
+
+	return &fenwickState{
+		Values:  append([]float64{}, r.values...),
+		Tree:    append([]float64{}, r.tree...),
+		View:    &models.TreeData{Root: nodes[0], Type: "n-ary"},
+		Touched: append([]int{}, r.touched...),
+	}
+}
+
+// lowbit 最低位的1对应的值
+func lowbit(i int) int {
+	return i & -i
+}
+
+// ValidateInput 验证输入数据
+func (ft *FenwickTree) ValidateInput(data interface{}) error {
+	if _, err := parseRangeInput(data, nil, maxFenwickTreeSize); err != nil {
+		return algorithms.ErrInvalidInput
+	}
+	return nil
+}
+
+// GetComplexity 获取复杂度信息
+func (ft *FenwickTree) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(1)", // 下标为2的幂时前缀查询只访问一个节点
+			Average: "O(log n)",
+			Worst:   "O(log n)",
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(n)",
+			Average: "O(n)",
+			Worst:   "O(n)",
+		},
+	}
+}
@@ -0,0 +1,81 @@
+package datastructure
+
+import (
+	"fmt"
+	"gin/models"
+	"strconv"
+)
+
+// HeapItem 堆中的元素
+type HeapItem struct {
+	ID  string      `json:"id"`  // 元素标识（decrease_key 等操作按标识定位元素）
+	Key interface{} `json:"key"` // 优先级键值
+}
+
+// HeapSnapshot 堆的快照，同时提供数组视图和树视图
+type HeapSnapshot struct {
+	Kind   string           `json:"kind"`             // 堆类型 (min, max)
+	Array  []HeapItem       `json:"array"`            // 数组视图
+	Tree   *models.TreeData `json:"tree"`             // 完全二叉树视图，节点ID为数组下标
+	Active []int            `json:"active,omitempty"` // 当前参与比较或交换的下标
+}
+
+// NewHeapSnapshot 根据堆数组生成快照，items 会被复制
+// 也供图算法在追踪中展示优先队列的状态
+func NewHeapSnapshot(kind string, items []HeapItem, active ...int) *HeapSnapshot {
+	array := make([]HeapItem, len(items))
+	copy(array, items)
+
+	return &HeapSnapshot{
+		Kind:   kind,
+		Array:  array,
+		Tree:   heapTree(array),
+		Active: append([]int{}, active...),
+	}
+}
+
+// heapTree 将堆数组转换为完全二叉树，下标i的子节点为 2i+1 和 2i+2
+func heapTree(items []HeapItem) *models.TreeData {
+	tree := &models.TreeData{Type: "binary"}
+	if len(items) == 0 {
+		return tree
+	}
+
+	nodes := make([]*models.TreeNode, len(items))
+	for i, item := range items {
+		nodes[i] = &models.TreeNode{
+			ID:       strconv.Itoa(i),
+			Value:    fmt.Sprintf("%s: %v", item.ID, item.Key),
+			Children: make([]*models.TreeNode, 0, 2),
+		}
+	}
+	for i := range nodes {
+		if left := 2*i + 1; left < len(nodes) {
+			nodes[i].Left = nodes[left]
+			nodes[i].Children = append(nodes[i].Children, nodes[left])
+		}
+		if right := 2*i + 2; right < len(nodes) {
+			nodes[i].Right = nodes[right]
+			nodes[i].Children = append(nodes[i].Children, nodes[right])
+		}
+	}
+
+	// 中序位置作为横坐标，层数作为纵坐标
+	position := 0.0
+	var layout func(i, level int)
+	layout = func(i, level int) {
+		if i >= len(nodes) {
+			return
+		}
+		layout(2*i+1, level+1)
+		nodes[i].X = position
+		nodes[i].Y = float64(level)
+		nodes[i].Level = level
+		position++
+		layout(2*i+2, level+1)
+	}
+	layout(0, 0)
+
+	tree.Root = nodes[0]
+	return tree
+}
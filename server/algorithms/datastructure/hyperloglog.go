@@ -0,0 +1,210 @@
+package datastructure
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"math"
+	"math/bits"
+)
+
+// HyperLogLog 精度 p 的取值范围，寄存器个数为 2^p
+const (
+	minHLLPrecision = 4
+	maxHLLPrecision = 10
+)
+
+// HyperLogLog HyperLogLog 基数估计
+type HyperLogLog struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewHyperLogLog 创建HyperLogLog实例
+func NewHyperLogLog() *HyperLogLog {
+	return &HyperLogLog{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "hyperloglog",
+			Name:            "HyperLogLog",
+			Category:        models.CategoryDataStructure,
+			Description:     "估计元素流中不同元素的个数：64位哈希值的前 p 位选择 m=2^p 个寄存器之一，其余位中第一个1出现的位置作为秩，寄存器保存见过的最大秩。基数估计为 α·m²/Σ2^(-M[j])，估计值偏小且有空寄存器时改用线性计数。每次插入后给出估计值与精确不同元素数的对照，标准误差约为 1.04/√m。",
+			TimeComplexity:  "O(1) 每次插入",
+			SpaceComplexity: "O(m)",
+			Parameters: []models.Parameter{
+				{
+					Name:         "precision",
+					Type:         "int",
+					Description:  "精度 p，寄存器个数为 2^p",
+					DefaultValue: 6,
+					Required:     false,
+					Min:          minHLLPrecision,
+					Max:          maxHLLPrecision,
+				},
+			},
+		},
+	}
+}
+
+// hllState HyperLogLog 步骤快照
+type hllState struct {
+	Registers []int   `json:"registers"`      // 各寄存器保存的最大秩
+	Item      string  `json:"item,omitempty"` // 当前插入的元素
+	Hash      string  `json:"hash,omitempty"` // 当前元素的64位哈希值（十六进制）
+	Register  int     `json:"register"`       // 当前元素选中的寄存器，-1 表示没有
+	Rank      int     `json:"rank"`           // 当前元素的秩
+	Estimate  float64 `json:"estimate"`       // 当前基数估计
+	Exact     int     `json:"exact"`          // 精确的不同元素个数
+}
+
+// hllRun 一次HyperLogLog执行
+type hllRun struct {
+	p         int
+	registers []int
+	zeros     int // 值为0的寄存器个数
+}
+
+// Execute 使用默认精度执行HyperLogLog
+func (h *HyperLogLog) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return h.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 依次插入元素流，跟踪基数估计
+func (h *HyperLogLog) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	input, err := parseStreamInput(data, params)
+	if err != nil {
+		return nil, err
+	}
+	p := algorithms.IntParam(params, "precision", 6)
+	if p < minHLLPrecision || p > maxHLLPrecision {
+		return nil, fmt.Errorf("精度必须在%d到%d之间", minHLLPrecision, maxHLLPrecision)
+	}
+
+	m := 1 << p
+	run := &hllRun{p: p, registers: make([]int, m), zeros: m}
+	state := func(item, hash string, register, rank, exact int) hllState {
+		estimate, _ := run.estimate()
+		return hllState{
+			Registers: append([]int(nil), run.registers...), Item: item, Hash: hash,
+			Register: register, Rank: rank, Estimate: algorithms.Round4(estimate), Exact: exact,
+		}
+	}
+
+	tracker.SetPhase("初始化")
+	tracker.AddStep(fmt.Sprintf("创建 %d 个寄存器（p=%d），全部为0", m, p), state("", "", -1, 0, 0), []int{})
+
+	tracker.SetPhase("插入")
+	seen := make(map[string]bool)
+	for _, item := range input.items {
+		seen[item] = true
+		hash, _ := streamHash(item)
+		register, rank := run.split(hash)
+		hexHash := fmt.Sprintf("%016x", hash)
+
+		old := run.registers[register]
+		if rank > old {
+			if old == 0 {
+				run.zeros--
+			}
+			run.registers[register] = rank
+		}
+		estimate, _ := run.estimate()
+		description := fmt.Sprintf("插入 %q：哈希 %s，寄存器 %d，秩 %d", item, hexHash, register, rank)
+		if rank > old {
+			description += fmt.Sprintf("，寄存器由 %d 更新为 %d", old, rank)
+		} else {
+			description += fmt.Sprintf("，不超过寄存器当前值 %d", old)
+		}
+		description += fmt.Sprintf("；估计基数 %.1f，精确 %d", estimate, len(seen))
+		tracker.AddStep(description, state(item, hexHash, register, rank, len(seen)), []int{register})
+		if rank > old {
+			tracker.AddOperation(models.OpTypeUpdate, []int{register}, []interface{}{old, rank}, "更新寄存器")
+		} else {
+			tracker.AddOperation(models.OpTypeAccess, []int{register}, []interface{}{rank}, "寄存器不变")
+		}
+	}
+
+	estimate, linear := run.estimate()
+	exact := len(seen)
+	relativeError := (estimate - float64(exact)) / float64(exact)
+	standardError := 1.04 / math.Sqrt(float64(m))
+	estimator := "调和平均"
+	if linear {
+		estimator = "线性计数"
+	}
+
+	tracker.SetPhase("完成")
+	tracker.AddStep(fmt.Sprintf("%s估计基数 %.1f，精确不同元素 %d 个，相对误差 %.2f%%，标准误差约 %.2f%%",
+		estimator, estimate, exact, relativeError*100, standardError*100), state("", "", -1, 0, exact), []int{})
+
+	return map[string]interface{}{
+		"registers":      run.registers,
+		"precision":      p,
+		"registerCount":  m,
+		"inserted":       len(input.items),
+		"estimate":       algorithms.Round4(estimate),
+		"exact":          exact,
+		"relativeError":  algorithms.Round4(relativeError),
+		"standardError":  algorithms.Round4(standardError),
+		"linearCounting": linear,
+		"emptyRegisters": run.zeros,
+	}, nil
+}
+
+// split 哈希值的前 p 位为寄存器下标，其余位中第一个1的位置（从1开始）为秩
+func (r *hllRun) split(hash uint64) (int, int) {
+	register := int(hash >> (64 - r.p))
+	rest := hash << r.p
+	rank := bits.LeadingZeros64(rest) + 1
+	if limit := 64 - r.p + 1; rank > limit {
+		rank = limit
+	}
+	return register, rank
+}
+
+// estimate 基数估计，第二个返回值表示是否使用了线性计数
+func (r *hllRun) estimate() (float64, bool) {
+	m := float64(len(r.registers))
+	sum := 0.0
+	for _, v := range r.registers {
+		sum += math.Ldexp(1, -v)
+	}
+	raw := hllAlpha(len(r.registers)) * m * m / sum
+	// 小基数时调和平均偏差较大，有空寄存器则用线性计数 m·ln(m/V)
+	if raw <= 2.5*m && r.zeros > 0 {
+		return m * math.Log(m/float64(r.zeros)), true
+	}
+	return raw, false
+}
+
+// hllAlpha 偏差修正常数
+func hllAlpha(m int) float64 {
+	switch m {
+	case 16:
+		return 0.673
+	case 32:
+		return 0.697
+	case 64:
+		return 0.709
+	}
+	return 0.7213 / (1 + 1.079/float64(m))
+}
+
+// ValidateInput 验证输入数据
+func (h *HyperLogLog) ValidateInput(data interface{}) error {
+	return validateStreamInput(data)
+}
+
+// GetComplexity 获取复杂度信息
+func (h *HyperLogLog) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(1)", // 每次插入只更新一个寄存器，求估计值需要 O(m)
+			Average: "O(1)",
+			Worst:   "O(1)",
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(m)",
+			Average: "O(m)",
+			Worst:   "O(m)",
+		},
+	}
+}
@@ -0,0 +1,222 @@
+package datastructure
+
+import (
+	"fmt"
+	"gin/models"
+	"math"
+	"strings"
+	"testing"
+)
+
+// numberedItems 生成 prefix0..prefix(n-1) 形式的元素
+func numberedItems(prefix string, n int) []interface{} {
+	items := make([]interface{}, n)
+	for i := range items {
+		items[i] = fmt.Sprintf("%s%d", prefix, i)
+	}
+	return items
+}
+
+func TestBloomFilter_NoFalseNegatives(t *testing.T) {
+	items := numberedItems("in", 100)
+	input := map[string]interface{}{
+		"items":   items,
+		"queries": append(append([]interface{}{}, items...), numberedItems("out", 1000)...),
+	}
+	tracker := models.NewStepTracker()
+	result, err := NewBloomFilter().ExecuteWithParams(input, map[string]interface{}{"size": 1024, "hashes": 7}, tracker)
+	if err != nil {
+		t.Fatalf("ExecuteWithParams() error = %v", err)
+	}
+	output := result.(map[string]interface{})
+
+	for _, q := range output["queries"].([]BloomQuery) {
+		if q.Present && !q.MaybePresent {
+			t.Fatalf("%q 已插入却回答不存在", q.Item)
+		}
+	}
+	if output["negativeQueries"] != 1000 {
+		t.Errorf("negativeQueries = %v, expected 1000", output["negativeQueries"])
+	}
+	// m/n ≈ 10、k = 7 时理论假阳性率约 0.8%
+	observed := output["falsePositiveRate"].(float64)
+	expected := output["expectedFalsePositiveRate"].(float64)
+	if expected < 0.005 || expected > 0.012 {
+		t.Errorf("expectedFalsePositiveRate = %v", expected)
+	}
+	if observed > 0.04 {
+		t.Errorf("falsePositiveRate = %v 远高于理论值 %v", observed, expected)
+	}
+	if output["setBits"] != strings.Count(output["bits"].(string), "1") {
+		t.Errorf("setBits = %v 与位数组不一致", output["setBits"])
+	}
+	if output["optimalHashes"] != 7 {
+		t.Errorf("optimalHashes = %v, expected 7", output["optimalHashes"])
+	}
+
+	// 初始化、100 次插入、1100 次查询、完成
+	if steps := len(tracker.GetSteps()); steps != 1+100+1100+1 {
+		t.Errorf("steps = %d", steps)
+	}
+}
+
+func TestBloomFilter_Saturated(t *testing.T) {
+	// 位数组很小时几乎全部置1，不存在的元素大多被误判
+	result, err := NewBloomFilter().ExecuteWithParams(numberedItems("x", 200),
+		map[string]interface{}{"size": 16, "hashes": 2, "queries": "a,b,c,d,e,f,g,h"}, models.NewStepTracker())
+	if err != nil {
+		t.Fatalf("ExecuteWithParams() error = %v", err)
+	}
+	output := result.(map[string]interface{})
+	if output["setBits"] != 16 || output["falsePositiveRate"] != 1.0 {
+		t.Errorf("setBits = %v, falsePositiveRate = %v", output["setBits"], output["falsePositiveRate"])
+	}
+}
+
+func TestBloomFilter_HeldOutProbes(t *testing.T) {
+	// 没有查询时用未插入的探测元素估计假阳性率，而不是报告 0
+	result, err := NewBloomFilter().ExecuteWithParams(numberedItems("in", 100), map[string]interface{}{"size": 256, "hashes": 3}, models.NewStepTracker())
+	if err != nil {
+		t.Fatalf("ExecuteWithParams() error = %v", err)
+	}
+	output := result.(map[string]interface{})
+	if output["negativeQueries"] != 0 || output["heldOutProbes"] != bloomProbes {
+		t.Errorf("negativeQueries = %v, heldOutProbes = %v", output["negativeQueries"], output["heldOutProbes"])
+	}
+	// m/n = 2.56、k = 3 时理论假阳性率约 33%
+	observed := output["falsePositiveRate"].(float64)
+	expected := output["expectedFalsePositiveRate"].(float64)
+	if math.Abs(observed-expected) > 0.1 {
+		t.Errorf("falsePositiveRate = %v, expected about %v", observed, expected)
+	}
+}
+
+func TestCountMinSketch_Overestimates(t *testing.T) {
+	items := make([]interface{}, 0)
+	exact := map[string]int{}
+	for i := 0; i < 60; i++ {
+		// 元素 k 出现 60/(k+1) 次，构成偏斜分布
+		for k := 0; k < 60/(i+1); k++ {
+			item := fmt.Sprintf("k%d", i)
+			items = append(items, item)
+			exact[item]++
+		}
+	}
+	input := map[string]interface{}{"items": items, "queries": []interface{}{"k0", "k1", "k59", "absent"}}
+	result, err := NewCountMinSketch().ExecuteWithParams(input, map[string]interface{}{"width": 32, "depth": 5}, models.NewStepTracker())
+	if err != nil {
+		t.Fatalf("ExecuteWithParams() error = %v", err)
+	}
+	output := result.(map[string]interface{})
+
+	for _, q := range output["queries"].([]CountMinQuery) {
+		if q.Exact != exact[q.Item] || q.Estimate < q.Exact || q.Error != q.Estimate-q.Exact {
+			t.Errorf("query %+v, exact %d", q, exact[q.Item])
+		}
+	}
+	if bound := output["errorBound"].(float64); math.Abs(bound-math.E/32*float64(len(items))) > 1e-3 {
+		t.Errorf("errorBound = %v", bound)
+	}
+	if rate := output["withinBoundRate"].(float64); rate < 0.9 {
+		t.Errorf("withinBoundRate = %v", rate)
+	}
+
+	// 每行计数器之和等于插入的元素个数
+	for r, row := range output["counters"].([][]int) {
+		sum := 0
+		for _, c := range row {
+			sum += c
+		}
+		if sum != len(items) {
+			t.Errorf("第 %d 行计数器之和 = %d, expected %d", r, sum, len(items))
+		}
+	}
+}
+
+func TestCountMinSketch_WideIsExact(t *testing.T) {
+	// 不同元素很少、表足够宽时各行几乎不冲突，估计值精确
+	result, err := NewCountMinSketch().ExecuteWithParams("a b a c a b", map[string]interface{}{"width": 128, "depth": 4, "queries": "a,b,c"}, models.NewStepTracker())
+	if err != nil {
+		t.Fatalf("ExecuteWithParams() error = %v", err)
+	}
+	want := map[string]int{"a": 3, "b": 2, "c": 1}
+	for _, q := range result.(map[string]interface{})["queries"].([]CountMinQuery) {
+		if q.Estimate != want[q.Item] {
+			t.Errorf("estimate(%s) = %d, expected %d", q.Item, q.Estimate, want[q.Item])
+		}
+	}
+}
+
+func TestHyperLogLog_Estimate(t *testing.T) {
+	tests := []struct {
+		distinct  int
+		precision int
+		linear    bool
+	}{
+		{10, 6, true},
+		{200, 6, false},
+		{2000, 8, false},
+		{2000, 10, true},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("n=%d,p=%d", tt.distinct, tt.precision), func(t *testing.T) {
+			// 每个元素重复两次，重复不影响基数
+			items := numberedItems("user", tt.distinct)
+			items = append(items, items...)
+			result, err := NewHyperLogLog().ExecuteWithParams(items, map[string]interface{}{"precision": tt.precision}, models.NewStepTracker())
+			if err != nil {
+				t.Fatalf("ExecuteWithParams() error = %v", err)
+			}
+			output := result.(map[string]interface{})
+			if output["exact"] != tt.distinct {
+				t.Errorf("exact = %v, expected %d", output["exact"], tt.distinct)
+			}
+			if output["linearCounting"] != tt.linear {
+				t.Errorf("linearCounting = %v, expected %v", output["linearCounting"], tt.linear)
+			}
+			// 误差不超过4倍标准误差
+			relative := math.Abs(output["relativeError"].(float64))
+			if limit := 4 * output["standardError"].(float64); relative > limit {
+				t.Errorf("relativeError = %v 超过 %v", relative, limit)
+			}
+		})
+	}
+}
+
+func TestHyperLogLog_Split(t *testing.T) {
+	run := &hllRun{p: 4}
+	register, rank := run.split(0xA000000000000000 | 1<<50)
+	// 前4位 1010 = 10；其余位以9个0开头，秩为10
+	if register != 10 || rank != 10 {
+		t.Errorf("split = (%d, %d), expected (10, 10)", register, rank)
+	}
+	if _, rank := run.split(0xF000000000000000); rank != 61 {
+		t.Errorf("全0的剩余位 rank = %d, expected 61", rank)
+	}
+}
+
+func TestProbabilisticStructures_InvalidInput(t *testing.T) {
+	bloom := NewBloomFilter()
+	for name, data := range map[string]interface{}{
+		"空数组":       []interface{}{},
+		"空字符串":      "",
+		"缺少items":   map[string]interface{}{"queries": []interface{}{"a"}},
+		"queries类型": map[string]interface{}{"items": []interface{}{"a"}, "queries": 1.0},
+		"非法类型":      42,
+		"超过上限":      numberedItems("x", maxStreamItems+1),
+	} {
+		if err := bloom.ValidateInput(data); err == nil {
+			t.Errorf("%s: ValidateInput() 应返回错误", name)
+		}
+	}
+
+	if _, err := bloom.ExecuteWithParams("a", map[string]interface{}{"hashes": 0}, models.NewStepTracker()); err == nil {
+		t.Error("哈希函数个数为0时应返回错误")
+	}
+	if _, err := NewCountMinSketch().ExecuteWithParams("a", map[string]interface{}{"width": 1}, models.NewStepTracker()); err == nil {
+		t.Error("宽度为1时应返回错误")
+	}
+	if _, err := NewHyperLogLog().ExecuteWithParams("a", map[string]interface{}{"precision": 16}, models.NewStepTracker()); err == nil {
+		t.Error("精度超过上限时应返回错误")
+	}
+}
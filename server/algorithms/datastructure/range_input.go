@@ -0,0 +1,125 @@
+package datastructure
+
+import (
+	"errors"
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"strings"
+)
+
+// rangeInput 区间查询结构的输入：初始数组和操作脚本
+type rangeInput struct {
+	values   []float64
+	commands []command
+}
+
+// parseRangeInput 解析区间查询结构的输入
+// 支持直接传入数值数组（操作脚本由参数 operations 给出），
+// 或 {"values": [...], "operations": [...]} 形式的对象；参数中的操作追加在输入的操作之后
+func parseRangeInput(data interface{}, params map[string]interface{}, maxLength int) (*rangeInput, error) {
+	input := &rangeInput{}
+
+	var raw []interface{}
+	switch v := data.(type) {
+	case []interface{}:
+		raw = v
+	case map[string]interface{}:
+		values, ok := v["values"].([]interface{})
+		if !ok {
+			return nil, errors.New("缺少values数组")
+		}
+		raw = values
+		s, err := parseScript(v)
+		if err != nil {
+			return nil, err
+		}
+		input.commands = s.commands
+	default:
+		return nil, algorithms.ErrInvalidInput
+	}
+
+	input.values = make([]float64, len(raw))
+	for i, item := range raw {
+		value, ok := numericKey(item)
+		if !ok {
+			return nil, fmt.Errorf("第%d个元素不是数值", i+1)
+		}
+		input.values[i] = value
+	}
+	if len(input.values) == 0 || len(input.values) > maxLength {
+		return nil, fmt.Errorf("数组长度必须在1到%d之间", maxLength)
+	}
+
+	// 参数中的操作以分号或换行分隔
+	if extra := algorithms.StringParam(params, "operations", ""); extra != "" {
+		for _, line := range strings.FieldsFunc(extra, func(r rune) bool { return r == ';' || r == '\n' }) {
+			if strings.TrimSpace(line) == "" {
+				continue
+			}
+			cmd, err := parseCommand(line)
+			if err != nil {
+				return nil, err
+			}
+			input.commands = append(input.commands, cmd)
+		}
+	}
+	return input, nil
+}
+
+// operationsParameter 操作脚本参数定义
+func operationsParameter(example string) models.Parameter {
+	return models.Parameter{
+		Name:         "operations",
+		Type:         "string",
+		Description:  "追加执行的操作，以分号分隔，例如 " + example + "；下标从0开始，区间为闭区间",
+		DefaultValue: "",
+		Required:     false,
+	}
+}
+
+// rangeResult 单个操作的结果
+type rangeResult struct {
+	Operation string      `json:"operation"`
+	Result    interface{} `json:"result"`  // 查询的答案，更新操作为nil
+	Touched   []int       `json:"touched"` // 操作访问过的节点
+}
+
+// indexArg 读取第i个参数作为数组下标并检查范围
+func (c command) indexArg(i, n int) (int, error) {
+	index, err := c.intArg(i)
+	if err != nil {
+		return 0, err
+	}
+	if index < 0 || index >= n {
+		return 0, fmt.Errorf("%s 的下标 %d 越界，应在0到%d之间", c.String(), index, n-1)
+	}
+	return index, nil
+}
+
+// floatArg 读取第i个数值参数
+func (c command) floatArg(i int) (float64, error) {
+	if i >= len(c.Args) {
+		return 0, fmt.Errorf("%s 缺少第%d个参数", c.Op, i+1)
+	}
+	if value, ok := numericKey(c.Args[i]); ok {
+		return value, nil
+	}
+	return 0, fmt.Errorf("%s 的第%d个参数必须是数值", c.Op, i+1)
+}
+
+// rangeArgs 读取闭区间 [l, r]
+func (c command) rangeArgs(n int) (int, int, error) {
+	l, err := c.indexArg(0, n)
+	if err != nil {
+		return 0, 0, err
+	}
+	r, err := c.indexArg(1, n)
+	if err != nil {
+		return 0, 0, err
+	}
+	if l > r {
+		return 0, 0, fmt.Errorf("%s 的区间左端点大于右端点", c.String())
+	}
+	return l, r, nil
+}
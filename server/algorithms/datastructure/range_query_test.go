@@ -0,0 +1,191 @@
+package datastructure
+
+import (
+	"fmt"
+	"gin/models"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// randomRangeScript 生成随机的更新/查询脚本，并用朴素数组计算期望答案
+func randomRangeScript(rng *rand.Rand, values []float64, count int, rangeAdd bool, aggregate string) ([]interface{}, []interface{}) {
+	naive := append([]float64{}, values...)
+	n := len(naive)
+	script := make([]interface{}, 0, count)
+	expected := make([]interface{}, 0, count)
+
+	for k := 0; k < count; k++ {
+		l := rng.Intn(n)
+		r := l + rng.Intn(n-l)
+		v := float64(rng.Intn(21) - 10)
+		switch rng.Intn(3) {
+		case 0:
+			if rangeAdd {
+				script = append(script, fmt.Sprintf("add %d %d %v", l, r, v))
+				for i := l; i <= r; i++ {
+					naive[i] += v
+				}
+			} else {
+				script = append(script, fmt.Sprintf("add %d %v", l, v))
+				naive[l] += v
+			}
+			expected = append(expected, nil)
+		case 1:
+			script = append(script, fmt.Sprintf("set %d %v", l, v))
+			naive[l] = v
+			expected = append(expected, nil)
+		default:
+			script = append(script, fmt.Sprintf("query %d %d", l, r))
+			answer := naive[l]
+			for i := l + 1; i <= r; i++ {
+				switch aggregate {
+				case "min":
+					answer = math.Min(answer, naive[i])
+				case "max":
+					answer = math.Max(answer, naive[i])
+				default:
+					answer += naive[i]
+				}
+			}
+			expected = append(expected, answer)
+		}
+	}
+	return script, expected
+}
+
+func randomValues(rng *rand.Rand, n int) []interface{} {
+	values := make([]interface{}, n)
+	for i := range values {
+		values[i] = float64(rng.Intn(100))
+	}
+	return values
+}
+
+func toFloats(values []interface{}) []float64 {
+	result := make([]float64, len(values))
+	for i, v := range values {
+		result[i] = v.(float64)
+	}
+	return result
+}
+
+func TestSegmentTree_MatchesBruteForce(t *testing.T) {
+	st := NewSegmentTree()
+	rng := rand.New(rand.NewSource(36))
+
+	for _, aggregate := range []string{"sum", "min", "max"} {
+		for _, n := range []int{1, 2, 7, 16, 33} {
+			values := randomValues(rng, n)
+			script, expected := randomRangeScript(rng, toFloats(values), 60, true, aggregate)
+
+			tracker := models.NewStepTracker()
+			data := map[string]interface{}{"values": values, "operations": script}
+			result, err := st.ExecuteWithParams(data, map[string]interface{}{"aggregate": aggregate}, tracker)
+			if err != nil {
+				t.Fatalf("%s n=%d: ExecuteWithParams() error = %v", aggregate, n, err)
+			}
+
+			// 带懒标记的区间操作访问 O(log n) 个节点
+			limit := 4*int(math.Ceil(math.Log2(float64(n))+1)) + 2
+			results := result.(map[string]interface{})["results"].([]rangeResult)
+			for i, r := range results {
+				if r.Result != expected[i] {
+					t.Fatalf("%s n=%d: %s = %v, expected %v", aggregate, n, r.Operation, r.Result, expected[i])
+				}
+				if len(r.Touched) == 0 || len(r.Touched) > limit {
+					t.Errorf("%s n=%d: %s touched %d nodes, limit %d", aggregate, n, r.Operation, len(r.Touched), limit)
+				}
+			}
+		}
+	}
+}
+
+func TestFenwickTree_MatchesBruteForce(t *testing.T) {
+	ft := NewFenwickTree()
+	rng := rand.New(rand.NewSource(36))
+
+	for _, n := range []int{1, 2, 7, 16, 33, 100} {
+		values := randomValues(rng, n)
+		script, expected := randomRangeScript(rng, toFloats(values), 80, false, "sum")
+
+		tracker := models.NewStepTracker()
+		data := map[string]interface{}{"values": values, "operations": script}
+		result, err := ft.Execute(data, tracker)
+		if err != nil {
+			t.Fatalf("n=%d: Execute() error = %v", n, err)
+		}
+
+		// 区间查询做两次前缀查询，每次至多 ⌊log n⌋+1 个下标
+		limit := 2 * (int(math.Log2(float64(n))) + 1)
+		results := result.(map[string]interface{})["results"].([]rangeResult)
+		for i, r := range results {
+			if r.Result != expected[i] {
+				t.Fatalf("n=%d: %s = %v, expected %v", n, r.Operation, r.Result, expected[i])
+			}
+			if len(r.Touched) > limit {
+				t.Errorf("n=%d: %s touched %d indices, limit %d", n, r.Operation, len(r.Touched), limit)
+			}
+		}
+
+		for _, step := range tracker.GetSteps() {
+			if _, ok := step.Data.(*fenwickState); !ok {
+				t.Fatalf("step %d data is %T", step.StepID, step.Data)
+			}
+		}
+	}
+}
+
+func TestRangeQuery_ParamOperations(t *testing.T) {
+	values := []interface{}{float64(5), float64(3), float64(8), float64(1)}
+	params := map[string]interface{}{"operations": "add 1 4; prefix 2; query 1 3"}
+
+	result, err := NewFenwickTree().ExecuteWithParams(values, params, models.NewStepTracker())
+	if err != nil {
+		t.Fatalf("ExecuteWithParams() error = %v", err)
+	}
+	results := result.(map[string]interface{})["results"].([]rangeResult)
+	if len(results) != 3 || results[1].Result != 20.0 || results[2].Result != 16.0 {
+		t.Errorf("results = %+v", results)
+	}
+
+	params = map[string]interface{}{"operations": "add 0 2 1\nmin 0 3", "aggregate": "min"}
+	result, err = NewSegmentTree().ExecuteWithParams(values, params, models.NewStepTracker())
+	if err != nil {
+		t.Fatalf("ExecuteWithParams() error = %v", err)
+	}
+	results = result.(map[string]interface{})["results"].([]rangeResult)
+	if results[1].Result != 1.0 {
+		t.Errorf("min = %v, expected 1", results[1].Result)
+	}
+}
+
+func TestRangeQuery_Errors(t *testing.T) {
+	values := []interface{}{float64(1), float64(2), float64(3)}
+	tests := []struct {
+		name      string
+		algorithm interface {
+			ExecuteWithParams(interface{}, map[string]interface{}, models.StepTracker) (interface{}, error)
+		}
+		operations string
+	}{
+		{"Fenwick range add", NewFenwickTree(), "add 0 2 1"},
+		{"Fenwick out of range", NewFenwickTree(), "prefix 3"},
+		{"Segment reversed range", NewSegmentTree(), "query 2 1"},
+		{"Segment aggregate mismatch", NewSegmentTree(), "max 0 2"},
+		{"Unknown operation", NewSegmentTree(), "pop"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := map[string]interface{}{"operations": tt.operations}
+			if _, err := tt.algorithm.ExecuteWithParams(values, params, models.NewStepTracker()); err == nil {
+				t.Errorf("expected error for %q", tt.operations)
+			}
+		})
+	}
+
+	if err := NewSegmentTree().ValidateInput([]interface{}{}); err == nil {
+		t.Error("expected error for empty values")
+	}
+}
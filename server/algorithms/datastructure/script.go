@@ -0,0 +1,138 @@
+package datastructure
+
+import (
+	"errors"
+	"fmt"
+	"gin/algorithms"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// command 操作脚本中的一条命令
+type command struct {
+	Op   string        `json:"op"`   // 操作名称（小写）
+	Args []interface{} `json:"args"` // 操作参数
+}
+
+// script 操作脚本
+// 支持三种输入形式：
+//   - 命令字符串数组，如 ["union 1 2", "find 3"]，参数以空格或逗号分隔
+//   - 命令对象数组，如 [{"op": "union", "args": [1, 2]}]
+//   - 带附加字段的对象，如 {"values": [...], "operations": [...]}，附加字段通过 fields 读取
+type script struct {
+	commands []command
+	fields   map[string]interface{}
+}
+
+// parseScript 解析操作脚本
+func parseScript(data interface{}) (*script, error) {
+	s := &script{fields: map[string]interface{}{}}
+
+	var items []interface{}
+	switch v := data.(type) {
+	case []interface{}:
+		items = v
+	case []string:
+		items = make([]interface{}, len(v))
+		for i, line := range v {
+			items[i] = line
+		}
+	case map[string]interface{}:
+		s.fields = v
+		ops, exists := v["operations"]
+		if !exists {
+			ops = []interface{}{}
+		}
+		list, ok := ops.([]interface{})
+		if !ok {
+			return nil, errors.New("operations必须是数组")
+		}
+		items = list
+	default:
+		return nil, algorithms.ErrInvalidInput
+	}
+
+	for i, item := range items {
+		cmd, err := parseCommand(item)
+		if err != nil {
+			return nil, fmt.Errorf("第%d条操作: %v", i+1, err)
+		}
+		s.commands = append(s.commands, cmd)
+	}
+	return s, nil
+}
+
+// parseCommand 解析单条命令
+func parseCommand(item interface{}) (command, error) {
+	switch v := item.(type) {
+	case string:
+		fields := strings.FieldsFunc(v, func(r rune) bool {
+			return r == ' ' || r == ',' || r == '(' || r == ')' || r == '\t'
+		})
+		if len(fields) == 0 {
+			return command{}, errors.New("空命令")
+		}
+		cmd := command{Op: strings.ToLower(fields[0]), Args: make([]interface{}, 0, len(fields)-1)}
+		for _, field := range fields[1:] {
+			if f, err := strconv.ParseFloat(field, 64); err == nil {
+				cmd.Args = append(cmd.Args, f)
+			} else {
+				cmd.Args = append(cmd.Args, field)
+			}
+		}
+		return cmd, nil
+	case map[string]interface{}:
+		op, ok := v["op"].(string)
+		if !ok || strings.TrimSpace(op) == "" {
+			return command{}, errors.New("缺少op字段")
+		}
+		cmd := command{Op: strings.ToLower(strings.TrimSpace(op)), Args: []interface{}{}}
+		switch args := v["args"].(type) {
+		case nil:
+		case []interface{}:
+			cmd.Args = args
+		default:
+			cmd.Args = []interface{}{args}
+		}
+		return cmd, nil
+	}
+	return command{}, errors.New("命令必须是字符串或对象")
+}
+
+// intArg 读取第i个整数参数
+func (c command) intArg(i int) (int, error) {
+	if i >= len(c.Args) {
+		return 0, fmt.Errorf("%s 缺少第%d个参数", c.Op, i+1)
+	}
+	switch v := c.Args[i].(type) {
+	case int:
+		return v, nil
+	case float64:
+		if v == math.Trunc(v) {
+			return int(v), nil
+		}
+	case string:
+		if n, err := strconv.Atoi(v); err == nil {
+			return n, nil
+		}
+	}
+	return 0, fmt.Errorf("%s 的第%d个参数必须是整数", c.Op, i+1)
+}
+
+// String 命令的文本形式
+func (c command) String() string {
+	parts := make([]string, 0, len(c.Args))
+	for _, arg := range c.Args {
+		parts = append(parts, fmt.Sprintf("%v", arg))
+	}
+	return c.Op + "(" + strings.Join(parts, ", ") + ")"
+}
+
+// expect 检查命令参数个数
+func (c command) expect(count int) error {
+	if len(c.Args) != count {
+		return fmt.Errorf("%s 需要%d个参数，实际%d个", c.Op, count, len(c.Args))
+	}
+	return nil
+}
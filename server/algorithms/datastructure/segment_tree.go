@@ -0,0 +1,377 @@
+package datastructure
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// maxSegmentTreeSize 线段树的数组长度上限
+const maxSegmentTreeSize = 256
+
+// SegmentTree 线段树算法
+type SegmentTree struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewSegmentTree 创建线段树算法实例
+func NewSegmentTree() *SegmentTree {
+	return &SegmentTree{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "segment_tree",
+			Name:            "线段树（懒标记）",
+			Category:        models.CategoryDataStructure,
+			Description:     "由数组构建线段树，然后按脚本执行区间加、单点赋值和区间查询。区间更新在完全覆盖的节点上打懒标记，访问子节点前再下推。每个操作高亮访问过的节点，可以看到只涉及 O(log n) 个节点。",
+			TimeComplexity:  "O(log n) 每次操作",
+			SpaceComplexity: "O(n)",
+			Parameters: []models.Parameter{
+				{
+					Name:         "aggregate",
+					Type:         "string",
+					Description:  "区间聚合方式",
+					DefaultValue: "sum",
+					Required:     false,
+					Options:      []string{"sum", "min", "max"},
+				},
+				operationsParameter("add 0 3 5; query 1 4; set 2 7"),
+			},
+		},
+	}
+}
+
+// segmentTreeState 线段树步骤快照
+type segmentTreeState struct {
+	Tree      *models.TreeData `json:"tree"`      // 线段树，节点ID为堆式编号（根为1）
+	Touched   []int            `json:"touched"`   // 当前操作访问过的节点编号
+	Aggregate string           `json:"aggregate"` // 聚合方式
+}
+
+// segmentTreeRun 一次线段树脚本执行
+type segmentTreeRun struct {
+	n         int
+	aggregate string
+	value     []float64 // value[node] 为节点区间的聚合值（已包含自身的懒标记）
+	lazy      []float64 // lazy[node] 为尚未下推给子节点的增量
+	touched   []int
+	pending   []models.Operation
+	tracker   models.StepTracker
+}
+
+// Execute 使用默认参数执行线段树脚本
+func (st *SegmentTree) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return st.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 按参数构建线段树并执行脚本
+func (st *SegmentTree) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	input, err := parseRangeInput(data, params, maxSegmentTreeSize)
+	if err != nil {
+		return nil, err
+	}
+
+	run := &segmentTreeRun{
+		n:         len(input.values),
+		aggregate: algorithms.OptionParam(params, "aggregate", []string{"sum", "min", "max"}, "sum"),
+		value:     make([]float64, 4*len(input.values)),
+		lazy:      make([]float64, 4*len(input.values)),
+		tracker:   tracker,
+	}
+
+	tracker.SetPhase("构建")
+	run.build(1, 0, run.n-1, input.values)
+	run.flush(fmt.Sprintf("由 %d 个元素自底向上构建线段树（%s）", run.n, run.aggregate))
+
+	results := make([]rangeResult, 0, len(input.commands))
+	for _, cmd := range input.commands {
+		result, err := run.apply(cmd)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, rangeResult{Operation: cmd.String(), Result: result, Touched: run.touched})
+	}
+
+	tracker.SetPhase("完成")
+	final := run.state()
+	tracker.AddStep(fmt.Sprintf("脚本执行完成，共 %d 个操作", len(results)), final, []int{})
+
+	values := make([]float64, run.n)
+	run.collect(1, 0, run.n-1, 0, values)
+	return map[string]interface{}{
+		"results":   results,
+		"values":    values,
+		"aggregate": run.aggregate,
+		"tree":      final.Tree,
+	}, nil
+}
+
+// apply 执行一条命令
+func (r *segmentTreeRun) apply(cmd command) (interface{}, error) {
+	op := strings.ReplaceAll(cmd.Op, "-", "_")
+	r.touched = make([]int, 0)
+	r.tracker.SetPhase(cmd.String())
+
+	switch op {
+	case "add", "range_add", "update":
+		// add i v 为单点加，add l r v 为区间加
+		if len(cmd.Args) == 2 {
+			cmd = command{Op: cmd.Op, Args: []interface{}{cmd.Args[0], cmd.Args[0], cmd.Args[1]}}
+		}
+		if err := cmd.expect(3); err != nil {
+			return nil, err
+		}
+		l, rr, err := cmd.rangeArgs(r.n)
+		if err != nil {
+			return nil, err
+		}
+		delta, err := cmd.floatArg(2)
+		if err != nil {
+			return nil, err
+		}
+		r.add(1, 0, r.n-1, l, rr, delta)
+		r.flush(fmt.Sprintf("区间 [%d, %d] 加 %s，访问 %d 个节点", l, rr, formatNumber(delta), len(r.touched)))
+		return nil, nil
+
+	case "set", "assign":
+		if err := cmd.expect(2); err != nil {
+			return nil, err
+		}
+		i, err := cmd.indexArg(0, r.n)
+		if err != nil {
+			return nil, err
+		}
+		v, err := cmd.floatArg(1)
+		if err != nil {
+			return nil, err
+		}
+		r.set(1, 0, r.n-1, i, v)
+		r.flush(fmt.Sprintf("将位置 %d 赋值为 %s，访问 %d 个节点", i, formatNumber(v), len(r.touched)))
+		return nil, nil
+
+	case "query", "sum", "min", "max":
+		if op != "query" && op != r.aggregate {
+			return nil, fmt.Errorf("%s 与线段树的聚合方式 %s 不一致", cmd.Op, r.aggregate)
+		}
+		if err := cmd.expect(2); err != nil {
+			return nil, err
+		}
+		l, rr, err := cmd.rangeArgs(r.n)
+		if err != nil {
+			return nil, err
+		}
+		answer := r.query(1, 0, r.n-1, l, rr)
+		r.flush(fmt.Sprintf("查询区间 [%d, %d] 的%s = %s，访问 %d 个节点", l, rr, r.aggregateName(), formatNumber(answer), len(r.touched)))
+		return answer, nil
+	}
+
+	return nil, fmt.Errorf("不支持的操作: %s", cmd.Op)
+}
+
+// build 递归构建
+func (r *segmentTreeRun) build(node, l, rr int, values []float64) {
+	if l == rr {
+		r.value[node] = values[l]
+		return
+	}
+	mid := (l + rr) / 2
+	r.build(2*node, l, mid, values)
+	r.build(2*node+1, mid+1, rr, values)
+	r.value[node] = r.combine(r.value[2*node], r.value[2*node+1])
+	r.record(models.OpTypeMerge, node, r.value[node], fmt.Sprintf("合并 [%d, %d]", l, rr))
+}
+
+// add 区间加
+func (r *segmentTreeRun) add(node, l, rr, ql, qr int, delta float64) {
+	r.touched = append(r.touched, node)
+	if ql <= l && rr <= qr {
+		r.applyLazy(node, l, rr, delta)
+		r.record(models.OpTypeUpdate, node, r.value[node], fmt.Sprintf("[%d, %d] 被完全覆盖，打懒标记 %s", l, rr, formatNumber(r.lazy[node])))
+		return
+	}
+	r.push(node, l, rr)
+	mid := (l + rr) / 2
+	if ql <= mid {
+		r.add(2*node, l, mid, ql, qr, delta)
+	}
+	if qr > mid {
+		r.add(2*node+1, mid+1, rr, ql, qr, delta)
+	}
+	r.value[node] = r.combine(r.value[2*node], r.value[2*node+1])
+	r.record(models.OpTypeMerge, node, r.value[node], fmt.Sprintf("回溯更新 [%d, %d]", l, rr))
+}
+
+// set 单点赋值
+func (r *segmentTreeRun) set(node, l, rr, i int, v float64) {
+	r.touched = append(r.touched, node)
+	if l == rr {
+		r.value[node] = v
+		r.lazy[node] = 0
+		r.record(models.OpTypeAssign, node, v, fmt.Sprintf("叶子 [%d, %d] 赋值", l, rr))
+		return
+	}
+	r.push(node, l, rr)
+	mid := (l + rr) / 2
+	if i <= mid {
+		r.set(2*node, l, mid, i, v)
+	} else {
+		r.set(2*node+1, mid+1, rr, i, v)
+	}
+	r.value[node] = r.combine(r.value[2*node], r.value[2*node+1])
+	r.record(models.OpTypeMerge, node, r.value[node], fmt.Sprintf("回溯更新 [%d, %d]", l, rr))
+}
+
+// query 区间查询
+func (r *segmentTreeRun) query(node, l, rr, ql, qr int) float64 {
+	r.touched = append(r.touched, node)
+	if ql <= l && rr <= qr {
+		r.record(models.OpTypeAccess, node, r.value[node], fmt.Sprintf("[%d, %d] 被完全覆盖，直接使用节点值", l, rr))
+		return r.value[node]
+	}
+	r.push(node, l, rr)
+	mid := (l + rr) / 2
+	switch {
+	case qr <= mid:
+		return r.query(2*node, l, mid, ql, qr)
+	case ql > mid:
+		return r.query(2*node+1, mid+1, rr, ql, qr)
+	}
+	return r.combine(r.query(2*node, l, mid, ql, qr), r.query(2*node+1, mid+1, rr, ql, qr))
+}
+
+// push 将懒标记下推给两个子节点
+func (r *segmentTreeRun) push(node, l, rr int) {
+	if r.lazy[node] == 0 {
+		return
+	}
+	mid := (l + rr) / 2
+	r.applyLazy(2*node, l, mid, r.lazy[node])
+	r.applyLazy(2*node+1, mid+1, rr, r.lazy[node])
+	r.record(models.OpTypeMove, node, r.lazy[node], fmt.Sprintf("下推懒标记 %s 到 [%d, %d] 和 [%d, %d]",
+		formatNumber(r.lazy[node]), l, mid, mid+1, rr))
+	r.lazy[node] = 0
+}
+
+// applyLazy 给整个节点区间加上增量
+func (r *segmentTreeRun) applyLazy(node, l, rr int, delta float64) {
+	if r.aggregate == "sum" {
+		r.value[node] += delta * float64(rr-l+1)
+	} else {
+		r.value[node] += delta
+	}
+	if l != rr {
+		r.lazy[node] += delta
+	}
+}
+
+// collect 不记录步骤地求出当前的数组
+func (r *segmentTreeRun) collect(node, l, rr int, pending float64, values []float64) {
+	if l == rr {
+		values[l] = r.value[node] + pending
+		return
+	}
+	pending += r.lazy[node]
+	mid := (l + rr) / 2
+	r.collect(2*node, l, mid, pending, values)
+	r.collect(2*node+1, mid+1, rr, pending, values)
+}
+
+// combine 合并两个子区间的聚合值
+func (r *segmentTreeRun) combine(a, b float64) float64 {
+	switch r.aggregate {
+	case "min":
+		return math.Min(a, b)
+	case "max":
+		return math.Max(a, b)
+	}
+	return a + b
+}
+
+// record 记录一个节点操作，在下一个步骤生成后附加
+func (r *segmentTreeRun) record(opType string, node int, value float64, description string) {
+	r.pending = append(r.pending, models.Operation{
+		Type: opType, Indices: []int{node}, Values: []interface{}{value}, Description: description,
+	})
+}
+
+// flush 生成步骤并附加累积的操作
+func (r *segmentTreeRun) flush(description string) {
+	r.tracker.AddStep(description, r.state(), r.touched)
+	for _, op := range r.pending {
+		r.tracker.AddOperation(op.Type, op.Indices, op.Values, op.Description)
+	}
+	r.pending = r.pending[:0]
+}
+
+// state 生成当前线段树的快照
+func (r *segmentTreeRun) state() *segmentTreeState {
+	var build func(node, l, rr, level int) *models.TreeNode
+	build = func(node, l, rr, level int) *models.TreeNode {
+		label := fmt.Sprintf("[%d,%d] %s", l, rr, formatNumber(r.value[node]))
+		if r.lazy[node] != 0 {
+			label += " +" + formatNumber(r.lazy[node])
+		}
+		tn := &models.TreeNode{
+			ID:       strconv.Itoa(node),
+			Value:    label,
+			Children: make([]*models.TreeNode, 0, 2),
+			X:        float64(l+rr) / 2,
+			Y:        float64(level),
+			Level:    level,
+		}
+		if l < rr {
+			mid := (l + rr) / 2
+			tn.Left = build(2*node, l, mid, level+1)
+			tn.Right = build(2*node+1, mid+1, rr, level+1)
+			tn.Children = append(tn.Children, tn.Left, tn.Right)
+		}
+		return tn
+	}
+
+	return &segmentTreeState{
+		Tree:      &models.TreeData{Root: build(1, 0, r.n-1, 0), Type: "binary"},
+		Touched:   append([]int{}, r.touched...),
+		Aggregate: r.aggregate,
+	}
+}
+
+// aggregateName 聚合方式名称
+func (r *segmentTreeRun) aggregateName() string {
+	switch r.aggregate {
+	case "min":
+		return "最小值"
+	case "max":
+		return "最大值"
+	}
+	return "和"
+}
+
+// formatNumber 格式化数值，整数不带小数部分
+func formatNumber(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// ValidateInput 验证输入数据
+func (st *SegmentTree) ValidateInput(data interface{}) error {
+	if _, err := parseRangeInput(data, nil, maxSegmentTreeSize); err != nil {
+		return algorithms.ErrInvalidInput
+	}
+	return nil
+}
+
+// GetComplexity 获取复杂度信息
+func (st *SegmentTree) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(log n)",
+			Average: "O(log n)", // 构建 O(n)
+			Worst:   "O(log n)",
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(n)",
+			Average: "O(n)",
+			Worst:   "O(n)",
+		},
+	}
+}
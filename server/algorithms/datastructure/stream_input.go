@@ -0,0 +1,170 @@
+package datastructure
+
+import (
+	"errors"
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"hash/fnv"
+	"strconv"
+	"strings"
+)
+
+// maxStreamItems 概率数据结构输入流中元素与查询的总数上限，每个步骤都保存整张位图或计数器表的快照
+const maxStreamItems = 5000
+
+// streamInput 概率数据结构的输入：依次插入的元素流和插入完成后的成员/频率查询
+type streamInput struct {
+	items   []string
+	queries []string
+}
+
+// parseStreamInput 解析元素流
+// 支持元素数组、以逗号或空白分隔的字符串，或 {"items": [...], "queries": [...]} 形式的对象（items 也可写作 values）；
+// 参数 queries（逗号分隔）中的查询追加在输入的查询之后。数字与字符串统一按文本形式作为键，1 与 "1" 视为同一元素
+func parseStreamInput(data interface{}, params map[string]interface{}) (*streamInput, error) {
+	input := &streamInput{items: make([]string, 0), queries: make([]string, 0)}
+
+	switch v := data.(type) {
+	case []interface{}:
+		input.items = streamKeys(v)
+	case *models.ArrayData:
+		input.items = streamKeys(v.Values)
+	case string:
+		input.items = splitStream(v)
+	case map[string]interface{}:
+		items, ok := v["items"]
+		if !ok {
+			items = v["values"]
+		}
+		switch list := items.(type) {
+		case []interface{}:
+			input.items = streamKeys(list)
+		case string:
+			input.items = splitStream(list)
+		default:
+			return nil, errors.New("缺少items字段")
+		}
+		switch list := v["queries"].(type) {
+		case nil:
+		case []interface{}:
+			input.queries = streamKeys(list)
+		case string:
+			input.queries = splitStream(list)
+		default:
+			return nil, errors.New("queries必须是数组或字符串")
+		}
+	default:
+		return nil, algorithms.ErrInvalidInput
+	}
+
+	if extra := algorithms.StringParam(params, "queries", ""); extra != "" {
+		for _, q := range strings.Split(extra, ",") {
+			if q = strings.TrimSpace(q); q != "" {
+				input.queries = append(input.queries, q)
+			}
+		}
+	}
+
+	if len(input.items) == 0 {
+		return nil, errors.New("元素流不能为空")
+	}
+	if len(input.items)+len(input.queries) > maxStreamItems {
+		return nil, fmt.Errorf("元素与查询总数不能超过%d", maxStreamItems)
+	}
+	return input, nil
+}
+
+// streamKeys 将元素转换为文本键
+func streamKeys(values []interface{}) []string {
+	keys := make([]string, len(values))
+	for i, v := range values {
+		keys[i] = streamKey(v)
+	}
+	return keys
+}
+
+// streamKey 元素的文本键，整数值的浮点数不带小数部分
+func streamKey(v interface{}) string {
+	switch x := v.(type) {
+	case string:
+		return x
+	case float64:
+		return strconv.FormatFloat(x, 'f', -1, 64)
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+// splitStream 按逗号或空白拆分元素
+func splitStream(s string) []string {
+	return strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == ' ' || r == '\t' || r == '\n' })
+}
+
+// streamHash 元素的两个独立64位哈希值：FNV-1a 及其 splitmix64 混合，
+// 第 i 个哈希函数取 h1 + i·h2（Kirsch-Mitzenmacher 双重哈希，与哈希搜索中的双重哈希探测同理）
+func streamHash(key string) (uint64, uint64) {
+	h := fnv.New64a()
+	h.Write([]byte(key))
+	h1 := h.Sum64()
+	h2 := mix64(h1) | 1 // 保证为奇数，各哈希函数的位置不会因 h2 为0而重合
+	return mix64(h1 ^ 0x9e3779b97f4a7c15), h2
+}
+
+// mix64 splitmix64 的终结混合函数，使低位充分依赖所有输入位
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+// hashPositions 元素在大小为 size 的表中由 k 个哈希函数得到的位置
+func hashPositions(key string, k, size int) []int {
+	h1, h2 := streamHash(key)
+	positions := make([]int, k)
+	for i := range positions {
+		positions[i] = int((h1 + uint64(i)*h2) % uint64(size))
+	}
+	return positions
+}
+
+// rowPositions 元素在 d 行、每行 w 列的表中每行对应的列
+// 每行使用独立混合的哈希函数，两个元素在一行冲突不意味着在其他行也冲突
+func rowPositions(key string, d, w int) []int {
+	h1, _ := streamHash(key)
+	cells := make([]int, d)
+	for r := range cells {
+		cells[r] = int(mix64(h1+uint64(r+1)*0x9e3779b97f4a7c15) % uint64(w))
+	}
+	return cells
+}
+
+// exactCounts 元素流中各元素的精确出现次数
+func exactCounts(items []string) map[string]int {
+	counts := make(map[string]int)
+	for _, item := range items {
+		counts[item]++
+	}
+	return counts
+}
+
+// streamQueriesParameter 查询参数定义
+func streamQueriesParameter(description string) models.Parameter {
+	return models.Parameter{
+		Name:         "queries",
+		Type:         "string",
+		Description:  description,
+		DefaultValue: "",
+		Required:     false,
+	}
+}
+
+// validateStreamInput 验证元素流输入
+func validateStreamInput(data interface{}) error {
+	if _, err := parseStreamInput(data, nil); err != nil {
+		return algorithms.ErrInvalidInput
+	}
+	return nil
+}
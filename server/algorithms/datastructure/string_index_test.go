@@ -0,0 +1,180 @@
+package datastructure
+
+import (
+	"gin/models"
+	"math/rand"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestTrie_Script(t *testing.T) {
+	input := map[string]interface{}{
+		"words":      []interface{}{"tea", "ten", "to", "inn"},
+		"operations": []interface{}{"insert tea", "search te", "search ten", "prefix te", "delete ten", "prefix te", "search tea", "insert in"},
+	}
+	tracker := models.NewStepTracker()
+	result, err := NewTrie().Execute(input, tracker)
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	output := result.(map[string]interface{})
+	expected := []interface{}{true, true, true, true, false, false, true, 2, true, 1, true, true}
+	results := output["results"].([]trieResult)
+	for i, r := range results {
+		if r.Result != expected[i] {
+			t.Errorf("%s = %v, expected %v", r.Operation, r.Result, expected[i])
+		}
+	}
+
+	if words := output["words"].([]string); !reflect.DeepEqual(words, []string{"in", "inn", "tea", "to"}) {
+		t.Errorf("words = %v", words)
+	}
+	// root, t, e, a, o, i, n, n（删除 ten 剪掉了一个节点）
+	if output["nodes"] != 8 {
+		t.Errorf("nodes = %v, expected 8", output["nodes"])
+	}
+	for _, step := range tracker.GetSteps() {
+		if state, ok := step.Data.(*trieState); !ok || state.Tree.Root == nil {
+			t.Fatalf("step %d has no trie snapshot", step.StepID)
+		}
+	}
+}
+
+func TestSuffixArray_Banana(t *testing.T) {
+	input := map[string]interface{}{"text": "banana", "queries": []interface{}{"ana", "nab"}}
+	result, err := NewSuffixArray().ExecuteWithParams(input, map[string]interface{}{"queries": "a"}, models.NewStepTracker())
+	if err != nil {
+		t.Fatalf("ExecuteWithParams() error = %v", err)
+	}
+
+	output := result.(map[string]interface{})
+	if sa := output["suffixArray"].([]int); !reflect.DeepEqual(sa, []int{5, 3, 1, 0, 4, 2}) {
+		t.Errorf("suffixArray = %v", sa)
+	}
+	if lcp := output["lcp"].([]int); !reflect.DeepEqual(lcp, []int{0, 1, 3, 0, 0, 2}) {
+		t.Errorf("lcp = %v", lcp)
+	}
+	if output["distinctSubstrings"] != 15 || output["longestRepeated"] != "ana" {
+		t.Errorf("distinct = %v, longest = %v", output["distinctSubstrings"], output["longestRepeated"])
+	}
+
+	queries := output["queries"].([]SubstringQuery)
+	expected := []SubstringQuery{
+		{Pattern: "ana", Found: true, Count: 2, Positions: []int{1, 3}},
+		{Pattern: "nab", Found: false, Count: 0, Positions: []int{}},
+		{Pattern: "a", Found: true, Count: 3, Positions: []int{1, 3, 5}},
+	}
+	if !reflect.DeepEqual(queries, expected) {
+		t.Errorf("queries = %+v", queries)
+	}
+}
+
+func TestStringIndexes_AgainstBruteForce(t *testing.T) {
+	rng := rand.New(rand.NewSource(7))
+	for round := 0; round < 30; round++ {
+		text := randomText(rng, 1+rng.Intn(40), "abc")
+		patterns := make([]interface{}, 0)
+		for i := 0; i < 5; i++ {
+			patterns = append(patterns, randomText(rng, 1+rng.Intn(4), "abc"))
+		}
+		input := map[string]interface{}{"text": text, "queries": patterns}
+
+		saResult, err := NewSuffixArray().Execute(input, models.NewStepTracker())
+		if err != nil {
+			t.Fatalf("suffix array error = %v", err)
+		}
+		samResult, err := NewSuffixAutomaton().Execute(input, models.NewStepTracker())
+		if err != nil {
+			t.Fatalf("suffix automaton error = %v", err)
+		}
+		saOutput := saResult.(map[string]interface{})
+		samOutput := samResult.(map[string]interface{})
+
+		// 后缀数组按字典序排列所有后缀
+		suffixes := saOutput["suffixes"].([]string)
+		if !sort.StringsAreSorted(suffixes) || len(suffixes) != len(text) {
+			t.Fatalf("text %q: suffixes not sorted: %v", text, suffixes)
+		}
+
+		distinct := map[string]bool{}
+		for i := range text {
+			for j := i + 1; j <= len(text); j++ {
+				distinct[text[i:j]] = true
+			}
+		}
+		if saOutput["distinctSubstrings"] != len(distinct) || samOutput["distinctSubstrings"] != len(distinct) {
+			t.Fatalf("text %q: distinct = %v / %v, expected %d",
+				text, saOutput["distinctSubstrings"], samOutput["distinctSubstrings"], len(distinct))
+		}
+		if states := samOutput["states"].(int); states > 2*len(text)-1 && len(text) > 1 {
+			t.Fatalf("text %q: %d states exceeds 2n-1", text, states)
+		}
+
+		for i, pattern := range patterns {
+			expected := bruteForceOccurrences(text, pattern.(string))
+			for name, queries := range map[string][]SubstringQuery{
+				"suffix array":     saOutput["queries"].([]SubstringQuery),
+				"suffix automaton": samOutput["queries"].([]SubstringQuery),
+			} {
+				if !reflect.DeepEqual(queries[i].Positions, expected) || queries[i].Count != len(expected) {
+					t.Fatalf("%s on %q: query %q = %+v, expected positions %v", name, text, pattern, queries[i], expected)
+				}
+			}
+		}
+	}
+}
+
+func TestSuffixAutomaton_Snapshots(t *testing.T) {
+	tracker := models.NewStepTracker()
+	result, err := NewSuffixAutomaton().Execute("abcbc", tracker)
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	output := result.(map[string]interface{})
+	if output["clones"].(int) == 0 {
+		t.Error("expected a clone for repeated substring bc")
+	}
+
+	splits := 0
+	for _, step := range tracker.GetSteps() {
+		state, ok := step.Data.(*suffixAutomatonState)
+		if !ok {
+			t.Fatalf("step %d data is %T", step.StepID, step.Data)
+		}
+		if len(state.SuffixLinks) != len(state.Graph.Nodes)-1 {
+			t.Fatalf("step %d: %d suffix links for %d states", step.StepID, len(state.SuffixLinks), len(state.Graph.Nodes))
+		}
+		for _, op := range step.Operations {
+			if op.Type == models.OpTypeSplit {
+				splits++
+			}
+		}
+	}
+	if splits != output["clones"] {
+		t.Errorf("split operations = %d, clones = %v", splits, output["clones"])
+	}
+}
+
+// randomText 生成由给定字母组成的随机字符串
+func randomText(rng *rand.Rand, length int, alphabet string) string {
+	var b strings.Builder
+	for i := 0; i < length; i++ {
+		b.WriteByte(alphabet[rng.Intn(len(alphabet))])
+	}
+	return b.String()
+}
+
+// bruteForceOccurrences 朴素匹配得到的出现位置
+func bruteForceOccurrences(text, pattern string) []int {
+	positions := []int{}
+	for i := 0; i+len(pattern) <= len(text); i++ {
+		if text[i:i+len(pattern)] == pattern {
+			positions = append(positions, i)
+		}
+	}
+	return positions
+}
@@ -0,0 +1,294 @@
+package datastructure
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"sort"
+)
+
+// maxSuffixArrayText 后缀数组的文本长度上限
+const maxSuffixArrayText = 500
+
+// SuffixArray 后缀数组算法
+type SuffixArray struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewSuffixArray 创建后缀数组算法实例
+func NewSuffixArray() *SuffixArray {
+	return &SuffixArray{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "suffix_array",
+			Name:            "后缀数组",
+			Category:        models.CategoryDataStructure,
+			Description:     "用倍增法构建后缀数组：每轮按前 2k 个字符的排名对（rank[i], rank[i+k]）排序，直到所有后缀的排名互不相同；再用Kasai算法在线性时间内求出LCP数组。构建完成后用二分查找回答子串查询。",
+			TimeComplexity:  "O(n log² n)",
+			SpaceComplexity: "O(n)",
+			Parameters: []models.Parameter{
+				queriesParameter(),
+			},
+		},
+	}
+}
+
+// suffixArrayState 后缀数组步骤快照
+type suffixArrayState struct {
+	Text  string `json:"text"`            // 文本
+	Order []int  `json:"order"`           // 按当前排名排序的后缀起点
+	Rank  []int  `json:"rank"`            // rank[i] 为从i开始的后缀的当前排名
+	K     int    `json:"k"`               // 当前排名对应的前缀长度
+	LCP   []int  `json:"lcp,omitempty"`   // lcp[r] 为排名 r-1 与 r 的后缀的最长公共前缀
+	Range []int  `json:"range,omitempty"` // 查询时的二分区间 [lo, hi)
+}
+
+// SubstringQuery 子串查询结果
+type SubstringQuery struct {
+	Pattern   string `json:"pattern"`   // 查询的子串
+	Found     bool   `json:"found"`     // 是否出现
+	Count     int    `json:"count"`     // 出现次数
+	Positions []int  `json:"positions"` // 出现位置（升序）
+}
+
+// suffixArrayRun 一次后缀数组构建
+type suffixArrayRun struct {
+	text    []rune
+	sa      []int
+	rank    []int
+	lcp     []int
+	tracker models.StepTracker
+}
+
+// Execute 使用默认参数构建后缀数组
+func (sa *SuffixArray) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return sa.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 构建后缀数组与LCP数组，并回答子串查询
+func (sa *SuffixArray) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	input, err := parseTextInput(data, params, maxSuffixArrayText)
+	if err != nil {
+		return nil, err
+	}
+
+	run := &suffixArrayRun{text: input.text, tracker: tracker}
+	rounds := run.build()
+	run.buildLCP()
+
+	queries := make([]SubstringQuery, 0, len(input.queries))
+	if len(input.queries) > 0 {
+		tracker.SetPhase("子串查询")
+		for _, pattern := range input.queries {
+			queries = append(queries, run.query(pattern))
+		}
+	}
+
+	n := len(run.text)
+	suffixes := make([]string, n)
+	distinct := n * (n + 1) / 2
+	longest := ""
+	longestLength := 0
+	for r, start := range run.sa {
+		suffixes[r] = string(run.text[start:])
+		distinct -= run.lcp[r]
+		if run.lcp[r] > longestLength {
+			longestLength = run.lcp[r]
+			longest = string(run.text[start : start+longestLength])
+		}
+	}
+
+	tracker.SetPhase("完成")
+	tracker.AddStep(fmt.Sprintf("构建完成：%d 轮倍增，本质不同的子串 %d 个", rounds, distinct), run.state(n, nil), []int{})
+
+	return map[string]interface{}{
+		"suffixArray":        run.sa,
+		"rank":               run.rank,
+		"lcp":                run.lcp,
+		"suffixes":           suffixes,
+		"rounds":             rounds,
+		"distinctSubstrings": distinct,
+		"longestRepeated":    longest,
+		"queries":            queries,
+	}, nil
+}
+
+// build 倍增法构建后缀数组，返回倍增轮数
+func (r *suffixArrayRun) build() int {
+	n := len(r.text)
+	r.sa = make([]int, n)
+	r.rank = make([]int, n)
+	for i := range r.sa {
+		r.sa[i] = i
+	}
+
+	// 第0轮：按首字符排序
+	r.tracker.SetPhase("按首字符排序")
+	r.tracker.AddStep("初始后缀按起点排列，先按首字符排序", r.state(0, nil), []int{})
+	r.sortRound(func(i int) [2]int { return [2]int{int(r.text[i]), 0} })
+	r.tracker.AddStep(fmt.Sprintf("按首字符排序后有 %d 种不同的排名", r.rank[r.sa[n-1]]+1), r.state(1, nil), []int{})
+
+	rounds := 0
+	for k := 1; r.rank[r.sa[n-1]] < n-1; k *= 2 {
+		rounds++
+		r.tracker.SetPhase(fmt.Sprintf("倍增 k=%d", k))
+
+		// 前 2k 个字符的排名由 (前k个字符的排名, 从i+k开始的前k个字符的排名) 决定，越界视为最小
+		rank := append([]int{}, r.rank...)
+		r.sortRound(func(i int) [2]int {
+			second := -1
+			if i+k < n {
+				second = rank[i+k]
+			}
+			return [2]int{rank[i], second}
+		})
+		r.tracker.AddStep(fmt.Sprintf("按排名对 (rank[i], rank[i+%d]) 排序，得到前 %d 个字符的排名，共 %d 种",
+			k, 2*k, r.rank[r.sa[n-1]]+1), r.state(2*k, nil), []int{})
+	}
+	return rounds
+}
+
+// sortRound 按关键字对后缀排序并重新分配排名
+func (r *suffixArrayRun) sortRound(key func(i int) [2]int) {
+	sort.SliceStable(r.sa, func(a, b int) bool {
+		ka, kb := key(r.sa[a]), key(r.sa[b])
+		result := compareRankPair(ka, kb)
+		r.tracker.AddComparison(r.sa[a], r.sa[b], result)
+		return result < 0
+	})
+
+	rank := make([]int, len(r.sa))
+	for j := 1; j < len(r.sa); j++ {
+		rank[r.sa[j]] = rank[r.sa[j-1]]
+		if compareRankPair(key(r.sa[j-1]), key(r.sa[j])) != 0 {
+			rank[r.sa[j]]++
+		}
+	}
+	r.rank = rank
+}
+
+// buildLCP Kasai算法：按文本顺序处理后缀，相邻后缀的LCP至多减少1
+func (r *suffixArrayRun) buildLCP() {
+	n := len(r.text)
+	r.lcp = make([]int, n)
+	r.tracker.SetPhase("Kasai求LCP")
+	r.tracker.AddStep("按文本顺序计算每个后缀与排名前一个后缀的最长公共前缀", r.state(n, nil), []int{})
+
+	h := 0
+	for i := 0; i < n; i++ {
+		if r.rank[i] == 0 {
+			h = 0
+			continue
+		}
+		j := r.sa[r.rank[i]-1]
+		for i+h < n && j+h < n && r.text[i+h] == r.text[j+h] {
+			h++
+		}
+		r.lcp[r.rank[i]] = h
+		r.tracker.AddStep(fmt.Sprintf("后缀 %d 与前一名的后缀 %d 的LCP为 %d", i, j, h), r.state(n, nil), []int{i, j})
+		r.tracker.AddOperation(models.OpTypeUpdate, []int{r.rank[i]}, []interface{}{h}, "写入LCP")
+		if h > 0 {
+			h--
+		}
+	}
+}
+
+// query 在后缀数组上二分查找以pattern为前缀的后缀区间
+func (r *suffixArrayRun) query(pattern string) SubstringQuery {
+	p := []rune(pattern)
+	n := len(r.sa)
+
+	// bound 返回第一个满足 cmp(后缀前缀, pattern) >= threshold 的排名
+	bound := func(threshold int, label string) int {
+		lo, hi := 0, n
+		for lo < hi {
+			mid := (lo + hi) / 2
+			result := r.comparePrefix(r.sa[mid], p)
+			r.tracker.AddStep(fmt.Sprintf("查询 %q（%s）: 比较排名 %d 的后缀 %q", pattern, label, mid, string(r.text[r.sa[mid]:])),
+				r.state(len(r.text), []int{lo, hi}), []int{r.sa[mid]})
+			r.tracker.AddOperation(models.OpTypeCompare, []int{r.sa[mid]}, []interface{}{result}, "与查询串比较")
+			if result >= threshold {
+				hi = mid
+			} else {
+				lo = mid + 1
+			}
+		}
+		return lo
+	}
+
+	lower := bound(0, "下界")
+	upper := bound(1, "上界")
+
+	positions := append([]int{}, r.sa[lower:upper]...)
+	sort.Ints(positions)
+	r.tracker.AddStep(fmt.Sprintf("%q 出现 %d 次，位于排名区间 [%d, %d)", pattern, upper-lower, lower, upper),
+		r.state(len(r.text), []int{lower, upper}), positions)
+	return SubstringQuery{Pattern: pattern, Found: upper > lower, Count: upper - lower, Positions: positions}
+}
+
+// comparePrefix 比较从start开始的后缀的前 len(p) 个字符与p
+func (r *suffixArrayRun) comparePrefix(start int, p []rune) int {
+	for i := 0; i < len(p); i++ {
+		if start+i >= len(r.text) {
+			return -1
+		}
+		if r.text[start+i] != p[i] {
+			if r.text[start+i] < p[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// state 生成当前状态的快照
+func (r *suffixArrayRun) state(k int, queryRange []int) *suffixArrayState {
+	state := &suffixArrayState{
+		Text:  string(r.text),
+		Order: append([]int{}, r.sa...),
+		Rank:  append([]int{}, r.rank...),
+		K:     k,
+		Range: queryRange,
+	}
+	if r.lcp != nil {
+		state.LCP = append([]int{}, r.lcp...)
+	}
+	return state
+}
+
+// compareRankPair 比较两个排名对
+func compareRankPair(a, b [2]int) int {
+	for i := 0; i < 2; i++ {
+		if a[i] != b[i] {
+			if a[i] < b[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// ValidateInput 验证输入数据
+func (sa *SuffixArray) ValidateInput(data interface{}) error {
+	if _, err := parseTextInput(data, nil, maxSuffixArrayText); err != nil {
+		return algorithms.ErrInvalidInput
+	}
+	return nil
+}
+
+// GetComplexity 获取复杂度信息
+func (sa *SuffixArray) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(n log n)",  // 首轮排序后排名已互不相同
+			Average: "O(n log² n)", // 每轮比较排序 O(n log n)，共 O(log n) 轮；查询 O(m log n)
+			Worst:   "O(n log² n)",
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(n)",
+			Average: "O(n)",
+			Worst:   "O(n)",
+		},
+	}
+}
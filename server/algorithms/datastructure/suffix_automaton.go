@@ -0,0 +1,348 @@
+package datastructure
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"sort"
+	"strconv"
+)
+
+// maxSuffixAutomatonText 后缀自动机的文本长度上限，每个步骤都保存整个自动机的快照
+const maxSuffixAutomatonText = 300
+
+// SuffixAutomaton 后缀自动机算法
+type SuffixAutomaton struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewSuffixAutomaton 创建后缀自动机算法实例
+func NewSuffixAutomaton() *SuffixAutomaton {
+	return &SuffixAutomaton{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "suffix_automaton",
+			Name:            "后缀自动机",
+			Category:        models.CategoryDataStructure,
+			Description:     "逐个字符在线构建后缀自动机：新建状态后沿后缀链接补充转移，必要时克隆状态以保持每个状态的endpos等价类。状态和转移以 GraphData 给出，后缀链接单独列出。构建完成后沿转移回答子串查询。",
+			TimeComplexity:  "O(n)",
+			SpaceComplexity: "O(n·Σ)",
+			Parameters: []models.Parameter{
+				queriesParameter(),
+			},
+		},
+	}
+}
+
+// samNode 后缀自动机状态
+type samNode struct {
+	length   int          // 该状态中最长子串的长度
+	link     int          // 后缀链接，初始状态为-1
+	next     map[rune]int // 转移
+	firstPos int          // 最长子串首次出现时的结束位置
+	clone    bool         // 是否为克隆出的状态
+	occ      int          // endpos集合的大小（出现次数）
+}
+
+// suffixAutomatonState 后缀自动机步骤快照
+type suffixAutomatonState struct {
+	Graph       *models.GraphData  `json:"graph"`               // 状态与转移，节点ID为 s<编号>
+	SuffixLinks []models.GraphEdge `json:"suffixLinks"`         // 后缀链接
+	Last        int                `json:"last"`                // 对应整个已处理前缀的状态
+	Processed   string             `json:"processed"`           // 已处理的前缀
+	Terminals   []int              `json:"terminals,omitempty"` // 接受状态（构建完成后给出）
+}
+
+// suffixAutomatonRun 一次后缀自动机构建
+type suffixAutomatonRun struct {
+	text    []rune
+	nodes   []*samNode
+	last    int
+	built   int // 已处理的字符数
+	tracker models.StepTracker
+	pending []models.Operation // 等待附加到下一个步骤的操作
+
+	clones int
+}
+
+// Execute 使用默认参数构建后缀自动机
+func (sam *SuffixAutomaton) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return sam.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 构建后缀自动机并回答子串查询
+func (sam *SuffixAutomaton) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	input, err := parseTextInput(data, params, maxSuffixAutomatonText)
+	if err != nil {
+		return nil, err
+	}
+
+	run := &suffixAutomatonRun{text: input.text, tracker: tracker}
+	run.nodes = []*samNode{{length: 0, link: -1, next: make(map[rune]int), firstPos: -1}}
+
+	tracker.SetPhase("初始化")
+	tracker.AddStep("创建初始状态 0，对应空串", run.state(nil), []int{0})
+
+	for i, c := range run.text {
+		tracker.SetPhase(fmt.Sprintf("添加字符 %d", i+1))
+		run.extend(c)
+	}
+
+	run.countOccurrences()
+	terminals := run.terminals()
+
+	queries := make([]SubstringQuery, 0, len(input.queries))
+	if len(input.queries) > 0 {
+		tracker.SetPhase("子串查询")
+		for _, pattern := range input.queries {
+			queries = append(queries, run.query(pattern))
+		}
+	}
+
+	distinct := 0
+	transitions := 0
+	for _, node := range run.nodes[1:] {
+		distinct += node.length - run.nodes[node.link].length
+	}
+	for _, node := range run.nodes {
+		transitions += len(node.next)
+	}
+
+	final := run.state(terminals)
+	tracker.SetPhase("完成")
+	tracker.AddStep(fmt.Sprintf("构建完成：%d 个状态（其中克隆 %d 个）、%d 条转移，本质不同的子串 %d 个",
+		len(run.nodes), run.clones, transitions, distinct), final, terminals)
+
+	return map[string]interface{}{
+		"states":             len(run.nodes),
+		"clones":             run.clones,
+		"transitions":        transitions,
+		"distinctSubstrings": distinct,
+		"terminals":          terminals,
+		"graph":              final.Graph,
+		"suffixLinks":        final.SuffixLinks,
+		"queries":            queries,
+	}, nil
+}
+
+// extend 在线添加一个字符
+func (r *suffixAutomatonRun) extend(c rune) {
+	cur := len(r.nodes)
+	r.nodes = append(r.nodes, &samNode{
+		length:   r.nodes[r.last].length + 1,
+		next:     make(map[rune]int),
+		firstPos: r.built,
+		occ:      1,
+	})
+	r.built++
+	r.record(models.OpTypeInsert, []int{cur}, fmt.Sprintf("新建状态 %d，长度 %d", cur, r.nodes[cur].length))
+
+	// 沿后缀链接向上，给还没有字符c转移的状态补上到cur的转移
+	p := r.last
+	for p != -1 {
+		if _, exists := r.nodes[p].next[c]; exists {
+			break
+		}
+		r.nodes[p].next[c] = cur
+		r.record(models.OpTypeUpdate, []int{p, cur}, fmt.Sprintf("添加转移 %d -%c-> %d", p, c, cur))
+		p = r.nodes[p].link
+	}
+
+	if p == -1 {
+		r.nodes[cur].link = 0
+		r.record(models.OpTypeAssign, []int{cur, 0}, fmt.Sprintf("到达初始状态之外，后缀链接 %d → 0", cur))
+		r.flush(fmt.Sprintf("添加字符 '%c'：新建状态 %d", c, cur), []int{cur})
+		r.last = cur
+		return
+	}
+
+	q := r.nodes[p].next[c]
+	if r.nodes[p].length+1 == r.nodes[q].length {
+		r.nodes[cur].link = q
+		r.record(models.OpTypeAssign, []int{cur, q}, fmt.Sprintf("len(%d)+1 = len(%d)，后缀链接 %d → %d", p, q, cur, q))
+		r.flush(fmt.Sprintf("添加字符 '%c'：新建状态 %d", c, cur), []int{cur, q})
+		r.last = cur
+		return
+	}
+
+	r.flush(fmt.Sprintf("添加字符 '%c'：新建状态 %d，状态 %d 需要拆分", c, cur, q), []int{cur, p, q})
+
+	// q 中只有一部分子串能由 p 加字符c得到，克隆出长度为 len(p)+1 的新状态
+	clone := len(r.nodes)
+	cloned := &samNode{
+		length:   r.nodes[p].length + 1,
+		link:     r.nodes[q].link,
+		next:     make(map[rune]int, len(r.nodes[q].next)),
+		firstPos: r.nodes[q].firstPos,
+		clone:    true,
+	}
+	for k, v := range r.nodes[q].next {
+		cloned.next[k] = v
+	}
+	r.nodes = append(r.nodes, cloned)
+	r.clones++
+	r.record(models.OpTypeSplit, []int{q, clone}, fmt.Sprintf("克隆状态 %d 为 %d，长度 %d", q, clone, cloned.length))
+
+	for p != -1 && r.nodes[p].next[c] == q {
+		r.nodes[p].next[c] = clone
+		r.record(models.OpTypeUpdate, []int{p, clone}, fmt.Sprintf("转移 %d -%c-> %d 改为指向 %d", p, c, q, clone))
+		p = r.nodes[p].link
+	}
+	r.nodes[q].link = clone
+	r.nodes[cur].link = clone
+	r.record(models.OpTypeAssign, []int{q, clone}, fmt.Sprintf("后缀链接 %d → %d", q, clone))
+	r.record(models.OpTypeAssign, []int{cur, clone}, fmt.Sprintf("后缀链接 %d → %d", cur, clone))
+	r.flush(fmt.Sprintf("克隆状态 %d 得到状态 %d，并重定向转移和后缀链接", q, clone), []int{q, clone, cur})
+	r.last = cur
+}
+
+// countOccurrences 按长度从大到小沿后缀链接累加出现次数
+func (r *suffixAutomatonRun) countOccurrences() {
+	order := make([]int, len(r.nodes))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(a, b int) bool { return r.nodes[order[a]].length > r.nodes[order[b]].length })
+	for _, v := range order {
+		if link := r.nodes[v].link; link >= 0 {
+			r.nodes[link].occ += r.nodes[v].occ
+		}
+	}
+}
+
+// terminals 接受状态：从 last 沿后缀链接到初始状态经过的所有状态
+func (r *suffixAutomatonRun) terminals() []int {
+	terminals := make([]int, 0)
+	for v := r.last; v != -1; v = r.nodes[v].link {
+		terminals = append(terminals, v)
+	}
+	return terminals
+}
+
+// query 沿转移查找子串，出现位置由后缀链接树中子树内非克隆状态的首次结束位置得到
+func (r *suffixAutomatonRun) query(pattern string) SubstringQuery {
+	result := SubstringQuery{Pattern: pattern, Positions: []int{}}
+	m := len([]rune(pattern))
+
+	v := 0
+	path := []int{0}
+	for _, c := range pattern {
+		next, exists := r.nodes[v].next[c]
+		if !exists {
+			r.tracker.AddStep(fmt.Sprintf("查询 %q: 状态 %d 没有字符 '%c' 的转移，子串不存在", pattern, v, c), r.state(nil), path)
+			return result
+		}
+		v = next
+		path = append(path, v)
+		r.tracker.AddStep(fmt.Sprintf("查询 %q: 沿 '%c' 转移到状态 %d", pattern, c, v), r.state(nil), path)
+		r.tracker.AddOperation(models.OpTypeAccess, []int{v}, []interface{}{string(c)}, "沿转移前进")
+	}
+
+	children := make([][]int, len(r.nodes))
+	for i, node := range r.nodes {
+		if node.link >= 0 {
+			children[node.link] = append(children[node.link], i)
+		}
+	}
+	stack := []int{v}
+	for len(stack) > 0 {
+		u := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if !r.nodes[u].clone && u != 0 {
+			result.Positions = append(result.Positions, r.nodes[u].firstPos-m+1)
+		}
+		stack = append(stack, children[u]...)
+	}
+	sort.Ints(result.Positions)
+
+	result.Found = true
+	result.Count = r.nodes[v].occ
+	r.tracker.AddStep(fmt.Sprintf("%q 对应状态 %d，出现 %d 次", pattern, v, result.Count), r.state(nil), path)
+	return result
+}
+
+// record 记录一个操作，在下一个步骤生成后附加
+func (r *suffixAutomatonRun) record(opType string, indices []int, description string) {
+	r.pending = append(r.pending, models.Operation{Type: opType, Indices: indices, Description: description})
+}
+
+// flush 生成步骤并附加累积的操作
+func (r *suffixAutomatonRun) flush(description string, highlights []int) {
+	r.tracker.AddStep(description, r.state(nil), highlights)
+	for _, op := range r.pending {
+		r.tracker.AddOperation(op.Type, op.Indices, nil, op.Description)
+	}
+	r.pending = r.pending[:0]
+}
+
+// state 生成当前自动机的快照
+func (r *suffixAutomatonRun) state(terminals []int) *suffixAutomatonState {
+	graph := &models.GraphData{
+		Nodes: make([]models.GraphNode, len(r.nodes)),
+		Edges: make([]models.GraphEdge, 0),
+		Type:  "directed",
+	}
+	links := make([]models.GraphEdge, 0, len(r.nodes))
+
+	// 横坐标为状态长度，同一长度的状态纵向排列
+	rows := make(map[int]int)
+	for i, node := range r.nodes {
+		id := samNodeID(i)
+		graph.Nodes[i] = models.GraphNode{
+			ID:    id,
+			Label: fmt.Sprintf("%d (len %d)", i, node.length),
+			Value: node.length,
+			X:     float64(node.length),
+			Y:     float64(rows[node.length]),
+		}
+		rows[node.length]++
+
+		keys := make([]rune, 0, len(node.next))
+		for c := range node.next {
+			keys = append(keys, c)
+		}
+		sort.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })
+		for _, c := range keys {
+			graph.Edges = append(graph.Edges, models.GraphEdge{From: id, To: samNodeID(node.next[c]), Label: string(c)})
+		}
+		if node.link >= 0 {
+			links = append(links, models.GraphEdge{From: id, To: samNodeID(node.link), Label: "link"})
+		}
+	}
+
+	return &suffixAutomatonState{
+		Graph:       graph,
+		SuffixLinks: links,
+		Last:        r.last,
+		Processed:   string(r.text[:r.built]),
+		Terminals:   terminals,
+	}
+}
+
+// samNodeID 状态在快照中的节点ID
+func samNodeID(i int) string {
+	return "s" + strconv.Itoa(i)
+}
+
+// ValidateInput 验证输入数据
+func (sam *SuffixAutomaton) ValidateInput(data interface{}) error {
+	if _, err := parseTextInput(data, nil, maxSuffixAutomatonText); err != nil {
+		return algorithms.ErrInvalidInput
+	}
+	return nil
+}
+
+// GetComplexity 获取复杂度信息
+func (sam *SuffixAutomaton) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(n)",
+			Average: "O(n)", // 状态数不超过 2n-1，转移数不超过 3n-4；查询 O(m)
+			Worst:   "O(n)",
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(n·Σ)",
+			Average: "O(n·Σ)",
+			Worst:   "O(n·Σ)",
+		},
+	}
+}
@@ -0,0 +1,66 @@
+package datastructure
+
+import (
+	"errors"
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"strings"
+)
+
+// textInput 字符串索引结构的输入：待建索引的文本和要回答的子串查询
+type textInput struct {
+	text    []rune
+	queries []string
+}
+
+// parseTextInput 解析文本输入
+// 支持直接传入字符串，或 {"text": "...", "queries": [...]} 形式的对象；
+// 参数 queries（逗号分隔）中的查询追加在输入的查询之后
+func parseTextInput(data interface{}, params map[string]interface{}, maxLength int) (*textInput, error) {
+	input := &textInput{queries: make([]string, 0)}
+
+	switch v := data.(type) {
+	case string:
+		input.text = []rune(v)
+	case map[string]interface{}:
+		text, ok := v["text"].(string)
+		if !ok {
+			return nil, errors.New("缺少text字段")
+		}
+		input.text = []rune(text)
+		if queries, ok := v["queries"].([]interface{}); ok {
+			for _, q := range queries {
+				if pattern := fmt.Sprintf("%v", q); pattern != "" {
+					input.queries = append(input.queries, pattern)
+				}
+			}
+		}
+	default:
+		return nil, algorithms.ErrInvalidInput
+	}
+
+	if extra := algorithms.StringParam(params, "queries", ""); extra != "" {
+		for _, q := range strings.Split(extra, ",") {
+			if q = strings.TrimSpace(q); q != "" {
+				input.queries = append(input.queries, q)
+			}
+		}
+	}
+
+	if len(input.text) == 0 || len(input.text) > maxLength {
+		return nil, fmt.Errorf("文本长度必须在1到%d之间", maxLength)
+	}
+	return input, nil
+}
+
+// queriesParameter 子串查询参数定义
+func queriesParameter() models.Parameter {
+	return models.Parameter{
+		Name:         "queries",
+		Type:         "string",
+		Description:  "要在构建好的结构上回答的子串查询，多个查询以逗号分隔",
+		DefaultValue: "",
+		Required:     false,
+	}
+}
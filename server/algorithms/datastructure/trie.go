@@ -0,0 +1,349 @@
+package datastructure
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// maxTrieNodes 字典树节点数量上限，每个步骤都保存整棵树的快照
+const maxTrieNodes = 2000
+
+// Trie 字典树算法
+type Trie struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewTrie 创建字典树算法实例
+func NewTrie() *Trie {
+	return &Trie{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "trie",
+			Name:            "字典树",
+			Category:        models.CategoryDataStructure,
+			Description:     "按脚本依次执行 insert、search、prefix、delete 操作。每个步骤给出字典树的 TreeData 快照，并高亮当前沿字符向下走过的路径。",
+			TimeComplexity:  "O(L) 每次操作",
+			SpaceComplexity: "O(N·Σ)",
+			Parameters:      []models.Parameter{},
+		},
+	}
+}
+
+// trieNode 字典树节点
+type trieNode struct {
+	serial   int // 节点编号，快照中作为节点ID和高亮下标
+	char     rune
+	children map[rune]*trieNode
+	terminal bool // 是否为某个单词的结尾
+	pass     int  // 经过该节点的单词数量（用于前缀计数）
+}
+
+// trieState 字典树步骤快照
+type trieState struct {
+	Tree      *models.TreeData `json:"tree"`           // 字典树，节点ID为节点编号，值为字符
+	Terminals []int            `json:"terminals"`      // 单词结尾节点的编号
+	Path      []int            `json:"path,omitempty"` // 当前操作经过的节点编号
+	Nodes     int              `json:"nodes"`          // 节点数量（含根）
+	Words     int              `json:"words"`          // 单词数量
+}
+
+// trieResult 单个操作的结果
+type trieResult struct {
+	Operation string      `json:"operation"`
+	Result    interface{} `json:"result"`
+}
+
+// trieRun 一次字典树脚本执行
+type trieRun struct {
+	root    *trieNode
+	nodes   int // 当前节点数量
+	serials int // 已分配的节点编号数量，删除的节点编号不再复用
+	words   int
+	tracker models.StepTracker
+}
+
+// Execute 执行字典树脚本
+func (tr *Trie) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	s, err := parseScript(data)
+	if err != nil {
+		return nil, err
+	}
+
+	run := &trieRun{tracker: tracker}
+	run.root = run.newNode(0)
+
+	tracker.SetPhase("初始化")
+	tracker.AddStep("创建只有根节点的空字典树", run.state(nil), []int{0})
+
+	// 附加字段 words 作为初始单词依次插入
+	commands := make([]command, 0, len(s.commands))
+	if words, ok := s.fields["words"].([]interface{}); ok {
+		for _, word := range words {
+			commands = append(commands, command{Op: "insert", Args: []interface{}{word}})
+		}
+	}
+	commands = append(commands, s.commands...)
+
+	results := make([]trieResult, 0, len(commands))
+	for _, cmd := range commands {
+		result, err := run.apply(cmd)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, trieResult{Operation: cmd.String(), Result: result})
+	}
+
+	tracker.SetPhase("完成")
+	tracker.AddStep(fmt.Sprintf("脚本执行完成，共 %d 个单词、%d 个节点", run.words, run.nodes), run.state(nil), []int{})
+
+	return map[string]interface{}{
+		"results": results,
+		"words":   run.collect(run.root, ""),
+		"nodes":   run.nodes,
+		"tree":    run.state(nil).Tree,
+	}, nil
+}
+
+// apply 执行一条命令
+func (r *trieRun) apply(cmd command) (interface{}, error) {
+	op := strings.ReplaceAll(cmd.Op, "-", "_")
+	if err := cmd.expect(1); err != nil {
+		return nil, err
+	}
+	word := fmt.Sprintf("%v", cmd.Args[0])
+	r.tracker.SetPhase(cmd.String())
+
+	switch op {
+	case "insert", "add":
+		return r.insert(word)
+	case "search", "contains":
+		node, path := r.walk(word)
+		found := node != nil && node.terminal
+		r.tracker.AddStep(fmt.Sprintf("search(%q) = %t", word, found), r.state(path), path)
+		return found, nil
+	case "prefix", "starts_with", "count_prefix":
+		node, path := r.walk(word)
+		count := 0
+		if node != nil {
+			count = node.pass
+		}
+		r.tracker.AddStep(fmt.Sprintf("以 %q 为前缀的单词有 %d 个", word, count), r.state(path), path)
+		return count, nil
+	case "delete", "remove":
+		return r.delete(word), nil
+	}
+	return nil, fmt.Errorf("不支持的操作: %s", cmd.Op)
+}
+
+// insert 插入单词，沿途缺失的节点逐个创建
+func (r *trieRun) insert(word string) (interface{}, error) {
+	if word == "" {
+		return nil, fmt.Errorf("不能插入空字符串")
+	}
+
+	node := r.root
+	path := []int{0}
+	for _, c := range word {
+		child, exists := node.children[c]
+		if !exists {
+			if r.nodes >= maxTrieNodes {
+				return nil, fmt.Errorf("字典树节点不能超过%d个", maxTrieNodes)
+			}
+			child = r.newNode(c)
+			node.children[c] = child
+			path = append(path, child.serial)
+			r.tracker.AddStep(fmt.Sprintf("插入 %q: 创建字符 '%c' 的节点", word, c), r.state(path), path)
+			r.tracker.AddOperation(models.OpTypeInsert, []int{node.serial, child.serial}, []interface{}{string(c)}, "新建子节点")
+		} else {
+			path = append(path, child.serial)
+			r.tracker.AddStep(fmt.Sprintf("插入 %q: 字符 '%c' 的节点已存在，继续向下", word, c), r.state(path), path)
+			r.tracker.AddOperation(models.OpTypeAccess, []int{child.serial}, []interface{}{string(c)}, "复用已有节点")
+		}
+		node = child
+	}
+
+	if node.terminal {
+		r.tracker.AddStep(fmt.Sprintf("单词 %q 已存在", word), r.state(path), path)
+		return false, nil
+	}
+
+	// 确认是新单词后再更新路径上的计数
+	node.terminal = true
+	r.words++
+	r.root.pass++
+	current := r.root
+	for _, c := range word {
+		current = current.children[c]
+		current.pass++
+	}
+	r.tracker.AddStep(fmt.Sprintf("标记节点 %d 为单词 %q 的结尾", node.serial, word), r.state(path), []int{node.serial})
+	r.tracker.AddOperation(models.OpTypeUpdate, []int{node.serial}, []interface{}{true}, "标记单词结尾")
+	return true, nil
+}
+
+// walk 沿字符向下查找节点，返回最后到达的节点（不存在时为nil）和经过的路径
+func (r *trieRun) walk(word string) (*trieNode, []int) {
+	node := r.root
+	path := []int{0}
+	for _, c := range word {
+		child, exists := node.children[c]
+		if !exists {
+			r.tracker.AddStep(fmt.Sprintf("节点 %d 没有字符 '%c' 的子节点，查找失败", node.serial, c), r.state(path), path)
+			return nil, path
+		}
+		node = child
+		path = append(path, node.serial)
+		r.tracker.AddStep(fmt.Sprintf("沿字符 '%c' 走到节点 %d", c, node.serial), r.state(path), path)
+		r.tracker.AddOperation(models.OpTypeAccess, []int{node.serial}, []interface{}{string(c)}, "访问子节点")
+	}
+	return node, path
+}
+
+// delete 删除单词，并剪掉不再被任何单词使用的节点
+func (r *trieRun) delete(word string) bool {
+	node, path := r.walk(word)
+	if node == nil || !node.terminal {
+		r.tracker.AddStep(fmt.Sprintf("单词 %q 不存在，无需删除", word), r.state(path), path)
+		return false
+	}
+
+	node.terminal = false
+	r.words--
+	r.root.pass--
+	parent := r.root
+	removed := make([]int, 0)
+	for _, c := range word {
+		child := parent.children[c]
+		child.pass--
+		if child.pass == 0 {
+			// 该子树中已没有单词，整体剪掉
+			delete(parent.children, c)
+			r.nodes -= countNodes(child)
+			removed = append(removed, child.serial)
+			break
+		}
+		parent = child
+	}
+
+	r.tracker.AddStep(fmt.Sprintf("删除单词 %q", word), r.state(nil), path)
+	r.tracker.AddOperation(models.OpTypeUpdate, []int{node.serial}, []interface{}{false}, "取消单词结尾标记")
+	if len(removed) > 0 {
+		r.tracker.AddOperation(models.OpTypeDelete, removed, nil, "剪掉没有单词经过的子树")
+	}
+	return true
+}
+
+// newNode 创建节点
+func (r *trieRun) newNode(c rune) *trieNode {
+	node := &trieNode{serial: r.serials, char: c, children: make(map[rune]*trieNode)}
+	r.serials++
+	r.nodes++
+	return node
+}
+
+// collect 按字典序收集子树中的所有单词
+func (r *trieRun) collect(node *trieNode, prefix string) []string {
+	words := make([]string, 0)
+	if node.terminal {
+		words = append(words, prefix)
+	}
+	for _, c := range sortedChildren(node) {
+		words = append(words, r.collect(node.children[c], prefix+string(c))...)
+	}
+	return words
+}
+
+// state 生成当前字典树的快照
+func (r *trieRun) state(path []int) *trieState {
+	terminals := make([]int, 0)
+	position := 0.0
+
+	var build func(node *trieNode, level int) *models.TreeNode
+	build = func(node *trieNode, level int) *models.TreeNode {
+		value := string(node.char)
+		if node.serial == 0 {
+			value = "root"
+		}
+		tn := &models.TreeNode{
+			ID:       strconv.Itoa(node.serial),
+			Value:    value,
+			Children: make([]*models.TreeNode, 0, len(node.children)),
+			Level:    level,
+			Y:        float64(level),
+		}
+		if node.terminal {
+			terminals = append(terminals, node.serial)
+		}
+		for _, c := range sortedChildren(node) {
+			tn.Children = append(tn.Children, build(node.children[c], level+1))
+		}
+		if len(tn.Children) == 0 {
+			tn.X = position
+			position++
+		} else {
+			tn.X = (tn.Children[0].X + tn.Children[len(tn.Children)-1].X) / 2
+		}
+		return tn
+	}
+
+	var pathCopy []int
+	if len(path) > 0 {
+		pathCopy = append([]int{}, path...)
+	}
+	return &trieState{
+		Tree:      &models.TreeData{Root: build(r.root, 0), Type: "n-ary"},
+		Terminals: terminals,
+		Path:      pathCopy,
+		Nodes:     r.nodes,
+		Words:     r.words,
+	}
+}
+
+// sortedChildren 按字符顺序排列的子节点键
+func sortedChildren(node *trieNode) []rune {
+	keys := make([]rune, 0, len(node.children))
+	for c := range node.children {
+		keys = append(keys, c)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
+
+// countNodes 子树中的节点数量
+func countNodes(node *trieNode) int {
+	count := 1
+	for _, child := range node.children {
+		count += countNodes(child)
+	}
+	return count
+}
+
+// ValidateInput 验证输入数据
+func (tr *Trie) ValidateInput(data interface{}) error {
+	if data == nil {
+		return algorithms.ErrInvalidInput
+	}
+	if _, err := parseScript(data); err != nil {
+		return algorithms.ErrInvalidInput
+	}
+	return nil
+}
+
+// GetComplexity 获取复杂度信息
+func (tr *Trie) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(L)", // L 为单词长度
+			Average: "O(L)",
+			Worst:   "O(L)",
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(N·Σ)", // N 为节点数，Σ 为字符集大小
+			Average: "O(N·Σ)",
+			Worst:   "O(N·Σ)",
+		},
+	}
+}
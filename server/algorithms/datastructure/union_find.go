@@ -0,0 +1,440 @@
+package datastructure
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"strconv"
+	"strings"
+)
+
+// maxUnionFindSize 元素数量上限，每个步骤都保存整个森林的快照
+const maxUnionFindSize = 256
+
+// UnionFind 并查集算法
+type UnionFind struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewUnionFind 创建并查集算法实例
+func NewUnionFind() *UnionFind {
+	return &UnionFind{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "union_find",
+			Name:            "并查集",
+			Category:        models.CategoryDataStructure,
+			Description:     "按脚本依次执行 union、find、connected 操作，每个操作后以树森林的形式展示父指针结构。可以分别开关路径压缩和按秩合并，对比不同优化下树的高度和查找路径长度。",
+			TimeComplexity:  "O(α(n)) 均摊",
+			SpaceComplexity: "O(n)",
+			Parameters: []models.Parameter{
+				{
+					Name:         "size",
+					Type:         "int",
+					Description:  "元素数量，元素编号为 0 到 size-1；为0时根据脚本中出现的最大编号推断",
+					DefaultValue: 0,
+					Required:     false,
+					Min:          0,
+					Max:          maxUnionFindSize,
+				},
+				{
+					Name:         "path_compression",
+					Type:         "bool",
+					Description:  "查找时是否进行路径压缩",
+					DefaultValue: true,
+					Required:     false,
+				},
+				{
+					Name:         "union_by_rank",
+					Type:         "bool",
+					Description:  "合并时是否按秩合并；关闭时总是把第一个集合的根挂到第二个集合的根下",
+					DefaultValue: true,
+					Required:     false,
+				},
+			},
+		},
+	}
+}
+
+// unionFindState 并查集步骤快照
+type unionFindState struct {
+	Parent []int              `json:"parent"`         // 父指针数组
+	Rank   []int              `json:"rank"`           // 秩数组
+	Forest []*models.TreeData `json:"forest"`         // 每个集合一棵树
+	Height int                `json:"height"`         // 森林的最大高度
+	Sets   int                `json:"sets"`           // 集合数量
+	Path   []int              `json:"path,omitempty"` // 当前查找路径（从查询元素到根）
+}
+
+// unionFindResult 单个操作的结果
+type unionFindResult struct {
+	Operation string      `json:"operation"`
+	Result    interface{} `json:"result"`
+	Height    int         `json:"height"` // 操作后森林的最大高度
+}
+
+// unionFindRun 一次并查集脚本执行
+type unionFindRun struct {
+	parent          []int
+	rank            []int
+	pathCompression bool
+	unionByRank     bool
+	tracker         models.StepTracker
+
+	totalPathLength int
+	compressions    int
+	rankUpdates     int
+	links           int
+}
+
+// Execute 使用默认参数执行并查集脚本
+func (uf *UnionFind) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return uf.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 按参数执行并查集脚本
+func (uf *UnionFind) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	s, err := parseScript(data)
+	if err != nil {
+		return nil, err
+	}
+
+	size := algorithms.IntParam(params, "size", algorithms.IntParam(s.fields, "size", 0))
+	size, err = unionFindSize(s.commands, size)
+	if err != nil {
+		return nil, err
+	}
+
+	run := &unionFindRun{
+		parent:          make([]int, size),
+		rank:            make([]int, size),
+		pathCompression: algorithms.BoolParam(params, "path_compression", true),
+		unionByRank:     algorithms.BoolParam(params, "union_by_rank", true),
+		tracker:         tracker,
+	}
+	for i := range run.parent {
+		run.parent[i] = i
+	}
+
+	tracker.SetPhase("初始化")
+	tracker.AddStep(fmt.Sprintf("初始化 %d 个单元素集合，路径压缩: %s，按秩合并: %s",
+		size, onOff(run.pathCompression), onOff(run.unionByRank)), run.state(nil), []int{})
+
+	results := make([]unionFindResult, 0, len(s.commands))
+	heights := make([]int, 0, len(s.commands))
+	for _, cmd := range s.commands {
+		result, err := run.apply(cmd)
+		if err != nil {
+			return nil, err
+		}
+		height := forestHeight(run.parent)
+		results = append(results, unionFindResult{Operation: cmd.String(), Result: result, Height: height})
+		heights = append(heights, height)
+	}
+
+	tracker.SetPhase("完成")
+	final := run.state(nil)
+	tracker.AddStep(fmt.Sprintf("脚本执行完成，共 %d 个集合，最大树高 %d", final.Sets, final.Height), final, []int{})
+
+	return map[string]interface{}{
+		"results":         results,
+		"parent":          final.Parent,
+		"rank":            final.Rank,
+		"sets":            run.sets(),
+		"setCount":        final.Sets,
+		"height":          final.Height,
+		"heights":         heights,
+		"totalPathLength": run.totalPathLength,
+		"compressions":    run.compressions,
+		"rankUpdates":     run.rankUpdates,
+		"links":           run.links,
+		"pathCompression": run.pathCompression,
+		"unionByRank":     run.unionByRank,
+	}, nil
+}
+
+// apply 执行一条命令
+func (r *unionFindRun) apply(cmd command) (interface{}, error) {
+	r.tracker.SetPhase(cmd.String())
+
+	switch cmd.Op {
+	case "find":
+		if err := cmd.expect(1); err != nil {
+			return nil, err
+		}
+		x, _ := cmd.intArg(0)
+		root := r.find(x)
+		r.tracker.AddStep(fmt.Sprintf("find(%d) = %d", x, root), r.state(nil), []int{x, root})
+		return root, nil
+
+	case "union":
+		if err := cmd.expect(2); err != nil {
+			return nil, err
+		}
+		x, _ := cmd.intArg(0)
+		y, _ := cmd.intArg(1)
+		return r.union(x, y), nil
+
+	case "connected", "same":
+		if err := cmd.expect(2); err != nil {
+			return nil, err
+		}
+		x, _ := cmd.intArg(0)
+		y, _ := cmd.intArg(1)
+		rootX, rootY := r.find(x), r.find(y)
+		connected := rootX == rootY
+		r.tracker.AddStep(fmt.Sprintf("connected(%d, %d) = %t（根分别为 %d 和 %d）", x, y, connected, rootX, rootY),
+			r.state(nil), []int{x, y})
+		return connected, nil
+	}
+
+	return nil, fmt.Errorf("不支持的操作: %s", cmd.Op)
+}
+
+// find 查找根节点，路径和路径压缩分别记录为独立的步骤
+func (r *unionFindRun) find(x int) int {
+	path := []int{x}
+	for r.parent[path[len(path)-1]] != path[len(path)-1] {
+		path = append(path, r.parent[path[len(path)-1]])
+	}
+	root := path[len(path)-1]
+	r.totalPathLength += len(path) - 1
+
+	r.tracker.AddStep(fmt.Sprintf("查找 %d 的根: %s", x, formatPath(path)), r.state(path), path)
+	for i := 1; i < len(path); i++ {
+		r.tracker.AddOperation(models.OpTypeAccess, []int{path[i-1], path[i]}, []interface{}{path[i]}, "沿父指针上移")
+	}
+
+	// 路径上除根和根的直接子节点外的元素才需要改变父指针
+	if !r.pathCompression || len(path) <= 2 {
+		return root
+	}
+
+	compressed := path[:len(path)-2]
+	previous := make([]int, len(compressed))
+	for i, node := range compressed {
+		previous[i] = r.parent[node]
+		r.parent[node] = root
+		r.compressions++
+	}
+	highlights := append(append([]int{}, compressed...), root)
+	r.tracker.AddStep(fmt.Sprintf("路径压缩: %d 个元素直接指向根 %d", len(compressed), root), r.state(nil), highlights)
+	for i, node := range compressed {
+		r.tracker.AddOperation(models.OpTypeCompress, []int{node, root}, []interface{}{previous[i], root},
+			fmt.Sprintf("%d 的父节点 %d → %d", node, previous[i], root))
+	}
+	return root
+}
+
+// union 合并两个元素所在的集合
+func (r *unionFindRun) union(x, y int) bool {
+	rootX, rootY := r.find(x), r.find(y)
+	if rootX == rootY {
+		r.tracker.AddStep(fmt.Sprintf("union(%d, %d): 已在同一集合（根 %d），无需合并", x, y, rootX), r.state(nil), []int{x, y, rootX})
+		return false
+	}
+
+	// child 挂到 newRoot 下
+	child, newRoot := rootX, rootY
+	if r.unionByRank {
+		child, newRoot = rootY, rootX
+		if r.rank[rootX] < r.rank[rootY] {
+			child, newRoot = rootX, rootY
+		}
+	}
+
+	r.parent[child] = newRoot
+	r.links++
+	rankChanged := r.unionByRank && r.rank[child] == r.rank[newRoot]
+	oldRank := r.rank[newRoot]
+	if rankChanged {
+		r.rank[newRoot]++
+		r.rankUpdates++
+	}
+
+	description := fmt.Sprintf("union(%d, %d): 把根 %d 挂到根 %d 下", x, y, child, newRoot)
+	if r.unionByRank {
+		description += fmt.Sprintf("（秩 %d ≤ %d）", r.rank[child], oldRank)
+	}
+	r.tracker.AddStep(description, r.state(nil), []int{child, newRoot})
+	r.tracker.AddOperation(models.OpTypeMerge, []int{child, newRoot}, []interface{}{child, newRoot}, "链接两棵树")
+	if rankChanged {
+		r.tracker.AddOperation(models.OpTypeRank, []int{newRoot}, []interface{}{oldRank, r.rank[newRoot]},
+			fmt.Sprintf("两棵树秩相同，根 %d 的秩 %d → %d", newRoot, oldRank, r.rank[newRoot]))
+	}
+	return true
+}
+
+// state 生成当前状态的快照
+func (r *unionFindRun) state(path []int) *unionFindState {
+	parent := make([]int, len(r.parent))
+	copy(parent, r.parent)
+	rank := make([]int, len(r.rank))
+	copy(rank, r.rank)
+
+	var pathCopy []int
+	if len(path) > 0 {
+		pathCopy = make([]int, len(path))
+		copy(pathCopy, path)
+	}
+
+	forest := parentForest(parent)
+	return &unionFindState{
+		Parent: parent,
+		Rank:   rank,
+		Forest: forest,
+		Height: forestHeight(parent),
+		Sets:   len(forest),
+		Path:   pathCopy,
+	}
+}
+
+// sets 按根分组的集合，集合和元素都按编号升序
+func (r *unionFindRun) sets() [][]int {
+	groups := make(map[int][]int)
+	roots := make([]int, 0)
+	for i := range r.parent {
+		root := i
+		for r.parent[root] != root {
+			root = r.parent[root]
+		}
+		if _, exists := groups[root]; !exists {
+			roots = append(roots, root)
+		}
+		groups[root] = append(groups[root], i)
+	}
+
+	// 按每个集合中最小的元素排序，元素按升序遍历，首次出现的顺序即为所求
+	result := make([][]int, 0, len(roots))
+	for _, root := range roots {
+		result = append(result, groups[root])
+	}
+	return result
+}
+
+// parentForest 将父指针数组转换为树森林，每棵树的根为集合代表元
+func parentForest(parent []int) []*models.TreeData {
+	nodes := make([]*models.TreeNode, len(parent))
+	for i := range parent {
+		nodes[i] = &models.TreeNode{ID: strconv.Itoa(i), Value: i, Children: make([]*models.TreeNode, 0)}
+	}
+
+	forest := make([]*models.TreeData, 0)
+	for i, p := range parent {
+		if p == i {
+			forest = append(forest, &models.TreeData{Root: nodes[i], Type: "n-ary"})
+		} else {
+			nodes[p].Children = append(nodes[p].Children, nodes[i])
+		}
+	}
+
+	// 按叶子顺序分配横坐标，父节点位于子节点中间
+	next := 0.0
+	var layout func(node *models.TreeNode, level int)
+	layout = func(node *models.TreeNode, level int) {
+		node.Level = level
+		node.Y = float64(level)
+		if len(node.Children) == 0 {
+			node.X = next
+			next++
+			return
+		}
+		for _, child := range node.Children {
+			layout(child, level+1)
+		}
+		node.X = (node.Children[0].X + node.Children[len(node.Children)-1].X) / 2
+	}
+	for _, tree := range forest {
+		layout(tree.Root, 0)
+		next++ // 树之间留出间隔
+	}
+	return forest
+}
+
+// forestHeight 森林中最深节点的深度（边数）
+func forestHeight(parent []int) int {
+	height := 0
+	for i := range parent {
+		depth := 0
+		for node := i; parent[node] != node; node = parent[node] {
+			depth++
+		}
+		if depth > height {
+			height = depth
+		}
+	}
+	return height
+}
+
+// unionFindSize 确定元素数量并检查脚本中的元素编号
+func unionFindSize(commands []command, size int) (int, error) {
+	maxElement := -1
+	for _, cmd := range commands {
+		for i := range cmd.Args {
+			x, err := cmd.intArg(i)
+			if err != nil {
+				return 0, err
+			}
+			if x < 0 {
+				return 0, fmt.Errorf("元素编号不能为负数: %s", cmd.String())
+			}
+			if x > maxElement {
+				maxElement = x
+			}
+		}
+	}
+
+	if size <= 0 {
+		size = maxElement + 1
+	}
+	if maxElement >= size {
+		return 0, fmt.Errorf("元素编号必须在0到%d之间", size-1)
+	}
+	if size == 0 || size > maxUnionFindSize {
+		return 0, fmt.Errorf("元素数量必须在1到%d之间", maxUnionFindSize)
+	}
+	return size, nil
+}
+
+// formatPath 格式化查找路径
+func formatPath(path []int) string {
+	parts := make([]string, len(path))
+	for i, node := range path {
+		parts[i] = strconv.Itoa(node)
+	}
+	return strings.Join(parts, " → ")
+}
+
+// onOff 开关状态的文本
+func onOff(enabled bool) string {
+	if enabled {
+		return "开"
+	}
+	return "关"
+}
+
+// ValidateInput 验证输入数据
+func (uf *UnionFind) ValidateInput(data interface{}) error {
+	if data == nil {
+		return algorithms.ErrInvalidInput
+	}
+	if _, err := parseScript(data); err != nil {
+		return algorithms.ErrInvalidInput
+	}
+	return nil
+}
+
+// GetComplexity 获取复杂度信息
+func (uf *UnionFind) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(1)",
+			Average: "O(α(n))", // 同时使用路径压缩和按秩合并时的均摊复杂度
+			Worst:   "O(n)",    // 两种优化都关闭时树可能退化为链
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(n)",
+			Average: "O(n)",
+			Worst:   "O(n)",
+		},
+	}
+}
@@ -0,0 +1,121 @@
+package datastructure
+
+import (
+	"gin/models"
+	"reflect"
+	"testing"
+)
+
+func TestUnionFind_Optimizations(t *testing.T) {
+	uf := NewUnionFind()
+	script := []interface{}{"union 0 1", "union 1 2", "union 2 3", "union 3 4", "find 0", "connected 0 4", "connected 0 5"}
+
+	tests := []struct {
+		name            string
+		pathCompression bool
+		unionByRank     bool
+		height          int
+		compressions    int
+	}{
+		// 不做优化时依次把前一个根挂到后一个根下，形成链 0→1→2→3→4
+		{"No optimizations", false, false, 4, 0},
+		// 只压缩路径：find(0) 把 0、1、2 直接挂到根 4 下
+		{"Path compression only", true, false, 1, 3},
+		// 按秩合并后 1..4 都挂在根 0 下
+		{"Union by rank only", false, true, 1, 0},
+		{"Both optimizations", true, true, 1, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tracker := models.NewStepTracker()
+			params := map[string]interface{}{"size": float64(6), "path_compression": tt.pathCompression, "union_by_rank": tt.unionByRank}
+			result, err := uf.ExecuteWithParams(script, params, tracker)
+			if err != nil {
+				t.Fatalf("ExecuteWithParams() error = %v", err)
+			}
+
+			output := result.(map[string]interface{})
+			if output["height"] != tt.height || output["compressions"] != tt.compressions {
+				t.Errorf("height/compressions = %v/%v, expected %d/%d",
+					output["height"], output["compressions"], tt.height, tt.compressions)
+			}
+
+			results := output["results"].([]unionFindResult)
+			if results[5].Result != true || results[6].Result != false {
+				t.Errorf("connected results = %v, %v", results[5].Result, results[6].Result)
+			}
+			if sets := output["sets"].([][]int); !reflect.DeepEqual(sets, [][]int{{0, 1, 2, 3, 4}, {5}}) {
+				t.Errorf("sets = %v", sets)
+			}
+
+			// 每一步都带有当前森林的快照
+			compressOps := 0
+			for _, step := range tracker.GetSteps() {
+				state, ok := step.Data.(*unionFindState)
+				if !ok {
+					t.Fatalf("step %d data is %T", step.StepID, step.Data)
+				}
+				if len(state.Forest) != state.Sets {
+					t.Fatalf("step %d has %d trees for %d sets", step.StepID, len(state.Forest), state.Sets)
+				}
+				for _, op := range step.Operations {
+					if op.Type == models.OpTypeCompress {
+						compressOps++
+					}
+				}
+			}
+			if compressOps != tt.compressions {
+				t.Errorf("compress operations = %d, expected %d", compressOps, tt.compressions)
+			}
+		})
+	}
+}
+
+func TestUnionFind_RankUpdates(t *testing.T) {
+	script := []interface{}{
+		map[string]interface{}{"op": "union", "args": []interface{}{0.0, 1.0}},
+		map[string]interface{}{"op": "union", "args": []interface{}{2.0, 3.0}},
+		map[string]interface{}{"op": "union", "args": []interface{}{1.0, 3.0}},
+	}
+	tracker := models.NewStepTracker()
+	result, err := NewUnionFind().Execute(script, tracker)
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	output := result.(map[string]interface{})
+	if !reflect.DeepEqual(output["rank"], []int{2, 0, 1, 0}) || output["rankUpdates"] != 3 {
+		t.Errorf("rank = %v, rankUpdates = %v", output["rank"], output["rankUpdates"])
+	}
+
+	rankOps := 0
+	for _, step := range tracker.GetSteps() {
+		for _, op := range step.Operations {
+			if op.Type == models.OpTypeRank {
+				rankOps++
+			}
+		}
+	}
+	if rankOps != 3 {
+		t.Errorf("rank operations = %d, expected 3", rankOps)
+	}
+}
+
+func TestUnionFind_InvalidScripts(t *testing.T) {
+	uf := NewUnionFind()
+	tests := map[string]interface{}{
+		"Unknown operation": []interface{}{"split 1 2"},
+		"Missing argument":  []interface{}{"union 1"},
+		"Out of range":      map[string]interface{}{"size": 3.0, "operations": []interface{}{"union 1 5"}},
+		"Negative element":  []interface{}{"find -1"},
+		"Not a script":      42,
+	}
+	for name, input := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := uf.Execute(input, models.NewStepTracker()); err == nil {
+				t.Error("Execute() should fail")
+			}
+		})
+	}
+}
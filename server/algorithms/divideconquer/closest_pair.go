@@ -0,0 +1,269 @@
+package divideconquer
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"math"
+	"sort"
+)
+
+// ClosestPair 最近点对算法
+type ClosestPair struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewClosestPair 创建最近点对算法实例
+func NewClosestPair() *ClosestPair {
+	return &ClosestPair{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "closest_pair",
+			Name:            "最近点对",
+			Category:        models.CategoryDivideConquer,
+			Description:     "按X坐标将点集一分为二，递归求出左右两侧的最近距离δ，再检查中线两侧宽度为δ的带状区域，每个点最多只需与按Y排序后的后续7个点比较。",
+			TimeComplexity:  "O(n log n)",
+			SpaceComplexity: "O(n)",
+			Parameters:      []models.Parameter{},
+			Stable:          false,
+			InPlace:         false,
+			Adaptive:        false,
+		},
+	}
+}
+
+// indexedPoint 带原始索引的点
+type indexedPoint struct {
+	models.Point2D
+	index int
+}
+
+// closestPairState 最近点对可视化状态
+type closestPairState struct {
+	Points   []models.Point2D `json:"points"`   // 原始点集
+	Left     int              `json:"left"`     // 当前子问题在X排序序列中的左边界
+	Right    int              `json:"right"`    // 当前子问题在X排序序列中的右边界
+	MidX     float64          `json:"midX"`     // 分割线X坐标
+	Delta    float64          `json:"delta"`    // 当前最近距离
+	Strip    []int            `json:"strip"`    // 带状区域内的点（原始索引）
+	BestPair []int            `json:"bestPair"` // 当前最近点对（原始索引）
+}
+
+// closestPairRun 单次执行的上下文
+type closestPairRun struct {
+	points   []models.Point2D
+	byX      []indexedPoint
+	tracker  models.StepTracker
+	best     float64
+	bestPair []int
+	checks   int
+}
+
+// Execute 执行最近点对算法
+func (cp *ClosestPair) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	if err := cp.ValidateInput(data); err != nil {
+		return nil, err
+	}
+
+	points, err := algorithms.ToPointSet(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return cp.ProcessPoints(points, tracker)
+}
+
+// ProcessPoints 处理点集
+func (cp *ClosestPair) ProcessPoints(points *models.PointSetData, tracker models.StepTracker) (interface{}, error) {
+	run := &closestPairRun{
+		points:   points.Points,
+		tracker:  tracker,
+		best:     math.Inf(1),
+		bestPair: []int{},
+	}
+
+	tracker.SetPhase("初始化")
+	tracker.AddStep(fmt.Sprintf("开始最近点对算法，共 %d 个点", len(points.Points)), run.state(0, len(points.Points)-1, 0, nil), []int{})
+
+	// 预先按X坐标排序
+	run.byX = make([]indexedPoint, len(points.Points))
+	for i, p := range points.Points {
+		run.byX[i] = indexedPoint{Point2D: p, index: i}
+	}
+	sort.SliceStable(run.byX, func(i, j int) bool {
+		if run.byX[i].X == run.byX[j].X {
+			return run.byX[i].Y < run.byX[j].Y
+		}
+		return run.byX[i].X < run.byX[j].X
+	})
+	tracker.AddStep("按X坐标对点排序", run.state(0, len(points.Points)-1, 0, nil), []int{})
+
+	run.solve(0, len(run.byX)-1, 0)
+
+	tracker.SetPhase("完成")
+	tracker.AddStep(fmt.Sprintf("最近点对距离: %.4f", run.best), run.state(0, len(run.byX)-1, 0, nil), run.bestPair)
+
+	pair := make([]models.Point2D, 0, 2)
+	for _, i := range run.bestPair {
+		pair = append(pair, points.Points[i])
+	}
+
+	return map[string]interface{}{
+		"distance":       run.best,
+		"pair":           pair,
+		"pairIndices":    run.bestPair,
+		"distanceChecks": run.checks,
+		"pointCount":     len(points.Points),
+	}, nil
+}
+
+// solve 递归求解 byX[left..right] 中的最近点对，返回按Y排序后的点
+func (r *closestPairRun) solve(left, right, depth int) []indexedPoint {
+	n := right - left + 1
+
+	// 小规模子问题直接暴力求解
+	if n <= 3 {
+		r.tracker.SetPhase(fmt.Sprintf("递归深度 %d - 暴力求解", depth))
+		r.tracker.AddStep(fmt.Sprintf("子问题 [%d, %d] 规模不超过3，直接两两比较", left, right),
+			r.state(left, right, 0, nil), r.rangeIndices(left, right))
+		for i := left; i <= right; i++ {
+			for j := i + 1; j <= right; j++ {
+				r.check(r.byX[i], r.byX[j])
+			}
+		}
+
+		byY := make([]indexedPoint, n)
+		copy(byY, r.byX[left:right+1])
+		sort.SliceStable(byY, func(i, j int) bool { return byY[i].Y < byY[j].Y })
+		return byY
+	}
+
+	// 分解
+	mid := left + (right-left)/2
+	midX := r.byX[mid].X
+	r.tracker.SetPhase(fmt.Sprintf("递归深度 %d - 分解", depth))
+	r.tracker.AddStep(fmt.Sprintf("以 x = %.2f 为分割线，将子问题 [%d, %d] 分为 [%d, %d] 和 [%d, %d]",
+		midX, left, right, left, mid, mid+1, right), r.state(left, right, midX, nil), r.rangeIndices(left, right))
+	r.tracker.AddOperation(models.OpTypeSplit, []int{left, mid, right}, []interface{}{midX}, "按中位X坐标分割点集")
+
+	leftByY := r.solve(left, mid, depth+1)
+	rightByY := r.solve(mid+1, right, depth+1)
+
+	// 合并：按Y坐标归并两侧结果
+	r.tracker.SetPhase(fmt.Sprintf("递归深度 %d - 合并", depth))
+	byY := make([]indexedPoint, 0, n)
+	i, j := 0, 0
+	for i < len(leftByY) && j < len(rightByY) {
+		if leftByY[i].Y <= rightByY[j].Y {
+			byY = append(byY, leftByY[i])
+			i++
+		} else {
+			byY = append(byY, rightByY[j])
+			j++
+		}
+	}
+	byY = append(byY, leftByY[i:]...)
+	byY = append(byY, rightByY[j:]...)
+
+	r.tracker.AddStep(fmt.Sprintf("合并子问题 [%d, %d]，当前最近距离 δ = %.4f", left, right, r.best),
+		r.state(left, right, midX, nil), r.bestPair)
+	r.tracker.AddOperation(models.OpTypeMerge, []int{left, mid, right}, []interface{}{r.best}, "按Y坐标归并左右两侧")
+
+	// 带状区域检查
+	strip := make([]indexedPoint, 0)
+	stripIndices := make([]int, 0)
+	for _, p := range byY {
+		if math.Abs(p.X-midX) < r.best {
+			strip = append(strip, p)
+			stripIndices = append(stripIndices, p.index)
+		}
+	}
+
+	r.tracker.SetPhase(fmt.Sprintf("递归深度 %d - 带状区域检查", depth))
+	r.tracker.AddStep(fmt.Sprintf("带状区域 |x - %.2f| < %.4f 内共有 %d 个点", midX, r.best, len(strip)),
+		r.state(left, right, midX, stripIndices), stripIndices)
+	r.tracker.AddNote("带状区域内的点按Y坐标有序，每个点最多与后续7个点比较")
+
+	for a := 0; a < len(strip); a++ {
+		for b := a + 1; b < len(strip) && b <= a+7; b++ {
+			if strip[b].Y-strip[a].Y >= r.best {
+				break
+			}
+			r.check(strip[a], strip[b])
+		}
+	}
+
+	return byY
+}
+
+// check 比较一对点的距离并在更近时更新结果
+func (r *closestPairRun) check(a, b indexedPoint) {
+	r.checks++
+	d := math.Hypot(a.X-b.X, a.Y-b.Y)
+	if d < r.best {
+		r.best = d
+		r.bestPair = []int{a.index, b.index}
+		r.tracker.AddStep(fmt.Sprintf("点 %s 与点 %s 的距离 %.4f 更小，更新最近点对", algorithms.PointName(r.points, a.index), algorithms.PointName(r.points, b.index), d),
+			r.state(0, len(r.byX)-1, 0, nil), []int{a.index, b.index})
+		r.tracker.AddComparison(a.index, b.index, -1)
+		r.tracker.AddOperation(models.OpTypeUpdate, []int{a.index, b.index}, []interface{}{d}, "更新最近距离")
+	} else {
+		r.tracker.AddComparison(a.index, b.index, 1)
+	}
+}
+
+// state 构建当前可视化状态
+func (r *closestPairRun) state(left, right int, midX float64, strip []int) closestPairState {
+	if strip == nil {
+		strip = []int{}
+	}
+	delta := r.best
+	if math.IsInf(delta, 1) {
+		delta = -1
+	}
+	return closestPairState{
+		Points:   r.points,
+		Left:     left,
+		Right:    right,
+		MidX:     midX,
+		Delta:    delta,
+		Strip:    strip,
+		BestPair: r.bestPair,
+	}
+}
+
+// rangeIndices 返回 byX[left..right] 对应的原始索引
+func (r *closestPairRun) rangeIndices(left, right int) []int {
+	indices := make([]int, 0, right-left+1)
+	for i := left; i <= right; i++ {
+		indices = append(indices, r.byX[i].index)
+	}
+	return indices
+}
+
+// ValidateInput 验证点集输入
+func (cp *ClosestPair) ValidateInput(data interface{}) error {
+	points, err := algorithms.ValidatePointSet(data, 10000)
+	if err != nil {
+		return err
+	}
+	if len(points.Points) < 2 {
+		return fmt.Errorf("最近点对至少需要2个点")
+	}
+	return nil
+}
+
+// GetComplexity 获取复杂度信息
+func (cp *ClosestPair) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(n log n)",
+			Average: "O(n log n)",
+			Worst:   "O(n log n)",
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(n)",
+			Average: "O(n)",
+			Worst:   "O(n)",
+		},
+	}
+}
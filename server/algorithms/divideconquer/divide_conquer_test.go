@@ -0,0 +1,134 @@
+package divideconquer
+
+import (
+	"gin/models"
+	"math"
+	"testing"
+)
+
+func TestClosestPair_Execute(t *testing.T) {
+	cp := NewClosestPair()
+
+	tests := []struct {
+		name     string
+		points   []models.Point2D
+		expected float64
+	}{
+		{
+			name:     "Two points",
+			points:   []models.Point2D{{X: 0, Y: 0}, {X: 3, Y: 4}},
+			expected: 5,
+		},
+		{
+			name: "Pair across the split line",
+			points: []models.Point2D{
+				{X: 0, Y: 0}, {X: 10, Y: 10}, {X: 4.9, Y: 5}, {X: 5.1, Y: 5}, {X: 20, Y: 0}, {X: 30, Y: 30},
+			},
+			expected: 0.2,
+		},
+		{
+			name: "Duplicate points",
+			points: []models.Point2D{
+				{X: 1, Y: 1}, {X: 7, Y: 3}, {X: 1, Y: 1}, {X: 9, Y: 9},
+			},
+			expected: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := cp.Execute(&models.PointSetData{Points: tt.points}, models.NewStepTracker())
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+
+			distance := result.(map[string]interface{})["distance"].(float64)
+			if math.Abs(distance-tt.expected) > 1e-9 {
+				t.Errorf("Execute() distance = %v, expected %v", distance, tt.expected)
+			}
+		})
+	}
+}
+
+func TestKaratsuba_Execute(t *testing.T) {
+	k := NewKaratsuba()
+
+	tests := []struct {
+		name     string
+		input    []interface{}
+		expected string
+	}{
+		{"Single digits", []interface{}{"7", "8"}, "56"},
+		{"Classic example", []interface{}{"1234", "5678"}, "7006652"},
+		{"Different lengths", []interface{}{"12345678901234567890", "987"}, "12185185075518518507430"},
+		{"Negative operand", []interface{}{"-25", "4"}, "-100"},
+		{"Zero", []interface{}{"0", "123456"}, "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := k.Execute(tt.input, models.NewStepTracker())
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+
+			product := result.(map[string]interface{})["product"].(string)
+			if product != tt.expected {
+				t.Errorf("Execute() product = %v, expected %v", product, tt.expected)
+			}
+		})
+	}
+
+	if err := k.ValidateInput([]interface{}{"12a", "3"}); err == nil {
+		t.Error("ValidateInput() should reject non-digit strings")
+	}
+}
+
+func TestStrassen_Execute(t *testing.T) {
+	s := NewStrassen()
+
+	a := &models.MatrixData{
+		Values: [][]interface{}{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}},
+		Rows:   3, Cols: 3, Type: "int",
+	}
+	b := &models.MatrixData{
+		Values: [][]interface{}{{9, 8}, {6, 5}, {3, 2}},
+		Rows:   3, Cols: 2, Type: "int",
+	}
+	expected := [][]int{{30, 24}, {84, 69}, {138, 114}}
+
+	result, err := s.Execute([]*models.MatrixData{a, b}, models.NewStepTracker())
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	product := result.(map[string]interface{})["product"].(*models.MatrixData)
+	if product.Rows != 3 || product.Cols != 2 {
+		t.Fatalf("Execute() product shape = %dx%d, expected 3x2", product.Rows, product.Cols)
+	}
+	for i := range expected {
+		for j := range expected[i] {
+			if product.Values[i][j] != expected[i][j] {
+				t.Errorf("Execute() product[%d][%d] = %v, expected %v", i, j, product.Values[i][j], expected[i][j])
+			}
+		}
+	}
+
+	if product.Type != "int" {
+		t.Errorf("Execute() product type = %s, expected int", product.Type)
+	}
+
+	// 任一操作数为浮点矩阵时乘积为浮点类型
+	b.Type = "float"
+	result, err = s.Execute([]*models.MatrixData{a, b}, models.NewStepTracker())
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if product := result.(map[string]interface{})["product"].(*models.MatrixData); product.Type != "float" || product.Values[0][0] != 30.0 {
+		t.Errorf("Execute() product type = %s, product[0][0] = %v, expected float 30", product.Type, product.Values[0][0])
+	}
+
+	if err := s.ValidateInput([]*models.MatrixData{b, b}); err == nil {
+		t.Error("ValidateInput() should reject mismatched dimensions")
+	}
+}
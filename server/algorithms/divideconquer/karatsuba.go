@@ -0,0 +1,283 @@
+package divideconquer
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Karatsuba Karatsuba大整数乘法
+type Karatsuba struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewKaratsuba 创建Karatsuba乘法实例
+func NewKaratsuba() *Karatsuba {
+	return &Karatsuba{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "karatsuba",
+			Name:            "Karatsuba乘法",
+			Category:        models.CategoryDivideConquer,
+			Description:     "将两个大整数按十进制位拆成高低两半，x·y = z2·10^(2m) + z1·10^m + z0，其中 z1 = (a+b)(c+d) - z2 - z0，只需3次而非4次递归乘法。输入为两个数字字符串。",
+			TimeComplexity:  "O(n^1.585)",
+			SpaceComplexity: "O(n)",
+			Parameters:      []models.Parameter{},
+			Stable:          false,
+			InPlace:         false,
+			Adaptive:        false,
+		},
+	}
+}
+
+// karatsubaState Karatsuba可视化状态
+type karatsubaState struct {
+	X      string `json:"x"`                // 当前乘数
+	Y      string `json:"y"`                // 当前被乘数
+	Depth  int    `json:"depth"`            // 递归深度
+	Split  int    `json:"split,omitempty"`  // 拆分位数 m
+	A      string `json:"a,omitempty"`      // x 的高位部分
+	B      string `json:"b,omitempty"`      // x 的低位部分
+	C      string `json:"c,omitempty"`      // y 的高位部分
+	D      string `json:"d,omitempty"`      // y 的低位部分
+	Z0     string `json:"z0,omitempty"`     // b·d
+	Z1     string `json:"z1,omitempty"`     // (a+b)(c+d) - z2 - z0
+	Z2     string `json:"z2,omitempty"`     // a·c
+	Result string `json:"result,omitempty"` // 子问题结果
+}
+
+// karatsubaRun 单次执行的上下文
+type karatsubaRun struct {
+	tracker   models.StepTracker
+	calls     int
+	baseMults int
+	maxDepth  int
+}
+
+// Execute 执行Karatsuba乘法
+func (k *Karatsuba) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	if err := k.ValidateInput(data); err != nil {
+		return nil, err
+	}
+
+	operands, err := k.parseOperands(data)
+	if err != nil {
+		return nil, err
+	}
+
+	x, y := operands[0], operands[1]
+
+	// 处理符号，递归只作用于绝对值
+	negative := false
+	if strings.HasPrefix(x, "-") {
+		negative = !negative
+		x = x[1:]
+	}
+	if strings.HasPrefix(y, "-") {
+		negative = !negative
+		y = y[1:]
+	}
+	x = trimLeadingZeros(x)
+	y = trimLeadingZeros(y)
+
+	run := &karatsubaRun{tracker: tracker}
+
+	tracker.SetPhase("初始化")
+	tracker.AddStep(fmt.Sprintf("开始Karatsuba乘法: %s × %s", operands[0], operands[1]),
+		karatsubaState{X: x, Y: y}, []int{})
+
+	xv, _ := new(big.Int).SetString(x, 10)
+	yv, _ := new(big.Int).SetString(y, 10)
+	product := run.multiply(xv, yv, 0)
+
+	if negative && product.Sign() != 0 {
+		product.Neg(product)
+	}
+
+	// 与标准库结果交叉验证
+	expected := new(big.Int).Mul(xv, yv)
+	if negative {
+		expected.Neg(expected)
+	}
+
+	tracker.SetPhase("完成")
+	tracker.AddStep("Karatsuba乘法完成，乘积: "+product.String(),
+		karatsubaState{X: x, Y: y, Result: product.String()}, []int{})
+
+	return map[string]interface{}{
+		"product":         product.String(),
+		"digits":          len(strings.TrimPrefix(product.String(), "-")),
+		"recursiveCalls":  run.calls,
+		"baseMultiplies":  run.baseMults,
+		"naiveMultiplies": len(x) * len(y),
+		"maxDepth":        run.maxDepth,
+		"verified":        product.Cmp(expected) == 0,
+	}, nil
+}
+
+// multiply 递归计算 x·y（x, y 非负）
+func (r *karatsubaRun) multiply(x, y *big.Int, depth int) *big.Int {
+	r.calls++
+	if depth > r.maxDepth {
+		r.maxDepth = depth
+	}
+
+	xs, ys := x.String(), y.String()
+
+	// 基本情况：任一乘数为一位数
+	if len(xs) == 1 || len(ys) == 1 {
+		r.baseMults++
+		result := new(big.Int).Mul(x, y)
+		r.tracker.SetPhase(fmt.Sprintf("递归深度 %d - 基本情况", depth))
+		r.tracker.AddStep(fmt.Sprintf("一位数乘法: %s × %s = %s", xs, ys, result.String()),
+			karatsubaState{X: xs, Y: ys, Depth: depth, Result: result.String()}, []int{})
+		r.tracker.AddOperation(models.OpTypeCall, []int{depth}, []interface{}{xs, ys}, "直接相乘")
+		return result
+	}
+
+	// 分解：按较长乘数的一半位数拆分
+	n := len(xs)
+	if len(ys) > n {
+		n = len(ys)
+	}
+	m := n / 2
+
+	a, b := splitDigits(x, m)
+	c, d := splitDigits(y, m)
+
+	state := karatsubaState{
+		X: xs, Y: ys, Depth: depth, Split: m,
+		A: a.String(), B: b.String(), C: c.String(), D: d.String(),
+	}
+
+	r.tracker.SetPhase(fmt.Sprintf("递归深度 %d - 分解", depth))
+	r.tracker.AddStep(fmt.Sprintf("拆分 %s = %s·10^%d + %s，%s = %s·10^%d + %s",
+		xs, a.String(), m, b.String(), ys, c.String(), m, d.String()), state, []int{})
+	r.tracker.AddOperation(models.OpTypeSplit, []int{depth}, []interface{}{m}, "按十进制位拆分乘数")
+
+	z2 := r.multiply(a, c, depth+1)
+	z0 := r.multiply(b, d, depth+1)
+
+	sumX := new(big.Int).Add(a, b)
+	sumY := new(big.Int).Add(c, d)
+	z1 := r.multiply(sumX, sumY, depth+1)
+	z1.Sub(z1, z2)
+	z1.Sub(z1, z0)
+
+	// 合并：z2·10^(2m) + z1·10^m + z0
+	shift := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(m)), nil)
+	result := new(big.Int).Mul(z2, shift)
+	result.Mul(result, shift)
+	result.Add(result, new(big.Int).Mul(z1, shift))
+	result.Add(result, z0)
+
+	state.Z0, state.Z1, state.Z2 = z0.String(), z1.String(), z2.String()
+	state.Result = result.String()
+
+	r.tracker.SetPhase(fmt.Sprintf("递归深度 %d - 合并", depth))
+	r.tracker.AddStep(fmt.Sprintf("合并: %s·10^%d + %s·10^%d + %s = %s",
+		state.Z2, 2*m, state.Z1, m, state.Z0, state.Result), state, []int{})
+	r.tracker.AddOperation(models.OpTypeMerge, []int{depth}, []interface{}{state.Result}, "组合三个子乘积")
+
+	return result
+}
+
+// splitDigits 将 v 拆分为 v / 10^m 与 v % 10^m
+func splitDigits(v *big.Int, m int) (*big.Int, *big.Int) {
+	divisor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(m)), nil)
+	high, low := new(big.Int).QuoRem(v, divisor, new(big.Int))
+	return high, low
+}
+
+// trimLeadingZeros 去除前导零
+func trimLeadingZeros(s string) string {
+	s = strings.TrimLeft(s, "0")
+	if s == "" {
+		return "0"
+	}
+	return s
+}
+
+// parseOperands 解析两个乘数
+func (k *Karatsuba) parseOperands(data interface{}) ([]string, error) {
+	arr, ok := data.([]interface{})
+	if !ok || len(arr) != 2 {
+		return nil, algorithms.ErrInvalidInput
+	}
+
+	operands := make([]string, 2)
+	for i, v := range arr {
+		switch val := v.(type) {
+		case string:
+			operands[i] = strings.TrimSpace(val)
+		case int:
+			operands[i] = strconv.Itoa(val)
+		case float64:
+			if val != float64(int64(val)) {
+				return nil, fmt.Errorf("Karatsuba乘法只支持整数: %v", val)
+			}
+			operands[i] = strconv.FormatInt(int64(val), 10)
+		default:
+			return nil, algorithms.ErrInvalidInput
+		}
+
+		if !isDigitString(operands[i]) {
+			return nil, fmt.Errorf("无效的整数字符串: %q", operands[i])
+		}
+	}
+
+	return operands, nil
+}
+
+// isDigitString 检查是否为可带负号的十进制数字串
+func isDigitString(s string) bool {
+	s = strings.TrimPrefix(s, "-")
+	if s == "" {
+		return false
+	}
+	for _, ch := range s {
+		if ch < '0' || ch > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidateInput 验证输入：两个数字字符串
+func (k *Karatsuba) ValidateInput(data interface{}) error {
+	if data == nil {
+		return algorithms.ErrInvalidInput
+	}
+
+	operands, err := k.parseOperands(data)
+	if err != nil {
+		return err
+	}
+
+	// 限制位数，避免步骤数量过多
+	for _, op := range operands {
+		if len(op) > 200 {
+			return algorithms.ErrInvalidInput
+		}
+	}
+
+	return nil
+}
+
+// GetComplexity 获取复杂度信息
+func (k *Karatsuba) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(n^1.585)",
+			Average: "O(n^1.585)",
+			Worst:   "O(n^1.585)",
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(n)",
+			Average: "O(n)",
+			Worst:   "O(n)",
+		},
+	}
+}
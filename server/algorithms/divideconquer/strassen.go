@@ -0,0 +1,344 @@
+package divideconquer
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+)
+
+// strassenLeafSize 子矩阵规模不超过该值时直接使用朴素乘法
+const strassenLeafSize = 2
+
+// Strassen Strassen矩阵乘法
+type Strassen struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewStrassen 创建Strassen矩阵乘法实例
+func NewStrassen() *Strassen {
+	return &Strassen{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "strassen",
+			Name:            "Strassen矩阵乘法",
+			Category:        models.CategoryDivideConquer,
+			Description:     "将矩阵补齐为2的幂并划分为四个象限，用7次而非8次子矩阵乘法（M1..M7）组合出乘积的四个象限。输入为两个矩阵 A、B。",
+			TimeComplexity:  "O(n^2.807)",
+			SpaceComplexity: "O(n²)",
+			Parameters:      []models.Parameter{},
+			Stable:          false,
+			InPlace:         false,
+			Adaptive:        false,
+		},
+	}
+}
+
+// strassenState Strassen可视化状态
+type strassenState struct {
+	Depth   int         `json:"depth"`             // 递归深度
+	Size    int         `json:"size"`              // 当前子矩阵规模
+	Label   string      `json:"label"`             // 当前子问题（如 M3）
+	A       [][]float64 `json:"a"`                 // 左操作数
+	B       [][]float64 `json:"b"`                 // 右操作数
+	Product [][]float64 `json:"product,omitempty"` // 子问题乘积
+}
+
+// strassenRun 单次执行的上下文
+type strassenRun struct {
+	tracker         models.StepTracker
+	multiplications int
+	calls           int
+	maxDepth        int
+}
+
+// Execute 执行Strassen矩阵乘法
+func (s *Strassen) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	if err := s.ValidateInput(data); err != nil {
+		return nil, err
+	}
+
+	matrices, ok := data.([]*models.MatrixData)
+	if !ok {
+		return nil, algorithms.ErrInvalidInput
+	}
+
+	return s.ProcessMatrices(matrices, tracker)
+}
+
+// ProcessMatrices 计算 matrices[0] × matrices[1]
+func (s *Strassen) ProcessMatrices(matrices []*models.MatrixData, tracker models.StepTracker) (interface{}, error) {
+	a, err := toFloatMatrix(matrices[0])
+	if err != nil {
+		return nil, err
+	}
+	b, err := toFloatMatrix(matrices[1])
+	if err != nil {
+		return nil, err
+	}
+
+	rows, inner, cols := matrices[0].Rows, matrices[0].Cols, matrices[1].Cols
+
+	tracker.SetPhase("初始化")
+	tracker.AddStep(fmt.Sprintf("开始Strassen矩阵乘法: (%d×%d) × (%d×%d)", rows, inner, inner, cols),
+		strassenState{Size: maxInt(rows, maxInt(inner, cols)), Label: "A×B", A: a, B: b}, []int{})
+
+	// 补齐为 2 的幂次方阵
+	size := 1
+	for size < rows || size < inner || size < cols {
+		size *= 2
+	}
+	paddedA := padMatrix(a, size)
+	paddedB := padMatrix(b, size)
+	if size != rows || size != inner || size != cols {
+		tracker.AddStep(fmt.Sprintf("用零补齐为 %d×%d 方阵", size, size),
+			strassenState{Size: size, Label: "A×B", A: paddedA, B: paddedB}, []int{})
+		tracker.AddNote("Strassen要求矩阵规模为2的幂，补零不影响乘积")
+	}
+
+	run := &strassenRun{tracker: tracker}
+	padded := run.multiply(paddedA, paddedB, 0, "A×B")
+
+	// 去除补齐部分，两个操作数都是整数矩阵时乘积保持整数类型
+	productType := "float"
+	if matrices[0].Type == "int" && matrices[1].Type == "int" {
+		productType = "int"
+	}
+	product := make([][]interface{}, rows)
+	for i := 0; i < rows; i++ {
+		product[i] = make([]interface{}, cols)
+		for j := 0; j < cols; j++ {
+			if productType == "int" {
+				product[i][j] = int(padded[i][j])
+			} else {
+				product[i][j] = padded[i][j]
+			}
+		}
+	}
+
+	tracker.SetPhase("完成")
+	tracker.AddStep("Strassen矩阵乘法完成", strassenState{
+		Size: size, Label: "A×B", A: a, B: b, Product: padded,
+	}, []int{})
+
+	naive := rows * inner * cols
+	return map[string]interface{}{
+		"product": &models.MatrixData{
+			Values: product,
+			Rows:   rows,
+			Cols:   cols,
+			Type:   productType,
+		},
+		"paddedSize":            size,
+		"recursiveCalls":        run.calls,
+		"scalarMultiplications": run.multiplications,
+		"naiveMultiplications":  naive,
+		"maxDepth":              run.maxDepth,
+	}, nil
+}
+
+// multiply 递归计算 a×b（a、b 为同阶2的幂方阵）
+func (r *strassenRun) multiply(a, b [][]float64, depth int, label string) [][]float64 {
+	r.calls++
+	if depth > r.maxDepth {
+		r.maxDepth = depth
+	}
+
+	n := len(a)
+	if n <= strassenLeafSize {
+		r.tracker.SetPhase(fmt.Sprintf("递归深度 %d - 基本情况", depth))
+		c := naiveMultiply(a, b)
+		r.multiplications += n * n * n
+		r.tracker.AddStep(fmt.Sprintf("%s: %d×%d 子矩阵直接相乘", label, n, n),
+			strassenState{Depth: depth, Size: n, Label: label, A: a, B: b, Product: c}, []int{})
+		r.tracker.AddOperation(models.OpTypeCall, []int{depth}, []interface{}{label}, "朴素矩阵乘法")
+		return c
+	}
+
+	// 分解为四个象限
+	half := n / 2
+	a11, a12, a21, a22 := quadrants(a)
+	b11, b12, b21, b22 := quadrants(b)
+
+	r.tracker.SetPhase(fmt.Sprintf("递归深度 %d - 分解", depth))
+	r.tracker.AddStep(fmt.Sprintf("%s: 将 %d×%d 矩阵划分为四个 %d×%d 象限", label, n, n, half, half),
+		strassenState{Depth: depth, Size: n, Label: label, A: a, B: b}, []int{})
+	r.tracker.AddOperation(models.OpTypeSplit, []int{depth}, []interface{}{half}, "划分象限")
+
+	// 7 次递归乘法
+	m1 := r.multiply(addMatrix(a11, a22), addMatrix(b11, b22), depth+1, "M1=(A11+A22)(B11+B22)")
+	m2 := r.multiply(addMatrix(a21, a22), b11, depth+1, "M2=(A21+A22)B11")
+	m3 := r.multiply(a11, subMatrix(b12, b22), depth+1, "M3=A11(B12-B22)")
+	m4 := r.multiply(a22, subMatrix(b21, b11), depth+1, "M4=A22(B21-B11)")
+	m5 := r.multiply(addMatrix(a11, a12), b22, depth+1, "M5=(A11+A12)B22")
+	m6 := r.multiply(subMatrix(a21, a11), addMatrix(b11, b12), depth+1, "M6=(A21-A11)(B11+B12)")
+	m7 := r.multiply(subMatrix(a12, a22), addMatrix(b21, b22), depth+1, "M7=(A12-A22)(B21+B22)")
+
+	// 合并象限
+	c11 := addMatrix(subMatrix(addMatrix(m1, m4), m5), m7)
+	c12 := addMatrix(m3, m5)
+	c21 := addMatrix(m2, m4)
+	c22 := addMatrix(subMatrix(addMatrix(m1, m3), m2), m6)
+	c := joinQuadrants(c11, c12, c21, c22)
+
+	r.tracker.SetPhase(fmt.Sprintf("递归深度 %d - 合并", depth))
+	r.tracker.AddStep(fmt.Sprintf("%s: 由 M1..M7 组合出四个象限", label),
+		strassenState{Depth: depth, Size: n, Label: label, A: a, B: b, Product: c}, []int{})
+	r.tracker.AddOperation(models.OpTypeMerge, []int{depth}, []interface{}{label}, "C11=M1+M4-M5+M7, C12=M3+M5, C21=M2+M4, C22=M1-M2+M3+M6")
+
+	return c
+}
+
+// toFloatMatrix 将矩阵值转换为浮点数
+func toFloatMatrix(m *models.MatrixData) ([][]float64, error) {
+	result := make([][]float64, m.Rows)
+	for i := 0; i < m.Rows; i++ {
+		result[i] = make([]float64, m.Cols)
+		for j := 0; j < m.Cols; j++ {
+			switch v := m.Values[i][j].(type) {
+			case int:
+				result[i][j] = float64(v)
+			case float64:
+				result[i][j] = v
+			default:
+				return nil, fmt.Errorf("矩阵元素(%d,%d)不是数值", i, j)
+			}
+		}
+	}
+	return result, nil
+}
+
+// padMatrix 用零补齐为 size×size 方阵
+func padMatrix(m [][]float64, size int) [][]float64 {
+	result := newMatrix(size)
+	for i := range m {
+		copy(result[i], m[i])
+	}
+	return result
+}
+
+// newMatrix 创建 n×n 零矩阵
+func newMatrix(n int) [][]float64 {
+	m := make([][]float64, n)
+	for i := range m {
+		m[i] = make([]float64, n)
+	}
+	return m
+}
+
+// naiveMultiply 朴素矩阵乘法
+func naiveMultiply(a, b [][]float64) [][]float64 {
+	n := len(a)
+	c := newMatrix(n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			for k := 0; k < n; k++ {
+				c[i][j] += a[i][k] * b[k][j]
+			}
+		}
+	}
+	return c
+}
+
+// quadrants 将方阵划分为四个象限
+func quadrants(m [][]float64) ([][]float64, [][]float64, [][]float64, [][]float64) {
+	half := len(m) / 2
+	q11, q12, q21, q22 := newMatrix(half), newMatrix(half), newMatrix(half), newMatrix(half)
+	for i := 0; i < half; i++ {
+		copy(q11[i], m[i][:half])
+		copy(q12[i], m[i][half:])
+		copy(q21[i], m[i+half][:half])
+		copy(q22[i], m[i+half][half:])
+	}
+	return q11, q12, q21, q22
+}
+
+// joinQuadrants 将四个象限拼接为方阵
+func joinQuadrants(q11, q12, q21, q22 [][]float64) [][]float64 {
+	half := len(q11)
+	m := newMatrix(half * 2)
+	for i := 0; i < half; i++ {
+		copy(m[i][:half], q11[i])
+		copy(m[i][half:], q12[i])
+		copy(m[i+half][:half], q21[i])
+		copy(m[i+half][half:], q22[i])
+	}
+	return m
+}
+
+// addMatrix 矩阵加法
+func addMatrix(a, b [][]float64) [][]float64 {
+	c := newMatrix(len(a))
+	for i := range a {
+		for j := range a[i] {
+			c[i][j] = a[i][j] + b[i][j]
+		}
+	}
+	return c
+}
+
+// subMatrix 矩阵减法
+func subMatrix(a, b [][]float64) [][]float64 {
+	c := newMatrix(len(a))
+	for i := range a {
+		for j := range a[i] {
+			c[i][j] = a[i][j] - b[i][j]
+		}
+	}
+	return c
+}
+
+// maxInt 返回两个整数中的较大值
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// ValidateInput 验证输入：两个可相乘的矩阵
+func (s *Strassen) ValidateInput(data interface{}) error {
+	if data == nil {
+		return algorithms.ErrInvalidInput
+	}
+
+	matrices, ok := data.([]*models.MatrixData)
+	if !ok || len(matrices) != 2 || matrices[0] == nil || matrices[1] == nil {
+		return algorithms.ErrInvalidInput
+	}
+
+	for _, m := range matrices {
+		if m.Rows <= 0 || m.Cols <= 0 || len(m.Values) != m.Rows {
+			return algorithms.ErrInvalidInput
+		}
+		for _, row := range m.Values {
+			if len(row) != m.Cols {
+				return algorithms.ErrInvalidInput
+			}
+		}
+		// 限制规模，避免递归步骤数量过多
+		if m.Rows > 32 || m.Cols > 32 {
+			return algorithms.ErrInvalidInput
+		}
+	}
+
+	if matrices[0].Cols != matrices[1].Rows {
+		return fmt.Errorf("矩阵维度不匹配: A的列数(%d)必须等于B的行数(%d)", matrices[0].Cols, matrices[1].Rows)
+	}
+
+	return nil
+}
+
+// GetComplexity 获取复杂度信息
+func (s *Strassen) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(n^2.807)",
+			Average: "O(n^2.807)",
+			Worst:   "O(n^2.807)",
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(n²)",
+			Average: "O(n²)",
+			Worst:   "O(n²)",
+		},
+	}
+}
@@ -0,0 +1,91 @@
+package geometry
+
+import (
+	"gin/algorithms"
+	"gin/models"
+	"math"
+	"sort"
+	"testing"
+)
+
+func TestConvexHull_Execute(t *testing.T) {
+	hulls := []algorithms.PointSetAlgorithm{NewGrahamScan(), NewJarvisMarch(), NewMonotoneChain()}
+
+	tests := []struct {
+		name     string
+		points   []models.Point2D
+		expected []string
+		area     float64
+	}{
+		{
+			name: "Square with interior and edge points",
+			points: []models.Point2D{
+				{Label: "A", X: 0, Y: 0}, {Label: "B", X: 4, Y: 0}, {Label: "C", X: 4, Y: 4}, {Label: "D", X: 0, Y: 4},
+				{Label: "E", X: 2, Y: 2}, {Label: "F", X: 2, Y: 0}, {Label: "G", X: 1, Y: 3}, {Label: "H", X: 0, Y: 2},
+			},
+			expected: []string{"A", "B", "C", "D"},
+			area:     16,
+		},
+		{
+			name: "Triangle with duplicates",
+			points: []models.Point2D{
+				{Label: "A", X: 0, Y: 0}, {Label: "B", X: 6, Y: 0}, {Label: "C", X: 3, Y: 5},
+				{Label: "D", X: 3, Y: 1}, {Label: "B", X: 6, Y: 0}, {Label: "F", X: 2, Y: 2},
+			},
+			expected: []string{"A", "B", "C"},
+			area:     15,
+		},
+		{
+			name: "All points on a circle",
+			points: func() []models.Point2D {
+				points := make([]models.Point2D, 12)
+				for i := range points {
+					theta := 2 * math.Pi * float64(i) / 12
+					points[i] = models.Point2D{Label: string(rune('a' + i)), X: 10 * math.Cos(theta), Y: 10 * math.Sin(theta)}
+				}
+				return points
+			}(),
+			expected: []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k", "l"},
+			area:     300,
+		},
+	}
+
+	for _, hull := range hulls {
+		for _, tt := range tests {
+			t.Run(hull.GetInfo().ID+"/"+tt.name, func(t *testing.T) {
+				result, err := hull.ProcessPoints(&models.PointSetData{Points: tt.points}, models.NewStepTracker())
+				if err != nil {
+					t.Fatalf("ProcessPoints() error = %v", err)
+				}
+
+				output := result.(map[string]interface{})
+				vertices := output["hull"].([]models.Point2D)
+				labels := make([]string, len(vertices))
+				for i, v := range vertices {
+					labels[i] = v.Label
+				}
+				sort.Strings(labels)
+				if len(labels) != len(tt.expected) {
+					t.Fatalf("hull = %v, expected %v", labels, tt.expected)
+				}
+				for i := range labels {
+					if labels[i] != tt.expected[i] {
+						t.Fatalf("hull = %v, expected %v", labels, tt.expected)
+					}
+				}
+
+				// 凸包顶点按逆时针排列
+				for i := range vertices {
+					a, b, c := vertices[i], vertices[(i+1)%len(vertices)], vertices[(i+2)%len(vertices)]
+					if cross(a, b, c) <= 0 {
+						t.Errorf("hull is not strictly counter-clockwise at vertex %s", b.Label)
+					}
+				}
+
+				if area := output["area"].(float64); math.Abs(area-tt.area) > 1e-9 {
+					t.Errorf("area = %v, expected %v", area, tt.area)
+				}
+			})
+		}
+	}
+}
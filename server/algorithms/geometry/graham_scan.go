@@ -0,0 +1,127 @@
+package geometry
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"sort"
+)
+
+// GrahamScan Graham扫描凸包算法
+type GrahamScan struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewGrahamScan 创建Graham扫描算法实例
+func NewGrahamScan() *GrahamScan {
+	return &GrahamScan{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "graham_scan",
+			Name:            "Graham扫描",
+			Category:        models.CategoryGeometry,
+			Description:     "以最下方的点为基点，将其余点按极角排序后依次扫描。维护一个候选凸包栈，每加入一个点前检查栈顶两点与新点是否构成左转，不是左转就弹出栈顶。",
+			TimeComplexity:  "O(n log n)",
+			SpaceComplexity: "O(n)",
+			Parameters:      []models.Parameter{},
+			Stable:          false,
+			InPlace:         false,
+			Adaptive:        false,
+		},
+	}
+}
+
+// Execute 执行Graham扫描
+func (gs *GrahamScan) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	if err := gs.ValidateInput(data); err != nil {
+		return nil, err
+	}
+
+	points, err := algorithms.ToPointSet(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return gs.ProcessPoints(points, tracker)
+}
+
+// ProcessPoints 处理点集
+func (gs *GrahamScan) ProcessPoints(points *models.PointSetData, tracker models.StepTracker) (interface{}, error) {
+	run := newHullRun(points.Points, tracker)
+	pts := points.Points
+	n := len(pts)
+
+	tracker.SetPhase("初始化")
+	tracker.AddStep(fmt.Sprintf("开始Graham扫描，共 %d 个点", n), run.state(-1, nil, nil), []int{})
+
+	// 选择Y坐标最小（相同时X最小）的点作为基点
+	pivot := 0
+	for i := 1; i < n; i++ {
+		if pts[i].Y < pts[pivot].Y || (pts[i].Y == pts[pivot].Y && pts[i].X < pts[pivot].X) {
+			pivot = i
+		}
+	}
+	tracker.AddStep("选择最下方的点 "+pts[pivot].Label+" 作为基点", run.state(pivot, nil, nil), []int{pivot})
+
+	// 其余点按相对基点的极角排序，极角相同时距离近的在前
+	tracker.SetPhase("极角排序")
+	order := make([]int, 0, n)
+	order = append(order, pivot)
+	for i := 0; i < n; i++ {
+		if i != pivot {
+			order = append(order, i)
+		}
+	}
+	rest := order[1:]
+	sort.SliceStable(rest, func(i, j int) bool {
+		value := cross(pts[pivot], pts[rest[i]], pts[rest[j]])
+		if value != 0 {
+			return value > 0
+		}
+		return algorithms.DistanceSquared(pts[pivot], pts[rest[i]]) < algorithms.DistanceSquared(pts[pivot], pts[rest[j]])
+	})
+	run.order = order
+	tracker.AddStep("按极角对其余点排序（极角相同时距离近的在前）", run.state(-1, nil, nil), order)
+	tracker.AddNote("极角比较同样通过叉积完成，无需计算反三角函数")
+
+	// 扫描
+	tracker.SetPhase("扫描")
+	run.push(order[0])
+	run.push(order[1])
+	for _, index := range order[2:] {
+		tracker.AddStep("考察点 "+pts[index].Label, run.state(index, nil, nil), []int{index})
+		for len(run.stack) >= 2 {
+			top := run.stack[len(run.stack)-1]
+			below := run.stack[len(run.stack)-2]
+			if run.orient(below, top, index) > 0 {
+				break
+			}
+			run.pop("不构成左转")
+		}
+		run.push(index)
+	}
+
+	hull := make([]int, len(run.stack))
+	copy(hull, run.stack)
+	return run.finish(hull), nil
+}
+
+// ValidateInput 验证点集输入
+func (gs *GrahamScan) ValidateInput(data interface{}) error {
+	return validateHullInput(data)
+}
+
+// GetComplexity 获取复杂度信息
+func (gs *GrahamScan) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(n log n)",
+			Average: "O(n log n)",
+			Worst:   "O(n log n)",
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(n)",
+			Average: "O(n)",
+			Worst:   "O(n)",
+		},
+	}
+}
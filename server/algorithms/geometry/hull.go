@@ -0,0 +1,170 @@
+package geometry
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"math"
+)
+
+// orientationTest 一次方向（叉积）测试
+type orientationTest struct {
+	A     int     `json:"a"`     // 起点（原始索引）
+	B     int     `json:"b"`     // 中间点（原始索引）
+	C     int     `json:"c"`     // 待测点（原始索引）
+	Cross float64 `json:"cross"` // 叉积 (B-A)×(C-A)
+	Turn  string  `json:"turn"`  // left: 逆时针, right: 顺时针, collinear: 共线
+}
+
+// hullState 凸包可视化状态
+type hullState struct {
+	Points    []models.Point2D `json:"points"`          // 原始点集
+	Order     []int            `json:"order,omitempty"` // 预处理后的点顺序（原始索引）
+	Stack     []int            `json:"stack"`           // 候选凸包栈（原始索引）
+	Candidate int              `json:"candidate"`       // 当前考察的点，-1 表示无
+	Test      *orientationTest `json:"test,omitempty"`  // 当前方向测试
+	Hull      []int            `json:"hull,omitempty"`  // 最终凸包（逆时针）
+}
+
+// hullRun 凸包算法单次执行的上下文
+type hullRun struct {
+	points  []models.Point2D
+	tracker models.StepTracker
+	order   []int
+	stack   []int
+	tests   int
+	pushes  int
+	pops    int
+}
+
+// newHullRun 创建执行上下文
+func newHullRun(points []models.Point2D, tracker models.StepTracker) *hullRun {
+	return &hullRun{
+		points:  points,
+		tracker: tracker,
+		stack:   []int{},
+	}
+}
+
+// cross 计算 (b-a)×(c-a)，大于0表示逆时针（左转）
+func cross(a, b, c models.Point2D) float64 {
+	return (b.X-a.X)*(c.Y-a.Y) - (b.Y-a.Y)*(c.X-a.X)
+}
+
+// turnName 叉积对应的转向
+func turnName(value float64) string {
+	switch {
+	case value > 0:
+		return "left"
+	case value < 0:
+		return "right"
+	default:
+		return "collinear"
+	}
+}
+
+// turnLabel 转向的中文描述
+func turnLabel(value float64) string {
+	switch {
+	case value > 0:
+		return "左转（逆时针）"
+	case value < 0:
+		return "右转（顺时针）"
+	default:
+		return "共线"
+	}
+}
+
+// orient 执行一次方向测试并记录步骤
+func (r *hullRun) orient(a, b, c int) float64 {
+	r.tests++
+	value := cross(r.points[a], r.points[b], r.points[c])
+	test := &orientationTest{A: a, B: b, C: c, Cross: value, Turn: turnName(value)}
+
+	sign := 0
+	if value > 0 {
+		sign = 1
+	} else if value < 0 {
+		sign = -1
+	}
+	r.tracker.AddComparison(b, c, sign)
+	r.tracker.AddStep(fmt.Sprintf("方向测试 %s → %s → %s: 叉积 = %.4g，%s",
+		r.points[a].Label, r.points[b].Label, r.points[c].Label, value, turnLabel(value)),
+		r.state(c, test, nil), []int{a, b, c})
+	return value
+}
+
+// push 将点压入候选栈
+func (r *hullRun) push(index int) {
+	r.stack = append(r.stack, index)
+	r.pushes++
+	r.tracker.AddOperation(models.OpTypeInsert, []int{index}, []interface{}{r.points[index].Label}, "压入候选栈")
+	r.tracker.AddStep("将点 "+r.points[index].Label+" 压入候选栈", r.state(index, nil, nil), []int{index})
+}
+
+// pop 弹出栈顶点
+func (r *hullRun) pop(reason string) {
+	top := r.stack[len(r.stack)-1]
+	r.stack = r.stack[:len(r.stack)-1]
+	r.pops++
+	r.tracker.AddOperation(models.OpTypeDelete, []int{top}, []interface{}{r.points[top].Label}, "弹出栈顶")
+	r.tracker.AddStep("弹出点 "+r.points[top].Label+"："+reason, r.state(-1, nil, nil), []int{top})
+}
+
+// state 构建当前可视化状态
+func (r *hullRun) state(candidate int, test *orientationTest, hull []int) hullState {
+	stack := make([]int, len(r.stack))
+	copy(stack, r.stack)
+	return hullState{
+		Points:    r.points,
+		Order:     r.order,
+		Stack:     stack,
+		Candidate: candidate,
+		Test:      test,
+		Hull:      hull,
+	}
+}
+
+// finish 记录最终凸包并构建输出
+func (r *hullRun) finish(hull []int) map[string]interface{} {
+	r.tracker.SetPhase("完成")
+	r.tracker.AddStep(fmt.Sprintf("凸包构建完成，共 %d 个顶点", len(hull)), r.state(-1, nil, hull), hull)
+
+	vertices := make([]models.Point2D, len(hull))
+	for i, index := range hull {
+		vertices[i] = r.points[index]
+	}
+
+	return map[string]interface{}{
+		"hull":             vertices,
+		"hullIndices":      hull,
+		"hullSize":         len(hull),
+		"area":             polygonArea(vertices),
+		"orientationTests": r.tests,
+		"pushes":           r.pushes,
+		"pops":             r.pops,
+		"pointCount":       len(r.points),
+	}
+}
+
+// polygonArea 鞋带公式计算多边形面积
+func polygonArea(vertices []models.Point2D) float64 {
+	area := 0.0
+	for i := range vertices {
+		j := (i + 1) % len(vertices)
+		area += vertices[i].X*vertices[j].Y - vertices[j].X*vertices[i].Y
+	}
+	return math.Abs(area) / 2
+}
+
+// validateHullInput 凸包算法的输入验证
+func validateHullInput(data interface{}) error {
+	points, err := algorithms.ValidatePointSet(data, 10000)
+	if err != nil {
+		return err
+	}
+	if len(points.Points) < 3 {
+		return fmt.Errorf("凸包至少需要3个点")
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package geometry
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+)
+
+// JarvisMarch Jarvis步进（礼品包装）凸包算法
+type JarvisMarch struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewJarvisMarch 创建Jarvis步进算法实例
+func NewJarvisMarch() *JarvisMarch {
+	return &JarvisMarch{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "jarvis_march",
+			Name:            "Jarvis步进",
+			Category:        models.CategoryGeometry,
+			Description:     "又称礼品包装算法。从最左侧的点出发，每次在所有点中找出使其余点都位于左侧的下一个点，像包装礼品一样绕点集一圈。复杂度与凸包顶点数h相关，所有点都在凸包上（如圆周上的点）时退化为O(n²)。",
+			TimeComplexity:  "O(nh)",
+			SpaceComplexity: "O(h)",
+			Parameters:      []models.Parameter{},
+			Stable:          false,
+			InPlace:         false,
+			Adaptive:        true,
+		},
+	}
+}
+
+// Execute 执行Jarvis步进
+func (jm *JarvisMarch) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	if err := jm.ValidateInput(data); err != nil {
+		return nil, err
+	}
+
+	points, err := algorithms.ToPointSet(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return jm.ProcessPoints(points, tracker)
+}
+
+// ProcessPoints 处理点集
+func (jm *JarvisMarch) ProcessPoints(points *models.PointSetData, tracker models.StepTracker) (interface{}, error) {
+	run := newHullRun(points.Points, tracker)
+	pts := points.Points
+	n := len(pts)
+
+	tracker.SetPhase("初始化")
+	tracker.AddStep(fmt.Sprintf("开始Jarvis步进，共 %d 个点", n), run.state(-1, nil, nil), []int{})
+
+	// 选择X坐标最小（相同时Y最小）的点作为起点，它一定在凸包上
+	start := 0
+	for i := 1; i < n; i++ {
+		if pts[i].X < pts[start].X || (pts[i].X == pts[start].X && pts[i].Y < pts[start].Y) {
+			start = i
+		}
+	}
+	tracker.AddStep("选择最左侧的点 "+algorithms.PointName(pts, start)+" 作为起点", run.state(start, nil, nil), []int{start})
+
+	current := start
+	for step := 1; step <= n; step++ {
+		tracker.SetPhase(fmt.Sprintf("第 %d 次包装", step))
+		run.push(current)
+
+		// 任选一个不同于当前点的候选点
+		candidate := (current + 1) % n
+		tracker.AddStep("以点 "+algorithms.PointName(pts, candidate)+" 作为初始候选", run.state(candidate, nil, nil), []int{current, candidate})
+
+		for i := 0; i < n; i++ {
+			if i == current || i == candidate {
+				continue
+			}
+			value := run.orient(current, candidate, i)
+			// 点i在 current→candidate 右侧，或共线但更远，则替换候选
+			if value < 0 || (value == 0 && algorithms.DistanceSquared(pts[current], pts[i]) > algorithms.DistanceSquared(pts[current], pts[candidate])) {
+				candidate = i
+				tracker.AddOperation(models.OpTypeUpdate, []int{candidate}, []interface{}{algorithms.PointName(pts, candidate)}, "更新候选点")
+				tracker.AddStep("更新候选点为 "+algorithms.PointName(pts, candidate), run.state(candidate, nil, nil), []int{current, candidate})
+			}
+		}
+
+		// 回到起点（或与起点重合的点）时凸包闭合
+		if candidate == start || (pts[candidate].X == pts[start].X && pts[candidate].Y == pts[start].Y) {
+			tracker.AddNote("下一个点回到起点，凸包闭合")
+			break
+		}
+		tracker.AddNote("点 " + algorithms.PointName(pts, candidate) + " 是下一个凸包顶点")
+		current = candidate
+	}
+
+	hull := make([]int, len(run.stack))
+	copy(hull, run.stack)
+	return run.finish(hull), nil
+}
+
+// ValidateInput 验证点集输入
+func (jm *JarvisMarch) ValidateInput(data interface{}) error {
+	return validateHullInput(data)
+}
+
+// GetComplexity 获取复杂度信息
+func (jm *JarvisMarch) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(n)",
+			Average: "O(nh)",
+			Worst:   "O(n²)", // 所有点都在凸包上
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(1)",
+			Average: "O(h)",
+			Worst:   "O(n)",
+		},
+	}
+}
@@ -0,0 +1,286 @@
+package geometry
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"math"
+	"sort"
+	"strconv"
+)
+
+// KDTree k-d树
+type KDTree struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewKDTree 创建k-d树实例
+func NewKDTree() *KDTree {
+	return &KDTree{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "kd_tree",
+			Name:            "k-d树",
+			Category:        models.CategoryGeometry,
+			Description:     "按深度交替以x、y坐标为轴，取当前点集在该轴上的中位数点作为节点，将区域一分为二递归建树。最近邻查询先进入查询点所在一侧，再回溯检查另一侧：区域到查询点的最短距离不小于当前第k近的距离时整个区域被剪枝。范围查询跳过与矩形不相交的区域，完全包含在矩形内的区域直接报告全部点。",
+			TimeComplexity:  "O(n log² n) 构建，O(log n) 平均查询",
+			SpaceComplexity: "O(n)",
+			Parameters:      spatialQueryParameters(),
+		},
+	}
+}
+
+// kdNode k-d树节点
+type kdNode struct {
+	id          string
+	point       int // 节点上的点（原始索引）
+	axis        int // 0: x, 1: y
+	split       float64
+	box         regionBox
+	left, right *kdNode
+}
+
+// kdRun 一次k-d树构建与查询
+type kdRun struct {
+	points  []models.Point2D
+	tracker models.StepTracker
+	nodes   int
+}
+
+// Execute 使用默认参数构建k-d树并查询
+func (kd *KDTree) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return kd.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 构建k-d树并按参数执行最近邻或范围查询
+func (kd *KDTree) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	if err := kd.ValidateInput(data); err != nil {
+		return nil, err
+	}
+	points, err := algorithms.ToPointSet(data)
+	if err != nil {
+		return nil, err
+	}
+	pts := points.Points
+	bounds := pointBounds(pts)
+	query, err := parseSpatialQuery(params, bounds)
+	if err != nil {
+		return nil, err
+	}
+
+	run := &kdRun{points: pts, tracker: tracker}
+	tracker.SetPhase("构建")
+	tracker.AddStep(fmt.Sprintf("开始构建k-d树，共 %d 个点，根区域为包围盒 %s", len(pts), bounds.describe()),
+		spatialState{Points: pts, Visited: []regionBox{}, Pruned: []regionBox{}, Radius: -1, Candidate: -1}, []int{})
+	indices := make([]int, len(pts))
+	for i := range indices {
+		indices[i] = i
+	}
+	root := run.build(indices, 0, bounds)
+	tree := run.export(root)
+	height := kdHeight(root)
+	tracker.AddStep(fmt.Sprintf("k-d树构建完成，共 %d 个节点，高度 %d", run.nodes, height),
+		spatialState{Points: pts, Tree: tree, Visited: []regionBox{}, Pruned: []regionBox{}, Radius: -1, Candidate: -1}, []int{})
+
+	search := newSpatialSearch(pts, query, tracker)
+	if query.mode == QueryNearest {
+		tracker.SetPhase("最近邻查询")
+		tracker.AddStep(fmt.Sprintf("查找距离 (%.4g, %.4g) 最近的 %d 个点", query.point.X, query.point.Y, query.k), search.state(nil, -1), []int{})
+		run.nearest(search, root)
+	} else {
+		tracker.SetPhase("范围查询")
+		tracker.AddStep("查找矩形 "+query.rect.describe()+" 内的点", search.state(nil, -1), []int{})
+		run.rangeSearch(search, root)
+	}
+	search.finish()
+
+	output := map[string]interface{}{
+		"tree":       tree,
+		"nodeCount":  run.nodes,
+		"height":     height,
+		"pointCount": len(pts),
+	}
+	search.result(output)
+	return output, nil
+}
+
+// ProcessPoints 处理点集
+func (kd *KDTree) ProcessPoints(points *models.PointSetData, tracker models.StepTracker) (interface{}, error) {
+	return kd.Execute(points, tracker)
+}
+
+// build 以中位数点划分递归建树
+func (r *kdRun) build(indices []int, depth int, box regionBox) *kdNode {
+	if len(indices) == 0 {
+		return nil
+	}
+	axis := depth % 2
+	sort.Slice(indices, func(a, b int) bool {
+		ca, cb := r.coordinate(indices[a], axis), r.coordinate(indices[b], axis)
+		if ca != cb {
+			return ca < cb
+		}
+		return indices[a] < indices[b]
+	})
+	mid := len(indices) / 2
+	node := &kdNode{id: "kd" + strconv.Itoa(r.nodes), point: indices[mid], axis: axis, split: r.coordinate(indices[mid], axis), box: box}
+	node.box.Node = node.id
+	r.nodes++
+
+	region := node.box
+	r.tracker.AddStep(fmt.Sprintf("节点 %s：%d 个点按 %s 坐标排序，中位数点 %s 在 %s=%.4g 处划分区域 %s",
+		node.id, len(indices), axisName(axis), algorithms.PointName(r.points, node.point), axisName(axis), node.split, box.describe()),
+		spatialState{Points: r.points, Region: &region, Split: &spatialSplit{Axis: axisName(axis), Value: node.split},
+			Visited: []regionBox{}, Pruned: []regionBox{}, Radius: -1, Candidate: node.point}, []int{node.point})
+	r.tracker.AddOperation(models.OpTypePartition, []int{node.point}, []interface{}{axisName(axis), node.split}, "中位数划分")
+
+	// 左子树在划分轴上不大于划分坐标，右子树不小于划分坐标
+	leftBox, rightBox := box, box
+	if axis == 0 {
+		leftBox.MaxX, rightBox.MinX = node.split, node.split
+	} else {
+		leftBox.MaxY, rightBox.MinY = node.split, node.split
+	}
+	left := append([]int{}, indices[:mid]...)
+	right := append([]int{}, indices[mid+1:]...)
+	node.left = r.build(left, depth+1, leftBox)
+	node.right = r.build(right, depth+1, rightBox)
+	return node
+}
+
+// nearest 最近邻搜索：先进入查询点所在一侧，回溯时按区域距离剪枝
+func (r *kdRun) nearest(s *spatialSearch, node *kdNode) {
+	if node == nil {
+		return
+	}
+	if d := node.box.minDistance(s.query.point); d >= s.radius() {
+		s.prune(node.box, fmt.Sprintf("区域到查询点的最短距离 %.4g 不小于当前第 %d 近的距离 %.4g", math.Sqrt(d), s.query.k, math.Sqrt(s.radius())))
+		return
+	}
+
+	improved := s.offer(node.point)
+	description := fmt.Sprintf("访问节点 %s，点 %s 到查询点的距离为 %.4g", node.id, algorithms.PointName(r.points, node.point),
+		math.Sqrt(algorithms.DistanceSquared(r.points[node.point], s.query.point)))
+	if improved {
+		description += "，更新最近点"
+	}
+	s.visit(node.box, description, []int{node.point})
+
+	near, far := node.left, node.right
+	if axisCoordinate(s.query.point, node.axis) > node.split {
+		near, far = far, near
+	}
+	r.nearest(s, near)
+	r.nearest(s, far)
+}
+
+// rangeSearch 范围查询
+func (r *kdRun) rangeSearch(s *spatialSearch, node *kdNode) {
+	if node == nil {
+		return
+	}
+	if !node.box.intersects(s.query.rect) {
+		s.prune(node.box, "区域与查询矩形不相交")
+		return
+	}
+	if node.box.within(s.query.rect) {
+		s.inside(node.box, r.collect(node, nil))
+		return
+	}
+	s.visit(node.box, fmt.Sprintf("访问节点 %s，区域 %s 与查询矩形部分相交", node.id, node.box.describe()), []int{node.point})
+	s.check(node.point, node.box)
+	r.rangeSearch(s, node.left)
+	r.rangeSearch(s, node.right)
+}
+
+// collect 收集子树中的全部点
+func (r *kdRun) collect(node *kdNode, out []int) []int {
+	if node == nil {
+		return out
+	}
+	out = append(out, node.point)
+	out = r.collect(node.left, out)
+	return r.collect(node.right, out)
+}
+
+// coordinate 点在指定轴上的坐标
+func (r *kdRun) coordinate(index, axis int) float64 {
+	return axisCoordinate(r.points[index], axis)
+}
+
+// axisCoordinate 点在指定轴上的坐标
+func axisCoordinate(p models.Point2D, axis int) float64 {
+	if axis == 0 {
+		return p.X
+	}
+	return p.Y
+}
+
+// export 将k-d树导出为二叉 TreeData，横坐标为中序位置，纵坐标为深度
+func (r *kdRun) export(root *kdNode) *models.TreeData {
+	position := 0.0
+	var build func(node *kdNode, level int) *models.TreeNode
+	build = func(node *kdNode, level int) *models.TreeNode {
+		if node == nil {
+			return nil
+		}
+		tn := &models.TreeNode{
+			ID:       node.id,
+			Value:    fmt.Sprintf("%s (%s=%.4g)", algorithms.PointName(r.points, node.point), axisName(node.axis), node.split),
+			Children: make([]*models.TreeNode, 0, 2),
+			Y:        float64(level),
+			Level:    level,
+		}
+		tn.Left = build(node.left, level+1)
+		tn.X = position
+		position++
+		tn.Right = build(node.right, level+1)
+		for _, child := range []*models.TreeNode{tn.Left, tn.Right} {
+			if child != nil {
+				tn.Children = append(tn.Children, child)
+			}
+		}
+		return tn
+	}
+	return &models.TreeData{Root: build(root, 0), Type: "binary"}
+}
+
+// kdHeight 树的高度，只有根节点时为1
+func kdHeight(node *kdNode) int {
+	if node == nil {
+		return 0
+	}
+	left, right := kdHeight(node.left), kdHeight(node.right)
+	if left > right {
+		return left + 1
+	}
+	return right + 1
+}
+
+// axisName 划分轴名称
+func axisName(axis int) string {
+	if axis == 0 {
+		return "x"
+	}
+	return "y"
+}
+
+// ValidateInput 验证点集输入
+func (kd *KDTree) ValidateInput(data interface{}) error {
+	return validateSpatialInput(data)
+}
+
+// GetComplexity 获取复杂度信息
+func (kd *KDTree) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(n log² n)", // 构建时每层排序；最近邻查询平均 O(log n)
+			Average: "O(n log² n)",
+			Worst:   "O(n log² n)", // 范围查询最坏 O(√n + m)
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(n)",
+			Average: "O(n)",
+			Worst:   "O(n)",
+		},
+	}
+}
@@ -0,0 +1,128 @@
+package geometry
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"sort"
+)
+
+// MonotoneChain Andrew单调链凸包算法
+type MonotoneChain struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewMonotoneChain 创建单调链算法实例
+func NewMonotoneChain() *MonotoneChain {
+	return &MonotoneChain{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "monotone_chain",
+			Name:            "Andrew单调链",
+			Category:        models.CategoryGeometry,
+			Description:     "将点按X坐标（相同时按Y坐标）排序后，分别从左到右构建下凸包、从右到左构建上凸包，两条单调链拼接即为凸包。与Graham扫描相比只需要坐标排序，避免了极角比较。",
+			TimeComplexity:  "O(n log n)",
+			SpaceComplexity: "O(n)",
+			Parameters:      []models.Parameter{},
+			Stable:          false,
+			InPlace:         false,
+			Adaptive:        false,
+		},
+	}
+}
+
+// Execute 执行单调链算法
+func (mc *MonotoneChain) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	if err := mc.ValidateInput(data); err != nil {
+		return nil, err
+	}
+
+	points, err := algorithms.ToPointSet(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return mc.ProcessPoints(points, tracker)
+}
+
+// ProcessPoints 处理点集
+func (mc *MonotoneChain) ProcessPoints(points *models.PointSetData, tracker models.StepTracker) (interface{}, error) {
+	run := newHullRun(points.Points, tracker)
+	pts := points.Points
+	n := len(pts)
+
+	tracker.SetPhase("初始化")
+	tracker.AddStep(fmt.Sprintf("开始Andrew单调链算法，共 %d 个点", n), run.state(-1, nil, nil), []int{})
+
+	// 按X坐标排序，X相同时按Y坐标排序
+	tracker.SetPhase("坐标排序")
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		a, b := pts[order[i]], pts[order[j]]
+		if a.X != b.X {
+			return a.X < b.X
+		}
+		return a.Y < b.Y
+	})
+	run.order = order
+	tracker.AddStep("按X坐标（相同时按Y坐标）对点排序", run.state(-1, nil, nil), order)
+
+	// 从左到右构建下凸包
+	tracker.SetPhase("构建下凸包")
+	for _, index := range order {
+		mc.add(run, index, 2)
+	}
+	lowerSize := len(run.stack)
+	tracker.AddNote(fmt.Sprintf("下凸包包含 %d 个点", lowerSize))
+
+	// 从右到左构建上凸包，栈底的下凸包部分保持不动
+	tracker.SetPhase("构建上凸包")
+	for i := n - 2; i >= 0; i-- {
+		mc.add(run, order[i], lowerSize+1)
+	}
+
+	// 最后一个点与起点重复，去掉
+	hull := make([]int, len(run.stack)-1)
+	copy(hull, run.stack[:len(run.stack)-1])
+	if len(hull) == 0 {
+		hull = []int{order[0]}
+	}
+	return run.finish(hull), nil
+}
+
+// add 将点加入当前单调链，栈中至少保留 minSize-1 个点不被弹出
+func (mc *MonotoneChain) add(run *hullRun, index, minSize int) {
+	run.tracker.AddStep("考察点 "+algorithms.PointName(run.points, index), run.state(index, nil, nil), []int{index})
+	for len(run.stack) >= minSize {
+		top := run.stack[len(run.stack)-1]
+		below := run.stack[len(run.stack)-2]
+		if run.orient(below, top, index) > 0 {
+			break
+		}
+		run.pop("不构成左转")
+	}
+	run.push(index)
+}
+
+// ValidateInput 验证点集输入
+func (mc *MonotoneChain) ValidateInput(data interface{}) error {
+	return validateHullInput(data)
+}
+
+// GetComplexity 获取复杂度信息
+func (mc *MonotoneChain) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(n log n)",
+			Average: "O(n log n)",
+			Worst:   "O(n log n)",
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(n)",
+			Average: "O(n)",
+			Worst:   "O(n)",
+		},
+	}
+}
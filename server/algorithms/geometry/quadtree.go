@@ -0,0 +1,359 @@
+package geometry
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// 四叉树叶子容量上限与最大深度（重合的点无法再分，到达最大深度后留在同一叶子中）
+const (
+	maxQuadCapacity = 16
+	maxQuadDepth    = 16
+)
+
+// quadrantNames 子区域名称，顺序为西北、东北、西南、东南
+var quadrantNames = []string{"西北", "东北", "西南", "东南"}
+
+// Quadtree 点区域四叉树
+type Quadtree struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewQuadtree 创建四叉树实例
+func NewQuadtree() *Quadtree {
+	parameters := append([]models.Parameter{
+		{
+			Name:         "capacity",
+			Type:         "int",
+			Description:  "叶子最多容纳的点数，超过时四等分",
+			DefaultValue: 1,
+			Required:     false,
+			Min:          1,
+			Max:          maxQuadCapacity,
+		},
+	}, spatialQueryParameters()...)
+
+	return &Quadtree{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "quadtree",
+			Name:            "四叉树",
+			Category:        models.CategoryGeometry,
+			Description:     "点区域（PR）四叉树：根区域为覆盖全部点的正方形，逐个插入点，叶子中的点数超过容量时按中心四等分并把点下放到子区域。与k-d树不同，划分位置由区域决定而与点无关。最近邻查询按区域到查询点的距离由近及远访问子区域，距离不小于当前第k近的距离时剪枝；范围查询跳过与矩形不相交的区域。",
+			TimeComplexity:  "O(n·d) 构建，d 为树深",
+			SpaceComplexity: "O(n·d)",
+			Parameters:      parameters,
+		},
+	}
+}
+
+// quadNode 四叉树节点
+type quadNode struct {
+	id       string
+	box      regionBox
+	depth    int
+	points   []int       // 叶子中的点（原始索引）
+	children []*quadNode // 内部节点的四个子区域，叶子为 nil
+}
+
+// quadRun 一次四叉树构建与查询
+type quadRun struct {
+	points   []models.Point2D
+	capacity int
+	tracker  models.StepTracker
+	nodes    int
+	splits   int
+}
+
+// Execute 使用默认参数构建四叉树并查询
+func (qt *Quadtree) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return qt.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 构建四叉树并按参数执行最近邻或范围查询
+func (qt *Quadtree) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	if err := qt.ValidateInput(data); err != nil {
+		return nil, err
+	}
+	points, err := algorithms.ToPointSet(data)
+	if err != nil {
+		return nil, err
+	}
+	capacity := algorithms.IntParam(params, "capacity", 1)
+	if capacity < 1 || capacity > maxQuadCapacity {
+		return nil, fmt.Errorf("叶子容量必须在1到%d之间", maxQuadCapacity)
+	}
+	pts := points.Points
+	bounds := pointBounds(pts)
+	query, err := parseSpatialQuery(params, bounds)
+	if err != nil {
+		return nil, err
+	}
+
+	// 根区域取包围盒的外接正方形
+	side := math.Max(bounds.MaxX-bounds.MinX, bounds.MaxY-bounds.MinY)
+	if side == 0 {
+		side = 1
+	}
+	cx, cy := (bounds.MinX+bounds.MaxX)/2, (bounds.MinY+bounds.MaxY)/2
+	rootBox := regionBox{Node: "q", MinX: cx - side/2, MinY: cy - side/2, MaxX: cx + side/2, MaxY: cy + side/2}
+
+	run := &quadRun{points: pts, capacity: capacity, tracker: tracker}
+	root := run.newNode("q", rootBox, 0)
+	tracker.SetPhase("构建")
+	tracker.AddStep(fmt.Sprintf("开始构建四叉树，共 %d 个点，叶子容量 %d，根区域 %s", len(pts), capacity, rootBox.describe()),
+		run.buildState(&rootBox, nil, -1), []int{})
+	for i := range pts {
+		run.insert(root, i)
+	}
+	tree := run.export(root)
+	height := quadHeight(root)
+	tracker.AddStep(fmt.Sprintf("四叉树构建完成，共 %d 个节点，分裂 %d 次，高度 %d", run.nodes, run.splits, height),
+		spatialState{Points: pts, Tree: tree, Visited: []regionBox{}, Pruned: []regionBox{}, Radius: -1, Candidate: -1}, []int{})
+
+	search := newSpatialSearch(pts, query, tracker)
+	if query.mode == QueryNearest {
+		tracker.SetPhase("最近邻查询")
+		tracker.AddStep(fmt.Sprintf("查找距离 (%.4g, %.4g) 最近的 %d 个点", query.point.X, query.point.Y, query.k), search.state(nil, -1), []int{})
+		run.nearest(search, root)
+	} else {
+		tracker.SetPhase("范围查询")
+		tracker.AddStep("查找矩形 "+query.rect.describe()+" 内的点", search.state(nil, -1), []int{})
+		run.rangeSearch(search, root)
+	}
+	search.finish()
+
+	output := map[string]interface{}{
+		"tree":       tree,
+		"nodeCount":  run.nodes,
+		"splits":     run.splits,
+		"height":     height,
+		"capacity":   capacity,
+		"pointCount": len(pts),
+	}
+	search.result(output)
+	return output, nil
+}
+
+// ProcessPoints 处理点集
+func (qt *Quadtree) ProcessPoints(points *models.PointSetData, tracker models.StepTracker) (interface{}, error) {
+	return qt.Execute(points, tracker)
+}
+
+// newNode 创建叶子节点
+func (r *quadRun) newNode(id string, box regionBox, depth int) *quadNode {
+	r.nodes++
+	box.Node = id
+	return &quadNode{id: id, box: box, depth: depth, points: make([]int, 0, r.capacity)}
+}
+
+// insert 沿区域向下找到包含该点的叶子并插入，超过容量时分裂
+func (r *quadRun) insert(node *quadNode, index int) {
+	for node.children != nil {
+		node = node.children[r.quadrant(node, index)]
+	}
+	node.points = append(node.points, index)
+	p := r.points[index]
+	region := node.box
+	r.tracker.AddStep(fmt.Sprintf("插入点 %s (%.4g, %.4g) 到叶子 %s，区域 %s，现有 %d 个点",
+		algorithms.PointName(r.points, index), p.X, p.Y, node.id, node.box.describe(), len(node.points)),
+		r.buildState(&region, nil, index), []int{index})
+	r.tracker.AddOperation(models.OpTypeInsert, []int{index}, []interface{}{node.id}, "插入叶子")
+
+	if len(node.points) > r.capacity && node.depth < maxQuadDepth {
+		r.split(node)
+	}
+}
+
+// split 将叶子按中心四等分，点下放到子区域，子区域仍超过容量时继续分裂
+func (r *quadRun) split(node *quadNode) {
+	r.splits++
+	midX, midY := (node.box.MinX+node.box.MaxX)/2, (node.box.MinY+node.box.MaxY)/2
+	b := node.box
+	boxes := []regionBox{
+		{MinX: b.MinX, MinY: midY, MaxX: midX, MaxY: b.MaxY}, // 西北
+		{MinX: midX, MinY: midY, MaxX: b.MaxX, MaxY: b.MaxY}, // 东北
+		{MinX: b.MinX, MinY: b.MinY, MaxX: midX, MaxY: midY}, // 西南
+		{MinX: midX, MinY: b.MinY, MaxX: b.MaxX, MaxY: midY}, // 东南
+	}
+	node.children = make([]*quadNode, 4)
+	for q := range node.children {
+		node.children[q] = r.newNode(node.id+strconv.Itoa(q), boxes[q], node.depth+1)
+	}
+	moved := node.points
+	node.points = nil
+	for _, index := range moved {
+		child := node.children[r.quadrant(node, index)]
+		child.points = append(child.points, index)
+	}
+
+	counts := make([]string, 4)
+	for q, child := range node.children {
+		counts[q] = fmt.Sprintf("%s %d", quadrantNames[q], len(child.points))
+	}
+	region := node.box
+	r.tracker.AddStep(fmt.Sprintf("叶子 %s 超过容量 %d，以 (%.4g, %.4g) 为中心四等分：%s",
+		node.id, r.capacity, midX, midY, strings.Join(counts, "，")),
+		r.buildState(&region, &spatialSplit{Axis: "xy", MidX: midX, MidY: midY}, -1), moved)
+	r.tracker.AddOperation(models.OpTypeSplit, moved, []interface{}{node.id}, "四等分")
+
+	for _, child := range node.children {
+		if len(child.points) > r.capacity && child.depth < maxQuadDepth {
+			r.split(child)
+		}
+	}
+}
+
+// quadrant 点所在的子区域，落在中线上的点归入东侧和北侧
+func (r *quadRun) quadrant(node *quadNode, index int) int {
+	p := r.points[index]
+	midX, midY := (node.box.MinX+node.box.MaxX)/2, (node.box.MinY+node.box.MaxY)/2
+	q := 0
+	if p.X >= midX {
+		q++
+	}
+	if p.Y < midY {
+		q += 2
+	}
+	return q
+}
+
+// buildState 构建阶段的快照
+func (r *quadRun) buildState(region *regionBox, split *spatialSplit, candidate int) spatialState {
+	return spatialState{Points: r.points, Region: region, Split: split, Visited: []regionBox{}, Pruned: []regionBox{}, Radius: -1, Candidate: candidate}
+}
+
+// nearest 最近邻搜索：子区域按到查询点的距离由近及远访问
+func (r *quadRun) nearest(s *spatialSearch, node *quadNode) {
+	if d := node.box.minDistance(s.query.point); d >= s.radius() {
+		s.prune(node.box, fmt.Sprintf("区域到查询点的最短距离 %.4g 不小于当前第 %d 近的距离 %.4g", math.Sqrt(d), s.query.k, math.Sqrt(s.radius())))
+		return
+	}
+
+	if node.children == nil {
+		improved := 0
+		for _, index := range node.points {
+			if s.offer(index) {
+				improved++
+			}
+		}
+		description := fmt.Sprintf("访问叶子 %s，计算其中 %d 个点的距离", node.id, len(node.points))
+		if improved > 0 {
+			description += "，更新最近点"
+		}
+		s.visit(node.box, description, append([]int{}, node.points...))
+		return
+	}
+
+	s.visit(node.box, fmt.Sprintf("访问内部节点 %s，子区域按到查询点的距离排序", node.id), []int{})
+	order := []int{0, 1, 2, 3}
+	sort.SliceStable(order, func(a, b int) bool {
+		return node.children[order[a]].box.minDistance(s.query.point) < node.children[order[b]].box.minDistance(s.query.point)
+	})
+	for _, q := range order {
+		r.nearest(s, node.children[q])
+	}
+}
+
+// rangeSearch 范围查询
+func (r *quadRun) rangeSearch(s *spatialSearch, node *quadNode) {
+	if !node.box.intersects(s.query.rect) {
+		s.prune(node.box, "区域与查询矩形不相交")
+		return
+	}
+	if node.box.within(s.query.rect) {
+		s.inside(node.box, r.collect(node, nil))
+		return
+	}
+	if node.children == nil {
+		s.visit(node.box, fmt.Sprintf("访问叶子 %s，区域 %s 与查询矩形部分相交，逐个检查 %d 个点", node.id, node.box.describe(), len(node.points)),
+			append([]int{}, node.points...))
+		for _, index := range node.points {
+			s.check(index, node.box)
+		}
+		return
+	}
+	s.visit(node.box, fmt.Sprintf("访问内部节点 %s，区域 %s 与查询矩形部分相交", node.id, node.box.describe()), []int{})
+	for _, child := range node.children {
+		r.rangeSearch(s, child)
+	}
+}
+
+// collect 收集子树中的全部点
+func (r *quadRun) collect(node *quadNode, out []int) []int {
+	out = append(out, node.points...)
+	for _, child := range node.children {
+		out = r.collect(child, out)
+	}
+	return out
+}
+
+// export 将四叉树导出为 TreeData，叶子按从左到右的顺序排布，内部节点位于子节点中间
+func (r *quadRun) export(root *quadNode) *models.TreeData {
+	position := 0.0
+	var build func(node *quadNode) *models.TreeNode
+	build = func(node *quadNode) *models.TreeNode {
+		tn := &models.TreeNode{
+			ID:       node.id,
+			Children: make([]*models.TreeNode, 0, len(node.children)),
+			Y:        float64(node.depth),
+			Level:    node.depth,
+		}
+		if node.children == nil {
+			names := make([]string, len(node.points))
+			for i, index := range node.points {
+				names[i] = algorithms.PointName(r.points, index)
+			}
+			tn.Value = "∅"
+			if len(names) > 0 {
+				tn.Value = strings.Join(names, ", ")
+			}
+			tn.X = position
+			position++
+			return tn
+		}
+		for _, child := range node.children {
+			tn.Children = append(tn.Children, build(child))
+		}
+		tn.X = (tn.Children[0].X + tn.Children[len(tn.Children)-1].X) / 2
+		tn.Value = fmt.Sprintf("(%.4g, %.4g)", (node.box.MinX+node.box.MaxX)/2, (node.box.MinY+node.box.MaxY)/2)
+		return tn
+	}
+	return &models.TreeData{Root: build(root), Type: "n-ary"}
+}
+
+// quadHeight 树的高度，只有根节点时为1
+func quadHeight(node *quadNode) int {
+	height := 0
+	for _, child := range node.children {
+		if h := quadHeight(child); h > height {
+			height = h
+		}
+	}
+	return height + 1
+}
+
+// ValidateInput 验证点集输入
+func (qt *Quadtree) ValidateInput(data interface{}) error {
+	return validateSpatialInput(data)
+}
+
+// GetComplexity 获取复杂度信息
+func (qt *Quadtree) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(n log n)", // 点分布均匀时树深为 O(log n)
+			Average: "O(n log n)",
+			Worst:   "O(n·d)", // 树深 d 取决于最近两点的距离与区域大小之比
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(n)",
+			Average: "O(n)",
+			Worst:   "O(n·d)",
+		},
+	}
+}
@@ -0,0 +1,376 @@
+package geometry
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// maxSpatialPoints 空间索引的点数上限
+const maxSpatialPoints = 10000
+
+// 空间查询类型
+const (
+	QueryNearest = "nearest"
+	QueryRange   = "range"
+)
+
+// 区域在查询中的状态
+const (
+	regionVisited = "visited" // 进入该区域继续搜索
+	regionPruned  = "pruned"  // 整个区域被剪枝
+	regionInside  = "inside"  // 区域完全落在查询矩形内，其中的点全部命中
+)
+
+// regionBox 空间索引节点对应的矩形区域
+type regionBox struct {
+	Node   string  `json:"node"`             // 节点ID，与导出的 TreeData 一致
+	MinX   float64 `json:"minX"`             // 左边界
+	MinY   float64 `json:"minY"`             // 下边界
+	MaxX   float64 `json:"maxX"`             // 右边界
+	MaxY   float64 `json:"maxY"`             // 上边界
+	Status string  `json:"status,omitempty"` // 查询中的状态
+}
+
+// spatialState 空间索引的步骤快照
+type spatialState struct {
+	Points    []models.Point2D `json:"points"`           // 原始点集
+	Tree      *models.TreeData `json:"tree,omitempty"`   // 构建完成后的树
+	Region    *regionBox       `json:"region,omitempty"` // 当前节点的区域
+	Split     *spatialSplit    `json:"split,omitempty"`  // 当前节点的划分方式
+	Query     *models.Point2D  `json:"query,omitempty"`  // 最近邻查询点
+	Range     *regionBox       `json:"range,omitempty"`  // 范围查询矩形
+	Visited   []regionBox      `json:"visited"`          // 已访问的区域
+	Pruned    []regionBox      `json:"pruned"`           // 已剪枝的区域
+	Best      []int            `json:"best,omitempty"`   // 当前最近的点（按距离升序）
+	Radius    float64          `json:"radius"`           // 当前第 k 近的距离，未找满 k 个时为 -1
+	Found     []int            `json:"found,omitempty"`  // 范围查询已命中的点
+	Candidate int              `json:"candidate"`        // 当前计算距离或检查的点，-1 表示无
+}
+
+// spatialSplit 节点的划分方式
+type spatialSplit struct {
+	Axis  string  `json:"axis"`           // k-d 树的划分轴 (x, y)；四叉树为 xy
+	Value float64 `json:"value"`          // k-d 树的划分坐标
+	MidX  float64 `json:"midX,omitempty"` // 四叉树的划分中心
+	MidY  float64 `json:"midY,omitempty"`
+}
+
+// spatialQuery 从参数解析的查询
+type spatialQuery struct {
+	mode  string
+	point models.Point2D
+	k     int
+	rect  regionBox
+}
+
+// spatialNeighbor 最近邻结果中的一个点
+type spatialNeighbor struct {
+	Index    int            `json:"index"`
+	Point    models.Point2D `json:"point"`
+	Distance float64        `json:"distance"`
+}
+
+// spatialSearch 一次空间查询的执行上下文，k-d 树和四叉树共用
+type spatialSearch struct {
+	points    []models.Point2D
+	query     spatialQuery
+	tracker   models.StepTracker
+	visited   []regionBox
+	pruned    []regionBox
+	best      []int     // 当前最近的点，按距离升序
+	bestDist  []float64 // best 中各点的距离平方
+	found     []int
+	distances int // 距离计算次数
+}
+
+// spatialQueryParameters 查询参数定义
+func spatialQueryParameters() []models.Parameter {
+	return []models.Parameter{
+		{
+			Name:         "query",
+			Type:         "string",
+			Description:  "查询类型 (nearest: 最近邻, range: 矩形范围)",
+			DefaultValue: QueryNearest,
+			Required:     false,
+			Options:      []string{QueryNearest, QueryRange},
+		},
+		{
+			Name:         "x",
+			Type:         "float",
+			Description:  "最近邻查询点的X坐标，默认为点集包围盒的中心",
+			DefaultValue: nil,
+			Required:     false,
+		},
+		{
+			Name:         "y",
+			Type:         "float",
+			Description:  "最近邻查询点的Y坐标，默认为点集包围盒的中心",
+			DefaultValue: nil,
+			Required:     false,
+		},
+		{
+			Name:         "k",
+			Type:         "int",
+			Description:  "返回最近的 k 个点",
+			DefaultValue: 1,
+			Required:     false,
+			Min:          1,
+			Max:          100,
+		},
+		{
+			Name:         "range",
+			Type:         "string",
+			Description:  "范围查询矩形 \"minX,minY,maxX,maxY\"，默认为包围盒中央一半大小的矩形",
+			DefaultValue: "",
+			Required:     false,
+		},
+	}
+}
+
+// parseSpatialQuery 解析查询参数，缺省的查询点和矩形由点集包围盒决定
+func parseSpatialQuery(params map[string]interface{}, bounds regionBox) (spatialQuery, error) {
+	q := spatialQuery{mode: algorithms.OptionParam(params, "query", []string{QueryNearest, QueryRange}, QueryNearest)}
+	cx, cy := (bounds.MinX+bounds.MaxX)/2, (bounds.MinY+bounds.MaxY)/2
+	q.point = models.Point2D{ID: "query", Label: "查询点", X: algorithms.FloatParam(params, "x", cx), Y: algorithms.FloatParam(params, "y", cy)}
+	q.k = algorithms.IntParam(params, "k", 1)
+	if q.k < 1 || q.k > 100 {
+		return q, fmt.Errorf("k 必须在1到100之间")
+	}
+
+	w, h := bounds.MaxX-bounds.MinX, bounds.MaxY-bounds.MinY
+	q.rect = regionBox{Node: "range", MinX: cx - w/4, MinY: cy - h/4, MaxX: cx + w/4, MaxY: cy + h/4}
+	var values []float64
+	switch v := algorithms.ValueParam(params, "range", nil).(type) {
+	case nil:
+	case string:
+		if strings.TrimSpace(v) == "" {
+			break
+		}
+		for _, field := range strings.Split(v, ",") {
+			f, err := strconv.ParseFloat(strings.TrimSpace(field), 64)
+			if err != nil {
+				return q, fmt.Errorf("范围矩形的坐标必须为数值")
+			}
+			values = append(values, f)
+		}
+	case []interface{}:
+		for _, field := range v {
+			f, ok := field.(float64)
+			if !ok {
+				return q, fmt.Errorf("范围矩形的坐标必须为数值")
+			}
+			values = append(values, f)
+		}
+	default:
+		return q, fmt.Errorf("范围矩形格式应为 \"minX,minY,maxX,maxY\"")
+	}
+	if values != nil {
+		if len(values) != 4 {
+			return q, fmt.Errorf("范围矩形需要4个坐标 minX,minY,maxX,maxY")
+		}
+		q.rect = regionBox{Node: "range",
+			MinX: math.Min(values[0], values[2]), MinY: math.Min(values[1], values[3]),
+			MaxX: math.Max(values[0], values[2]), MaxY: math.Max(values[1], values[3])}
+	}
+	return q, nil
+}
+
+// pointBounds 点集的包围盒
+func pointBounds(points []models.Point2D) regionBox {
+	box := regionBox{MinX: math.Inf(1), MinY: math.Inf(1), MaxX: math.Inf(-1), MaxY: math.Inf(-1)}
+	for _, p := range points {
+		box.MinX = math.Min(box.MinX, p.X)
+		box.MinY = math.Min(box.MinY, p.Y)
+		box.MaxX = math.Max(box.MaxX, p.X)
+		box.MaxY = math.Max(box.MaxY, p.Y)
+	}
+	return box
+}
+
+// minDistance 点到矩形的最短距离的平方，点在矩形内时为0
+func (b regionBox) minDistance(p models.Point2D) float64 {
+	dx := math.Max(0, math.Max(b.MinX-p.X, p.X-b.MaxX))
+	dy := math.Max(0, math.Max(b.MinY-p.Y, p.Y-b.MaxY))
+	return dx*dx + dy*dy
+}
+
+// intersects 两个矩形是否相交（含边界）
+func (b regionBox) intersects(o regionBox) bool {
+	return b.MinX <= o.MaxX && o.MinX <= b.MaxX && b.MinY <= o.MaxY && o.MinY <= b.MaxY
+}
+
+// within 矩形是否完全落在另一个矩形内
+func (b regionBox) within(o regionBox) bool {
+	return o.MinX <= b.MinX && b.MaxX <= o.MaxX && o.MinY <= b.MinY && b.MaxY <= o.MaxY
+}
+
+// contains 点是否在矩形内（含边界）
+func (b regionBox) contains(p models.Point2D) bool {
+	return b.MinX <= p.X && p.X <= b.MaxX && b.MinY <= p.Y && p.Y <= b.MaxY
+}
+
+// describe 矩形的文本形式
+func (b regionBox) describe() string {
+	return fmt.Sprintf("[%.4g, %.4g]×[%.4g, %.4g]", b.MinX, b.MaxX, b.MinY, b.MaxY)
+}
+
+// newSpatialSearch 创建查询上下文
+func newSpatialSearch(points []models.Point2D, query spatialQuery, tracker models.StepTracker) *spatialSearch {
+	return &spatialSearch{
+		points:  points,
+		query:   query,
+		tracker: tracker,
+		visited: make([]regionBox, 0),
+		pruned:  make([]regionBox, 0),
+		best:    make([]int, 0, query.k),
+		found:   make([]int, 0),
+	}
+}
+
+// radius 当前第 k 近的距离平方，未找满 k 个时为正无穷
+func (s *spatialSearch) radius() float64 {
+	if len(s.best) < s.query.k {
+		return math.Inf(1)
+	}
+	return s.bestDist[len(s.bestDist)-1]
+}
+
+// offer 计算点到查询点的距离，更近时加入候选
+func (s *spatialSearch) offer(index int) bool {
+	s.distances++
+	d := algorithms.DistanceSquared(s.points[index], s.query.point)
+	if len(s.best) == s.query.k && d >= s.radius() {
+		return false
+	}
+	pos := sort.SearchFloat64s(s.bestDist, d)
+	// 距离相同时按下标排序，保证结果确定
+	for pos < len(s.best) && s.bestDist[pos] == d && s.best[pos] < index {
+		pos++
+	}
+	s.best = append(s.best, 0)
+	s.bestDist = append(s.bestDist, 0)
+	copy(s.best[pos+1:], s.best[pos:])
+	copy(s.bestDist[pos+1:], s.bestDist[pos:])
+	s.best[pos], s.bestDist[pos] = index, d
+	if len(s.best) > s.query.k {
+		s.best = s.best[:s.query.k]
+		s.bestDist = s.bestDist[:s.query.k]
+	}
+	return true
+}
+
+// visit 记录进入区域
+func (s *spatialSearch) visit(box regionBox, description string, highlights []int) {
+	box.Status = regionVisited
+	s.visited = append(s.visited, box)
+	s.tracker.AddStep(description, s.state(&box, -1), highlights)
+	s.tracker.AddOperation(models.OpTypeAccess, highlights, []interface{}{box.Node}, "访问区域")
+}
+
+// prune 记录剪枝的区域
+func (s *spatialSearch) prune(box regionBox, reason string) {
+	box.Status = regionPruned
+	s.pruned = append(s.pruned, box)
+	s.tracker.AddStep(fmt.Sprintf("剪枝节点 %s 的区域 %s：%s", box.Node, box.describe(), reason), s.state(&box, -1), []int{})
+	s.tracker.AddOperation(models.OpTypePrune, []int{}, []interface{}{box.Node}, "剪枝")
+}
+
+// inside 记录完全落在查询矩形内的区域
+func (s *spatialSearch) inside(box regionBox, indices []int) {
+	box.Status = regionInside
+	s.visited = append(s.visited, box)
+	s.found = append(s.found, indices...)
+	s.tracker.AddStep(fmt.Sprintf("节点 %s 的区域 %s 完全在查询矩形内，其中 %d 个点全部命中", box.Node, box.describe(), len(indices)),
+		s.state(&box, -1), indices)
+}
+
+// check 范围查询中检查单个点
+func (s *spatialSearch) check(index int, box regionBox) {
+	p := s.points[index]
+	hit := s.query.rect.contains(p)
+	if hit {
+		s.found = append(s.found, index)
+	}
+	verdict := "不在矩形内"
+	if hit {
+		verdict = "在矩形内"
+	}
+	s.tracker.AddStep(fmt.Sprintf("检查点 %s (%.4g, %.4g)：%s", algorithms.PointName(s.points, index), p.X, p.Y, verdict), s.state(&box, index), []int{index})
+}
+
+// state 构建查询步骤的快照
+func (s *spatialSearch) state(region *regionBox, candidate int) spatialState {
+	st := spatialState{
+		Points:    s.points,
+		Region:    region,
+		Visited:   append([]regionBox{}, s.visited...),
+		Pruned:    append([]regionBox{}, s.pruned...),
+		Candidate: candidate,
+		Radius:    -1,
+	}
+	if s.query.mode == QueryNearest {
+		query := s.query.point
+		st.Query = &query
+		st.Best = append([]int{}, s.best...)
+		if r := s.radius(); !math.IsInf(r, 1) {
+			st.Radius = math.Sqrt(r)
+		}
+	} else {
+		rect := s.query.rect
+		st.Range = &rect
+		st.Found = append([]int{}, s.found...)
+	}
+	return st
+}
+
+// result 查询结果
+func (s *spatialSearch) result(output map[string]interface{}) {
+	output["query"] = s.query.mode
+	output["visitedRegions"] = len(s.visited)
+	output["prunedRegions"] = len(s.pruned)
+	output["regions"] = map[string]interface{}{"visited": s.visited, "pruned": s.pruned}
+	output["distanceComputations"] = s.distances
+
+	if s.query.mode == QueryNearest {
+		neighbors := make([]spatialNeighbor, len(s.best))
+		for i, index := range s.best {
+			neighbors[i] = spatialNeighbor{Index: index, Point: s.points[index], Distance: math.Sqrt(s.bestDist[i])}
+		}
+		output["queryPoint"] = s.query.point
+		output["k"] = s.query.k
+		output["neighbors"] = neighbors
+		return
+	}
+	sort.Ints(s.found)
+	found := make([]models.Point2D, len(s.found))
+	for i, index := range s.found {
+		found[i] = s.points[index]
+	}
+	output["rect"] = s.query.rect
+	output["foundIndices"] = s.found
+	output["found"] = found
+	output["count"] = len(s.found)
+}
+
+// finish 记录查询结束的步骤
+func (s *spatialSearch) finish() {
+	s.tracker.SetPhase("完成")
+	if s.query.mode == QueryNearest {
+		s.tracker.AddStep(fmt.Sprintf("最近邻查询完成：访问 %d 个区域，剪枝 %d 个区域，计算距离 %d 次（暴力需要 %d 次）",
+			len(s.visited), len(s.pruned), s.distances, len(s.points)), s.state(nil, -1), append([]int{}, s.best...))
+		return
+	}
+	s.tracker.AddStep(fmt.Sprintf("范围查询完成：命中 %d 个点，访问 %d 个区域，剪枝 %d 个区域",
+		len(s.found), len(s.visited), len(s.pruned)), s.state(nil, -1), append([]int{}, s.found...))
+}
+
+// validateSpatialInput 空间索引的输入验证
+func validateSpatialInput(data interface{}) error {
+	_, err := algorithms.ValidatePointSet(data, maxSpatialPoints)
+	return err
+}
@@ -0,0 +1,206 @@
+package geometry
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+// randomPointSet 生成整数坐标的随机点集，坐标范围 [0, 100)
+func randomPointSet(rng *rand.Rand, n int) *models.PointSetData {
+	points := make([]models.Point2D, n)
+	for i := range points {
+		id := fmt.Sprintf("p%d", i)
+		points[i] = models.Point2D{ID: id, Label: id, X: float64(rng.Intn(100)), Y: float64(rng.Intn(100))}
+	}
+	return &models.PointSetData{Points: points}
+}
+
+// spatialIndexes 待测的空间索引
+func spatialIndexes() map[string]algorithms.ParameterizedAlgorithm {
+	return map[string]algorithms.ParameterizedAlgorithm{
+		"kd_tree":    NewKDTree(),
+		"quadtree":   NewQuadtree(),
+		"quadtree_4": &quadtreeWithCapacity{NewQuadtree(), 4},
+	}
+}
+
+// quadtreeWithCapacity 固定叶子容量的四叉树
+type quadtreeWithCapacity struct {
+	*Quadtree
+	capacity int
+}
+
+func (q *quadtreeWithCapacity) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	merged := map[string]interface{}{"capacity": q.capacity}
+	for k, v := range params {
+		merged[k] = v
+	}
+	return q.Quadtree.ExecuteWithParams(data, merged, tracker)
+}
+
+func TestSpatialIndex_NearestMatchesBruteForce(t *testing.T) {
+	rng := rand.New(rand.NewSource(42))
+	for name, index := range spatialIndexes() {
+		t.Run(name, func(t *testing.T) {
+			for trial := 0; trial < 30; trial++ {
+				points := randomPointSet(rng, 1+rng.Intn(200))
+				k := 1 + rng.Intn(5)
+				qx, qy := rng.Float64()*120-10, rng.Float64()*120-10
+				result, err := index.ExecuteWithParams(points, map[string]interface{}{"x": qx, "y": qy, "k": float64(k)}, models.NewStepTracker())
+				if err != nil {
+					t.Fatalf("ExecuteWithParams() error = %v", err)
+				}
+				neighbors := result.(map[string]interface{})["neighbors"].([]spatialNeighbor)
+
+				// 暴力求前 k 近的距离
+				distances := make([]float64, len(points.Points))
+				for i, p := range points.Points {
+					distances[i] = math.Hypot(p.X-qx, p.Y-qy)
+				}
+				sort.Float64s(distances)
+				if k > len(distances) {
+					k = len(distances)
+				}
+				if len(neighbors) != k {
+					t.Fatalf("len(neighbors) = %d, expected %d", len(neighbors), k)
+				}
+				for i, nb := range neighbors {
+					if math.Abs(nb.Distance-distances[i]) > 1e-9 {
+						t.Fatalf("第 %d 近的距离 = %v, expected %v", i+1, nb.Distance, distances[i])
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestSpatialIndex_RangeMatchesBruteForce(t *testing.T) {
+	rng := rand.New(rand.NewSource(7))
+	for name, index := range spatialIndexes() {
+		t.Run(name, func(t *testing.T) {
+			for trial := 0; trial < 30; trial++ {
+				points := randomPointSet(rng, 1+rng.Intn(200))
+				x1, y1 := float64(rng.Intn(100)), float64(rng.Intn(100))
+				x2, y2 := x1+float64(rng.Intn(50)), y1+float64(rng.Intn(50))
+				rect := fmt.Sprintf("%g,%g,%g,%g", x1, y1, x2, y2)
+				result, err := index.ExecuteWithParams(points, map[string]interface{}{"query": "range", "range": rect}, models.NewStepTracker())
+				if err != nil {
+					t.Fatalf("ExecuteWithParams() error = %v", err)
+				}
+				found := result.(map[string]interface{})["foundIndices"].([]int)
+
+				expected := make([]int, 0)
+				for i, p := range points.Points {
+					if p.X >= x1 && p.X <= x2 && p.Y >= y1 && p.Y <= y2 {
+						expected = append(expected, i)
+					}
+				}
+				if fmt.Sprint(found) != fmt.Sprint(expected) {
+					t.Fatalf("range %s: found = %v, expected %v", rect, found, expected)
+				}
+			}
+		})
+	}
+}
+
+func TestSpatialIndex_Pruning(t *testing.T) {
+	points := randomPointSet(rand.New(rand.NewSource(1)), 1000)
+	for name, index := range spatialIndexes() {
+		tracker := models.NewStepTracker()
+		result, err := index.ExecuteWithParams(points, map[string]interface{}{"x": 30.5, "y": 60.5}, tracker)
+		if err != nil {
+			t.Fatalf("%s: ExecuteWithParams() error = %v", name, err)
+		}
+		output := result.(map[string]interface{})
+		if output["prunedRegions"].(int) == 0 {
+			t.Errorf("%s: 没有剪枝任何区域", name)
+		}
+		if computations := output["distanceComputations"].(int); computations > 100 {
+			t.Errorf("%s: 计算距离 %d 次，剪枝效果过差", name, computations)
+		}
+
+		// 最后一步包含全部已访问与已剪枝的区域
+		steps := tracker.GetSteps()
+		state := steps[len(steps)-1].Data.(spatialState)
+		if len(state.Visited) != output["visitedRegions"] || len(state.Pruned) != output["prunedRegions"] {
+			t.Errorf("%s: 最后一步的区域数与结果不一致", name)
+		}
+	}
+}
+
+func TestKDTree_Structure(t *testing.T) {
+	points := &models.PointSetData{Points: []models.Point2D{
+		{Label: "A", X: 2, Y: 3}, {Label: "B", X: 5, Y: 4}, {Label: "C", X: 9, Y: 6},
+		{Label: "D", X: 4, Y: 7}, {Label: "E", X: 8, Y: 1}, {Label: "F", X: 7, Y: 2},
+	}}
+	result, err := NewKDTree().Execute(points, models.NewStepTracker())
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	output := result.(map[string]interface{})
+	tree := output["tree"].(*models.TreeData)
+
+	// 经典例子：根按x划分于 F(7,2)，左子树按y划分于 B(5,4)，右子树按y划分于 C(9,6)
+	if tree.Root.Value != "F (x=7)" || tree.Root.Left.Value != "B (y=4)" || tree.Root.Right.Value != "C (y=6)" {
+		t.Errorf("root = %v, left = %v, right = %v", tree.Root.Value, tree.Root.Left.Value, tree.Root.Right.Value)
+	}
+	if output["nodeCount"] != 6 || output["height"] != 3 {
+		t.Errorf("nodeCount = %v, height = %v", output["nodeCount"], output["height"])
+	}
+}
+
+func TestQuadtree_Structure(t *testing.T) {
+	points := &models.PointSetData{Points: []models.Point2D{
+		{Label: "A", X: 0, Y: 0}, {Label: "B", X: 10, Y: 10}, {Label: "C", X: 1, Y: 1}, {Label: "D", X: 9, Y: 1},
+		{Label: "E", X: 3, Y: 3}, {Label: "E2", X: 3, Y: 3},
+	}}
+	result, err := NewQuadtree().ExecuteWithParams(points, map[string]interface{}{"capacity": 2}, models.NewStepTracker())
+	if err != nil {
+		t.Fatalf("ExecuteWithParams() error = %v", err)
+	}
+	output := result.(map[string]interface{})
+	tree := output["tree"].(*models.TreeData)
+	if len(tree.Root.Children) != 4 {
+		t.Fatalf("根节点应有4个子区域，实际 %d 个", len(tree.Root.Children))
+	}
+	// 东北象限只有B，东南只有D
+	if tree.Root.Children[1].Value != "B" || tree.Root.Children[3].Value != "D" {
+		t.Errorf("NE = %v, SE = %v", tree.Root.Children[1].Value, tree.Root.Children[3].Value)
+	}
+
+	// 重合的点无法再分，到达最大深度后仍然能构建完成
+	same := &models.PointSetData{Points: []models.Point2D{{Label: "P", X: 1, Y: 1}, {Label: "Q", X: 1, Y: 1}}}
+	result, err = NewQuadtree().Execute(same, models.NewStepTracker())
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if height := result.(map[string]interface{})["height"]; height != maxQuadDepth+1 {
+		t.Errorf("height = %v, expected %d", height, maxQuadDepth+1)
+	}
+}
+
+func TestSpatialIndex_InvalidInput(t *testing.T) {
+	for name, index := range map[string]algorithms.ParameterizedAlgorithm{"kd_tree": NewKDTree(), "quadtree": NewQuadtree()} {
+		if err := index.ValidateInput(&models.PointSetData{}); err == nil {
+			t.Errorf("%s: 空点集应返回错误", name)
+		}
+		if err := index.ValidateInput([]int{1, 2}); err == nil {
+			t.Errorf("%s: 非点集输入应返回错误", name)
+		}
+		points := randomPointSet(rand.New(rand.NewSource(3)), 5)
+		for _, params := range []map[string]interface{}{
+			{"k": 0.0},
+			{"query": "range", "range": "1,2,3"},
+			{"query": "range", "range": "a,b,c,d"},
+		} {
+			if _, err := index.ExecuteWithParams(points, params, models.NewStepTracker()); err == nil {
+				t.Errorf("%s: 参数 %v 应返回错误", name, params)
+			}
+		}
+	}
+}
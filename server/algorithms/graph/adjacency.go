@@ -0,0 +1,89 @@
+package graph
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+)
+
+// maxStructureNodes 以下标邻接表实现的图算法的节点数上限
+const maxStructureNodes = 2000
+
+// arc 邻接表中的一条出边，edge 为该边在 graph.Edges 中的下标
+type arc struct {
+	to   int
+	edge int
+}
+
+// isDirected 与 BFS/DFS 一致：只有 Type 为 undirected 时按无向图处理
+func isDirected(g *models.GraphData) bool {
+	return g.Type != "undirected"
+}
+
+// buildAdjacency 按边的输入顺序构建以下标表示的邻接表
+// 无向边在两个端点各记一次且边号相同，自环在无向图中也记两次，使邻接表长度等于度数
+func buildAdjacency(g *models.GraphData, directed bool) [][]arc {
+	index := nodeIndex(g)
+	adj := make([][]arc, len(g.Nodes))
+	for k, e := range g.Edges {
+		u, v := index[e.From], index[e.To]
+		adj[u] = append(adj[u], arc{to: v, edge: k})
+		if !directed {
+			adj[v] = append(adj[v], arc{to: u, edge: k})
+		}
+	}
+	return adj
+}
+
+// checkEndpoints 检查每条边的端点都是图中的节点
+func checkEndpoints(g *models.GraphData) error {
+	index := nodeIndex(g)
+	for _, e := range g.Edges {
+		if _, ok := index[e.From]; !ok {
+			return fmt.Errorf("边的起点 %s 不存在", e.From)
+		}
+		if _, ok := index[e.To]; !ok {
+			return fmt.Errorf("边的终点 %s 不存在", e.To)
+		}
+	}
+	return nil
+}
+
+// validateStructureInput 结构分析算法的输入验证：允许没有边，但边的端点必须存在
+func validateStructureInput(data interface{}) error {
+	if data == nil {
+		return algorithms.ErrInvalidInput
+	}
+	g, err := toGraph(data)
+	if err != nil {
+		return err
+	}
+	if len(g.Nodes) == 0 || len(g.Nodes) > maxStructureNodes {
+		return algorithms.ErrInvalidInput
+	}
+	return checkEndpoints(g)
+}
+
+// nodeIDs 按下标序列取出节点ID
+func nodeIDs(g *models.GraphData, indices []int) []string {
+	ids := make([]string, len(indices))
+	for k, i := range indices {
+		ids[k] = g.Nodes[i].ID
+	}
+	return ids
+}
+
+// findNode 按节点ID或标签查找节点下标
+func findNode(g *models.GraphData, name string) (int, error) {
+	for i, n := range g.Nodes {
+		if n.ID == name {
+			return i, nil
+		}
+	}
+	for i, n := range g.Nodes {
+		if n.Label == name {
+			return i, nil
+		}
+	}
+	return -1, fmt.Errorf("节点 %s 不存在", name)
+}
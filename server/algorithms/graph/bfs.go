@@ -2,6 +2,7 @@ package graph
 
 import (
 	"container/list"
+	"fmt"
 	"gin/algorithms"
 	"gin/models"
 )
@@ -11,6 +12,13 @@ type BFS struct {
 	algorithms.BaseAlgorithm
 }
 
+// bfsTree BFS 森林中的一棵树，Layers[d] 为该树中到根距离为 d 的节点
+// 遍历所有连通分量时每个根各有一组层次，不同分量的节点不会混在同一层
+type bfsTree struct {
+	Root   string     `json:"root"`
+	Layers [][]string `json:"layers"`
+}
+
 // NewBFS 创建BFS实例
 func NewBFS() *BFS {
 	return &BFS{
@@ -18,27 +26,24 @@ func NewBFS() *BFS {
 			ID:              "graph_bfs",
 			Name:            "广度优先搜索 (BFS)",
 			Category:        models.CategoryGraph,
-			Description:     "从起始节点开始逐层遍历图的所有可达节点。",
+			Description:     "从起始节点开始逐层遍历图的所有可达节点，记录每个节点到起点的边数距离，并按距离分层。",
 			TimeComplexity:  "O(V+E)",
 			SpaceComplexity: "O(V)",
-			Parameters: []models.Parameter{
-				{
-					Name:         "start",
-					Type:         "string",
-					Description:  "起始节点ID",
-					DefaultValue: "node_0",
-					Required:     false,
-				},
-			},
-			Stable:   false,
-			InPlace:  false,
-			Adaptive: false,
+			Parameters:      traversalParameters(),
+			Stable:          false,
+			InPlace:         false,
+			Adaptive:        false,
 		},
 	}
 }
 
 // Execute 执行BFS
 func (b *BFS) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return b.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 按参数执行BFS
+func (b *BFS) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
 	if err := b.ValidateInput(data); err != nil {
 		return nil, err
 	}
@@ -51,73 +56,93 @@ func (b *BFS) Execute(data interface{}, tracker models.StepTracker) (interface{}
 			return nil, algorithms.ErrInvalidInput
 		}
 	}
+	if err := checkEndpoints(graph); err != nil {
+		return nil, err
+	}
+	roots, err := traversalRoots(graph, params)
+	if err != nil {
+		return nil, err
+	}
 
 	tracker.SetPhase("初始化")
 	tracker.AddStep("开始广度优先搜索", graph, []int{})
 
-	// 构建邻接表和索引
-	idx := make(map[string]int)
-	for i, n := range graph.Nodes {
-		idx[n.ID] = i
-	}
-	adj := make(map[string][]string)
-	for _, e := range graph.Edges {
-		adj[e.From] = append(adj[e.From], e.To)
-		if graph.Type == "undirected" {
-			adj[e.To] = append(adj[e.To], e.From)
-		}
-	}
+	// 构建以下标表示的邻接表
+	adj := buildAdjacency(graph, isDirected(graph))
+	n := len(graph.Nodes)
 
-	startID := "node_0"
-	// 如果存在第一个节点，默认从第一个节点开始
-	if len(graph.Nodes) > 0 {
-		startID = graph.Nodes[0].ID
+	// distance 为 -1 表示尚未到达；多个连通分量时各自从根开始计算距离
+	distance := make([]int, n)
+	parent := make([]int, n)
+	for i := range distance {
+		distance[i], parent[i] = -1, -1
 	}
-
-	visited := make(map[string]bool)
-	order := make([]string, 0, len(graph.Nodes))
+	order := make([]string, 0, n)
+	forest := make([]bfsTree, 0)
+	rootIDs := make([]string, 0)
 
 	q := list.New()
-	q.PushBack(startID)
-	visited[startID] = true
-
-	if sidx, ok := idx[startID]; ok {
-		tracker.AddStep("入队起始节点", graph, []int{sidx})
-		tracker.AddOperation(models.OpTypeUpdate, []int{sidx}, []interface{}{startID}, "起始节点入队")
-	}
-
-	for q.Len() > 0 {
-		front := q.Front()
-		v := front.Value.(string)
-		q.Remove(front)
+	for _, root := range roots {
+		if distance[root] >= 0 {
+			continue
+		}
+		if len(rootIDs) > 0 {
+			tracker.SetPhase("新的连通分量")
+		}
+		rootIDs = append(rootIDs, graph.Nodes[root].ID)
+		forest = append(forest, bfsTree{Root: graph.Nodes[root].ID, Layers: [][]string{}})
+		tree := &forest[len(forest)-1]
+		distance[root] = 0
+		q.PushBack(root)
+		tracker.AddStep("入队起始节点 "+nodeLabel(graph, root), graph, []int{root})
+		tracker.AddOperation(models.OpTypeUpdate, []int{root}, []interface{}{graph.Nodes[root].ID}, "起始节点入队")
+
+		for q.Len() > 0 {
+			front := q.Front()
+			v := front.Value.(int)
+			q.Remove(front)
 
-		if vi, ok := idx[v]; ok {
 			tracker.SetPhase("访问节点")
-			tracker.AddStep("访问节点 "+graph.Nodes[vi].Label, graph, []int{vi})
-			tracker.AddOperation(models.OpTypeAccess, []int{vi}, nil, "出队访问")
-		}
+			tracker.AddStep(fmt.Sprintf("访问节点 %s（距离 %d）", nodeLabel(graph, v), distance[v]), graph, []int{v})
+			tracker.AddOperation(models.OpTypeAccess, []int{v}, []interface{}{distance[v]}, "出队访问")
 
-		order = append(order, v)
+			order = append(order, graph.Nodes[v].ID)
+			if distance[v] == len(tree.Layers) {
+				tree.Layers = append(tree.Layers, []string{})
+			}
+			tree.Layers[distance[v]] = append(tree.Layers[distance[v]], graph.Nodes[v].ID)
 
-		for _, to := range adj[v] {
-			if !visited[to] {
-				visited[to] = true
-				q.PushBack(to)
-				// 可视化边的遍历
-				if vi, ok1 := idx[v]; ok1 {
-					if tj, ok2 := idx[to]; ok2 {
-						// 用比较表示边的探索
-						tracker.AddComparison(vi, tj, 0)
-						tracker.AddStep("发现新节点并入队", graph, []int{tj})
-						tracker.AddOperation(models.OpTypeInsert, []int{tj}, []interface{}{to}, "节点入队")
-					}
+			for _, a := range adj[v] {
+				to := a.to
+				if distance[to] >= 0 {
+					continue
 				}
+				distance[to] = distance[v] + 1
+				parent[to] = v
+				q.PushBack(to)
+				// 用比较表示边的探索
+				tracker.AddComparison(v, to, 0)
+				tracker.AddStep(fmt.Sprintf("发现新节点 %s 并入队，距离 %d", nodeLabel(graph, to), distance[to]), graph, []int{to})
+				tracker.AddOperation(models.OpTypeInsert, []int{to}, []interface{}{graph.Nodes[to].ID}, "节点入队")
 			}
 		}
 	}
 
+	reached := make([]bool, n)
+	distanceMap := make(map[string]int)
+	for i, d := range distance {
+		if d >= 0 {
+			reached[i] = true
+			distanceMap[graph.Nodes[i].ID] = d
+		}
+	}
+
 	return map[string]interface{}{
-		"order": order,
+		"order":    order,
+		"distance": distanceMap,
+		"layers":   forest,
+		"parent":   parentMap(graph, parent, reached),
+		"roots":    rootIDs,
 	}, nil
 }
 
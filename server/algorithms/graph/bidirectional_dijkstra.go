@@ -0,0 +1,260 @@
+package graph
+
+import (
+	"container/heap"
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"math"
+)
+
+// bidirectionalState 双向 Dijkstra 的步骤快照；距离只记录已到达的节点，避免出现无穷大
+type bidirectionalState struct {
+	Graph            *models.GraphData  `json:"graph"`            // 图数据
+	ForwardDistance  map[string]float64 `json:"forwardDistance"`  // 正向搜索中起点到各节点的距离
+	BackwardDistance map[string]float64 `json:"backwardDistance"` // 反向搜索中各节点到终点的距离
+	ForwardSettled   []int              `json:"forwardSettled"`   // 正向已确定距离的节点
+	BackwardSettled  []int              `json:"backwardSettled"`  // 反向已确定距离的节点
+	ForwardFrontier  []int              `json:"forwardFrontier"`  // 正向边界：已到达但尚未确定的节点
+	BackwardFrontier []int              `json:"backwardFrontier"` // 反向边界
+	Meeting          int                `json:"meeting"`          // 当前最优路径上两侧相接的节点，-1 表示尚未相遇
+	Best             float64            `json:"best,omitempty"`   // 当前最优路径的长度
+	Path             []int              `json:"path,omitempty"`   // 最终路径上的节点
+}
+
+// searchSide 双向搜索中的一侧
+type searchSide struct {
+	name     string
+	adj      [][]arc
+	dist     []float64
+	prevNode []int // 正向为前驱，反向为后继
+	prevEdge []int
+	settled  []bool
+	order    []int
+	pq       distHeap
+}
+
+func newSearchSide(name string, adj [][]arc, root int) *searchSide {
+	n := len(adj)
+	s := &searchSide{
+		name:     name,
+		adj:      adj,
+		dist:     make([]float64, n),
+		prevNode: make([]int, n),
+		prevEdge: make([]int, n),
+		settled:  make([]bool, n),
+		order:    make([]int, 0),
+		pq:       distHeap{{node: root}},
+	}
+	for i := range s.dist {
+		s.dist[i] = math.Inf(1)
+		s.prevNode[i], s.prevEdge[i] = -1, -1
+	}
+	s.dist[root] = 0
+	return s
+}
+
+// chain 沿前驱（或后继）指针从 v 走回该侧的根，返回经过的节点与边
+func (s *searchSide) chain(v int) ([]int, []int) {
+	nodes, edges := []int{v}, []int{}
+	for s.prevNode[v] >= 0 {
+		edges = append(edges, s.prevEdge[v])
+		v = s.prevNode[v]
+		nodes = append(nodes, v)
+	}
+	return nodes, edges
+}
+
+// BidirectionalDijkstra 双向Dijkstra最短路径算法
+type BidirectionalDijkstra struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewBidirectionalDijkstra 创建双向Dijkstra实例
+func NewBidirectionalDijkstra() *BidirectionalDijkstra {
+	return &BidirectionalDijkstra{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "graph_bidirectional_dijkstra",
+			Name:            "双向Dijkstra最短路径算法",
+			Category:        models.CategoryGraph,
+			Description:     "从起点沿正向边、从终点沿反向边同时运行 Dijkstra，每次扩展队首距离较小的一侧。两侧到达同一节点时得到候选路径，当两侧队首距离之和不小于当前最优长度时停止，通常比单向搜索确定更少的节点。",
+			TimeComplexity:  "O((V+E)logV)",
+			SpaceComplexity: "O(V)",
+			Parameters:      endpointParameters(),
+		},
+	}
+}
+
+// Execute 使用默认参数执行
+func (bd *BidirectionalDijkstra) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return bd.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 按参数执行双向Dijkstra
+func (bd *BidirectionalDijkstra) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	if err := bd.ValidateInput(data); err != nil {
+		return nil, err
+	}
+	graph, err := toGraph(data)
+	if err != nil {
+		return nil, err
+	}
+	source, target, err := resolveEndpoints(graph, params)
+	if err != nil {
+		return nil, err
+	}
+
+	weights := edgeWeights(graph)
+	forward := newSearchSide("正向", buildAdjacency(graph, isDirected(graph)), source)
+	backward := newSearchSide("反向", reverseAdjacency(graph), target)
+	best, meeting := math.Inf(1), -1
+	if source == target {
+		best, meeting = 0, source
+	}
+	state := func(path []int) bidirectionalState {
+		return bd.state(graph, forward, backward, best, meeting, path)
+	}
+
+	tracker.SetPhase("初始化")
+	tracker.AddStep(fmt.Sprintf("双向Dijkstra：正向从 %s 出发，反向从 %s 出发", nodeLabel(graph, source), nodeLabel(graph, target)),
+		state(nil), []int{source, target})
+
+	for forward.pq.top()+backward.pq.top() < best {
+		// 扩展队首距离较小的一侧
+		side, other := forward, backward
+		if backward.pq.top() < forward.pq.top() {
+			side, other = backward, forward
+		}
+		u := heap.Pop(&side.pq).(distItem).node
+		if side.settled[u] {
+			continue
+		}
+		side.settled[u] = true
+		side.order = append(side.order, u)
+
+		tracker.SetPhase(side.name + "搜索")
+		tracker.AddOperation(models.OpTypeAccess, []int{u}, nil, side.name+"确定")
+		tracker.AddStep(fmt.Sprintf("%s搜索确定节点 %s (距离: %s)", side.name, nodeLabel(graph, u), formatWeight(side.dist[u])),
+			state(nil), []int{u})
+
+		for _, a := range side.adj[u] {
+			v := a.to
+			if side.settled[v] {
+				continue
+			}
+			d := side.dist[u] + weights[a.edge]
+			if d >= side.dist[v] {
+				continue
+			}
+			side.dist[v], side.prevNode[v], side.prevEdge[v] = d, u, a.edge
+			heap.Push(&side.pq, distItem{node: v, dist: d})
+			tracker.AddComparison(u, v, int(weights[a.edge]))
+			tracker.AddStep(fmt.Sprintf("%s松弛边 %s-%s，新距离: %s", side.name, nodeLabel(graph, u), nodeLabel(graph, v), formatWeight(d)),
+				state(nil), []int{v})
+
+			// 另一侧已经到达 v 时，两个边界在 v 相接，得到一条经过 v 的候选路径
+			if total := d + other.dist[v]; total < best {
+				description := "找到更短的候选路径"
+				if meeting < 0 {
+					tracker.SetPhase("相遇")
+					description = "两个边界首次相遇"
+				}
+				best, meeting = total, v
+				tracker.AddOperation(models.OpTypeUpdate, []int{v}, []interface{}{total}, "更新最优路径")
+				tracker.AddStep(fmt.Sprintf("%s：两侧都已到达节点 %s，路径长度 %s + %s = %s", description,
+					nodeLabel(graph, v), formatWeight(forward.dist[v]), formatWeight(backward.dist[v]), formatWeight(best)),
+					state(nil), []int{v})
+			}
+		}
+	}
+
+	result := map[string]interface{}{
+		"start":           graph.Nodes[source].ID,
+		"target":          graph.Nodes[target].ID,
+		"reachable":       meeting >= 0,
+		"forwardSettled":  nodeIDs(graph, forward.order),
+		"backwardSettled": nodeIDs(graph, backward.order),
+		"settledCount":    len(forward.order) + len(backward.order),
+	}
+
+	tracker.SetPhase("完成")
+	if meeting < 0 {
+		result["message"] = fmt.Sprintf("从 %s 无法到达 %s", graph.Nodes[source].ID, graph.Nodes[target].ID)
+		tracker.AddStep(fmt.Sprintf("一侧的队列已空，两侧没有相遇，%s", result["message"]), state(nil), []int{})
+		return result, nil
+	}
+
+	// 正向链从相遇节点回到起点，反向链从相遇节点走到终点
+	nodes, edges := forward.chain(meeting)
+	reverseInts(nodes)
+	reverseInts(edges)
+	tail, tailEdges := backward.chain(meeting)
+	nodes = append(nodes, tail[1:]...)
+	edges = append(edges, tailEdges...)
+	path := newPathResult(graph, weights, nodes, edges)
+	result["path"] = path
+	result["distance"] = path.Distance
+	result["meeting"] = graph.Nodes[meeting].ID
+
+	tracker.AddStep(fmt.Sprintf("两侧队首距离之和不小于 %s，停止搜索；最短路径 %s (长度: %s)，共确定 %d 个节点",
+		formatWeight(best), joinLabels(graph, nodes, " → "), formatWeight(path.Distance), result["settledCount"]),
+		state(nodes), nodes)
+
+	return result, nil
+}
+
+// state 生成步骤快照
+func (bd *BidirectionalDijkstra) state(g *models.GraphData, forward, backward *searchSide, best float64, meeting int, path []int) bidirectionalState {
+	snapshot := func(s *searchSide) (map[string]float64, []int) {
+		distance := make(map[string]float64)
+		frontier := make([]int, 0)
+		for i, d := range s.dist {
+			if math.IsInf(d, 1) {
+				continue
+			}
+			distance[g.Nodes[i].ID] = d
+			if !s.settled[i] {
+				frontier = append(frontier, i)
+			}
+		}
+		return distance, frontier
+	}
+	state := bidirectionalState{Graph: g, Meeting: meeting, Path: path}
+	state.ForwardDistance, state.ForwardFrontier = snapshot(forward)
+	state.BackwardDistance, state.BackwardFrontier = snapshot(backward)
+	state.ForwardSettled = append([]int{}, forward.order...)
+	state.BackwardSettled = append([]int{}, backward.order...)
+	if meeting >= 0 {
+		state.Best = best
+	}
+	return state
+}
+
+// ProcessGraph 处理图
+func (bd *BidirectionalDijkstra) ProcessGraph(graph *models.GraphData, tracker models.StepTracker) (interface{}, error) {
+	return bd.Execute(graph, tracker)
+}
+
+// GetGraphType 图类型
+func (bd *BidirectionalDijkstra) GetGraphType() string { return "weighted" }
+
+// ValidateInput 验证输入
+func (bd *BidirectionalDijkstra) ValidateInput(data interface{}) error {
+	return validateShortestPathInput(data)
+}
+
+// GetComplexity 获取复杂度信息
+func (bd *BidirectionalDijkstra) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(1)", // 起点与终点相同
+			Average: "O((V+E)logV)",
+			Worst:   "O((V+E)logV)",
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(V)",
+			Average: "O(V)",
+			Worst:   "O(V)",
+		},
+	}
+}
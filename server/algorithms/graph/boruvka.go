@@ -0,0 +1,224 @@
+package graph
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+)
+
+// boruvkaState Borůvka 算法的步骤快照
+type boruvkaState struct {
+	Graph      *models.GraphData `json:"graph"`              // 图数据
+	Components []int             `json:"components"`         // 每个节点所在分量，用分量中最小的节点下标表示
+	Round      int               `json:"round"`              // 当前轮次，从1开始；0 为初始状态
+	TreeEdges  []int             `json:"treeEdges"`          // 已加入森林的边（graph.Edges 的下标）
+	Selected   []int             `json:"selected,omitempty"` // 本轮各分量选出的最便宜出边
+}
+
+// boruvkaRound 一轮合并的汇总
+type boruvkaRound struct {
+	Round            int       `json:"round"`
+	ComponentsBefore int       `json:"componentsBefore"`
+	ComponentsAfter  int       `json:"componentsAfter"`
+	Edges            []MSTEdge `json:"edges"`
+	Weight           float64   `json:"weight"`
+}
+
+// Boruvka Borůvka最小生成树算法
+type Boruvka struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewBoruvka 创建Borůvka实例
+func NewBoruvka() *Boruvka {
+	return &Boruvka{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "graph_boruvka",
+			Name:            "Borůvka最小生成树算法",
+			Category:        models.CategoryGraph,
+			Description:     "按轮进行的最小生成树算法：每轮为每个连通分量选出权重最小的出边并同时合并，分量数至少减半，最多 logV 轮。权重相同时按边的输入顺序决定先后，保证不会成环；图不连通时得到最小生成森林。",
+			TimeComplexity:  "O(ElogV)",
+			SpaceComplexity: "O(V+E)",
+			Parameters:      []models.Parameter{},
+		},
+	}
+}
+
+// Execute 执行Borůvka算法
+func (b *Boruvka) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	if err := b.ValidateInput(data); err != nil {
+		return nil, err
+	}
+	graph, err := toGraph(data)
+	if err != nil {
+		return nil, err
+	}
+
+	n := len(graph.Nodes)
+	index := nodeIndex(graph)
+	weights := make([]float64, len(graph.Edges))
+	for k, e := range graph.Edges {
+		weights[k] = algorithms.EdgeWeight(e)
+	}
+	// 权重相同时下标小的边优先，所有分量按同一全序比较，同一轮选出的边不会成环
+	lighter := func(a, c int) bool {
+		return weights[a] < weights[c] || (weights[a] == weights[c] && a < c)
+	}
+
+	uf := NewUnionFind(n)
+	treeEdges := make([]int, 0, n)
+	mstEdges := make([]MSTEdge, 0, n)
+	rounds := make([]boruvkaRound, 0)
+	totalWeight := 0.0
+	componentCount := n
+
+	tracker.SetPhase("初始化")
+	tracker.AddStep(fmt.Sprintf("开始Borůvka最小生成树算法，初始每个节点各自是一个分量，共 %d 个", n),
+		b.state(graph, uf, 0, treeEdges, nil), []int{})
+
+	for {
+		// 为每个分量（以并查集的根表示）找权重最小的出边
+		cheapest := make([]int, n)
+		for i := range cheapest {
+			cheapest[i] = -1
+		}
+		for k, e := range graph.Edges {
+			ru, rv := uf.Find(index[e.From]), uf.Find(index[e.To])
+			if ru == rv {
+				continue
+			}
+			for _, r := range []int{ru, rv} {
+				if cheapest[r] < 0 || lighter(k, cheapest[r]) {
+					cheapest[r] = k
+				}
+			}
+		}
+
+		selected := make([]int, 0)
+		chosen := make(map[int]bool)
+		roots := make([]int, 0)
+		for i := 0; i < n; i++ {
+			if uf.Find(i) == i && cheapest[i] >= 0 {
+				roots = append(roots, i)
+				if !chosen[cheapest[i]] {
+					chosen[cheapest[i]] = true
+					selected = append(selected, cheapest[i])
+				}
+			}
+		}
+		if len(selected) == 0 {
+			break
+		}
+
+		round := len(rounds) + 1
+		tracker.SetPhase(fmt.Sprintf("第 %d 轮", round))
+		tracker.AddStep(fmt.Sprintf("第 %d 轮开始：%d 个分量中有 %d 个存在出边", round, componentCount, len(roots)),
+			b.state(graph, uf, round, treeEdges, nil), []int{})
+
+		for _, r := range roots {
+			k := cheapest[r]
+			u, v := index[graph.Edges[k].From], index[graph.Edges[k].To]
+			tracker.AddComparison(u, v, int(weights[k]))
+			tracker.AddStep(fmt.Sprintf("节点 %s 所在分量的最便宜出边: %s-%s (权重: %s)",
+				nodeLabel(graph, r), nodeLabel(graph, u), nodeLabel(graph, v), formatWeight(weights[k])),
+				b.state(graph, uf, round, treeEdges, selected), []int{u, v})
+		}
+
+		record := boruvkaRound{Round: round, ComponentsBefore: componentCount, Edges: []MSTEdge{}}
+		for _, k := range selected {
+			e := graph.Edges[k]
+			u, v := index[e.From], index[e.To]
+			if !uf.Union(u, v) {
+				continue
+			}
+			componentCount--
+			edge := MSTEdge{From: e.From, To: e.To, Weight: weights[k], Label: e.Label}
+			treeEdges = append(treeEdges, k)
+			mstEdges = append(mstEdges, edge)
+			record.Edges = append(record.Edges, edge)
+			record.Weight += weights[k]
+			totalWeight += weights[k]
+
+			tracker.AddOperation(models.OpTypeInsert, []int{u, v}, []interface{}{weights[k]}, "合并分量")
+			tracker.AddStep(fmt.Sprintf("加入边 %s-%s，合并两个分量，当前总权重: %s",
+				nodeLabel(graph, u), nodeLabel(graph, v), formatWeight(totalWeight)),
+				b.state(graph, uf, round, treeEdges, selected), []int{u, v})
+		}
+		record.ComponentsAfter = componentCount
+		rounds = append(rounds, record)
+
+		tracker.AddStep(fmt.Sprintf("第 %d 轮结束：加入 %d 条边，分量数 %d → %d",
+			round, len(record.Edges), record.ComponentsBefore, record.ComponentsAfter),
+			b.state(graph, uf, round, treeEdges, nil), []int{})
+	}
+
+	result := map[string]interface{}{
+		"mstEdges":     mstEdges,
+		"totalWeight":  totalWeight,
+		"edgeCount":    len(mstEdges),
+		"nodeCount":    n,
+		"rounds":       len(rounds),
+		"roundDetails": rounds,
+	}
+
+	tracker.SetPhase("完成")
+	tracker.AddStep(addForestSummary(result, graph, mstEdges), b.state(graph, uf, len(rounds), treeEdges, nil), []int{})
+
+	return result, nil
+}
+
+// state 生成步骤快照
+func (b *Boruvka) state(g *models.GraphData, uf *UnionFind, round int, treeEdges, selected []int) boruvkaState {
+	components := make([]int, len(g.Nodes))
+	first := make(map[int]int)
+	for i := range g.Nodes {
+		root := uf.Find(i)
+		if _, ok := first[root]; !ok {
+			first[root] = i
+		}
+		components[i] = first[root]
+	}
+	return boruvkaState{
+		Graph:      g,
+		Components: components,
+		Round:      round,
+		TreeEdges:  append([]int{}, treeEdges...),
+		Selected:   selected,
+	}
+}
+
+// ValidateInput 验证输入，只接受无向图
+func (b *Boruvka) ValidateInput(data interface{}) error {
+	if err := validateStructureInput(data); err != nil {
+		return err
+	}
+	g, _ := toGraph(data)
+	if g.Type == "directed" {
+		return fmt.Errorf("Borůvka算法只适用于无向图")
+	}
+	return nil
+}
+
+// ProcessGraph 处理图
+func (b *Boruvka) ProcessGraph(graph *models.GraphData, tracker models.StepTracker) (interface{}, error) {
+	return b.Execute(graph, tracker)
+}
+
+// GetGraphType 图类型
+func (b *Boruvka) GetGraphType() string { return "undirected_weighted" }
+
+// GetComplexity 获取复杂度信息
+func (b *Boruvka) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(E)", // 一轮就合并成一棵树
+			Average: "O(ElogV)",
+			Worst:   "O(ElogV)", // 每轮分量数至少减半
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(V+E)",
+			Average: "O(V+E)",
+			Worst:   "O(V+E)",
+		},
+	}
+}
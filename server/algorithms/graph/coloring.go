@@ -0,0 +1,237 @@
+package graph
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"sort"
+)
+
+// 着色时选择下一个节点的策略
+const (
+	ColoringGreedy       = "greedy"        // 按输入顺序
+	ColoringLargestFirst = "largest_first" // 按度数从大到小（Welsh-Powell 顺序）
+	ColoringDSatur       = "dsatur"        // 每次选饱和度最大的节点
+)
+
+// coloringState 图着色的步骤快照，各数组按 graph.Nodes 的顺序排列
+type coloringState struct {
+	Graph      *models.GraphData `json:"graph"`                // 图数据
+	Colors     []int             `json:"colors"`               // 节点颜色，-1 表示尚未着色
+	Saturation []int             `json:"saturation,omitempty"` // DSatur 中每个节点邻居已用的不同颜色数
+	Current    int               `json:"current"`              // 本步着色的节点，-1 表示无
+	Forbidden  []int             `json:"forbidden,omitempty"`  // 当前节点的邻居已经使用的颜色
+}
+
+// GraphColoring 贪心 / DSatur 图着色
+type GraphColoring struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewGraphColoring 创建图着色实例
+func NewGraphColoring() *GraphColoring {
+	return &GraphColoring{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "graph_coloring",
+			Name:            "图着色 (贪心/DSatur)",
+			Category:        models.CategoryGraph,
+			Description:     "为每个节点分配颜色，使相邻节点颜色不同。贪心法按固定顺序给每个节点分配邻居未使用的最小颜色编号，颜色数不超过最大度数加1；DSatur 每次选择饱和度（邻居已用的不同颜色数）最大的节点，饱和度相同时选未着色邻居最多的节点，通常用更少的颜色。有向边按无向边处理。",
+			TimeComplexity:  "O(V²+E)",
+			SpaceComplexity: "O(V+E)",
+			Parameters: []models.Parameter{
+				{
+					Name:         "method",
+					Type:         "string",
+					Description:  "节点顺序 (greedy: 输入顺序, largest_first: 度数从大到小, dsatur: 饱和度优先)",
+					DefaultValue: ColoringDSatur,
+					Required:     false,
+					Options:      []string{ColoringGreedy, ColoringLargestFirst, ColoringDSatur},
+				},
+			},
+		},
+	}
+}
+
+// Execute 使用默认参数执行
+func (gc *GraphColoring) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return gc.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 按参数执行图着色
+func (gc *GraphColoring) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	if err := gc.ValidateInput(data); err != nil {
+		return nil, err
+	}
+	graph, err := toGraph(data)
+	if err != nil {
+		return nil, err
+	}
+	method := algorithms.OptionParam(params, "method", []string{ColoringGreedy, ColoringLargestFirst, ColoringDSatur}, ColoringDSatur)
+
+	// 着色只关心相邻关系：去掉重边，有向边视为无向边
+	n := len(graph.Nodes)
+	neighbors := make([][]int, n)
+	for u, row := range buildAdjacency(graph, false) {
+		seen := map[int]bool{}
+		for _, a := range row {
+			if a.to == u {
+				return nil, fmt.Errorf("节点 %s 有自环，不存在合法的着色", nodeLabel(graph, u))
+			}
+			if !seen[a.to] {
+				seen[a.to] = true
+				neighbors[u] = append(neighbors[u], a.to)
+			}
+		}
+		sort.Ints(neighbors[u])
+	}
+	maxDegree := 0
+	for _, row := range neighbors {
+		if len(row) > maxDegree {
+			maxDegree = len(row)
+		}
+	}
+
+	colors := make([]int, n)
+	for i := range colors {
+		colors[i] = -1
+	}
+	// neighborColors[v] 记录 v 的邻居已经使用的颜色，其大小即饱和度
+	neighborColors := make([]map[int]bool, n)
+	for i := range neighborColors {
+		neighborColors[i] = map[int]bool{}
+	}
+	uncoloredDegree := make([]int, n)
+	for i, row := range neighbors {
+		uncoloredDegree[i] = len(row)
+	}
+
+	tracker.SetPhase("初始化")
+	tracker.AddStep(fmt.Sprintf("图着色（%s）：%d 个节点，最大度数 %d，颜色数上界 %d", method, n, maxDegree, maxDegree+1),
+		gc.state(graph, colors, neighborColors, method, -1, nil), []int{})
+
+	// 贪心法的顺序事先确定，DSatur 每步动态选择
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	if method == ColoringLargestFirst {
+		sort.SliceStable(order, func(a, b int) bool { return len(neighbors[order[a]]) > len(neighbors[order[b]]) })
+	}
+
+	tracker.SetPhase("着色")
+	colorCount := 0
+	sequence := make([]int, 0, n)
+	for step := 0; step < n; step++ {
+		v := order[step]
+		if method == ColoringDSatur {
+			v = -1
+			// 饱和度最大，其次未着色邻居最多，再次下标最小
+			for u := 0; u < n; u++ {
+				if colors[u] >= 0 {
+					continue
+				}
+				if v < 0 || len(neighborColors[u]) > len(neighborColors[v]) ||
+					(len(neighborColors[u]) == len(neighborColors[v]) && uncoloredDegree[u] > uncoloredDegree[v]) {
+					v = u
+				}
+			}
+		}
+
+		forbidden := make([]int, 0, len(neighborColors[v]))
+		for c := range neighborColors[v] {
+			forbidden = append(forbidden, c)
+		}
+		sort.Ints(forbidden)
+		color := 0
+		for color < len(forbidden) && forbidden[color] == color {
+			color++
+		}
+		colors[v] = color
+		sequence = append(sequence, v)
+		newColor := color == colorCount
+		if newColor {
+			colorCount++
+		}
+		for _, u := range neighbors[v] {
+			neighborColors[u][color] = true
+			uncoloredDegree[u]--
+		}
+
+		description := fmt.Sprintf("为 %s 着色 %d", nodeLabel(graph, v), color)
+		if method == ColoringDSatur {
+			description = fmt.Sprintf("选择饱和度 %d 的 %s，着色 %d", len(forbidden), nodeLabel(graph, v), color)
+		}
+		if len(forbidden) > 0 {
+			description += fmt.Sprintf("（邻居已用颜色 %v）", forbidden)
+		}
+		if newColor && color > 0 {
+			description += fmt.Sprintf("，启用第 %d 种颜色", colorCount)
+		}
+		tracker.AddStep(description, gc.state(graph, colors, neighborColors, method, v, forbidden), []int{v})
+		tracker.AddOperation(models.OpTypeAssign, []int{v}, []interface{}{color}, "分配颜色")
+	}
+
+	classes := make([][]string, colorCount)
+	for c := range classes {
+		classes[c] = []string{}
+	}
+	colorMap := make(map[string]int, n)
+	for i, c := range colors {
+		classes[c] = append(classes[c], graph.Nodes[i].ID)
+		colorMap[graph.Nodes[i].ID] = c
+	}
+
+	tracker.SetPhase("完成")
+	tracker.AddStep(fmt.Sprintf("着色完成，共使用 %d 种颜色（最大度数 %d）", colorCount, maxDegree),
+		gc.state(graph, colors, nil, method, -1, nil), []int{})
+
+	return map[string]interface{}{
+		"method":       method,
+		"colors":       colorMap,
+		"colorCount":   colorCount,
+		"colorClasses": classes,
+		"order":        nodeIDs(graph, sequence),
+		"maxDegree":    maxDegree,
+	}, nil
+}
+
+// state 生成步骤快照，只有 DSatur 记录饱和度
+func (gc *GraphColoring) state(g *models.GraphData, colors []int, neighborColors []map[int]bool, method string, current int, forbidden []int) coloringState {
+	state := coloringState{Graph: g, Colors: append([]int{}, colors...), Current: current, Forbidden: forbidden}
+	if method == ColoringDSatur && neighborColors != nil {
+		state.Saturation = make([]int, len(neighborColors))
+		for i, set := range neighborColors {
+			state.Saturation[i] = len(set)
+		}
+	}
+	return state
+}
+
+// ProcessGraph 处理图
+func (gc *GraphColoring) ProcessGraph(graph *models.GraphData, tracker models.StepTracker) (interface{}, error) {
+	return gc.Execute(graph, tracker)
+}
+
+// GetGraphType 图类型
+func (gc *GraphColoring) GetGraphType() string { return "both" }
+
+// ValidateInput 验证输入
+func (gc *GraphColoring) ValidateInput(data interface{}) error {
+	return validateStructureInput(data)
+}
+
+// GetComplexity 获取复杂度信息
+func (gc *GraphColoring) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(V+E)", // 贪心法按固定顺序
+			Average: "O(V²+E)",
+			Worst:   "O(V²+E)", // DSatur 每步线性扫描选择节点
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(V+E)",
+			Average: "O(V+E)",
+			Worst:   "O(V+E)",
+		},
+	}
+}
@@ -0,0 +1,211 @@
+package graph
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+)
+
+// 深度优先搜索中节点的状态
+const (
+	nodeUnvisited = 0 // 未访问
+	nodeOnStack   = 1 // 在递归栈中
+	nodeFinished  = 2 // 已完成
+)
+
+// 环检测按哪种图处理
+const (
+	CycleGraphAuto       = "auto" // 按 graph.Type
+	CycleGraphDirected   = "directed"
+	CycleGraphUndirected = "undirected"
+)
+
+// cycleState 环检测的步骤快照
+type cycleState struct {
+	Graph      *models.GraphData `json:"graph"`                // 图数据
+	Status     []int             `json:"status"`               // 节点状态：0 未访问，1 在栈中，2 已完成
+	Stack      []int             `json:"stack"`                // 当前递归栈（节点下标）
+	Cycle      []int             `json:"cycle,omitempty"`      // 找到的环上的节点
+	CycleEdges []int             `json:"cycleEdges,omitempty"` // 找到的环上的边（graph.Edges 的下标）
+}
+
+// cycleSearch 环检测的结果；找到环时 nodes[k] 经 edges[k] 走到 nodes[k+1]，最后一条边回到 nodes[0]
+type cycleSearch struct {
+	nodes   []int
+	edges   []int
+	status  []int // 搜索结束时各节点的状态
+	visited int
+}
+
+// CycleDetection 基于深度优先搜索的环检测
+type CycleDetection struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewCycleDetection 创建环检测实例
+func NewCycleDetection() *CycleDetection {
+	return &CycleDetection{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "graph_cycle_detection",
+			Name:            "环检测",
+			Category:        models.CategoryGraph,
+			Description:     "深度优先搜索并维护递归栈：节点分为未访问、在栈中、已完成三种状态。有向图中遇到指向栈中节点的边（回边）即找到环；无向图中遇到连向栈中节点、且不是来时那条边的边即找到环，重边与自环也构成环。沿递归栈从该节点截取到当前节点，再加上这条回边，就是具体的环。",
+			TimeComplexity:  "O(V+E)",
+			SpaceComplexity: "O(V)",
+			Parameters: []models.Parameter{
+				{
+					Name:         "graph_type",
+					Type:         "string",
+					Description:  "按哪种图检测 (auto: 按图数据的类型, directed: 有向图, undirected: 无向图)",
+					DefaultValue: CycleGraphAuto,
+					Required:     false,
+					Options:      []string{CycleGraphAuto, CycleGraphDirected, CycleGraphUndirected},
+				},
+			},
+		},
+	}
+}
+
+// Execute 使用默认参数执行
+func (cd *CycleDetection) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return cd.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 按参数执行环检测
+func (cd *CycleDetection) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	if err := cd.ValidateInput(data); err != nil {
+		return nil, err
+	}
+	graph, err := toGraph(data)
+	if err != nil {
+		return nil, err
+	}
+	directed := isDirected(graph)
+	switch algorithms.OptionParam(params, "graph_type", []string{CycleGraphAuto, CycleGraphDirected, CycleGraphUndirected}, CycleGraphAuto) {
+	case CycleGraphDirected:
+		directed = true
+	case CycleGraphUndirected:
+		directed = false
+	}
+
+	kind := "无向图"
+	if directed {
+		kind = "有向图"
+	}
+	tracker.SetPhase("初始化")
+	tracker.AddStep(fmt.Sprintf("按%s进行深度优先搜索，寻找回边", kind),
+		cycleState{Graph: graph, Status: make([]int, len(graph.Nodes)), Stack: []int{}}, []int{})
+	search := findCycle(graph, directed, tracker)
+
+	output := map[string]interface{}{
+		"directed":     directed,
+		"hasCycle":     len(search.nodes) > 0,
+		"visitedNodes": search.visited,
+	}
+	tracker.SetPhase("完成")
+	if len(search.nodes) == 0 {
+		message := fmt.Sprintf("%s中不存在环", kind)
+		tracker.AddStep(message, cycleState{Graph: graph, Status: search.status, Stack: []int{}}, []int{})
+		output["message"] = message
+		return output, nil
+	}
+	closed := append(append([]int{}, search.nodes...), search.nodes[0])
+	message := fmt.Sprintf("找到长度为 %d 的环：%s", len(search.nodes), joinLabels(graph, closed, " → "))
+	tracker.AddStep(message, cycleState{Graph: graph, Status: search.status, Stack: []int{}, Cycle: search.nodes, CycleEdges: search.edges}, search.nodes)
+	output["cycle"] = nodeIDs(graph, search.nodes)
+	output["cycleEdges"] = search.edges
+	output["length"] = len(search.nodes)
+	output["message"] = message
+	return output, nil
+}
+
+// findCycle 深度优先搜索找出一个环，没有环时 nodes 为空；tracker 为 nil 时不记录步骤
+func findCycle(g *models.GraphData, directed bool, tracker models.StepTracker) cycleSearch {
+	n := len(g.Nodes)
+	adj := buildAdjacency(g, directed)
+	status := make([]int, n)
+	position := make([]int, n) // 节点在递归栈中的位置
+	stack := make([]int, 0, n)
+	stackEdges := make([]int, 0, n) // stackEdges[k] 为走到 stack[k] 的边，根节点为 -1
+	result := cycleSearch{status: status}
+	snapshot := func() cycleState {
+		return cycleState{Graph: g, Status: append([]int{}, status...), Stack: append([]int{}, stack...)}
+	}
+
+	var visit func(u, via int) bool
+	visit = func(u, via int) bool {
+		status[u] = nodeOnStack
+		position[u] = len(stack)
+		stack, stackEdges = append(stack, u), append(stackEdges, via)
+		result.visited++
+		if tracker != nil {
+			tracker.AddStep(fmt.Sprintf("访问 %s，压入递归栈", nodeLabel(g, u)), snapshot(), []int{u})
+			tracker.AddOperation(models.OpTypeCall, []int{u}, nil, "进入节点")
+		}
+		for _, a := range adj[u] {
+			// 无向图中不能沿来时的同一条边返回，但重边可以
+			if !directed && a.edge == via {
+				continue
+			}
+			switch status[a.to] {
+			case nodeOnStack:
+				start := position[a.to]
+				result.nodes = append([]int{}, stack[start:]...)
+				result.edges = append(append([]int{}, stackEdges[start+1:]...), a.edge)
+				if tracker != nil {
+					state := snapshot()
+					state.Cycle, state.CycleEdges = result.nodes, result.edges
+					tracker.AddStep(fmt.Sprintf("边 %s 指向递归栈中的 %s，构成环", edgeText(g, nodeIndex(g), a.edge, directed), nodeLabel(g, a.to)),
+						state, result.nodes)
+				}
+				return true
+			case nodeUnvisited:
+				if visit(a.to, a.edge) {
+					return true
+				}
+			}
+		}
+		status[u] = nodeFinished
+		stack, stackEdges = stack[:len(stack)-1], stackEdges[:len(stackEdges)-1]
+		if tracker != nil {
+			tracker.AddStep(fmt.Sprintf("%s 的边都已检查，出栈", nodeLabel(g, u)), snapshot(), []int{u})
+		}
+		return false
+	}
+
+	for root := 0; root < n; root++ {
+		if status[root] == nodeUnvisited && visit(root, -1) {
+			break
+		}
+	}
+	return result
+}
+
+// ProcessGraph 处理图
+func (cd *CycleDetection) ProcessGraph(graph *models.GraphData, tracker models.StepTracker) (interface{}, error) {
+	return cd.Execute(graph, tracker)
+}
+
+// GetGraphType 图类型
+func (cd *CycleDetection) GetGraphType() string { return "both" }
+
+// ValidateInput 验证输入
+func (cd *CycleDetection) ValidateInput(data interface{}) error {
+	return validateStructureInput(data)
+}
+
+// GetComplexity 获取复杂度信息
+func (cd *CycleDetection) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(1)", // 第一条边就是自环
+			Average: "O(V+E)",
+			Worst:   "O(V+E)",
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(V)",
+			Average: "O(V)",
+			Worst:   "O(V)",
+		},
+	}
+}
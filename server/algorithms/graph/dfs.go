@@ -1,6 +1,7 @@
 package graph
 
 import (
+	"fmt"
 	"gin/algorithms"
 	"gin/models"
 )
@@ -10,6 +11,28 @@ type DFS struct {
 	algorithms.BaseAlgorithm
 }
 
+// DFS 中边的分类
+const (
+	EdgeTree    = "tree"    // 树边：发现新节点的边
+	EdgeBack    = "back"    // 回边：指向递归栈中的祖先（含自环）
+	EdgeForward = "forward" // 前向边：指向已完成的后代（仅有向图）
+	EdgeCross   = "cross"   // 横跨边：指向已完成、且不是后代的节点（仅有向图）
+)
+
+// classifiedEdge 按检查顺序记录的边分类，Index 为边在 graph.Edges 中的下标
+type classifiedEdge struct {
+	From  string `json:"from"`
+	To    string `json:"to"`
+	Index int    `json:"index"`
+	Type  string `json:"type"`
+}
+
+// dfsTree DFS 森林中的一棵树，Nodes 按发现顺序排列
+type dfsTree struct {
+	Root  string   `json:"root"`
+	Nodes []string `json:"nodes"`
+}
+
 // NewDFS 创建DFS实例
 func NewDFS() *DFS {
 	return &DFS{
@@ -17,27 +40,24 @@ func NewDFS() *DFS {
 			ID:              "graph_dfs",
 			Name:            "深度优先搜索 (DFS)",
 			Category:        models.CategoryGraph,
-			Description:     "沿着路径尽可能深入节点，遇到未访问的邻居继续递归。",
+			Description:     "沿着路径尽可能深入节点，遇到未访问的邻居继续递归。记录每个节点的发现与完成时间戳，并把边分为树边、回边、前向边和横跨边；无向图中只有树边和回边。",
 			TimeComplexity:  "O(V+E)",
 			SpaceComplexity: "O(V)",
-			Parameters: []models.Parameter{
-				{
-					Name:         "start",
-					Type:         "string",
-					Description:  "起始节点ID",
-					DefaultValue: "node_0",
-					Required:     false,
-				},
-			},
-			Stable:   false,
-			InPlace:  false,
-			Adaptive: false,
+			Parameters:      traversalParameters(),
+			Stable:          false,
+			InPlace:         false,
+			Adaptive:        false,
 		},
 	}
 }
 
 // Execute 执行DFS
 func (d *DFS) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return d.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 按参数执行DFS
+func (d *DFS) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
 	if err := d.ValidateInput(data); err != nil {
 		return nil, err
 	}
@@ -50,68 +70,132 @@ func (d *DFS) Execute(data interface{}, tracker models.StepTracker) (interface{}
 			return nil, algorithms.ErrInvalidInput
 		}
 	}
+	if err := checkEndpoints(graph); err != nil {
+		return nil, err
+	}
+	roots, err := traversalRoots(graph, params)
+	if err != nil {
+		return nil, err
+	}
 
 	tracker.SetPhase("初始化")
 	tracker.AddStep("开始深度优先搜索", graph, []int{})
 
-	// 构建索引和邻接
-	idx := make(map[string]int)
-	for i, n := range graph.Nodes {
-		idx[n.ID] = i
+	// 邻接表记录边的下标，无向图中沿来时的边返回不算回边
+	directed := isDirected(graph)
+	adj := buildAdjacency(graph, directed)
+	index := nodeIndex(graph)
+	n := len(graph.Nodes)
+
+	// discovery 为0表示未发现，finish 为0表示仍在递归栈中
+	discovery := make([]int, n)
+	finish := make([]int, n)
+	parent := make([]int, n)
+	for i := range parent {
+		parent[i] = -1
 	}
-	adj := make(map[string][]string)
-	for _, e := range graph.Edges {
-		adj[e.From] = append(adj[e.From], e.To)
-		if graph.Type == "undirected" {
-			adj[e.To] = append(adj[e.To], e.From)
-		}
+	classified := make([]bool, len(graph.Edges))
+	edges := make([]classifiedEdge, 0, len(graph.Edges))
+	counts := map[string]int{EdgeTree: 0, EdgeBack: 0, EdgeForward: 0, EdgeCross: 0}
+	order := make([]string, 0, n)
+	forest := make([]dfsTree, 0)
+	clock := 0
+
+	classify := func(u int, a arc, kind string) {
+		classified[a.edge] = true
+		counts[kind]++
+		edges = append(edges, classifiedEdge{From: graph.Nodes[u].ID, To: graph.Nodes[a.to].ID, Index: a.edge, Type: kind})
 	}
 
-	startID := "node_0"
-	if len(graph.Nodes) > 0 {
-		startID = graph.Nodes[0].ID
-	}
+	var dfs func(u, via int)
+	dfs = func(u, via int) {
+		clock++
+		discovery[u] = clock
+		tracker.SetPhase("访问节点")
+		tracker.AddStep(fmt.Sprintf("访问节点 %s，发现时间 %d", nodeLabel(graph, u), clock), graph, []int{u})
+		tracker.AddOperation(models.OpTypeAccess, []int{u}, []interface{}{clock}, "进入节点")
+		order = append(order, graph.Nodes[u].ID)
+		forest[len(forest)-1].Nodes = append(forest[len(forest)-1].Nodes, graph.Nodes[u].ID)
+
+		for _, a := range adj[u] {
+			// 无向边从两端各出现一次，只在第一次检查时分类
+			if !directed && (a.edge == via || classified[a.edge]) {
+				continue
+			}
+			v := a.to
+			switch {
+			case discovery[v] == 0:
+				classify(u, a, EdgeTree)
+				parent[v] = u
+				tracker.AddComparison(u, v, 0)
+				tracker.AddStep("沿树边 "+edgeText(graph, index, a.edge, directed)+" 深入", graph, []int{v})
+				tracker.AddOperation(models.OpTypeCall, []int{v}, nil, "递归访问")
+				dfs(v, a.edge)
+				tracker.SetPhase("回溯")
+				tracker.AddStep("回溯到节点 "+nodeLabel(graph, u), graph, []int{u})
+				continue
+			case finish[v] == 0:
+				classify(u, a, EdgeBack)
+			case discovery[u] < discovery[v]:
+				classify(u, a, EdgeForward)
+			default:
+				classify(u, a, EdgeCross)
+			}
+			kind := edges[len(edges)-1].Type
+			tracker.AddStep(fmt.Sprintf("边 %s 指向%s的 %s，为%s", edgeText(graph, index, a.edge, directed),
+				map[string]string{EdgeBack: "递归栈中", EdgeForward: "已完成的后代", EdgeCross: "已完成的非后代"}[kind],
+				nodeLabel(graph, v), edgeTypeNames[kind]), graph, []int{u, v})
+		}
 
-	visited := make(map[string]bool)
-	order := make([]string, 0, len(graph.Nodes))
+		clock++
+		finish[u] = clock
+		tracker.AddStep(fmt.Sprintf("节点 %s 的边都已检查，完成时间 %d", nodeLabel(graph, u), clock), graph, []int{u})
+		tracker.AddOperation(models.OpTypeUpdate, []int{u}, []interface{}{clock}, "完成节点")
+	}
 
-	var dfs func(u string)
-	dfs = func(u string) {
-		if visited[u] {
-			return
+	for _, root := range roots {
+		if discovery[root] != 0 {
+			continue
 		}
-		visited[u] = true
-		if ui, ok := idx[u]; ok {
-			tracker.SetPhase("访问节点")
-			tracker.AddStep("访问节点 "+graph.Nodes[ui].Label, graph, []int{ui})
-			tracker.AddOperation(models.OpTypeAccess, []int{ui}, nil, "进入节点")
-		}
-		order = append(order, u)
-		for _, v := range adj[u] {
-			if !visited[v] {
-				if ui, ok1 := idx[u]; ok1 {
-					if vi, ok2 := idx[v]; ok2 {
-						tracker.AddComparison(ui, vi, 0)
-						tracker.AddStep("沿边深入", graph, []int{vi})
-						tracker.AddOperation(models.OpTypeCall, []int{vi}, nil, "递归访问")
-					}
-				}
-				dfs(v)
-				if vi, ok := idx[v]; ok {
-					tracker.SetPhase("回溯")
-					tracker.AddStep("回溯到节点 "+graph.Nodes[vi].Label, graph, []int{vi})
-				}
-			}
+		if len(forest) > 0 {
+			tracker.SetPhase("新的连通分量")
+			tracker.AddStep("从未访问的节点 "+nodeLabel(graph, root)+" 开始新的一棵DFS树", graph, []int{root})
 		}
+		forest = append(forest, dfsTree{Root: graph.Nodes[root].ID, Nodes: []string{}})
+		dfs(root, -1)
 	}
 
-	dfs(startID)
+	reached := make([]bool, n)
+	discoveryMap := make(map[string]int)
+	finishMap := make(map[string]int)
+	for i, t := range discovery {
+		if t != 0 {
+			reached[i] = true
+			discoveryMap[graph.Nodes[i].ID] = t
+			finishMap[graph.Nodes[i].ID] = finish[i]
+		}
+	}
 
 	return map[string]interface{}{
-		"order": order,
+		"order":      order,
+		"directed":   directed,
+		"discovery":  discoveryMap,
+		"finish":     finishMap,
+		"parent":     parentMap(graph, parent, reached),
+		"forest":     forest,
+		"edges":      edges,
+		"edgeCounts": counts,
 	}, nil
 }
 
+// edgeTypeNames 边分类的中文名称
+var edgeTypeNames = map[string]string{
+	EdgeTree:    "树边",
+	EdgeBack:    "回边",
+	EdgeForward: "前向边",
+	EdgeCross:   "横跨边",
+}
+
 // ValidateInput 验证图输入
 func (d *DFS) ValidateInput(data interface{}) error {
 	if data == nil {
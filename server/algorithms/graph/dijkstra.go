@@ -20,17 +20,25 @@ func NewDijkstra() *Dijkstra {
 			ID:              "graph_dijkstra",
 			Name:            "Dijkstra最短路径算法",
 			Category:        models.CategoryGraph,
-			Description:     "计算从起始节点到所有其他节点的最短路径，适用于带权重的有向图和无向图。",
+			Description:     "计算从起始节点到所有其他节点的最短路径，适用于带权重的有向图和无向图；指定终点时，终点出队即提前结束。",
 			TimeComplexity:  "O((V+E)logV)",
 			SpaceComplexity: "O(V)",
 			Parameters: []models.Parameter{
 				{
 					Name:         "start",
 					Type:         "string",
-					Description:  "起始节点ID",
-					DefaultValue: "node_0",
+					Description:  "起始节点ID或标签，默认为第一个节点",
+					DefaultValue: "",
 					Required:     false,
 				},
+				{
+					Name:         "target",
+					Type:         "string",
+					Description:  "终点ID或标签；指定后终点的最短距离确定时即停止，结果只包含已确定距离的节点",
+					DefaultValue: "",
+					Required:     false,
+				},
+				showQueueParameter(),
 			},
 			Stable:   false,
 			InPlace:  false,
@@ -80,6 +88,11 @@ func (pq *PriorityQueue) Pop() interface{} {
 
 // Execute 执行Dijkstra算法
 func (d *Dijkstra) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return d.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 按参数执行Dijkstra算法
+func (d *Dijkstra) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
 	if err := d.ValidateInput(data); err != nil {
 		return nil, err
 	}
@@ -93,8 +106,18 @@ func (d *Dijkstra) Execute(data interface{}, tracker models.StepTracker) (interf
 		}
 	}
 
+	// 开启 show_queue 时步骤数据附带优先队列快照
+	showQueue := algorithms.BoolParam(params, "show_queue", false)
+	pq := make(PriorityQueue, 0)
+	stepData := func() interface{} {
+		if showQueue {
+			return &queueTraceState{Graph: graph, Queue: pq.snapshot()}
+		}
+		return graph
+	}
+
 	tracker.SetPhase("初始化")
-	tracker.AddStep("开始Dijkstra最短路径算法", graph, []int{})
+	tracker.AddStep("开始Dijkstra最短路径算法", stepData(), []int{})
 
 	// 构建节点索引和邻接表
 	idx := make(map[string]int)
@@ -104,31 +127,37 @@ func (d *Dijkstra) Execute(data interface{}, tracker models.StepTracker) (interf
 
 	// 构建邻接表，包含权重信息
 	adj := make(map[string][]EdgeInfo)
-	for _, e := range graph.Edges {
-		weight := 1.0 // 默认权重
-		if e.Weight != nil {
-			if w, ok := e.Weight.(float64); ok {
-				weight = w
-			} else if w, ok := e.Weight.(int); ok {
-				weight = float64(w)
-			}
-		}
+	for k, e := range graph.Edges {
+		weight := algorithms.EdgeWeight(e)
 
-		adj[e.From] = append(adj[e.From], EdgeInfo{To: e.To, Weight: weight})
+		adj[e.From] = append(adj[e.From], EdgeInfo{To: e.To, Weight: weight, Index: k})
 		if graph.Type == "undirected" {
-			adj[e.To] = append(adj[e.To], EdgeInfo{To: e.From, Weight: weight})
+			adj[e.To] = append(adj[e.To], EdgeInfo{To: e.From, Weight: weight, Index: k})
 		}
 	}
 
-	// 确定起始节点
-	startID := "node_0"
-	if len(graph.Nodes) > 0 {
-		startID = graph.Nodes[0].ID
+	// 确定起始节点与可选的终点
+	startID := graph.Nodes[0].ID
+	if name := algorithms.StringParam(params, "start", ""); name != "" {
+		i, err := findNode(graph, name)
+		if err != nil {
+			return nil, err
+		}
+		startID = graph.Nodes[i].ID
+	}
+	targetID := ""
+	if name := algorithms.StringParam(params, "target", ""); name != "" {
+		i, err := findNode(graph, name)
+		if err != nil {
+			return nil, err
+		}
+		targetID = graph.Nodes[i].ID
 	}
 
 	// 初始化距离数组
 	distances := make(map[string]float64)
 	previous := make(map[string]string)
+	previousEdge := make(map[string]int)
 	visited := make(map[string]bool)
 
 	for _, node := range graph.Nodes {
@@ -137,7 +166,6 @@ func (d *Dijkstra) Execute(data interface{}, tracker models.StepTracker) (interf
 	distances[startID] = 0
 
 	// 初始化优先队列
-	pq := make(PriorityQueue, 0)
 	heap.Init(&pq)
 
 	// 将起始节点加入队列
@@ -147,7 +175,7 @@ func (d *Dijkstra) Execute(data interface{}, tracker models.StepTracker) (interf
 	})
 
 	if sidx, ok := idx[startID]; ok {
-		tracker.AddStep("设置起始节点距离为0", graph, []int{sidx})
+		tracker.AddStep("设置起始节点距离为0", stepData(), []int{sidx})
 		tracker.AddOperation(models.OpTypeUpdate, []int{sidx}, []interface{}{0}, "起始节点")
 	}
 
@@ -158,6 +186,10 @@ func (d *Dijkstra) Execute(data interface{}, tracker models.StepTracker) (interf
 
 		// 如果已访问过，跳过
 		if visited[currentNodeID] {
+			if showQueue {
+				tracker.AddStep(fmt.Sprintf("弹出过期队列项 %s (距离: %.1f)，该节点已确定最短距离，跳过",
+					currentNodeID, current.distance), stepData(), []int{})
+			}
 			continue
 		}
 
@@ -166,10 +198,18 @@ func (d *Dijkstra) Execute(data interface{}, tracker models.StepTracker) (interf
 		if cidx, ok := idx[currentNodeID]; ok {
 			tracker.SetPhase("处理节点")
 			tracker.AddStep(fmt.Sprintf("处理节点 %s (距离: %.1f)",
-				graph.Nodes[cidx].Label, distances[currentNodeID]), graph, []int{cidx})
+				graph.Nodes[cidx].Label, distances[currentNodeID]), stepData(), []int{cidx})
 			tracker.AddOperation(models.OpTypeAccess, []int{cidx}, nil, "访问节点")
 		}
 
+		// 终点出队时距离已经确定，不必再扩展
+		if currentNodeID == targetID {
+			tracker.SetPhase("到达终点")
+			tracker.AddStep(fmt.Sprintf("终点 %s 的最短距离已确定 (%.1f)，提前结束",
+				graph.Nodes[idx[targetID]].Label, distances[targetID]), stepData(), []int{idx[targetID]})
+			break
+		}
+
 		// 遍历所有邻接节点
 		for _, neighbor := range adj[currentNodeID] {
 			neighborID := neighbor.To
@@ -185,6 +225,13 @@ func (d *Dijkstra) Execute(data interface{}, tracker models.StepTracker) (interf
 			if newDistance < distances[neighborID] {
 				distances[neighborID] = newDistance
 				previous[neighborID] = currentNodeID
+				previousEdge[neighborID] = neighbor.Index
+
+				// 将更新后的节点加入队列
+				heap.Push(&pq, &Item{
+					nodeID:   neighborID,
+					distance: newDistance,
+				})
 
 				// 可视化边的松弛操作
 				if cidx, ok1 := idx[currentNodeID]; ok1 {
@@ -192,60 +239,68 @@ func (d *Dijkstra) Execute(data interface{}, tracker models.StepTracker) (interf
 						tracker.AddComparison(cidx, nidx, int(neighbor.Weight))
 						tracker.AddStep(fmt.Sprintf("松弛边 %s->%s，新距离: %.1f",
 							graph.Nodes[cidx].Label, graph.Nodes[nidx].Label, newDistance),
-							graph, []int{nidx})
+							stepData(), []int{nidx})
 						tracker.AddOperation(models.OpTypeUpdate, []int{nidx},
 							[]interface{}{newDistance}, "更新距离")
 					}
 				}
-
-				// 将更新后的节点加入队列
-				heap.Push(&pq, &Item{
-					nodeID:   neighborID,
-					distance: newDistance,
-				})
 			}
 		}
 	}
 
-	// 构建路径结果
+	// 构建路径结果；提前结束时只保留已确定最短距离的节点
 	paths := make(map[string]PathResult)
 	for nodeID := range distances {
+		if targetID != "" && !visited[nodeID] {
+			delete(distances, nodeID)
+			continue
+		}
 		path := []string{}
+		edges := []int{}
 		current := nodeID
 
 		// 重建路径
 		for current != "" && current != startID {
 			path = append([]string{current}, path...)
+			edges = append([]int{previousEdge[current]}, edges...)
 			current = previous[current]
 		}
 
 		if current == startID {
 			path = append([]string{startID}, path...)
+		} else {
+			edges = nil
 		}
 
 		paths[nodeID] = PathResult{
 			Distance: distances[nodeID],
 			Path:     path,
+			Edges:    edges,
 		}
 	}
 
-	return map[string]interface{}{
+	result := map[string]interface{}{
 		"distances": distances,
 		"paths":     paths,
 		"startNode": startID,
-	}, nil
+	}
+	if targetID != "" {
+		result["target"] = targetID
+		result["reachable"] = visited[targetID]
+		if visited[targetID] {
+			result["path"] = paths[targetID]
+		} else {
+			result["message"] = fmt.Sprintf("从 %s 无法到达 %s", startID, targetID)
+		}
+	}
+	return result, nil
 }
 
 // EdgeInfo 边信息结构
 type EdgeInfo struct {
 	To     string
 	Weight float64
-}
-
-// PathResult 路径结果
-type PathResult struct {
-	Distance float64  `json:"distance"`
-	Path     []string `json:"path"`
+	Index  int
 }
 
 // ValidateInput 验证图输入
@@ -0,0 +1,378 @@
+package graph
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"strings"
+)
+
+// maxEulerEdges 欧拉路径的边数上限，每一步都记录栈和已完成的路径
+const maxEulerEdges = 2000
+
+// 欧拉路径的类型
+const (
+	EulerCircuit = "circuit" // 欧拉回路：起点与终点相同
+	EulerPath    = "path"    // 欧拉路径：起点与终点不同
+	EulerNone    = "none"    // 不存在
+)
+
+// eulerState Hierholzer 算法的步骤快照
+type eulerState struct {
+	Graph     *models.GraphData `json:"graph"`     // 图数据
+	Stack     []int             `json:"stack"`     // 当前栈中的节点（下标）
+	Path      []int             `json:"path"`      // 已经出栈的节点，逆序即为欧拉路径的后缀
+	UsedEdges []int             `json:"usedEdges"` // 已经走过的边（graph.Edges 的下标）
+	Current   int               `json:"current"`   // 栈顶节点，-1 表示无
+}
+
+// EulerianPath Hierholzer 算法求欧拉路径/回路
+type EulerianPath struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewEulerianPath 创建欧拉路径实例
+func NewEulerianPath() *EulerianPath {
+	return &EulerianPath{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "graph_eulerian_path",
+			Name:            "欧拉路径 (Hierholzer)",
+			Category:        models.CategoryGraph,
+			Description:     "先检查度数条件：无向图的奇度节点必须为0个（回路）或2个（路径），有向图每个节点的出度与入度之差必须全为0（回路）或恰有一个+1和一个−1（路径），且所有有边的节点连通。满足条件时用Hierholzer算法：沿未走过的边一直前进并压栈，走不动时出栈并加入路径，最后把出栈顺序反转。不满足时报告不存在的原因。",
+			TimeComplexity:  "O(V+E)",
+			SpaceComplexity: "O(V+E)",
+			Parameters: []models.Parameter{
+				{
+					Name:         "start",
+					Type:         "string",
+					Description:  "起点的节点ID或标签；欧拉路径只能从指定的端点出发，默认自动选择",
+					DefaultValue: "",
+					Required:     false,
+				},
+			},
+		},
+	}
+}
+
+// eulerCheck 度数与连通性检查的结果
+type eulerCheck struct {
+	kind    string
+	starts  []int    // 合法的起点
+	reasons []string // 不存在时的原因
+	problem []int    // 违反条件的节点，用于高亮
+}
+
+// Execute 使用默认参数执行
+func (ep *EulerianPath) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return ep.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 按参数执行 Hierholzer 算法
+func (ep *EulerianPath) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	if err := ep.ValidateInput(data); err != nil {
+		return nil, err
+	}
+	graph, err := toGraph(data)
+	if err != nil {
+		return nil, err
+	}
+	directed := isDirected(graph)
+	adj := buildAdjacency(graph, directed)
+
+	tracker.SetPhase("度数检查")
+	tracker.AddStep(fmt.Sprintf("检查 %d 个节点、%d 条边的度数条件与连通性", len(graph.Nodes), len(graph.Edges)),
+		ep.state(graph, nil, nil, nil), []int{})
+	check := checkEuler(graph, directed, adj)
+	output := map[string]interface{}{"directed": directed, "type": check.kind}
+	if directed {
+		in, out := make(map[string]int), make(map[string]int)
+		for i, n := range graph.Nodes {
+			out[n.ID] = len(adj[i])
+		}
+		for _, e := range graph.Edges {
+			in[e.To]++
+		}
+		output["inDegree"], output["outDegree"] = in, out
+	} else {
+		degree := make(map[string]int)
+		for i, n := range graph.Nodes {
+			degree[n.ID] = len(adj[i])
+		}
+		output["degree"] = degree
+	}
+
+	if check.kind == EulerNone {
+		message := "不存在欧拉路径：" + strings.Join(check.reasons, "；")
+		tracker.SetPhase("完成")
+		tracker.AddStep(message, ep.state(graph, nil, nil, nil), check.problem)
+		output["exists"] = false
+		output["reasons"] = check.reasons
+		output["message"] = message
+		return output, nil
+	}
+
+	start := check.starts[0]
+	if name := algorithms.StringParam(params, "start", ""); name != "" {
+		if start, err = findNode(graph, name); err != nil {
+			return nil, err
+		}
+		if !containsInt(check.starts, start) {
+			return nil, fmt.Errorf("%s 不能作为起点，可选的起点为 %s", nodeLabel(graph, start), joinLabels(graph, check.starts, "、"))
+		}
+	}
+	kindName := map[string]string{EulerCircuit: "欧拉回路", EulerPath: "欧拉路径"}[check.kind]
+	tracker.AddStep(fmt.Sprintf("满足%s的条件，从 %s 出发", kindName, nodeLabel(graph, start)),
+		ep.state(graph, []int{start}, nil, nil), []int{start})
+
+	// Hierholzer：ptr[u] 指向 u 的下一条待检查的边
+	tracker.SetPhase("Hierholzer")
+	index := nodeIndex(graph)
+	used := make([]bool, len(graph.Edges))
+	usedEdges := make([]int, 0, len(graph.Edges))
+	ptr := make([]int, len(graph.Nodes))
+	stack, stackEdges := []int{start}, []int{-1}
+	path, pathEdges := make([]int, 0, len(graph.Edges)+1), make([]int, 0, len(graph.Edges))
+	for len(stack) > 0 {
+		u := stack[len(stack)-1]
+		for ptr[u] < len(adj[u]) && used[adj[u][ptr[u]].edge] {
+			ptr[u]++
+		}
+		if ptr[u] < len(adj[u]) {
+			a := adj[u][ptr[u]]
+			used[a.edge] = true
+			usedEdges = append(usedEdges, a.edge)
+			stack, stackEdges = append(stack, a.to), append(stackEdges, a.edge)
+			tracker.AddStep(fmt.Sprintf("沿边 %s 从 %s 走到 %s，压栈", edgeText(graph, index, a.edge, directed), nodeLabel(graph, u), nodeLabel(graph, a.to)),
+				ep.state(graph, stack, path, usedEdges), []int{u, a.to})
+			tracker.AddOperation(models.OpTypeInsert, []int{a.to}, []interface{}{a.edge}, "压栈")
+			continue
+		}
+		stack = stack[:len(stack)-1]
+		path = append(path, u)
+		if edge := stackEdges[len(stackEdges)-1]; edge >= 0 {
+			pathEdges = append(pathEdges, edge)
+		}
+		stackEdges = stackEdges[:len(stackEdges)-1]
+		tracker.AddStep(fmt.Sprintf("%s 没有未走过的边，出栈并加入路径", nodeLabel(graph, u)),
+			ep.state(graph, stack, path, usedEdges), []int{u})
+		tracker.AddOperation(models.OpTypeMove, []int{u}, nil, "出栈")
+	}
+	reverseInts(path)
+	reverseInts(pathEdges)
+
+	message := fmt.Sprintf("%s：%s", kindName, joinLabels(graph, path, " → "))
+	tracker.SetPhase("完成")
+	tracker.AddStep(message, ep.state(graph, nil, path, usedEdges), path)
+	output["exists"] = true
+	output["path"] = nodeIDs(graph, path)
+	output["edges"] = pathEdges
+	output["start"] = graph.Nodes[path[0]].ID
+	output["end"] = graph.Nodes[path[len(path)-1]].ID
+	output["message"] = message
+	return output, nil
+}
+
+// checkEuler 按度数条件与连通性判断欧拉路径的类型，并给出合法的起点
+func checkEuler(g *models.GraphData, directed bool, adj [][]arc) eulerCheck {
+	n := len(g.Nodes)
+	check := eulerCheck{}
+
+	// 有边的节点必须在同一个（弱）连通分量中
+	undirected := adj
+	if directed {
+		undirected = buildAdjacency(g, false)
+	}
+	first := -1
+	for i := 0; i < n; i++ {
+		if len(undirected[i]) > 0 {
+			first = i
+			break
+		}
+	}
+	if first < 0 {
+		// 没有边：只含起点的平凡回路
+		check.kind = EulerCircuit
+		check.starts = make([]int, n)
+		for i := range check.starts {
+			check.starts[i] = i
+		}
+		return check
+	}
+	reached := make([]bool, n)
+	reached[first] = true
+	queue := []int{first}
+	for len(queue) > 0 {
+		u := queue[0]
+		queue = queue[1:]
+		for _, a := range undirected[u] {
+			if !reached[a.to] {
+				reached[a.to] = true
+				queue = append(queue, a.to)
+			}
+		}
+	}
+	for i := 0; i < n; i++ {
+		if len(undirected[i]) > 0 && !reached[i] {
+			check.reasons = append(check.reasons, fmt.Sprintf("有边的节点不连通，%s 与 %s 之间没有路径", nodeLabel(g, first), nodeLabel(g, i)))
+			check.problem = append(check.problem, first, i)
+			break
+		}
+	}
+
+	var candidates []int
+	kind := EulerCircuit
+	if directed {
+		in := make([]int, n)
+		for _, row := range adj {
+			for _, a := range row {
+				in[a.to]++
+			}
+		}
+		var plus, minus, bad []string
+		var plusNodes, badNodes []int
+		for i := 0; i < n; i++ {
+			switch diff := len(adj[i]) - in[i]; {
+			case diff == 1:
+				plus = append(plus, nodeLabel(g, i))
+				plusNodes = append(plusNodes, i)
+			case diff == -1:
+				minus = append(minus, nodeLabel(g, i))
+			case diff != 0:
+				bad = append(bad, fmt.Sprintf("%s（出度%d，入度%d）", nodeLabel(g, i), len(adj[i]), in[i]))
+				badNodes = append(badNodes, i)
+			}
+		}
+		switch {
+		case len(bad) > 0:
+			check.reasons = append(check.reasons, fmt.Sprintf("节点 %s 的出度与入度相差超过1", strings.Join(bad, "、")))
+			check.problem = append(check.problem, badNodes...)
+		case len(plus) == 0 && len(minus) == 0:
+		case len(plus) == 1 && len(minus) == 1:
+			kind, candidates = EulerPath, plusNodes
+		default:
+			check.reasons = append(check.reasons, fmt.Sprintf("出度比入度多1的节点有 %d 个（%s），入度比出度多1的节点有 %d 个（%s），欧拉路径要求各恰好1个",
+				len(plus), strings.Join(plus, "、"), len(minus), strings.Join(minus, "、")))
+			check.problem = append(check.problem, plusNodes...)
+		}
+	} else {
+		var odd []int
+		for i := 0; i < n; i++ {
+			if len(adj[i])%2 == 1 {
+				odd = append(odd, i)
+			}
+		}
+		switch len(odd) {
+		case 0:
+		case 2:
+			kind, candidates = EulerPath, odd
+		default:
+			described := make([]string, len(odd))
+			for k, i := range odd {
+				described[k] = fmt.Sprintf("%s（度%d）", nodeLabel(g, i), len(adj[i]))
+			}
+			check.reasons = append(check.reasons, fmt.Sprintf("有 %d 个奇度节点 %s，欧拉路径要求0个或2个", len(odd), strings.Join(described, "、")))
+			check.problem = append(check.problem, odd...)
+		}
+	}
+
+	if len(check.reasons) > 0 {
+		check.kind = EulerNone
+		return check
+	}
+	check.kind = kind
+	if kind == EulerCircuit {
+		for i := 0; i < n; i++ {
+			if len(undirected[i]) > 0 {
+				candidates = append(candidates, i)
+			}
+		}
+	}
+	check.starts = candidates
+	return check
+}
+
+// state 生成步骤快照
+func (ep *EulerianPath) state(g *models.GraphData, stack, path, used []int) eulerState {
+	current := -1
+	if len(stack) > 0 {
+		current = stack[len(stack)-1]
+	}
+	return eulerState{
+		Graph:     g,
+		Stack:     append([]int{}, stack...),
+		Path:      append([]int{}, path...),
+		UsedEdges: append([]int{}, used...),
+		Current:   current,
+	}
+}
+
+// edgeText 边的文字描述，有向边用箭头
+func edgeText(g *models.GraphData, index map[string]int, k int, directed bool) string {
+	e := g.Edges[k]
+	separator := "-"
+	if directed {
+		separator = "→"
+	}
+	return nodeLabel(g, index[e.From]) + separator + nodeLabel(g, index[e.To])
+}
+
+// joinLabels 按下标序列拼接节点名称
+func joinLabels(g *models.GraphData, indices []int, separator string) string {
+	labels := make([]string, len(indices))
+	for k, i := range indices {
+		labels[k] = nodeLabel(g, i)
+	}
+	return strings.Join(labels, separator)
+}
+
+// containsInt 判断切片中是否包含 x
+func containsInt(values []int, x int) bool {
+	for _, v := range values {
+		if v == x {
+			return true
+		}
+	}
+	return false
+}
+
+// reverseInts 原地反转切片
+func reverseInts(values []int) {
+	for i, j := 0, len(values)-1; i < j; i, j = i+1, j-1 {
+		values[i], values[j] = values[j], values[i]
+	}
+}
+
+// ProcessGraph 处理图
+func (ep *EulerianPath) ProcessGraph(graph *models.GraphData, tracker models.StepTracker) (interface{}, error) {
+	return ep.Execute(graph, tracker)
+}
+
+// GetGraphType 图类型
+func (ep *EulerianPath) GetGraphType() string { return "both" }
+
+// ValidateInput 验证输入
+func (ep *EulerianPath) ValidateInput(data interface{}) error {
+	if err := validateStructureInput(data); err != nil {
+		return err
+	}
+	if g, _ := toGraph(data); len(g.Edges) > maxEulerEdges {
+		return fmt.Errorf("欧拉路径最多支持 %d 条边，当前 %d 条", maxEulerEdges, len(g.Edges))
+	}
+	return nil
+}
+
+// GetComplexity 获取复杂度信息
+func (ep *EulerianPath) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(V+E)",
+			Average: "O(V+E)",
+			Worst:   "O(V+E)",
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(V+E)",
+			Average: "O(V+E)",
+			Worst:   "O(V+E)",
+		},
+	}
+}
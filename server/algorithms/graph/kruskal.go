@@ -19,7 +19,7 @@ func NewKruskal() *Kruskal {
 			ID:              "graph_kruskal",
 			Name:            "Kruskal最小生成树算法",
 			Category:        models.CategoryGraph,
-			Description:     "基于边排序的最小生成树算法，使用并查集结构避免环路，适用于稀疏图。",
+			Description:     "基于边排序的最小生成树算法，使用并查集结构避免环路，适用于稀疏图；图不连通时得到最小生成森林。",
 			TimeComplexity:  "O(ElogE)",
 			SpaceComplexity: "O(V)",
 			Parameters:      []models.Parameter{},
@@ -126,14 +126,7 @@ func (k *Kruskal) Execute(data interface{}, tracker models.StepTracker) (interfa
 	// 提取所有边并排序
 	edges := make([]EdgeWithWeight, 0, len(graph.Edges))
 	for _, edge := range graph.Edges {
-		weight := 1.0 // 默认权重
-		if edge.Weight != nil {
-			if w, ok := edge.Weight.(float64); ok {
-				weight = w
-			} else if w, ok := edge.Weight.(int); ok {
-				weight = float64(w)
-			}
-		}
+		weight := algorithms.EdgeWeight(edge)
 
 		fromIdx, fromExists := idx[edge.From]
 		toIdx, toExists := idx[edge.To]
@@ -153,8 +146,8 @@ func (k *Kruskal) Execute(data interface{}, tracker models.StepTracker) (interfa
 	tracker.SetPhase("边排序")
 	tracker.AddStep("按权重对所有边进行排序", graph, []int{})
 
-	// 按权重排序边
-	sort.Slice(edges, func(i, j int) bool {
+	// 按权重排序边，权重相同时保持输入顺序
+	sort.SliceStable(edges, func(i, j int) bool {
 		return edges[i].Weight < edges[j].Weight
 	})
 
@@ -183,7 +176,7 @@ func (k *Kruskal) Execute(data interface{}, tracker models.StepTracker) (interfa
 			tracker.AddStep("边被添加到最小生成树，当前总权重: "+formatWeight(totalWeight),
 				graph, []int{edge.FromIdx, edge.ToIdx})
 
-			// 如果已经选择了V-1条边，MST构建完成
+			// 如果已经选择了V-1条边，MST构建完成；图不连通时会检查完所有边，得到最小生成森林
 			if len(mstEdges) == len(graph.Nodes)-1 {
 				break
 			}
@@ -195,20 +188,17 @@ func (k *Kruskal) Execute(data interface{}, tracker models.StepTracker) (interfa
 	}
 
 	// 构建结果
+	forest := convertMSTEdges(mstEdges)
 	result := map[string]interface{}{
-		"mstEdges":    convertMSTEdges(mstEdges),
+		"mstEdges":    forest,
 		"totalWeight": totalWeight,
 		"edgeCount":   len(mstEdges),
 		"nodeCount":   len(graph.Nodes),
 	}
 
-	// 检查是否构成连通图
-	if len(mstEdges) < len(graph.Nodes)-1 {
-		result["isConnected"] = false
-		result["message"] = "图不连通，无法构成最小生成树"
-	} else {
-		result["isConnected"] = true
-	}
+	// 按连通分量汇总最小生成森林，并判断最小生成树是否唯一
+	tracker.SetPhase("完成")
+	tracker.AddStep(addForestSummary(result, graph, forest), graph, []int{})
 
 	return result, nil
 }
@@ -262,13 +252,10 @@ func (k *Kruskal) validateGraph(g *models.GraphData) error {
 	if len(g.Nodes) == 0 {
 		return algorithms.ErrInvalidInput
 	}
-	if len(g.Edges) == 0 {
-		return algorithms.ErrInvalidInput
-	}
 	if g.Type == "directed" {
 		return fmt.Errorf("Kruskal算法只适用于无向图")
 	}
-	return nil
+	return checkEndpoints(g)
 }
 
 // ProcessGraph 处理图（与Execute一致）
@@ -0,0 +1,218 @@
+package graph
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"math/rand"
+	"time"
+)
+
+// LabelPropagation 标签传播社区发现
+type LabelPropagation struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewLabelPropagation 创建标签传播实例
+func NewLabelPropagation() *LabelPropagation {
+	return &LabelPropagation{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "graph_label_propagation",
+			Name:            "标签传播社区发现",
+			Category:        models.CategoryGraph,
+			Description:     "每个节点初始时自成一个社区。每轮按随机顺序异步更新：节点采用邻居中（按边权加权）出现最多的标签，若当前标签已是最多者之一则保持不变，否则在并列者中随机选取。一轮中没有节点改变标签时收敛。有向边视为无向边。",
+			TimeComplexity:  "O(E·t)",
+			SpaceComplexity: "O(V+E)",
+			Parameters: []models.Parameter{
+				{
+					Name:         "max_iterations",
+					Type:         "int",
+					Description:  "最大迭代轮数",
+					DefaultValue: 100,
+					Required:     false,
+					Min:          1,
+					Max:          1000,
+				},
+				{
+					Name:         "weighted",
+					Type:         "bool",
+					Description:  "是否按边权统计邻居标签；否则每个邻居计一票",
+					DefaultValue: false,
+					Required:     false,
+				},
+				{
+					Name:         "seed",
+					Type:         "int",
+					Description:  "随机种子，决定更新顺序与平局时的选择；为0时使用当前时间",
+					DefaultValue: 0,
+					Required:     false,
+				},
+			},
+		},
+	}
+}
+
+// Execute 使用默认参数执行标签传播
+func (lp *LabelPropagation) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return lp.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 按参数执行标签传播
+func (lp *LabelPropagation) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	if err := lp.ValidateInput(data); err != nil {
+		return nil, err
+	}
+	graph, err := toGraph(data)
+	if err != nil {
+		return nil, err
+	}
+	maxIterations := algorithms.IntParam(params, "max_iterations", 100)
+	if maxIterations < 1 || maxIterations > 1000 {
+		return nil, fmt.Errorf("最大迭代轮数必须在1到1000之间")
+	}
+	weighted := algorithms.BoolParam(params, "weighted", false)
+	seed := int64(algorithms.IntParam(params, "seed", 0))
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	rng := rand.New(rand.NewSource(seed))
+
+	wg := newWeightedGraph(graph, weighted)
+	n := len(graph.Nodes)
+	labels := make([]int, n)
+	for i := range labels {
+		labels[i] = i
+	}
+	tracker.SetPhase("初始化")
+	tracker.AddStep(fmt.Sprintf("开始标签传播：%d 个节点各自成为一个社区，随机种子 %d", n, seed),
+		&networkState{Graph: graph, Labels: append([]int{}, labels...), Modularity: wg.modularity(labels, 1)}, []int{})
+
+	tracker.SetPhase("标签传播")
+	changedHistory := make([]int, 0)
+	modularityHistory := make([]float64, 0)
+	converged := false
+	iterations := 0
+	for iterations < maxIterations && !converged {
+		iterations++
+		changed := make([]int, 0)
+		for _, i := range rng.Perm(n) {
+			if next := propagateLabel(wg, labels, i, rng); next != labels[i] {
+				labels[i] = next
+				changed = append(changed, i)
+			}
+		}
+		converged = len(changed) == 0
+		q := wg.modularity(labels, 1)
+		changedHistory = append(changedHistory, len(changed))
+		modularityHistory = append(modularityHistory, roundTo(q, 6))
+
+		tracker.AddStep(fmt.Sprintf("第 %d 轮：%d 个节点改变了标签，当前 %d 个社区，模块度 %.4f", iterations, len(changed), countLabels(labels), q),
+			&networkState{Graph: graph, Labels: append([]int{}, labels...), Iteration: iterations, Changed: changed, Modularity: q}, changed)
+		if len(changed) > 0 {
+			values := make([]interface{}, len(changed))
+			for j, i := range changed {
+				values[j] = labels[i]
+			}
+			tracker.AddOperation(models.OpTypeAssign, changed, values, "采用邻居中最多的标签")
+		}
+	}
+
+	final := normalizeLabels(labels)
+	communities := wg.communities(graph, final)
+	q := wg.modularity(final, 1)
+	tracker.SetPhase("完成")
+	status := "已收敛"
+	if !converged {
+		status = fmt.Sprintf("达到最大迭代轮数 %d 仍未收敛", maxIterations)
+	}
+	tracker.AddStep(fmt.Sprintf("标签传播结束：共迭代 %d 轮，%s，得到 %d 个社区，模块度 %.4f", iterations, status, len(communities), q),
+		&networkState{Graph: graph, Labels: final, Iteration: iterations, Modularity: q}, []int{})
+
+	return map[string]interface{}{
+		"labels":            labelMap(graph, final),
+		"communities":       communities,
+		"communityCount":    len(communities),
+		"modularity":        roundTo(q, 6),
+		"iterations":        iterations,
+		"converged":         converged,
+		"changedHistory":    changedHistory,
+		"modularityHistory": modularityHistory,
+		"weighted":          weighted,
+		"seed":              seed,
+		"nodeCount":         n,
+	}, nil
+}
+
+// propagateLabel 节点 i 的新标签：邻居中权重最大的标签，当前标签并列最大时保持不变
+func propagateLabel(wg *weightedGraph, labels []int, i int, rng *rand.Rand) int {
+	neighbors := wg.neighbors(i)
+	if len(neighbors) == 0 {
+		return labels[i]
+	}
+	votes := map[int]float64{}
+	order := make([]int, 0) // 标签首次出现的顺序，保证平局候选的顺序确定
+	for _, j := range neighbors {
+		if _, ok := votes[labels[j]]; !ok {
+			order = append(order, labels[j])
+		}
+		votes[labels[j]] += wg.adj[i][j]
+	}
+	best := 0.0
+	for _, l := range order {
+		if votes[l] > best {
+			best = votes[l]
+		}
+	}
+	if best == 0 {
+		// 所有邻边的权重都为0
+		return labels[i]
+	}
+	if votes[labels[i]] == best {
+		return labels[i]
+	}
+	candidates := make([]int, 0, len(order))
+	for _, l := range order {
+		if votes[l] == best {
+			candidates = append(candidates, l)
+		}
+	}
+	return candidates[rng.Intn(len(candidates))]
+}
+
+// countLabels 不同标签的个数
+func countLabels(labels []int) int {
+	seen := map[int]bool{}
+	for _, l := range labels {
+		seen[l] = true
+	}
+	return len(seen)
+}
+
+// ValidateInput 验证图输入
+func (lp *LabelPropagation) ValidateInput(data interface{}) error {
+	return validateNetworkInput(data)
+}
+
+// ProcessGraph 处理图（与Execute一致）
+func (lp *LabelPropagation) ProcessGraph(graph *models.GraphData, tracker models.StepTracker) (interface{}, error) {
+	return lp.Execute(graph, tracker)
+}
+
+// GetGraphType 图类型
+func (lp *LabelPropagation) GetGraphType() string { return "both" }
+
+// GetComplexity 获取复杂度信息
+func (lp *LabelPropagation) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(V+E)",
+			Average: "O(E·t)", // 实践中迭代轮数 t 通常很小
+			Worst:   "O(E·t)",
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(V+E)",
+			Average: "O(V+E)",
+			Worst:   "O(V+E)",
+		},
+	}
+}
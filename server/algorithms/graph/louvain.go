@@ -0,0 +1,261 @@
+package graph
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"math"
+	"sort"
+)
+
+// Louvain Louvain社区发现
+type Louvain struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewLouvain 创建Louvain实例
+func NewLouvain() *Louvain {
+	return &Louvain{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "graph_louvain",
+			Name:            "Louvain社区发现",
+			Category:        models.CategoryGraph,
+			Description:     "贪心地最大化模块度。局部移动阶段依次尝试把每个节点移入相邻节点所在的社区，选择模块度增益最大的移动，直到一轮中没有节点移动；聚合阶段把每个社区收缩为一个超节点，社区内部的边变为自环，然后在新图上重复，直到局部移动不再改变划分。有向边视为无向边。",
+			TimeComplexity:  "O(E·log V)",
+			SpaceComplexity: "O(V+E)",
+			Parameters: []models.Parameter{
+				{
+					Name:         "resolution",
+					Type:         "float",
+					Description:  "分辨率参数 γ，越大得到的社区越小越多",
+					DefaultValue: 1.0,
+					Required:     false,
+					Min:          0.01,
+					Max:          10,
+				},
+				{
+					Name:         "weighted",
+					Type:         "bool",
+					Description:  "是否使用边权；否则所有边的权重视为1",
+					DefaultValue: false,
+					Required:     false,
+				},
+			},
+		},
+	}
+}
+
+// maxLouvainPasses 每层局部移动的轮数上限，防止浮点误差导致的来回移动
+const maxLouvainPasses = 100
+
+// louvainRun 一次Louvain的执行上下文
+type louvainRun struct {
+	graph      *models.GraphData
+	original   *weightedGraph
+	resolution float64
+	tracker    models.StepTracker
+	membership []int // 原始节点所属的超节点（当前层的节点）
+	passes     int
+	history    []float64
+}
+
+// Execute 使用默认参数执行Louvain
+func (l *Louvain) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return l.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 按参数执行Louvain
+func (l *Louvain) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	if err := l.ValidateInput(data); err != nil {
+		return nil, err
+	}
+	graph, err := toGraph(data)
+	if err != nil {
+		return nil, err
+	}
+	resolution := algorithms.FloatParam(params, "resolution", 1)
+	if resolution < 0.01 || resolution > 10 || math.IsNaN(resolution) {
+		return nil, fmt.Errorf("分辨率必须在0.01到10之间")
+	}
+	weighted := algorithms.BoolParam(params, "weighted", false)
+
+	wg := newWeightedGraph(graph, weighted)
+	n := len(graph.Nodes)
+	run := &louvainRun{graph: graph, original: wg, resolution: resolution, tracker: tracker,
+		membership: make([]int, n), history: make([]float64, 0)}
+	for i := range run.membership {
+		run.membership[i] = i
+	}
+	tracker.SetPhase("初始化")
+	tracker.AddStep(fmt.Sprintf("开始Louvain：%d 个节点各自成为一个社区，分辨率 %.2f", n, resolution),
+		&networkState{Graph: graph, Labels: append([]int{}, run.membership...), Modularity: wg.modularity(run.membership, resolution)}, []int{})
+
+	levels := 0
+	current := wg
+	for {
+		levels++
+		tracker.SetPhase(fmt.Sprintf("第 %d 层", levels))
+		community, moved := run.localMoving(current, levels)
+		if !moved {
+			levels--
+			break
+		}
+		before := len(current.adj)
+		current = run.aggregate(current, community, levels)
+		// 只剩一个超节点，或移动后社区数没有减少时停止
+		if len(current.adj) == 1 || len(current.adj) == before {
+			break
+		}
+	}
+
+	final := normalizeLabels(run.membership)
+	communities := wg.communities(graph, final)
+	q := wg.modularity(final, resolution)
+	tracker.SetPhase("完成")
+	tracker.AddStep(fmt.Sprintf("Louvain 结束：共 %d 层、%d 轮局部移动，得到 %d 个社区，模块度 %.4f", levels, run.passes, len(communities), q),
+		&networkState{Graph: graph, Labels: final, Iteration: run.passes, Modularity: q, Level: levels}, []int{})
+
+	return map[string]interface{}{
+		"labels":            labelMap(graph, final),
+		"communities":       communities,
+		"communityCount":    len(communities),
+		"modularity":        roundTo(q, 6),
+		"levels":            levels,
+		"passes":            run.passes,
+		"modularityHistory": run.history,
+		"resolution":        resolution,
+		"weighted":          weighted,
+		"nodeCount":         n,
+	}, nil
+}
+
+// localMoving 局部移动阶段，返回当前层各节点所属的社区以及是否有节点移动过
+func (r *louvainRun) localMoving(g *weightedGraph, level int) ([]int, bool) {
+	n := len(g.adj)
+	community := make([]int, n)
+	totals := make([]float64, n) // 各社区的度数之和
+	for i := range community {
+		community[i] = i
+		totals[i] = g.degree[i]
+	}
+
+	movedAny := false
+	for pass := 0; pass < maxLouvainPasses; pass++ {
+		moved := make([]int, 0)
+		for i := 0; i < n; i++ {
+			// 节点 i 到各相邻社区的边权之和，按社区编号排序保证结果确定
+			links := map[int]float64{}
+			for _, j := range g.neighbors(i) {
+				links[community[j]] += g.adj[i][j]
+			}
+			candidates := make([]int, 0, len(links))
+			for c := range links {
+				candidates = append(candidates, c)
+			}
+			sort.Ints(candidates)
+
+			// 先把 i 移出原社区，再比较移入各社区的增益 k_i,in − γ·tot_c·k_i / 2m
+			old := community[i]
+			totals[old] -= g.degree[i]
+			gain := func(c int) float64 {
+				return links[c] - r.resolution*totals[c]*g.degree[i]/g.total
+			}
+			best, bestGain := old, gain(old)
+			for _, c := range candidates {
+				if value := gain(c); value > bestGain+1e-12 {
+					best, bestGain = c, value
+				}
+			}
+			totals[best] += g.degree[i]
+			if best != old {
+				community[i] = best
+				moved = append(moved, i)
+			}
+		}
+		if len(moved) == 0 {
+			break
+		}
+		movedAny = true
+		r.passes++
+
+		// 把当前层的移动映射回原始节点
+		labels := make([]int, len(r.membership))
+		highlights := make([]int, 0)
+		movedSet := map[int]bool{}
+		for _, i := range moved {
+			movedSet[i] = true
+		}
+		for v, m := range r.membership {
+			labels[v] = community[m]
+			if movedSet[m] {
+				highlights = append(highlights, v)
+			}
+		}
+		q := r.original.modularity(labels, r.resolution)
+		r.history = append(r.history, roundTo(q, 6))
+		r.tracker.AddStep(fmt.Sprintf("第 %d 层第 %d 轮局部移动：%d 个节点移入模块度增益最大的相邻社区，当前 %d 个社区，模块度 %.4f",
+			level, pass+1, len(moved), countLabels(community), q),
+			&networkState{Graph: r.graph, Labels: labels, Iteration: r.passes, Changed: highlights, Modularity: q, Level: level}, highlights)
+		r.tracker.AddOperation(models.OpTypeMove, highlights, []interface{}{len(moved)}, "移入相邻社区")
+	}
+	return community, movedAny
+}
+
+// aggregate 聚合阶段：每个社区收缩为一个超节点，社区间的边权相加，社区内部的边变为自环
+func (r *louvainRun) aggregate(g *weightedGraph, community []int, level int) *weightedGraph {
+	renumber := normalizeLabels(community)
+	size := 0
+	for _, c := range renumber {
+		if c+1 > size {
+			size = c + 1
+		}
+	}
+	next := &weightedGraph{adj: make([]map[int]float64, size), degree: make([]float64, size), total: g.total}
+	for c := range next.adj {
+		next.adj[c] = make(map[int]float64)
+	}
+	for i, row := range g.adj {
+		for j, w := range row {
+			next.adj[renumber[i]][renumber[j]] += w
+		}
+		next.degree[renumber[i]] += g.degree[i]
+	}
+	for v, m := range r.membership {
+		r.membership[v] = renumber[m]
+	}
+
+	r.tracker.AddStep(fmt.Sprintf("第 %d 层聚合：%d 个节点收缩为 %d 个超节点", level, len(g.adj), size),
+		&networkState{Graph: r.graph, Labels: append([]int{}, r.membership...), Iteration: r.passes,
+			Modularity: r.original.modularity(r.membership, r.resolution), Level: level}, []int{})
+	r.tracker.AddOperation(models.OpTypeMerge, []int{}, []interface{}{len(g.adj), size}, "社区收缩为超节点")
+	return next
+}
+
+// ValidateInput 验证图输入
+func (l *Louvain) ValidateInput(data interface{}) error {
+	return validateNetworkInput(data)
+}
+
+// ProcessGraph 处理图（与Execute一致）
+func (l *Louvain) ProcessGraph(graph *models.GraphData, tracker models.StepTracker) (interface{}, error) {
+	return l.Execute(graph, tracker)
+}
+
+// GetGraphType 图类型
+func (l *Louvain) GetGraphType() string { return "both" }
+
+// GetComplexity 获取复杂度信息
+func (l *Louvain) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(V+E)",
+			Average: "O(E·log V)", // 经验复杂度，聚合后图的规模迅速缩小
+			Worst:   "O(E·V)",
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(V+E)",
+			Average: "O(V+E)",
+			Worst:   "O(V+E)",
+		},
+	}
+}
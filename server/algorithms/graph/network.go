@@ -0,0 +1,217 @@
+package graph
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"math"
+	"sort"
+)
+
+// maxNetworkNodes 网络分析算法的节点数上限，每一步都记录全部节点的得分或标签
+const maxNetworkNodes = 2000
+
+// networkState PageRank 与社区发现的步骤快照，各数组按 graph.Nodes 的顺序排列
+type networkState struct {
+	Graph      *models.GraphData `json:"graph"`                // 图数据
+	Scores     []float64         `json:"scores,omitempty"`     // PageRank 值
+	Labels     []int             `json:"labels,omitempty"`     // 社区标签
+	Iteration  int               `json:"iteration"`            // 迭代轮次，从1开始；0 为初始状态
+	Delta      float64           `json:"delta,omitempty"`      // PageRank 本轮得分变化的 L1 范数
+	Changed    []int             `json:"changed,omitempty"`    // 本轮改变标签的节点
+	Modularity float64           `json:"modularity,omitempty"` // 当前划分的模块度
+	Level      int               `json:"level,omitempty"`      // Louvain 的聚合层数，从1开始
+}
+
+// weightedGraph 以下标表示的加权无向图，社区发现算法共用
+// adj[i][j] 为 i、j 之间的边权之和；自环记在 adj[i][i] 中并计两次，使 degree[i] = Σ_j adj[i][j]
+type weightedGraph struct {
+	adj    []map[int]float64
+	degree []float64
+	total  float64 // 所有度数之和，即 2m
+}
+
+// communityResult 社区发现的输出
+type communityResult struct {
+	ID        int      `json:"id"`
+	Size      int      `json:"size"`
+	Nodes     []string `json:"nodes"`
+	Internal  float64  `json:"internalWeight"` // 社区内部的边权之和
+	DegreeSum float64  `json:"degreeSum"`      // 社区内节点的度数之和
+}
+
+// toGraph 将输入转换为图
+func toGraph(data interface{}) (*models.GraphData, error) {
+	switch g := data.(type) {
+	case *models.GraphData:
+		return g, nil
+	case models.GraphData:
+		return &g, nil
+	default:
+		return nil, algorithms.ErrInvalidInput
+	}
+}
+
+// validateNetworkInput 网络分析算法的输入验证：允许没有边，但边的端点必须存在
+func validateNetworkInput(data interface{}) error {
+	if data == nil {
+		return algorithms.ErrInvalidInput
+	}
+	g, err := toGraph(data)
+	if err != nil {
+		return err
+	}
+	if len(g.Nodes) == 0 || len(g.Nodes) > maxNetworkNodes {
+		return algorithms.ErrInvalidInput
+	}
+	if err := checkEndpoints(g); err != nil {
+		return err
+	}
+	for _, e := range g.Edges {
+		if w := algorithms.EdgeWeight(e); w < 0 || math.IsNaN(w) || math.IsInf(w, 0) {
+			return fmt.Errorf("边 %s-%s 的权重必须为非负数", e.From, e.To)
+		}
+	}
+	return nil
+}
+
+// nodeIndex 节点ID到下标的映射
+func nodeIndex(g *models.GraphData) map[string]int {
+	index := make(map[string]int, len(g.Nodes))
+	for i, n := range g.Nodes {
+		index[n.ID] = i
+	}
+	return index
+}
+
+// nodeLabel 节点的显示名称
+func nodeLabel(g *models.GraphData, i int) string {
+	if g.Nodes[i].Label != "" {
+		return g.Nodes[i].Label
+	}
+	return g.Nodes[i].ID
+}
+
+// newWeightedGraph 构建社区发现使用的无向图；有向边视为无向边，互为反向的两条边权重相加
+// weighted 为 false 时所有边的权重视为1
+func newWeightedGraph(g *models.GraphData, weighted bool) *weightedGraph {
+	n := len(g.Nodes)
+	wg := &weightedGraph{adj: make([]map[int]float64, n), degree: make([]float64, n)}
+	for i := range wg.adj {
+		wg.adj[i] = make(map[int]float64)
+	}
+	index := nodeIndex(g)
+	for _, e := range g.Edges {
+		w := 1.0
+		if weighted {
+			w = algorithms.EdgeWeight(e)
+		}
+		wg.add(index[e.From], index[e.To], w)
+	}
+	return wg
+}
+
+// add 加入一条无向边
+func (wg *weightedGraph) add(u, v int, w float64) {
+	if u == v {
+		wg.adj[u][u] += 2 * w
+		wg.degree[u] += 2 * w
+	} else {
+		wg.adj[u][v] += w
+		wg.adj[v][u] += w
+		wg.degree[u] += w
+		wg.degree[v] += w
+	}
+	wg.total += 2 * w
+}
+
+// neighbors 节点 i 的邻居（不含自身），按下标升序，保证结果与 map 的遍历顺序无关
+func (wg *weightedGraph) neighbors(i int) []int {
+	result := make([]int, 0, len(wg.adj[i]))
+	for j := range wg.adj[i] {
+		if j != i {
+			result = append(result, j)
+		}
+	}
+	sort.Ints(result)
+	return result
+}
+
+// modularity 划分的模块度 Q = Σ_c [in_c / 2m − γ (tot_c / 2m)²]
+func (wg *weightedGraph) modularity(labels []int, resolution float64) float64 {
+	if wg.total == 0 {
+		return 0
+	}
+	internal := map[int]float64{}
+	totals := map[int]float64{}
+	for i, row := range wg.adj {
+		totals[labels[i]] += wg.degree[i]
+		for j, w := range row {
+			if labels[i] == labels[j] {
+				internal[labels[i]] += w
+			}
+		}
+	}
+	q := 0.0
+	for c, tot := range totals {
+		q += internal[c]/wg.total - resolution*(tot/wg.total)*(tot/wg.total)
+	}
+	return q
+}
+
+// normalizeLabels 按节点顺序中首次出现的先后将标签重新编号为 0..c-1
+func normalizeLabels(labels []int) []int {
+	mapping := map[int]int{}
+	result := make([]int, len(labels))
+	for i, l := range labels {
+		if _, ok := mapping[l]; !ok {
+			mapping[l] = len(mapping)
+		}
+		result[i] = mapping[l]
+	}
+	return result
+}
+
+// communities 按规范化后的标签汇总各社区
+func (wg *weightedGraph) communities(g *models.GraphData, labels []int) []communityResult {
+	count := 0
+	for _, l := range labels {
+		if l+1 > count {
+			count = l + 1
+		}
+	}
+	result := make([]communityResult, count)
+	for c := range result {
+		result[c] = communityResult{ID: c, Nodes: []string{}}
+	}
+	for i, l := range labels {
+		result[l].Size++
+		result[l].Nodes = append(result[l].Nodes, g.Nodes[i].ID)
+		result[l].DegreeSum += wg.degree[i]
+		for j, w := range wg.adj[i] {
+			if labels[j] == l {
+				result[l].Internal += w
+			}
+		}
+	}
+	// 内部边在邻接表中计了两次
+	for c := range result {
+		result[c].Internal /= 2
+	}
+	return result
+}
+
+// labelMap 节点ID到社区编号的映射
+func labelMap(g *models.GraphData, labels []int) map[string]int {
+	result := make(map[string]int, len(labels))
+	for i, l := range labels {
+		result[g.Nodes[i].ID] = l
+	}
+	return result
+}
+
+// roundTo 保留 digits 位小数
+func roundTo(x float64, digits int) float64 {
+	scale := math.Pow(10, float64(digits))
+	return math.Round(x*scale) / scale
+}
@@ -0,0 +1,254 @@
+package graph
+
+import (
+	"fmt"
+	"gin/models"
+	"math"
+	"reflect"
+	"testing"
+)
+
+// cliqueRing 由 count 个大小为 size 的完全子图组成、相邻子图之间各有一条边相连的环
+func cliqueRing(count, size int) *models.GraphData {
+	g := &models.GraphData{Type: "undirected"}
+	id := func(c, i int) string { return fmt.Sprintf("c%d_%d", c, i) }
+	for c := 0; c < count; c++ {
+		for i := 0; i < size; i++ {
+			g.Nodes = append(g.Nodes, models.GraphNode{ID: id(c, i), Label: id(c, i)})
+			for j := 0; j < i; j++ {
+				g.Edges = append(g.Edges, models.GraphEdge{From: id(c, j), To: id(c, i), Weight: 1})
+			}
+		}
+		g.Edges = append(g.Edges, models.GraphEdge{From: id(c, 0), To: id((c+1)%count, size-1), Weight: 1})
+	}
+	return g
+}
+
+// blockLabels 每 size 个节点一个社区时的标签
+func blockLabels(count, size int) []int {
+	labels := make([]int, count*size)
+	for i := range labels {
+		labels[i] = i / size
+	}
+	return labels
+}
+
+// resultLabels 按节点顺序取出结果中的社区编号
+func resultLabels(g *models.GraphData, output map[string]interface{}) []int {
+	labels := output["labels"].(map[string]int)
+	result := make([]int, len(g.Nodes))
+	for i, n := range g.Nodes {
+		result[i] = labels[n.ID]
+	}
+	return result
+}
+
+func TestPageRank_MatchesDirectSolution(t *testing.T) {
+	// A→B, A→C, B→C, C→A，D 没有出边且只被 C 指向
+	g := &models.GraphData{
+		Type:  "directed",
+		Nodes: []models.GraphNode{{ID: "A"}, {ID: "B"}, {ID: "C"}, {ID: "D"}},
+		Edges: []models.GraphEdge{
+			{From: "A", To: "B"}, {From: "A", To: "C"}, {From: "B", To: "C"}, {From: "C", To: "A"}, {From: "C", To: "D"},
+		},
+	}
+	tracker := models.NewStepTracker()
+	result, err := NewPageRank().ExecuteWithParams(g, map[string]interface{}{"tolerance": 1e-12, "max_iterations": 1000}, tracker)
+	if err != nil {
+		t.Fatalf("ExecuteWithParams() error = %v", err)
+	}
+	output := result.(map[string]interface{})
+	if !output["converged"].(bool) || output["danglingNodes"] != 1 {
+		t.Fatalf("converged = %v, danglingNodes = %v", output["converged"], output["danglingNodes"])
+	}
+
+	// 直接迭代转移矩阵足够多次作为参照
+	d, n := 0.85, 4.0
+	out := map[int][]int{0: {1, 2}, 1: {2}, 2: {0, 3}}
+	expected := []float64{0.25, 0.25, 0.25, 0.25}
+	for iter := 0; iter < 2000; iter++ {
+		next := make([]float64, 4)
+		for i := range next {
+			next[i] = (1-d)/n + d*expected[3]/n
+		}
+		for from, targets := range out {
+			for _, to := range targets {
+				next[to] += d * expected[from] / float64(len(targets))
+			}
+		}
+		expected = next
+	}
+	scores := output["scores"].(map[string]float64)
+	sum := 0.0
+	for i, id := range []string{"A", "B", "C", "D"} {
+		if math.Abs(scores[id]-expected[i]) > 1e-6 {
+			t.Errorf("score(%s) = %v, expected %v", id, scores[id], expected[i])
+		}
+		sum += scores[id]
+	}
+	if math.Abs(sum-1) > 1e-5 {
+		t.Errorf("得分之和 = %v", sum)
+	}
+	if ranking := output["ranking"].([]PageRankEntry); ranking[0].ID != "C" || ranking[0].Rank != 1 {
+		t.Errorf("ranking[0] = %+v", ranking[0])
+	}
+
+	// 初始步骤 + 每轮一步 + 结束步骤，每一步都记录全部节点的得分
+	iterations := output["iterations"].(int)
+	steps := tracker.GetSteps()
+	if len(steps) != iterations+2 || len(output["deltaHistory"].([]float64)) != iterations {
+		t.Fatalf("steps = %d, iterations = %d", len(steps), iterations)
+	}
+	for _, step := range steps {
+		if state := step.Data.(*networkState); len(state.Scores) != 4 {
+			t.Fatalf("step %d scores = %v", step.StepID, state.Scores)
+		}
+	}
+}
+
+func TestPageRank_SymmetricAndWeighted(t *testing.T) {
+	// 有向环上所有节点得分相同
+	cycle := &models.GraphData{Type: "directed", Nodes: []models.GraphNode{{ID: "a"}, {ID: "b"}, {ID: "c"}},
+		Edges: []models.GraphEdge{{From: "a", To: "b"}, {From: "b", To: "c"}, {From: "c", To: "a"}}}
+	result, err := NewPageRank().Execute(cycle, models.NewStepTracker())
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	for id, score := range result.(map[string]interface{})["scores"].(map[string]float64) {
+		if math.Abs(score-1.0/3) > 1e-6 {
+			t.Errorf("score(%s) = %v", id, score)
+		}
+	}
+
+	// 按边权分配时，权重大的后继得分更高
+	fork := &models.GraphData{Type: "directed", Nodes: []models.GraphNode{{ID: "s"}, {ID: "x"}, {ID: "y"}},
+		Edges: []models.GraphEdge{{From: "s", To: "x", Weight: 9.0}, {From: "s", To: "y", Weight: 1.0}}}
+	for weighted, xWins := range map[bool]bool{false: false, true: true} {
+		result, err := NewPageRank().ExecuteWithParams(fork, map[string]interface{}{"weighted": weighted}, models.NewStepTracker())
+		if err != nil {
+			t.Fatalf("ExecuteWithParams() error = %v", err)
+		}
+		scores := result.(map[string]interface{})["scores"].(map[string]float64)
+		if (scores["x"] > scores["y"]+1e-9) != xWins {
+			t.Errorf("weighted = %v: x = %v, y = %v", weighted, scores["x"], scores["y"])
+		}
+	}
+}
+
+func TestLouvain_CliqueRing(t *testing.T) {
+	g := cliqueRing(4, 5)
+	tracker := models.NewStepTracker()
+	result, err := NewLouvain().Execute(g, tracker)
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	output := result.(map[string]interface{})
+	if labels := resultLabels(g, output); !reflect.DeepEqual(labels, blockLabels(4, 5)) {
+		t.Fatalf("labels = %v", labels)
+	}
+	// 每个社区内部10条边，社区度数之和为 2×10+2=22，总边数 44
+	expected := 4 * (10.0/44 - (22.0/88)*(22.0/88))
+	if q := output["modularity"].(float64); math.Abs(q-expected) > 1e-6 {
+		t.Errorf("modularity = %v, expected %v", q, expected)
+	}
+	communities := output["communities"].([]communityResult)
+	if len(communities) != 4 || communities[0].Size != 5 || communities[0].Internal != 10 || communities[0].DegreeSum != 22 {
+		t.Errorf("communities[0] = %+v", communities[0])
+	}
+
+	// 模块度在局部移动中单调不减
+	history := output["modularityHistory"].([]float64)
+	for i := 1; i < len(history); i++ {
+		if history[i] < history[i-1]-1e-9 {
+			t.Errorf("modularity history decreases: %v", history)
+		}
+	}
+	for _, step := range tracker.GetSteps() {
+		if state := step.Data.(*networkState); len(state.Labels) != len(g.Nodes) {
+			t.Fatalf("step %d labels = %v", step.StepID, state.Labels)
+		}
+	}
+}
+
+func TestLouvain_Resolution(t *testing.T) {
+	// 分辨率很小时倾向于把所有节点合并为一个社区
+	g := cliqueRing(4, 5)
+	result, err := NewLouvain().ExecuteWithParams(g, map[string]interface{}{"resolution": 0.01}, models.NewStepTracker())
+	if err != nil {
+		t.Fatalf("ExecuteWithParams() error = %v", err)
+	}
+	if count := result.(map[string]interface{})["communityCount"]; count != 1 {
+		t.Errorf("communityCount = %v, expected 1", count)
+	}
+
+	// 没有边时每个节点自成一个社区
+	isolated := &models.GraphData{Type: "undirected", Nodes: []models.GraphNode{{ID: "a"}, {ID: "b"}}}
+	result, err = NewLouvain().Execute(isolated, models.NewStepTracker())
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	output := result.(map[string]interface{})
+	if output["communityCount"] != 2 || output["levels"] != 0 || output["modularity"] != 0.0 {
+		t.Errorf("communityCount = %v, levels = %v, modularity = %v", output["communityCount"], output["levels"], output["modularity"])
+	}
+}
+
+func TestLabelPropagation_CliqueRing(t *testing.T) {
+	g := cliqueRing(3, 6)
+	g.Type = "directed" // 有向边视为无向边
+	run := func(seed int) map[string]interface{} {
+		tracker := models.NewStepTracker()
+		result, err := NewLabelPropagation().ExecuteWithParams(g, map[string]interface{}{"seed": seed}, tracker)
+		if err != nil {
+			t.Fatalf("ExecuteWithParams() error = %v", err)
+		}
+		output := result.(map[string]interface{})
+		if steps := len(tracker.GetSteps()); steps != output["iterations"].(int)+2 {
+			t.Errorf("steps = %d, iterations = %v", steps, output["iterations"])
+		}
+		return output
+	}
+
+	found := 0
+	for seed := 1; seed <= 20; seed++ {
+		output := run(seed)
+		if !output["converged"].(bool) {
+			t.Fatalf("seed %d 未收敛", seed)
+		}
+		// 最后一轮没有节点改变标签
+		changed := output["changedHistory"].([]int)
+		if changed[len(changed)-1] != 0 {
+			t.Errorf("seed %d: changedHistory = %v", seed, changed)
+		}
+		if reflect.DeepEqual(resultLabels(g, output), blockLabels(3, 6)) {
+			found++
+		}
+	}
+	if found < 10 {
+		t.Errorf("只有 %d/20 个种子找到了三个完全子图", found)
+	}
+
+	a, b := run(7), run(7)
+	if !reflect.DeepEqual(a["labels"], b["labels"]) || !reflect.DeepEqual(a["changedHistory"], b["changedHistory"]) {
+		t.Error("相同种子的结果不同")
+	}
+}
+
+func TestNetworkAlgorithms_InvalidInput(t *testing.T) {
+	bad := &models.GraphData{Nodes: []models.GraphNode{{ID: "a"}}, Edges: []models.GraphEdge{{From: "a", To: "missing"}}}
+	negative := &models.GraphData{Nodes: []models.GraphNode{{ID: "a"}, {ID: "b"}}, Edges: []models.GraphEdge{{From: "a", To: "b", Weight: -1.0}}}
+	for _, algorithm := range []interface{ ValidateInput(interface{}) error }{NewPageRank(), NewLabelPropagation(), NewLouvain()} {
+		for _, data := range []interface{}{nil, &models.GraphData{}, bad, negative, []int{1}} {
+			if algorithm.ValidateInput(data) == nil {
+				t.Errorf("%T.ValidateInput(%v) 应返回错误", algorithm, data)
+			}
+		}
+	}
+	g := cliqueRing(2, 3)
+	if _, err := NewPageRank().ExecuteWithParams(g, map[string]interface{}{"damping": 1.0}, models.NewStepTracker()); err == nil {
+		t.Error("阻尼系数为1时应返回错误")
+	}
+	if _, err := NewLouvain().ExecuteWithParams(g, map[string]interface{}{"resolution": 0.0}, models.NewStepTracker()); err == nil {
+		t.Error("分辨率为0时应返回错误")
+	}
+}
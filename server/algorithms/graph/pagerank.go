@@ -0,0 +1,261 @@
+package graph
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"math"
+	"sort"
+)
+
+// PageRank PageRank算法（幂迭代）
+type PageRank struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewPageRank 创建PageRank实例
+func NewPageRank() *PageRank {
+	return &PageRank{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "graph_pagerank",
+			Name:            "PageRank算法",
+			Category:        models.CategoryGraph,
+			Description:     "幂迭代计算网页排名：每轮每个节点以阻尼系数 d 的比例把得分沿出边平均分给后继，其余 1-d 均匀分给所有节点；没有出边的悬挂节点把得分均匀分给所有节点。得分变化的 L1 范数小于容差时收敛。无向图中每条边视为两条方向相反的有向边。",
+			TimeComplexity:  "O((V+E)·t)",
+			SpaceComplexity: "O(V+E)",
+			Parameters: []models.Parameter{
+				{
+					Name:         "damping",
+					Type:         "float",
+					Description:  "阻尼系数，沿链接跳转的概率",
+					DefaultValue: 0.85,
+					Required:     false,
+					Min:          0,
+					Max:          0.99,
+				},
+				{
+					Name:         "tolerance",
+					Type:         "float",
+					Description:  "收敛容差，得分变化的 L1 范数小于该值时停止",
+					DefaultValue: 1e-6,
+					Required:     false,
+					Min:          0,
+				},
+				{
+					Name:         "max_iterations",
+					Type:         "int",
+					Description:  "最大迭代轮数",
+					DefaultValue: 100,
+					Required:     false,
+					Min:          1,
+					Max:          1000,
+				},
+				{
+					Name:         "weighted",
+					Type:         "bool",
+					Description:  "是否按边权比例分配得分；否则每条出边平分",
+					DefaultValue: false,
+					Required:     false,
+				},
+			},
+		},
+	}
+}
+
+// PageRankEntry 排名结果中的一个节点
+type PageRankEntry struct {
+	Rank  int     `json:"rank"`
+	ID    string  `json:"id"`
+	Label string  `json:"label"`
+	Score float64 `json:"score"`
+}
+
+// pageRankLink 出边
+type pageRankLink struct {
+	to     int
+	weight float64
+}
+
+// Execute 使用默认参数执行PageRank
+func (p *PageRank) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return p.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 按参数执行PageRank
+func (p *PageRank) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	if err := p.ValidateInput(data); err != nil {
+		return nil, err
+	}
+	graph, err := toGraph(data)
+	if err != nil {
+		return nil, err
+	}
+
+	damping := algorithms.FloatParam(params, "damping", 0.85)
+	if damping < 0 || damping > 0.99 || math.IsNaN(damping) {
+		return nil, fmt.Errorf("阻尼系数必须在0到0.99之间")
+	}
+	tolerance := algorithms.FloatParam(params, "tolerance", 1e-6)
+	if tolerance < 0 || math.IsNaN(tolerance) {
+		return nil, fmt.Errorf("收敛容差不能为负数")
+	}
+	maxIterations := algorithms.IntParam(params, "max_iterations", 100)
+	if maxIterations < 1 || maxIterations > 1000 {
+		return nil, fmt.Errorf("最大迭代轮数必须在1到1000之间")
+	}
+	weighted := algorithms.BoolParam(params, "weighted", false)
+
+	// 构建出边表，权重为0的边不参与分配
+	n := len(graph.Nodes)
+	index := nodeIndex(graph)
+	links := make([][]pageRankLink, n)
+	outWeight := make([]float64, n)
+	addLink := func(from, to int, w float64) {
+		if w > 0 {
+			links[from] = append(links[from], pageRankLink{to: to, weight: w})
+			outWeight[from] += w
+		}
+	}
+	for _, e := range graph.Edges {
+		w := 1.0
+		if weighted {
+			w = algorithms.EdgeWeight(e)
+		}
+		from, to := index[e.From], index[e.To]
+		addLink(from, to, w)
+		if graph.Type != "directed" && from != to {
+			addLink(to, from, w)
+		}
+	}
+	dangling := make([]int, 0)
+	for i := range links {
+		if outWeight[i] == 0 {
+			dangling = append(dangling, i)
+		}
+	}
+
+	scores := make([]float64, n)
+	for i := range scores {
+		scores[i] = 1 / float64(n)
+	}
+	tracker.SetPhase("初始化")
+	tracker.AddStep(fmt.Sprintf("开始PageRank：%d 个节点，阻尼系数 %.2f，%d 个悬挂节点，所有节点的初始得分为 1/%d", n, damping, len(dangling), n),
+		&networkState{Graph: graph, Scores: roundScores(scores)}, dangling)
+
+	tracker.SetPhase("幂迭代")
+	deltaHistory := make([]float64, 0)
+	converged := false
+	iterations := 0
+	for iterations < maxIterations && !converged {
+		iterations++
+		danglingSum := 0.0
+		for _, i := range dangling {
+			danglingSum += scores[i]
+		}
+		base := (1-damping)/float64(n) + damping*danglingSum/float64(n)
+		next := make([]float64, n)
+		for i := range next {
+			next[i] = base
+		}
+		for from, out := range links {
+			for _, link := range out {
+				next[link.to] += damping * scores[from] * link.weight / outWeight[from]
+			}
+		}
+
+		delta := 0.0
+		for i := range next {
+			delta += math.Abs(next[i] - scores[i])
+		}
+		scores = next
+		deltaHistory = append(deltaHistory, delta)
+		converged = delta < tolerance
+
+		top := topScore(scores)
+		tracker.AddStep(fmt.Sprintf("第 %d 轮：得分变化 %.3e，当前最高的是 %s (%.4f)", iterations, delta, nodeLabel(graph, top), scores[top]),
+			&networkState{Graph: graph, Scores: roundScores(scores), Iteration: iterations, Delta: delta}, []int{top})
+		tracker.AddOperation(models.OpTypeUpdate, []int{}, []interface{}{delta}, "更新所有节点的得分")
+	}
+
+	ranking := make([]PageRankEntry, n)
+	for i, node := range graph.Nodes {
+		ranking[i] = PageRankEntry{ID: node.ID, Label: nodeLabel(graph, i), Score: roundTo(scores[i], 6)}
+	}
+	sort.SliceStable(ranking, func(a, b int) bool { return ranking[a].Score > ranking[b].Score })
+	scoreMap := make(map[string]float64, n)
+	for r := range ranking {
+		ranking[r].Rank = r + 1
+		scoreMap[ranking[r].ID] = ranking[r].Score
+	}
+
+	tracker.SetPhase("完成")
+	status := "已收敛"
+	if !converged {
+		status = fmt.Sprintf("达到最大迭代轮数 %d 仍未收敛", maxIterations)
+	}
+	tracker.AddStep(fmt.Sprintf("PageRank 结束：共迭代 %d 轮，%s，排名第一的是 %s", iterations, status, ranking[0].Label),
+		&networkState{Graph: graph, Scores: roundScores(scores), Iteration: iterations, Delta: deltaHistory[len(deltaHistory)-1]},
+		[]int{index[ranking[0].ID]})
+
+	return map[string]interface{}{
+		"scores":        scoreMap,
+		"ranking":       ranking,
+		"iterations":    iterations,
+		"converged":     converged,
+		"deltaHistory":  deltaHistory,
+		"damping":       damping,
+		"tolerance":     tolerance,
+		"weighted":      weighted,
+		"danglingNodes": len(dangling),
+		"nodeCount":     n,
+	}, nil
+}
+
+// topScore 得分最高的节点下标
+func topScore(scores []float64) int {
+	best := 0
+	for i, s := range scores {
+		if s > scores[best] {
+			best = i
+		}
+	}
+	return best
+}
+
+// roundScores 步骤快照中的得分保留6位小数
+func roundScores(scores []float64) []float64 {
+	result := make([]float64, len(scores))
+	for i, s := range scores {
+		result[i] = roundTo(s, 6)
+	}
+	return result
+}
+
+// ValidateInput 验证图输入
+func (p *PageRank) ValidateInput(data interface{}) error {
+	return validateNetworkInput(data)
+}
+
+// ProcessGraph 处理图（与Execute一致）
+func (p *PageRank) ProcessGraph(graph *models.GraphData, tracker models.StepTracker) (interface{}, error) {
+	return p.Execute(graph, tracker)
+}
+
+// GetGraphType 图类型
+func (p *PageRank) GetGraphType() string { return "both" }
+
+// GetComplexity 获取复杂度信息
+func (p *PageRank) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(V+E)",
+			Average: "O((V+E)·log(1/ε)/log(1/d))", // 误差每轮至少缩小为原来的 d 倍
+			Worst:   "O((V+E)·t)",
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(V+E)",
+			Average: "O(V+E)",
+			Worst:   "O(V+E)",
+		},
+	}
+}
@@ -20,17 +20,18 @@ func NewPrim() *Prim {
 			ID:              "graph_prim",
 			Name:            "Prim最小生成树算法",
 			Category:        models.CategoryGraph,
-			Description:     "基于顶点的最小生成树算法，从任意顶点开始逐步扩展MST，适用于稠密图。",
+			Description:     "基于顶点的最小生成树算法，从任意顶点开始逐步扩展MST，适用于稠密图；图不连通时得到最小生成森林。",
 			TimeComplexity:  "O((V+E)logV)",
 			SpaceComplexity: "O(V)",
 			Parameters: []models.Parameter{
 				{
 					Name:         "start",
 					Type:         "string",
-					Description:  "起始节点ID",
-					DefaultValue: "node_0",
+					Description:  "起始节点ID或标签，默认为第一个节点",
+					DefaultValue: "",
 					Required:     false,
 				},
+				showQueueParameter(),
 			},
 			Stable:   false,
 			InPlace:  false,
@@ -44,6 +45,7 @@ type PrimEdge struct {
 	from   string
 	to     string
 	weight float64
+	label  string
 	index  int
 }
 
@@ -81,6 +83,11 @@ func (pq *PrimPriorityQueue) Pop() interface{} {
 
 // Execute 执行Prim算法
 func (p *Prim) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return p.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 按参数执行Prim算法
+func (p *Prim) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
 	if err := p.ValidateInput(data); err != nil {
 		return nil, err
 	}
@@ -94,8 +101,18 @@ func (p *Prim) Execute(data interface{}, tracker models.StepTracker) (interface{
 		}
 	}
 
+	// 开启 show_queue 时步骤数据附带优先队列快照
+	showQueue := algorithms.BoolParam(params, "show_queue", false)
+	pq := make(PrimPriorityQueue, 0)
+	stepData := func() interface{} {
+		if showQueue {
+			return &queueTraceState{Graph: graph, Queue: pq.snapshot()}
+		}
+		return graph
+	}
+
 	tracker.SetPhase("初始化")
-	tracker.AddStep("开始Prim最小生成树算法", graph, []int{})
+	tracker.AddStep("开始Prim最小生成树算法", stepData(), []int{})
 
 	// 检查图类型
 	if graph.Type == "directed" {
@@ -111,14 +128,7 @@ func (p *Prim) Execute(data interface{}, tracker models.StepTracker) (interface{
 	// 构建邻接表，包含权重信息
 	adj := make(map[string][]PrimEdgeInfo)
 	for _, edge := range graph.Edges {
-		weight := 1.0 // 默认权重
-		if edge.Weight != nil {
-			if w, ok := edge.Weight.(float64); ok {
-				weight = w
-			} else if w, ok := edge.Weight.(int); ok {
-				weight = float64(w)
-			}
-		}
+		weight := algorithms.EdgeWeight(edge)
 
 		adj[edge.From] = append(adj[edge.From], PrimEdgeInfo{
 			To:     edge.To,
@@ -133,97 +143,132 @@ func (p *Prim) Execute(data interface{}, tracker models.StepTracker) (interface{
 	}
 
 	// 确定起始节点
-	startID := "node_0"
-	if len(graph.Nodes) > 0 {
-		startID = graph.Nodes[0].ID
+	start := 0
+	if name := algorithms.StringParam(params, "start", ""); name != "" {
+		var err error
+		if start, err = findNode(graph, name); err != nil {
+			return nil, err
+		}
 	}
+	startID := graph.Nodes[start].ID
 
 	// 初始化
 	inMST := make(map[string]bool)
 	minWeight := make(map[string]float64)
 	parent := make(map[string]string)
 	mstEdges := make([]PrimMSTEdge, 0)
+	forestEdges := make([]MSTEdge, 0)
+	treeRoots := make([]string, 0)
 	totalWeight := 0.0
 
 	// 初始化所有节点的最小权重为无穷大
 	for _, node := range graph.Nodes {
 		minWeight[node.ID] = math.Inf(1)
 	}
-	minWeight[startID] = 0
 
 	// 初始化优先队列
-	pq := make(PrimPriorityQueue, 0)
 	heap.Init(&pq)
 
-	// 将起始节点的所有邻接边加入队列
-	if sidx, ok := idx[startID]; ok {
-		tracker.AddStep("选择起始节点 "+graph.Nodes[sidx].Label, graph, []int{sidx})
-		tracker.AddOperation(models.OpTypeUpdate, []int{sidx}, []interface{}{startID}, "起始节点")
+	// 队列取空后若仍有节点不在MST中，说明图不连通，按节点顺序从下一个未加入的节点开始新的一棵树
+	roots := []int{start}
+	for i := range graph.Nodes {
+		if i != start {
+			roots = append(roots, i)
+		}
 	}
 
-	inMST[startID] = true
+	for _, root := range roots {
+		rootID := graph.Nodes[root].ID
+		if inMST[rootID] {
+			continue
+		}
+		if len(treeRoots) == 0 {
+			tracker.AddStep("选择起始节点 "+graph.Nodes[root].Label, stepData(), []int{root})
+			tracker.AddOperation(models.OpTypeUpdate, []int{root}, []interface{}{rootID}, "起始节点")
+		} else {
+			tracker.SetPhase("新的连通分量")
+			tracker.AddStep(fmt.Sprintf("优先队列已空，节点 %s 尚未加入，从它开始构建第 %d 棵树",
+				graph.Nodes[root].Label, len(treeRoots)+1), stepData(), []int{root})
+			tracker.AddOperation(models.OpTypeUpdate, []int{root}, []interface{}{rootID}, "新树的根")
+		}
+		treeRoots = append(treeRoots, rootID)
+		minWeight[rootID] = 0
+		inMST[rootID] = true
 
-	// 将起始节点的所有邻接边加入优先队列
-	for _, neighbor := range adj[startID] {
-		if !inMST[neighbor.To] {
-			heap.Push(&pq, &PrimEdge{
-				from:   startID,
-				to:     neighbor.To,
-				weight: neighbor.Weight,
-			})
+		// 将根节点的所有邻接边加入优先队列
+		for _, neighbor := range adj[rootID] {
+			if !inMST[neighbor.To] {
+				heap.Push(&pq, &PrimEdge{
+					from:   rootID,
+					to:     neighbor.To,
+					weight: neighbor.Weight,
+					label:  neighbor.Label,
+				})
+			}
 		}
-	}
 
-	tracker.SetPhase("构建最小生成树")
+		tracker.SetPhase("构建最小生成树")
 
-	// Prim主循环
-	for pq.Len() > 0 && len(mstEdges) < len(graph.Nodes)-1 {
-		// 取出权重最小的边
-		minEdge := heap.Pop(&pq).(*PrimEdge)
+		// Prim主循环
+		for pq.Len() > 0 && len(mstEdges) < len(graph.Nodes)-1 {
+			// 取出权重最小的边
+			minEdge := heap.Pop(&pq).(*PrimEdge)
 
-		// 如果目标节点已经在MST中，跳过这条边
-		if inMST[minEdge.to] {
-			continue
-		}
+			// 如果目标节点已经在MST中，跳过这条边
+			if inMST[minEdge.to] {
+				if showQueue {
+					tracker.AddStep(fmt.Sprintf("弹出边 %s->%s，终点已在MST中，跳过",
+						minEdge.from, minEdge.to), stepData(), []int{})
+				}
+				continue
+			}
 
-		// 可视化当前选择的边
-		if fromIdx, ok1 := idx[minEdge.from]; ok1 {
-			if toIdx, ok2 := idx[minEdge.to]; ok2 {
-				tracker.AddComparison(fromIdx, toIdx, int(minEdge.weight))
-				tracker.AddStep(fmt.Sprintf("选择最小权重边 %s->%s (权重: %s)",
-					graph.Nodes[fromIdx].Label, graph.Nodes[toIdx].Label,
-					formatWeight(minEdge.weight)), graph, []int{fromIdx, toIdx})
+			// 可视化当前选择的边
+			if fromIdx, ok1 := idx[minEdge.from]; ok1 {
+				if toIdx, ok2 := idx[minEdge.to]; ok2 {
+					tracker.AddComparison(fromIdx, toIdx, int(minEdge.weight))
+					tracker.AddStep(fmt.Sprintf("选择最小权重边 %s->%s (权重: %s)",
+						graph.Nodes[fromIdx].Label, graph.Nodes[toIdx].Label,
+						formatWeight(minEdge.weight)), stepData(), []int{fromIdx, toIdx})
+				}
 			}
-		}
 
-		// 将目标节点加入MST
-		inMST[minEdge.to] = true
-		parent[minEdge.to] = minEdge.from
-		totalWeight += minEdge.weight
+			// 将目标节点加入MST
+			inMST[minEdge.to] = true
+			parent[minEdge.to] = minEdge.from
+			totalWeight += minEdge.weight
 
-		// 添加边到MST结果中
-		mstEdges = append(mstEdges, PrimMSTEdge{
-			From:   minEdge.from,
-			To:     minEdge.to,
-			Weight: minEdge.weight,
-		})
+			// 添加边到MST结果中
+			mstEdges = append(mstEdges, PrimMSTEdge{
+				From:   minEdge.from,
+				To:     minEdge.to,
+				Weight: minEdge.weight,
+			})
+			forestEdges = append(forestEdges, MSTEdge{
+				From:   minEdge.from,
+				To:     minEdge.to,
+				Weight: minEdge.weight,
+				Label:  minEdge.label,
+			})
 
-		if toIdx, ok := idx[minEdge.to]; ok {
-			tracker.AddOperation(models.OpTypeInsert, []int{toIdx},
-				[]interface{}{minEdge.to}, "加入MST")
-			tracker.AddStep(fmt.Sprintf("节点 %s 加入MST，当前总权重: %s",
-				graph.Nodes[toIdx].Label, formatWeight(totalWeight)),
-				graph, []int{toIdx})
-		}
+			if toIdx, ok := idx[minEdge.to]; ok {
+				tracker.AddOperation(models.OpTypeInsert, []int{toIdx},
+					[]interface{}{minEdge.to}, "加入MST")
+				tracker.AddStep(fmt.Sprintf("节点 %s 加入MST，当前总权重: %s",
+					graph.Nodes[toIdx].Label, formatWeight(totalWeight)),
+					stepData(), []int{toIdx})
+			}
 
-		// 将新加入节点的所有未访问邻接边加入优先队列
-		for _, neighbor := range adj[minEdge.to] {
-			if !inMST[neighbor.To] {
-				heap.Push(&pq, &PrimEdge{
-					from:   minEdge.to,
-					to:     neighbor.To,
-					weight: neighbor.Weight,
-				})
+			// 将新加入节点的所有未访问邻接边加入优先队列
+			for _, neighbor := range adj[minEdge.to] {
+				if !inMST[neighbor.To] {
+					heap.Push(&pq, &PrimEdge{
+						from:   minEdge.to,
+						to:     neighbor.To,
+						weight: neighbor.Weight,
+						label:  neighbor.Label,
+					})
+				}
 			}
 		}
 	}
@@ -235,15 +280,12 @@ func (p *Prim) Execute(data interface{}, tracker models.StepTracker) (interface{
 		"edgeCount":   len(mstEdges),
 		"nodeCount":   len(graph.Nodes),
 		"startNode":   startID,
+		"roots":       treeRoots,
 	}
 
-	// 检查是否构成连通图
-	if len(mstEdges) < len(graph.Nodes)-1 {
-		result["isConnected"] = false
-		result["message"] = "图不连通，无法构成最小生成树"
-	} else {
-		result["isConnected"] = true
-	}
+	// 按连通分量汇总最小生成森林，并判断最小生成树是否唯一
+	tracker.SetPhase("完成")
+	tracker.AddStep(addForestSummary(result, graph, forestEdges), stepData(), []int{})
 
 	return result, nil
 }
@@ -281,13 +323,10 @@ func (p *Prim) validateGraph(g *models.GraphData) error {
 	if len(g.Nodes) == 0 {
 		return algorithms.ErrInvalidInput
 	}
-	if len(g.Edges) == 0 {
-		return algorithms.ErrInvalidInput
-	}
 	if g.Type == "directed" {
 		return fmt.Errorf("Prim算法只适用于无向图")
 	}
-	return nil
+	return checkEndpoints(g)
 }
 
 // ProcessGraph 处理图（与Execute一致）
@@ -0,0 +1,41 @@
+package graph
+
+import (
+	"gin/algorithms/datastructure"
+	"gin/models"
+)
+
+// queueTraceState 附带优先队列快照的步骤数据
+type queueTraceState struct {
+	Graph *models.GraphData           `json:"graph"` // 图数据
+	Queue *datastructure.HeapSnapshot `json:"queue"` // 优先队列（最小堆）的数组视图和树视图
+}
+
+// showQueueParameter 是否在追踪中展示优先队列的参数定义
+func showQueueParameter() models.Parameter {
+	return models.Parameter{
+		Name:         "show_queue",
+		Type:         "bool",
+		Description:  "是否在每个步骤中附带优先队列的快照；开启后步骤数据为 {graph, queue}",
+		DefaultValue: false,
+		Required:     false,
+	}
+}
+
+// snapshot 优先队列的堆快照，队列中可能包含已过期的项
+func (pq PriorityQueue) snapshot() *datastructure.HeapSnapshot {
+	items := make([]datastructure.HeapItem, len(pq))
+	for i, item := range pq {
+		items[i] = datastructure.HeapItem{ID: item.nodeID, Key: item.distance}
+	}
+	return datastructure.NewHeapSnapshot("min", items)
+}
+
+// snapshot 优先队列的堆快照，队列中可能包含终点已在MST中的边
+func (pq PrimPriorityQueue) snapshot() *datastructure.HeapSnapshot {
+	items := make([]datastructure.HeapItem, len(pq))
+	for i, edge := range pq {
+		items[i] = datastructure.HeapItem{ID: edge.from + "-" + edge.to, Key: edge.weight}
+	}
+	return datastructure.NewHeapSnapshot("min", items)
+}
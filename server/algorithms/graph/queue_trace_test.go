@@ -0,0 +1,67 @@
+package graph
+
+import (
+	"gin/models"
+	"testing"
+)
+
+func TestPriorityQueueTrace(t *testing.T) {
+	graph := &models.GraphData{
+		Type: "undirected",
+		Nodes: []models.GraphNode{
+			{ID: "A", Label: "A"}, {ID: "B", Label: "B"}, {ID: "C", Label: "C"}, {ID: "D", Label: "D"},
+		},
+		Edges: []models.GraphEdge{
+			{From: "A", To: "B", Weight: 4.0},
+			{From: "A", To: "C", Weight: 1.0},
+			{From: "C", To: "B", Weight: 2.0},
+			{From: "B", To: "D", Weight: 5.0},
+		},
+	}
+
+	algorithms := map[string]interface {
+		ExecuteWithParams(interface{}, map[string]interface{}, models.StepTracker) (interface{}, error)
+	}{
+		"dijkstra": NewDijkstra(),
+		"prim":     NewPrim(),
+	}
+
+	for name, algorithm := range algorithms {
+		t.Run(name, func(t *testing.T) {
+			// 默认步骤数据仍然是图
+			plain := models.NewStepTracker()
+			if _, err := algorithm.ExecuteWithParams(graph, nil, plain); err != nil {
+				t.Fatalf("ExecuteWithParams() error = %v", err)
+			}
+			for _, step := range plain.GetSteps() {
+				if _, ok := step.Data.(*models.GraphData); !ok {
+					t.Fatalf("step %d data is %T, expected graph", step.StepID, step.Data)
+				}
+			}
+
+			tracker := models.NewStepTracker()
+			if _, err := algorithm.ExecuteWithParams(graph, map[string]interface{}{"show_queue": true}, tracker); err != nil {
+				t.Fatalf("ExecuteWithParams() error = %v", err)
+			}
+			maxQueue := 0
+			for _, step := range tracker.GetSteps() {
+				state, ok := step.Data.(*queueTraceState)
+				if !ok {
+					t.Fatalf("step %d data is %T, expected queue state", step.StepID, step.Data)
+				}
+				if state.Graph != graph {
+					t.Fatalf("step %d graph mismatch", step.StepID)
+				}
+				if len(state.Queue.Array) > maxQueue {
+					maxQueue = len(state.Queue.Array)
+				}
+			}
+			if maxQueue == 0 {
+				t.Error("queue snapshots are always empty")
+			}
+			if len(tracker.GetSteps()) <= len(plain.GetSteps()) {
+				t.Error("show_queue should add steps for stale queue entries")
+			}
+		})
+	}
+}
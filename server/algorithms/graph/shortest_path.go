@@ -0,0 +1,179 @@
+package graph
+
+import (
+	"container/heap"
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"math"
+)
+
+// PathResult 路径结果，Dijkstra、双向 Dijkstra 与 Yen 的 k 条最短路径共用
+type PathResult struct {
+	Distance float64  `json:"distance"`
+	Path     []string `json:"path"`
+	Edges    []int    `json:"edges,omitempty"` // 路径依次经过的边（graph.Edges 的下标）
+}
+
+// endpointParameters 起点与终点参数，终点缺省为最后一个节点
+func endpointParameters() []models.Parameter {
+	return []models.Parameter{
+		{
+			Name:         "start",
+			Type:         "string",
+			Description:  "起始节点ID或标签，默认为第一个节点",
+			DefaultValue: "",
+			Required:     false,
+		},
+		{
+			Name:         "target",
+			Type:         "string",
+			Description:  "终点ID或标签，默认为最后一个节点",
+			DefaultValue: "",
+			Required:     false,
+		},
+	}
+}
+
+// resolveEndpoints 解析起点与终点的下标
+func resolveEndpoints(graph *models.GraphData, params map[string]interface{}) (int, int, error) {
+	source, target := 0, len(graph.Nodes)-1
+	var err error
+	if name := algorithms.StringParam(params, "start", ""); name != "" {
+		if source, err = findNode(graph, name); err != nil {
+			return 0, 0, err
+		}
+	}
+	if name := algorithms.StringParam(params, "target", ""); name != "" {
+		if target, err = findNode(graph, name); err != nil {
+			return 0, 0, err
+		}
+	}
+	return source, target, nil
+}
+
+// validateShortestPathInput 最短路径算法的输入验证：边的端点必须存在且没有负权重
+func validateShortestPathInput(data interface{}) error {
+	if err := validateStructureInput(data); err != nil {
+		return err
+	}
+	g, _ := toGraph(data)
+	for _, e := range g.Edges {
+		if algorithms.EdgeWeight(e) < 0 {
+			return fmt.Errorf("最短路径算法不支持负权重边 %s-%s", e.From, e.To)
+		}
+	}
+	return nil
+}
+
+// edgeWeights 按 graph.Edges 的顺序取出权重
+func edgeWeights(g *models.GraphData) []float64 {
+	weights := make([]float64, len(g.Edges))
+	for k, e := range g.Edges {
+		weights[k] = algorithms.EdgeWeight(e)
+	}
+	return weights
+}
+
+// reverseAdjacency 反向邻接表：有向边 u→v 记在 v 上，无向图与正向邻接表相同
+func reverseAdjacency(g *models.GraphData) [][]arc {
+	if !isDirected(g) {
+		return buildAdjacency(g, false)
+	}
+	index := nodeIndex(g)
+	adj := make([][]arc, len(g.Nodes))
+	for k, e := range g.Edges {
+		u, v := index[e.From], index[e.To]
+		adj[v] = append(adj[v], arc{to: u, edge: k})
+	}
+	return adj
+}
+
+// newPathResult 由节点与边的下标序列构造路径结果
+func newPathResult(g *models.GraphData, weights []float64, nodes, edges []int) PathResult {
+	distance := 0.0
+	for _, k := range edges {
+		distance += weights[k]
+	}
+	return PathResult{Distance: distance, Path: nodeIDs(g, nodes), Edges: append([]int{}, edges...)}
+}
+
+// distItem 以下标表示的优先队列元素
+type distItem struct {
+	node int
+	dist float64
+}
+
+// distHeap 按距离排序的最小堆，距离相同时下标小的优先，保证结果确定
+type distHeap []distItem
+
+func (h distHeap) Len() int { return len(h) }
+
+func (h distHeap) Less(i, j int) bool {
+	return h[i].dist < h[j].dist || (h[i].dist == h[j].dist && h[i].node < h[j].node)
+}
+
+func (h distHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *distHeap) Push(x interface{}) { *h = append(*h, x.(distItem)) }
+
+func (h *distHeap) Pop() interface{} {
+	old := *h
+	item := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return item
+}
+
+// top 队首距离，队列为空时为正无穷
+func (h distHeap) top() float64 {
+	if len(h) == 0 {
+		return math.Inf(1)
+	}
+	return h[0].dist
+}
+
+// shortestPath 不带追踪的 Dijkstra，跳过被屏蔽的节点和边；找不到路径时返回 false
+// 返回的节点与边序列从 source 到 target
+func shortestPath(adj [][]arc, weights []float64, source, target int, blockedNodes []bool, blockedEdges map[int]bool) ([]int, []int, bool) {
+	n := len(adj)
+	dist := make([]float64, n)
+	prevNode := make([]int, n)
+	prevEdge := make([]int, n)
+	done := make([]bool, n)
+	for i := range dist {
+		dist[i] = math.Inf(1)
+		prevNode[i], prevEdge[i] = -1, -1
+	}
+	dist[source] = 0
+	pq := &distHeap{{node: source}}
+	for pq.Len() > 0 {
+		u := heap.Pop(pq).(distItem).node
+		if done[u] {
+			continue
+		}
+		done[u] = true
+		if u == target {
+			break
+		}
+		for _, a := range adj[u] {
+			if blockedEdges[a.edge] || (blockedNodes != nil && blockedNodes[a.to]) || done[a.to] {
+				continue
+			}
+			if d := dist[u] + weights[a.edge]; d < dist[a.to] {
+				dist[a.to], prevNode[a.to], prevEdge[a.to] = d, u, a.edge
+				heap.Push(pq, distItem{node: a.to, dist: d})
+			}
+		}
+	}
+	if !done[target] {
+		return nil, nil, false
+	}
+	nodes, edges := []int{target}, []int{}
+	for v := target; v != source; v = prevNode[v] {
+		nodes = append(nodes, prevNode[v])
+		edges = append(edges, prevEdge[v])
+	}
+	reverseInts(nodes)
+	reverseInts(edges)
+	return nodes, edges, true
+}
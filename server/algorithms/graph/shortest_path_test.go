@@ -0,0 +1,237 @@
+package graph
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+// randomWeightedDigraph 随机有向或无向图，权重取 0..maxWeight 的整数
+func randomWeightedDigraph(rng *rand.Rand, graphType string, n int, p float64, maxWeight int) *models.GraphData {
+	g := randomGraph(rng, graphType, n, p)
+	for k := range g.Edges {
+		g.Edges[k].Weight = rng.Intn(maxWeight + 1)
+	}
+	return g
+}
+
+// checkPath 检查路径从 source 到 target，相邻节点由对应的边相连，且长度等于边权之和
+func checkPath(t *testing.T, g *models.GraphData, p PathResult, source, target string) {
+	t.Helper()
+	if len(p.Path) == 0 || p.Path[0] != source || p.Path[len(p.Path)-1] != target || len(p.Edges) != len(p.Path)-1 {
+		t.Fatalf("路径 %v (边 %v) 不是从 %s 到 %s", p.Path, p.Edges, source, target)
+	}
+	total := 0.0
+	for k, e := range p.Edges {
+		edge := g.Edges[e]
+		forward := edge.From == p.Path[k] && edge.To == p.Path[k+1]
+		backward := g.Type == "undirected" && edge.To == p.Path[k] && edge.From == p.Path[k+1]
+		if !forward && !backward {
+			t.Fatalf("边 %d 不连接 %s 与 %s", e, p.Path[k], p.Path[k+1])
+		}
+		total += algorithms.EdgeWeight(edge)
+	}
+	if total != p.Distance {
+		t.Fatalf("路径 %v 的边权之和 %v，distance = %v", p.Path, total, p.Distance)
+	}
+}
+
+// simplePathLengths 枚举 source 到 target 的所有无环路径，返回排好序的长度
+func simplePathLengths(g *models.GraphData, source, target int) []float64 {
+	adj := buildAdjacency(g, isDirected(g))
+	onPath := make([]bool, len(g.Nodes))
+	lengths := []float64{}
+	var walk func(u int, length float64)
+	walk = func(u int, length float64) {
+		if u == target {
+			lengths = append(lengths, length)
+			return
+		}
+		onPath[u] = true
+		for _, a := range adj[u] {
+			if !onPath[a.to] {
+				walk(a.to, length+algorithms.EdgeWeight(g.Edges[a.edge]))
+			}
+		}
+		onPath[u] = false
+	}
+	walk(source, 0)
+	sort.Float64s(lengths)
+	return lengths
+}
+
+func TestDijkstra_Target(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 40; trial++ {
+		graphType := []string{"directed", "undirected"}[trial%2]
+		g := randomWeightedDigraph(rng, graphType, 2+rng.Intn(15), 0.2, 9)
+		start, target := g.Nodes[rng.Intn(len(g.Nodes))].ID, g.Nodes[rng.Intn(len(g.Nodes))].ID
+
+		full, err := NewDijkstra().ExecuteWithParams(g, map[string]interface{}{"start": start}, models.NewStepTracker())
+		if err != nil {
+			t.Fatalf("ExecuteWithParams() error = %v", err)
+		}
+		expected := full.(map[string]interface{})["distances"].(map[string]float64)[target]
+
+		result, err := NewDijkstra().ExecuteWithParams(g, map[string]interface{}{"start": start, "target": target}, models.NewStepTracker())
+		if err != nil {
+			t.Fatalf("ExecuteWithParams() error = %v", err)
+		}
+		output := result.(map[string]interface{})
+		if output["startNode"] != start || output["reachable"] != !math.IsInf(expected, 1) {
+			t.Fatalf("startNode = %v, reachable = %v", output["startNode"], output["reachable"])
+		}
+		// 提前结束时只返回已确定的节点
+		for id, d := range output["distances"].(map[string]float64) {
+			if math.IsInf(d, 1) || d > expected {
+				t.Errorf("提前结束后 distances[%s] = %v，终点距离为 %v", id, d, expected)
+			}
+		}
+		if output["reachable"].(bool) {
+			path := output["path"].(PathResult)
+			checkPath(t, g, path, start, target)
+			if path.Distance != expected {
+				t.Errorf("distance = %v, want %v", path.Distance, expected)
+			}
+		}
+	}
+}
+
+func TestBidirectionalDijkstra(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	for trial := 0; trial < 60; trial++ {
+		graphType := []string{"directed", "undirected"}[trial%2]
+		g := randomWeightedDigraph(rng, graphType, 2+rng.Intn(20), 0.15, 9)
+		start, target := g.Nodes[rng.Intn(len(g.Nodes))].ID, g.Nodes[rng.Intn(len(g.Nodes))].ID
+
+		full, _ := NewDijkstra().ExecuteWithParams(g, map[string]interface{}{"start": start}, models.NewStepTracker())
+		expected := full.(map[string]interface{})["distances"].(map[string]float64)[target]
+
+		tracker := models.NewStepTracker()
+		result, err := NewBidirectionalDijkstra().ExecuteWithParams(g, map[string]interface{}{"start": start, "target": target}, tracker)
+		if err != nil {
+			t.Fatalf("ExecuteWithParams() error = %v", err)
+		}
+		output := result.(map[string]interface{})
+		if output["reachable"] != !math.IsInf(expected, 1) {
+			t.Fatalf("reachable = %v, distance = %v", output["reachable"], expected)
+		}
+		steps := tracker.GetSteps()
+		if !output["reachable"].(bool) {
+			continue
+		}
+		last := steps[len(steps)-1].Data.(bidirectionalState)
+		path := output["path"].(PathResult)
+		checkPath(t, g, path, start, target)
+		if path.Distance != expected || output["distance"] != expected {
+			t.Fatalf("distance = %v, want %v", path.Distance, expected)
+		}
+		if len(last.Path) != len(path.Path) || last.Meeting < 0 || last.Best != expected {
+			t.Errorf("最后一步 path = %v, meeting = %d, best = %v", last.Path, last.Meeting, last.Best)
+		}
+	}
+}
+
+func TestBidirectionalDijkstra_Frontiers(t *testing.T) {
+	// 链 v0-v1-...-v6，两侧各走一半后相遇
+	var edges [][2]int
+	for i := 0; i < 6; i++ {
+		edges = append(edges, [2]int{i, i + 1})
+	}
+	g := indexedGraph("directed", 7, edges)
+	tracker := models.NewStepTracker()
+	result, err := NewBidirectionalDijkstra().Execute(g, tracker)
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	output := result.(map[string]interface{})
+	if output["distance"] != 6.0 || output["settledCount"].(int) > 8 {
+		t.Errorf("distance = %v, settledCount = %v", output["distance"], output["settledCount"])
+	}
+	met := false
+	for _, step := range tracker.GetSteps() {
+		state := step.Data.(bidirectionalState)
+		if step.Metadata.Phase == "相遇" {
+			met = true
+			// 相遇的节点同时在两侧的边界上
+			if len(state.ForwardSettled) != 3 || len(state.BackwardSettled) != 3 ||
+				!containsInt(state.ForwardFrontier, state.Meeting) || !containsInt(state.BackwardFrontier, state.Meeting) {
+				t.Errorf("相遇时 forward = %v / %v, backward = %v / %v", state.ForwardSettled, state.ForwardFrontier, state.BackwardSettled, state.BackwardFrontier)
+			}
+		}
+		for _, v := range state.ForwardFrontier {
+			if _, ok := state.ForwardDistance[g.Nodes[v].ID]; !ok {
+				t.Errorf("边界节点 %d 没有距离", v)
+			}
+		}
+	}
+	if !met {
+		t.Error("追踪中没有两侧相遇的步骤")
+	}
+
+	if _, err := NewBidirectionalDijkstra().ExecuteWithParams(g, map[string]interface{}{"target": "missing"}, models.NewStepTracker()); err == nil {
+		t.Error("终点不存在时应返回错误")
+	}
+	g.Edges[0].Weight = -1
+	if _, err := NewBidirectionalDijkstra().Execute(g, models.NewStepTracker()); err == nil {
+		t.Error("负权重边应返回错误")
+	}
+}
+
+func TestYenKShortestPaths(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	for trial := 0; trial < 40; trial++ {
+		graphType := []string{"directed", "undirected"}[trial%2]
+		g := randomWeightedDigraph(rng, graphType, 2+rng.Intn(6), 0.35, 5)
+		source, target := rng.Intn(len(g.Nodes)), rng.Intn(len(g.Nodes))
+		k := 1 + rng.Intn(8)
+
+		result, err := NewYenKShortestPaths().ExecuteWithParams(g, map[string]interface{}{
+			"start": g.Nodes[source].ID, "target": g.Nodes[target].ID, "k": k,
+		}, models.NewStepTracker())
+		if err != nil {
+			t.Fatalf("ExecuteWithParams() error = %v", err)
+		}
+		output := result.(map[string]interface{})
+		paths := output["paths"].([]PathResult)
+		expected := simplePathLengths(g, source, target)
+		if len(expected) > k {
+			expected = expected[:k]
+		}
+		if len(paths) != len(expected) || output["count"] != len(expected) {
+			t.Fatalf("求出 %d 条路径，应有 %d 条", len(paths), len(expected))
+		}
+		seen := map[string]bool{}
+		for i, p := range paths {
+			checkPath(t, g, p, g.Nodes[source].ID, g.Nodes[target].ID)
+			if p.Distance != expected[i] {
+				t.Fatalf("第 %d 条路径长度 %v，应为 %v", i+1, p.Distance, expected[i])
+			}
+			nodes := map[string]bool{}
+			for _, id := range p.Path {
+				if nodes[id] {
+					t.Fatalf("路径 %v 有环", p.Path)
+				}
+				nodes[id] = true
+			}
+			if key := fmt.Sprint(p.Edges); seen[key] {
+				t.Fatalf("路径 %v 重复", p.Path)
+			} else {
+				seen[key] = true
+			}
+		}
+	}
+
+	g := indexedGraph("directed", 3, [][2]int{{0, 1}})
+	result, _ := NewYenKShortestPaths().Execute(g, models.NewStepTracker())
+	if output := result.(map[string]interface{}); output["count"] != 0 || output["message"] == nil {
+		t.Errorf("不可达时 count = %v", output["count"])
+	}
+	if _, err := NewYenKShortestPaths().ExecuteWithParams(g, map[string]interface{}{"k": 0}, models.NewStepTracker()); err == nil {
+		t.Error("k 为0时应返回错误")
+	}
+}
@@ -0,0 +1,123 @@
+package graph
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"sort"
+	"strings"
+)
+
+// spanningComponent 最小生成森林中的一棵树，对应图的一个连通分量
+type spanningComponent struct {
+	ID          int       `json:"id"`
+	Nodes       []string  `json:"nodes"`
+	Edges       []MSTEdge `json:"edges"`
+	EdgeCount   int       `json:"edgeCount"`
+	TotalWeight float64   `json:"totalWeight"`
+}
+
+// forestComponents 按森林的边把节点分组，编号按各组中最小节点下标的先后
+func forestComponents(graph *models.GraphData, edges []MSTEdge) []spanningComponent {
+	index := nodeIndex(graph)
+	uf := NewUnionFind(len(graph.Nodes))
+	for _, e := range edges {
+		uf.Union(index[e.From], index[e.To])
+	}
+	componentOf := make(map[int]int)
+	components := make([]spanningComponent, 0)
+	for i, node := range graph.Nodes {
+		root := uf.Find(i)
+		id, ok := componentOf[root]
+		if !ok {
+			id = len(components)
+			componentOf[root] = id
+			components = append(components, spanningComponent{ID: id, Nodes: []string{}, Edges: []MSTEdge{}})
+		}
+		components[id].Nodes = append(components[id].Nodes, node.ID)
+	}
+	for _, e := range edges {
+		c := &components[componentOf[uf.Find(index[e.From])]]
+		c.Edges = append(c.Edges, e)
+		c.EdgeCount++
+		c.TotalWeight += e.Weight
+	}
+	return components
+}
+
+// mstUniqueness 判断最小生成树（森林）是否唯一：按权重分组模拟 Kruskal，
+// 若某组中连接不同分量的边多于该组实际合并的次数，就能用同权重的边互相替换，得到另一棵最小生成树
+// 返回这些可互换的同权重边
+func mstUniqueness(graph *models.GraphData) (bool, []MSTEdge) {
+	index := nodeIndex(graph)
+	order := make([]int, 0, len(graph.Edges))
+	for k, e := range graph.Edges {
+		if index[e.From] != index[e.To] {
+			order = append(order, k)
+		}
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return algorithms.EdgeWeight(graph.Edges[order[a]]) < algorithms.EdgeWeight(graph.Edges[order[b]])
+	})
+
+	uf := NewUnionFind(len(graph.Nodes))
+	tied := make([]MSTEdge, 0)
+	for start := 0; start < len(order); {
+		end := start
+		weight := algorithms.EdgeWeight(graph.Edges[order[start]])
+		for end < len(order) && algorithms.EdgeWeight(graph.Edges[order[end]]) == weight {
+			end++
+		}
+		// 先统计组内能连接不同分量的边，再逐条合并
+		candidates := make([]int, 0)
+		for _, k := range order[start:end] {
+			e := graph.Edges[k]
+			if uf.Find(index[e.From]) != uf.Find(index[e.To]) {
+				candidates = append(candidates, k)
+			}
+		}
+		merges := 0
+		for _, k := range candidates {
+			if uf.Union(index[graph.Edges[k].From], index[graph.Edges[k].To]) {
+				merges++
+			}
+		}
+		if len(candidates) > merges {
+			for _, k := range candidates {
+				e := graph.Edges[k]
+				tied = append(tied, MSTEdge{From: e.From, To: e.To, Weight: weight, Label: e.Label})
+			}
+		}
+		start = end
+	}
+	return len(tied) == 0, tied
+}
+
+// addForestSummary 在结果中加入森林的分量信息与唯一性判断，返回用于最后一步的描述
+func addForestSummary(result map[string]interface{}, graph *models.GraphData, edges []MSTEdge) string {
+	components := forestComponents(graph, edges)
+	unique, tied := mstUniqueness(graph)
+	result["components"] = components
+	result["componentCount"] = len(components)
+	result["isConnected"] = len(components) == 1
+	result["unique"] = unique
+	result["tiedEdges"] = tied
+
+	var description string
+	if len(components) == 1 {
+		description = fmt.Sprintf("最小生成树构建完成，总权重 %s", formatWeight(components[0].TotalWeight))
+	} else {
+		totals := make([]string, len(components))
+		for i, c := range components {
+			totals[i] = formatWeight(c.TotalWeight)
+		}
+		description = fmt.Sprintf("图不连通，得到由 %d 棵树组成的最小生成森林，各树总权重: %s", len(components), strings.Join(totals, ", "))
+		result["message"] = description
+	}
+	if unique {
+		description += "；没有可互换的同权重边，最小生成树唯一"
+	} else {
+		description += fmt.Sprintf("；有 %d 条同权重的边可以互换，最小生成树不唯一", len(tied))
+	}
+	return description
+}
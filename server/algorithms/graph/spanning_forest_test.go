@@ -0,0 +1,201 @@
+package graph
+
+import (
+	"gin/algorithms"
+	"gin/models"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// randomWeightedGraph 随机无向图，权重取 1..maxWeight 的整数，便于出现同权重的边
+func randomWeightedGraph(rng *rand.Rand, n int, p float64, maxWeight int) *models.GraphData {
+	g := randomGraph(rng, "undirected", n, p)
+	for k := range g.Edges {
+		g.Edges[k].Weight = float64(1 + rng.Intn(maxWeight))
+	}
+	return g
+}
+
+// bruteForceForests 枚举边的子集，返回最小生成森林的权重与达到该权重的森林个数
+func bruteForceForests(g *models.GraphData) (float64, int) {
+	index := nodeIndex(g)
+	all := NewUnionFind(len(g.Nodes))
+	components := len(g.Nodes)
+	for _, e := range g.Edges {
+		if all.Union(index[e.From], index[e.To]) {
+			components--
+		}
+	}
+	want := len(g.Nodes) - components
+
+	best, count := 0.0, 0
+	for mask := 0; mask < 1<<len(g.Edges); mask++ {
+		uf := NewUnionFind(len(g.Nodes))
+		size, weight, acyclic := 0, 0.0, true
+		for k, e := range g.Edges {
+			if mask&(1<<k) == 0 {
+				continue
+			}
+			if !uf.Union(index[e.From], index[e.To]) {
+				acyclic = false
+				break
+			}
+			size++
+			weight += algorithms.EdgeWeight(e)
+		}
+		if !acyclic || size != want {
+			continue
+		}
+		if count == 0 || weight < best {
+			best, count = weight, 1
+		} else if weight == best {
+			count++
+		}
+	}
+	return best, count
+}
+
+func TestSpanningForest_AlgorithmsAgree(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 60; trial++ {
+		g := randomWeightedGraph(rng, 1+rng.Intn(7), 0.3, 3)
+		if len(g.Edges) > 12 {
+			g.Edges = g.Edges[:12]
+		}
+		best, count := bruteForceForests(g)
+
+		outputs := map[string]map[string]interface{}{}
+		for name, run := range map[string]func() (interface{}, error){
+			"kruskal": func() (interface{}, error) { return NewKruskal().Execute(g, models.NewStepTracker()) },
+			"prim":    func() (interface{}, error) { return NewPrim().Execute(g, models.NewStepTracker()) },
+			"boruvka": func() (interface{}, error) { return NewBoruvka().Execute(g, models.NewStepTracker()) },
+		} {
+			result, err := run()
+			if err != nil {
+				t.Fatalf("%s: Execute() error = %v", name, err)
+			}
+			output := result.(map[string]interface{})
+			if output["totalWeight"] != best {
+				t.Fatalf("%s: totalWeight = %v, want %v", name, output["totalWeight"], best)
+			}
+			if unique := output["unique"].(bool); unique != (count == 1) {
+				t.Fatalf("%s: unique = %v，最小生成森林共有 %d 个", name, unique, count)
+			}
+			components := output["components"].([]spanningComponent)
+			sum, nodes := 0.0, 0
+			for _, c := range components {
+				if c.EdgeCount != len(c.Nodes)-1 {
+					t.Errorf("%s: 分量 %v 有 %d 条边", name, c.Nodes, c.EdgeCount)
+				}
+				sum += c.TotalWeight
+				nodes += len(c.Nodes)
+			}
+			if sum != best || nodes != len(g.Nodes) || output["isConnected"] != (len(components) == 1) {
+				t.Errorf("%s: 各分量权重和 %v，覆盖 %d 个节点", name, sum, nodes)
+			}
+			outputs[name] = output
+		}
+		// 森林的分量划分与算法无关
+		for _, name := range []string{"prim", "boruvka"} {
+			a, b := outputs["kruskal"]["components"].([]spanningComponent), outputs[name]["components"].([]spanningComponent)
+			for k := range a {
+				if !reflect.DeepEqual(a[k].Nodes, b[k].Nodes) || a[k].TotalWeight != b[k].TotalWeight {
+					t.Errorf("%s 的分量 %d 与 kruskal 不同", name, k)
+				}
+			}
+		}
+	}
+}
+
+func TestSpanningForest_Disconnected(t *testing.T) {
+	// 两个分量 {v0,v1,v2} 与 {v3,v4}，v5 孤立
+	g := indexedGraph("undirected", 6, [][2]int{{0, 1}, {1, 2}, {0, 2}, {3, 4}})
+	for k, w := range []float64{1, 2, 4, 3} {
+		g.Edges[k].Weight = w
+	}
+
+	result, err := NewPrim().ExecuteWithParams(g, map[string]interface{}{"start": "v4"}, models.NewStepTracker())
+	if err != nil {
+		t.Fatalf("ExecuteWithParams() error = %v", err)
+	}
+	output := result.(map[string]interface{})
+	if roots := output["roots"].([]string); !reflect.DeepEqual(roots, []string{"v4", "v0", "v5"}) {
+		t.Errorf("roots = %v", roots)
+	}
+	components := output["components"].([]spanningComponent)
+	if len(components) != 3 || components[0].TotalWeight != 3 || components[1].TotalWeight != 3 || components[2].EdgeCount != 0 {
+		t.Errorf("components = %+v", components)
+	}
+	if output["componentCount"] != 3 || output["isConnected"].(bool) || output["message"] == nil {
+		t.Errorf("componentCount = %v, isConnected = %v", output["componentCount"], output["isConnected"])
+	}
+
+	// 没有边时每个节点各成一棵树
+	result, err = NewKruskal().Execute(indexedGraph("undirected", 3, nil), models.NewStepTracker())
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if output := result.(map[string]interface{}); output["componentCount"] != 3 || !output["unique"].(bool) {
+		t.Errorf("componentCount = %v", output["componentCount"])
+	}
+
+	if _, err := NewBoruvka().Execute(indexedGraph("directed", 2, [][2]int{{0, 1}}), models.NewStepTracker()); err == nil {
+		t.Error("有向图应返回错误")
+	}
+}
+
+func TestSpanningForest_Uniqueness(t *testing.T) {
+	// 三角形中两条权重为 2 的边可以互换
+	g := indexedGraph("undirected", 3, [][2]int{{0, 1}, {1, 2}, {0, 2}})
+	for k, w := range []float64{1, 2, 2} {
+		g.Edges[k].Weight = w
+	}
+	result, _ := NewKruskal().Execute(g, models.NewStepTracker())
+	output := result.(map[string]interface{})
+	if output["unique"].(bool) || len(output["tiedEdges"].([]MSTEdge)) != 2 {
+		t.Errorf("unique = %v, tiedEdges = %v", output["unique"], output["tiedEdges"])
+	}
+
+	// 同权重的边若不能互换，最小生成树仍然唯一
+	g.Edges[0].Weight = 2.0
+	g.Edges[2].Weight = 3.0
+	result, _ = NewKruskal().Execute(g, models.NewStepTracker())
+	if output := result.(map[string]interface{}); !output["unique"].(bool) {
+		t.Errorf("tiedEdges = %v", output["tiedEdges"])
+	}
+}
+
+func TestBoruvka_Rounds(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	for trial := 0; trial < 30; trial++ {
+		g := randomWeightedGraph(rng, 2+rng.Intn(30), 0.2, 5)
+		tracker := models.NewStepTracker()
+		result, err := NewBoruvka().Execute(g, tracker)
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		output := result.(map[string]interface{})
+		rounds := output["roundDetails"].([]boruvkaRound)
+		merged, components := 0, len(g.Nodes)
+		for _, r := range rounds {
+			if r.ComponentsBefore != components || r.ComponentsAfter != r.ComponentsBefore-len(r.Edges) || len(r.Edges) == 0 {
+				t.Fatalf("round %+v", r)
+			}
+			merged += len(r.Edges)
+			components = r.ComponentsAfter
+		}
+		// 每轮参与合并的分量大小至少翻倍，轮数不超过 log2(V)
+		if 1<<len(rounds) > len(g.Nodes) {
+			t.Errorf("%d 个节点用了 %d 轮", len(g.Nodes), len(rounds))
+		}
+		if merged != output["edgeCount"] || components != output["componentCount"] {
+			t.Errorf("合并 %d 次，剩余 %d 个分量，结果为 %v / %v", merged, components, output["edgeCount"], output["componentCount"])
+		}
+		steps := tracker.GetSteps()
+		last := steps[len(steps)-1].Data.(boruvkaState)
+		if len(last.TreeEdges) != merged {
+			t.Errorf("最后一步记录了 %d 条树边", len(last.TreeEdges))
+		}
+	}
+}
@@ -0,0 +1,341 @@
+package graph
+
+import (
+	"fmt"
+	"gin/models"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+// indexedGraph 节点为 v0..v(n-1) 的图，edges 为下标对
+func indexedGraph(graphType string, n int, edges [][2]int) *models.GraphData {
+	g := &models.GraphData{Type: graphType}
+	for i := 0; i < n; i++ {
+		id := fmt.Sprintf("v%d", i)
+		g.Nodes = append(g.Nodes, models.GraphNode{ID: id, Label: id})
+	}
+	for _, e := range edges {
+		g.Edges = append(g.Edges, models.GraphEdge{From: fmt.Sprintf("v%d", e[0]), To: fmt.Sprintf("v%d", e[1]), Weight: 1})
+	}
+	return g
+}
+
+// randomWalkEdges 长度为 length 的随机游走经过的边；closed 为 true 时最后回到起点
+func randomWalkEdges(rng *rand.Rand, n, length int, closed bool) [][2]int {
+	edges := make([][2]int, 0, length+1)
+	u := 0
+	for k := 0; k < length; k++ {
+		v := rng.Intn(n)
+		edges = append(edges, [2]int{u, v})
+		u = v
+	}
+	if closed && u != 0 {
+		edges = append(edges, [2]int{u, 0})
+	}
+	return edges
+}
+
+// checkTrail 检查 path/edges 恰好使用每条边一次，且相邻节点由对应的边相连
+func checkTrail(t *testing.T, g *models.GraphData, output map[string]interface{}) {
+	t.Helper()
+	path := output["path"].([]string)
+	edges := output["edges"].([]int)
+	if len(edges) != len(g.Edges) || len(path) != len(edges)+1 {
+		t.Fatalf("path 长度 %d，edges 长度 %d，图有 %d 条边", len(path), len(edges), len(g.Edges))
+	}
+	used := make([]bool, len(g.Edges))
+	for k, e := range edges {
+		if used[e] {
+			t.Fatalf("边 %d 被使用了两次", e)
+		}
+		used[e] = true
+		from, to := g.Edges[e].From, g.Edges[e].To
+		forward := from == path[k] && to == path[k+1]
+		backward := g.Type == "undirected" && to == path[k] && from == path[k+1]
+		if !forward && !backward {
+			t.Fatalf("第 %d 条边 %s-%s 不连接 %s 与 %s", k, from, to, path[k], path[k+1])
+		}
+	}
+}
+
+func TestEulerianPath_RandomWalks(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 40; trial++ {
+		graphType := []string{"directed", "undirected"}[trial%2]
+		closed := trial%4 < 2
+		n := 2 + rng.Intn(6)
+		g := indexedGraph(graphType, n, randomWalkEdges(rng, n, 5+rng.Intn(20), closed))
+		result, err := NewEulerianPath().Execute(g, models.NewStepTracker())
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		output := result.(map[string]interface{})
+		if !output["exists"].(bool) {
+			t.Fatalf("%s 图上的随机游走应存在欧拉路径：%v", graphType, output["message"])
+		}
+		checkTrail(t, g, output)
+		path := output["path"].([]string)
+		if isCircuit := path[0] == path[len(path)-1]; isCircuit != (output["type"] == EulerCircuit) {
+			t.Errorf("type = %v，path = %v", output["type"], path)
+		}
+		if closed && output["type"] != EulerCircuit {
+			t.Errorf("闭合的随机游走应得到欧拉回路，type = %v", output["type"])
+		}
+	}
+}
+
+func TestEulerianPath_Start(t *testing.T) {
+	// 无向路径 v0-v1-v2 加上三角形 v1-v3-v4：奇度节点为 v0 与 v2
+	g := indexedGraph("undirected", 5, [][2]int{{0, 1}, {1, 2}, {1, 3}, {3, 4}, {4, 1}})
+	result, err := NewEulerianPath().ExecuteWithParams(g, map[string]interface{}{"start": "v2"}, models.NewStepTracker())
+	if err != nil {
+		t.Fatalf("ExecuteWithParams() error = %v", err)
+	}
+	output := result.(map[string]interface{})
+	checkTrail(t, g, output)
+	if output["type"] != EulerPath || output["start"] != "v2" || output["end"] != "v0" {
+		t.Errorf("type = %v, start = %v, end = %v", output["type"], output["start"], output["end"])
+	}
+	if _, err := NewEulerianPath().ExecuteWithParams(g, map[string]interface{}{"start": "v1"}, models.NewStepTracker()); err == nil {
+		t.Error("从偶度节点出发的欧拉路径应返回错误")
+	}
+
+	// 没有边时得到只含起点的平凡回路
+	result, _ = NewEulerianPath().ExecuteWithParams(indexedGraph("directed", 3, nil), map[string]interface{}{"start": "v1"}, models.NewStepTracker())
+	if output := result.(map[string]interface{}); output["type"] != EulerCircuit || len(output["path"].([]string)) != 1 {
+		t.Errorf("type = %v, path = %v", output["type"], output["path"])
+	}
+}
+
+func TestEulerianPath_Reasons(t *testing.T) {
+	tests := []struct {
+		name    string
+		graph   *models.GraphData
+		reasons []string
+	}{
+		{"四个奇度节点", indexedGraph("undirected", 4, [][2]int{{0, 1}, {0, 2}, {0, 3}, {1, 2}, {1, 3}, {2, 3}}), []string{"有 4 个奇度节点"}},
+		{"不连通", indexedGraph("undirected", 6, [][2]int{{0, 1}, {1, 2}, {2, 0}, {3, 4}, {4, 5}, {5, 3}}), []string{"不连通"}},
+		{"出入度相差2", indexedGraph("directed", 3, [][2]int{{0, 1}, {0, 2}}), []string{"相差超过1"}},
+		{"两个起点", indexedGraph("directed", 4, [][2]int{{0, 1}, {2, 3}, {1, 2}, {2, 1}}), []string{"出度比入度多1的节点有 2 个"}},
+		{"度数与连通性都不满足", indexedGraph("undirected", 5, [][2]int{{0, 1}, {0, 2}, {0, 3}, {1, 2}, {1, 3}, {2, 3}, {4, 4}}), []string{"不连通", "奇度节点"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewEulerianPath().Execute(tt.graph, models.NewStepTracker())
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			output := result.(map[string]interface{})
+			reasons := output["reasons"].([]string)
+			if output["exists"].(bool) || output["type"] != EulerNone || len(reasons) != len(tt.reasons) {
+				t.Fatalf("exists = %v, reasons = %v", output["exists"], reasons)
+			}
+			for k, want := range tt.reasons {
+				if !strings.Contains(reasons[k], want) {
+					t.Errorf("reasons[%d] = %q，应包含 %q", k, reasons[k], want)
+				}
+			}
+		})
+	}
+}
+
+// checkColoring 检查着色合法并返回颜色数
+func checkColoring(t *testing.T, g *models.GraphData, output map[string]interface{}) int {
+	t.Helper()
+	colors := output["colors"].(map[string]int)
+	for _, e := range g.Edges {
+		if colors[e.From] == colors[e.To] {
+			t.Fatalf("相邻节点 %s、%s 颜色相同", e.From, e.To)
+		}
+	}
+	count := output["colorCount"].(int)
+	if len(output["colorClasses"].([][]string)) != count {
+		t.Fatalf("colorClasses 数量与 colorCount = %d 不符", count)
+	}
+	return count
+}
+
+func TestGraphColoring_Random(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	for _, method := range []string{ColoringGreedy, ColoringLargestFirst, ColoringDSatur} {
+		for trial := 0; trial < 20; trial++ {
+			n := 1 + rng.Intn(30)
+			var edges [][2]int
+			for u := 0; u < n; u++ {
+				for v := u + 1; v < n; v++ {
+					if rng.Float64() < 0.3 {
+						edges = append(edges, [2]int{u, v})
+					}
+				}
+			}
+			g := indexedGraph("directed", n, edges)
+			result, err := NewGraphColoring().ExecuteWithParams(g, map[string]interface{}{"method": method}, models.NewStepTracker())
+			if err != nil {
+				t.Fatalf("ExecuteWithParams() error = %v", err)
+			}
+			output := result.(map[string]interface{})
+			if count := checkColoring(t, g, output); count > output["maxDegree"].(int)+1 {
+				t.Errorf("%s: 使用 %d 种颜色，超过最大度数加1", method, count)
+			}
+		}
+	}
+}
+
+func TestGraphColoring_KnownGraphs(t *testing.T) {
+	// 皇冠图：u_i 与 w_j (i≠j) 相连，按 u0,w0,u1,w1,... 的顺序贪心需要 n 种颜色，而它是二分图
+	const half = 5
+	var crown [][2]int
+	for i := 0; i < half; i++ {
+		for j := 0; j < half; j++ {
+			if i != j {
+				crown = append(crown, [2]int{2 * i, 2*j + 1})
+			}
+		}
+	}
+	cycle := func(n int) [][2]int {
+		edges := make([][2]int, n)
+		for i := range edges {
+			edges[i] = [2]int{i, (i + 1) % n}
+		}
+		return edges
+	}
+	var complete [][2]int
+	for u := 0; u < 5; u++ {
+		for v := u + 1; v < 5; v++ {
+			complete = append(complete, [2]int{u, v})
+		}
+	}
+	tests := []struct {
+		name   string
+		graph  *models.GraphData
+		method string
+		colors int
+	}{
+		{"皇冠图贪心", indexedGraph("undirected", 2*half, crown), ColoringGreedy, half},
+		{"皇冠图DSatur", indexedGraph("undirected", 2*half, crown), ColoringDSatur, 2},
+		{"偶环", indexedGraph("undirected", 8, cycle(8)), ColoringDSatur, 2},
+		{"奇环", indexedGraph("undirected", 7, cycle(7)), ColoringDSatur, 3},
+		{"完全图", indexedGraph("undirected", 5, complete), ColoringLargestFirst, 5},
+		{"无边", indexedGraph("undirected", 4, nil), ColoringDSatur, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tracker := models.NewStepTracker()
+			result, err := NewGraphColoring().ExecuteWithParams(tt.graph, map[string]interface{}{"method": tt.method}, tracker)
+			if err != nil {
+				t.Fatalf("ExecuteWithParams() error = %v", err)
+			}
+			if count := checkColoring(t, tt.graph, result.(map[string]interface{})); count != tt.colors {
+				t.Errorf("colorCount = %d, want %d", count, tt.colors)
+			}
+			// 初始化、每个节点一步、完成
+			if steps := len(tracker.GetSteps()); steps != len(tt.graph.Nodes)+2 {
+				t.Errorf("steps = %d", steps)
+			}
+		})
+	}
+
+	if _, err := NewGraphColoring().Execute(indexedGraph("undirected", 2, [][2]int{{0, 1}, {1, 1}}), models.NewStepTracker()); err == nil {
+		t.Error("有自环的图应返回错误")
+	}
+}
+
+// checkCycle 检查结果是图中真实存在的简单环
+func checkCycle(t *testing.T, g *models.GraphData, output map[string]interface{}) []string {
+	t.Helper()
+	cycle := output["cycle"].([]string)
+	edges := output["cycleEdges"].([]int)
+	if len(cycle) == 0 || len(edges) != len(cycle) || output["length"] != len(cycle) {
+		t.Fatalf("cycle = %v, cycleEdges = %v", cycle, edges)
+	}
+	seen := map[string]bool{}
+	for k, id := range cycle {
+		if seen[id] {
+			t.Fatalf("环 %v 重复经过 %s", cycle, id)
+		}
+		seen[id] = true
+		from, to := cycle[k], cycle[(k+1)%len(cycle)]
+		e := g.Edges[edges[k]]
+		if !(e.From == from && e.To == to) && !(!output["directed"].(bool) && e.From == to && e.To == from) {
+			t.Fatalf("边 %d (%s-%s) 不连接 %s 与 %s", edges[k], e.From, e.To, from, to)
+		}
+	}
+	return cycle
+}
+
+func TestCycleDetection_Random(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	for trial := 0; trial < 40; trial++ {
+		n := 3 + rng.Intn(20)
+		// 只含从小下标指向大下标的边，是有向无环图
+		var edges [][2]int
+		for u := 0; u < n; u++ {
+			for v := u + 1; v < n; v++ {
+				if rng.Float64() < 0.2 {
+					edges = append(edges, [2]int{u, v})
+				}
+			}
+		}
+		dag := indexedGraph("directed", n, edges)
+		result, err := NewCycleDetection().Execute(dag, models.NewStepTracker())
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		if output := result.(map[string]interface{}); output["hasCycle"].(bool) || output["visitedNodes"] != n {
+			t.Fatalf("DAG 不应有环：%v", output["message"])
+		}
+
+		// 加入一条从大下标指向小下标的边后，只要存在对应的路径就有环
+		edges = append(edges, [2]int{n - 1, 0}, [2]int{0, n - 1})
+		cyclic := indexedGraph("directed", n, edges)
+		result, _ = NewCycleDetection().Execute(cyclic, models.NewStepTracker())
+		output := result.(map[string]interface{})
+		if !output["hasCycle"].(bool) {
+			t.Fatal("应检测到环")
+		}
+		checkCycle(t, cyclic, output)
+	}
+}
+
+func TestCycleDetection_Cases(t *testing.T) {
+	tree := [][2]int{{0, 1}, {0, 2}, {1, 3}, {1, 4}, {2, 5}}
+	tests := []struct {
+		name      string
+		graph     *models.GraphData
+		graphType string
+		length    int // 0 表示无环
+	}{
+		{"无向树", indexedGraph("undirected", 6, tree), CycleGraphAuto, 0},
+		{"无向树加一条边", indexedGraph("undirected", 6, append(append([][2]int{}, tree...), [2]int{4, 5})), CycleGraphAuto, 5},
+		{"无向重边", indexedGraph("undirected", 3, [][2]int{{0, 1}, {1, 2}, {2, 1}}), CycleGraphAuto, 2},
+		{"无向自环", indexedGraph("undirected", 2, [][2]int{{0, 1}, {1, 1}}), CycleGraphAuto, 1},
+		{"有向双向边", indexedGraph("directed", 2, [][2]int{{0, 1}, {1, 0}}), CycleGraphAuto, 2},
+		{"双向边按无向图", indexedGraph("directed", 2, [][2]int{{0, 1}, {1, 0}}), CycleGraphUndirected, 2},
+		{"单边按无向图", indexedGraph("directed", 2, [][2]int{{0, 1}}), CycleGraphUndirected, 0},
+		{"无向三角形按有向图", indexedGraph("undirected", 3, [][2]int{{0, 1}, {1, 2}, {0, 2}}), CycleGraphDirected, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewCycleDetection().ExecuteWithParams(tt.graph, map[string]interface{}{"graph_type": tt.graphType}, models.NewStepTracker())
+			if err != nil {
+				t.Fatalf("ExecuteWithParams() error = %v", err)
+			}
+			output := result.(map[string]interface{})
+			if tt.length == 0 {
+				if output["hasCycle"].(bool) {
+					t.Fatalf("不应有环，cycle = %v", output["cycle"])
+				}
+				return
+			}
+			if cycle := checkCycle(t, tt.graph, output); len(cycle) != tt.length {
+				t.Errorf("cycle = %v，长度应为 %d", cycle, tt.length)
+			}
+		})
+	}
+
+	bad := &models.GraphData{Nodes: []models.GraphNode{{ID: "a"}}, Edges: []models.GraphEdge{{From: "a", To: "b"}}}
+	if NewCycleDetection().ValidateInput(bad) == nil || NewEulerianPath().ValidateInput(bad) == nil || NewGraphColoring().ValidateInput(bad) == nil {
+		t.Error("边的端点不存在时应返回错误")
+	}
+}
@@ -11,6 +11,48 @@ type TopologicalSort struct {
 	algorithms.BaseAlgorithm
 }
 
+// 拓扑排序方法
+const (
+	TopoMethodKahn = "kahn" // Kahn算法
+	TopoMethodDFS  = "dfs"  // 基于DFS
+	TopoMethodAll  = "all"  // 枚举所有拓扑序
+)
+
+// maxTopologicalOrders 枚举模式的结果数上限
+const maxTopologicalOrders = 1000
+
+// CycleError 图中存在环、无法拓扑排序时返回的错误，携带具体的环路
+type CycleError struct {
+	Cycle      []string // 环上的节点ID，首尾不重复
+	CycleEdges []int    // 环上的边在 graph.Edges 中的下标
+	path       string   // 以节点标签表示的闭合环路
+}
+
+// newCycleError 由环检测结果构造错误
+func newCycleError(graph *models.GraphData, search cycleSearch) *CycleError {
+	closed := append(append([]int{}, search.nodes...), search.nodes[0])
+	return &CycleError{
+		Cycle:      nodeIDs(graph, search.nodes),
+		CycleEdges: search.edges,
+		path:       joinLabels(graph, closed, " → "),
+	}
+}
+
+// Error 错误信息
+func (e *CycleError) Error() string {
+	return "图中存在环，无法进行拓扑排序：" + e.path
+}
+
+// Details 错误的结构化信息
+func (e *CycleError) Details() interface{} {
+	return map[string]interface{}{
+		"hasCycle":   true,
+		"cycle":      e.Cycle,
+		"cycleEdges": e.CycleEdges,
+		"length":     len(e.Cycle),
+	}
+}
+
 // NewTopologicalSort 创建拓扑排序实例
 func NewTopologicalSort() *TopologicalSort {
 	return &TopologicalSort{
@@ -18,16 +60,26 @@ func NewTopologicalSort() *TopologicalSort {
 			ID:              "graph_topological_sort",
 			Name:            "拓扑排序算法",
 			Category:        models.CategoryGraph,
-			Description:     "对有向无环图(DAG)进行拓扑排序，产生一个线性顺序，使得所有边都从前面的顶点指向后面的顶点。",
+			Description:     "对有向无环图(DAG)进行拓扑排序，产生一个线性顺序，使得所有边都从前面的顶点指向后面的顶点。Kahn算法反复取出入度为0的节点；DFS方法按完成时间的逆序排列节点；枚举模式回溯列出所有拓扑序（有上限）。图中存在环时返回包含具体环路的错误。",
 			TimeComplexity:  "O(V+E)",
 			SpaceComplexity: "O(V)",
 			Parameters: []models.Parameter{
 				{
 					Name:         "method",
 					Type:         "string",
-					Description:  "拓扑排序方法 (dfs: 基于DFS, kahn: Kahn算法)",
-					DefaultValue: "kahn",
+					Description:  "拓扑排序方法 (dfs: 基于DFS, kahn: Kahn算法, all: 枚举所有拓扑序)",
+					DefaultValue: TopoMethodKahn,
+					Required:     false,
+					Options:      []string{TopoMethodKahn, TopoMethodDFS, TopoMethodAll},
+				},
+				{
+					Name:         "max_orders",
+					Type:         "int",
+					Description:  "枚举模式下最多列出的拓扑序数量",
+					DefaultValue: 100,
 					Required:     false,
+					Min:          1,
+					Max:          maxTopologicalOrders,
 				},
 			},
 			Stable:   false,
@@ -39,6 +91,11 @@ func NewTopologicalSort() *TopologicalSort {
 
 // Execute 执行拓扑排序算法
 func (t *TopologicalSort) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return t.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 按参数执行拓扑排序算法
+func (t *TopologicalSort) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
 	if err := t.ValidateInput(data); err != nil {
 		return nil, err
 	}
@@ -60,8 +117,23 @@ func (t *TopologicalSort) Execute(data interface{}, tracker models.StepTracker)
 		return nil, fmt.Errorf("拓扑排序只适用于有向图")
 	}
 
-	// 使用Kahn算法进行拓扑排序
-	return t.kahnTopologicalSort(graph, tracker)
+	if err := checkEndpoints(graph); err != nil {
+		return nil, err
+	}
+
+	method := algorithms.OptionParam(params, "method", []string{TopoMethodKahn, TopoMethodDFS, TopoMethodAll}, TopoMethodKahn)
+	switch method {
+	case TopoMethodDFS:
+		return t.dfsTopologicalSort(graph, tracker)
+	case TopoMethodAll:
+		maxOrders := algorithms.IntParam(params, "max_orders", 100)
+		if maxOrders < 1 || maxOrders > maxTopologicalOrders {
+			return nil, fmt.Errorf("最多列出的拓扑序数量必须在1到%d之间", maxTopologicalOrders)
+		}
+		return t.allTopologicalSorts(graph, maxOrders, tracker)
+	default:
+		return t.kahnTopologicalSort(graph, tracker)
+	}
 }
 
 // kahnTopologicalSort Kahn算法实现拓扑排序
@@ -90,15 +162,13 @@ func (t *TopologicalSort) kahnTopologicalSort(graph *models.GraphData, tracker m
 	tracker.SetPhase("计算入度")
 	tracker.AddStep("计算所有节点的入度", graph, []int{})
 
-	// 找到所有入度为0的节点
+	// 按节点顺序找到所有入度为0的节点
 	queue := make([]string, 0)
-	for nodeID, degree := range inDegree {
-		if degree == 0 {
-			queue = append(queue, nodeID)
-			if nodeIdx, ok := idx[nodeID]; ok {
-				tracker.AddStep("找到入度为0的节点: "+graph.Nodes[nodeIdx].Label, graph, []int{nodeIdx})
-				tracker.AddOperation(models.OpTypeInsert, []int{nodeIdx}, []interface{}{nodeID}, "入度为0")
-			}
+	for nodeIdx, node := range graph.Nodes {
+		if inDegree[node.ID] == 0 {
+			queue = append(queue, node.ID)
+			tracker.AddStep("找到入度为0的节点: "+graph.Nodes[nodeIdx].Label, graph, []int{nodeIdx})
+			tracker.AddOperation(models.OpTypeInsert, []int{nodeIdx}, []interface{}{node.ID}, "入度为0")
 		}
 	}
 
@@ -147,51 +217,46 @@ func (t *TopologicalSort) kahnTopologicalSort(graph *models.GraphData, tracker m
 		}
 	}
 
-	// 检查是否存在环
-	hasCycle := len(result) != len(graph.Nodes)
-	var cycleNodes []string
-
-	if hasCycle {
-		// 找出参与环的节点
-		for nodeID, degree := range inDegree {
-			if degree > 0 {
-				cycleNodes = append(cycleNodes, nodeID)
-			}
-		}
+	// 检查是否存在环：未处理的节点入度都大于0，其中必有环
+	if len(result) != len(graph.Nodes) {
+		return nil, t.reportCycle(graph, tracker, fmt.Sprintf("仍有 %d 个节点的入度大于0", len(graph.Nodes)-len(result)))
 	}
 
-	// 构建结果
-	resultMap := map[string]interface{}{
-		"topologicalOrder": result,
-		"isDAG":            !hasCycle,
-		"processedCount":   len(result),
-		"totalNodes":       len(graph.Nodes),
-	}
+	return t.orderResult(TopoMethodKahn, result, len(graph.Nodes)), nil
+}
 
-	if hasCycle {
-		resultMap["hasCycle"] = true
-		resultMap["cycleNodes"] = cycleNodes
-		resultMap["message"] = "图中存在环，无法进行拓扑排序"
+// reportCycle 在追踪中标出环路并返回 CycleError
+func (t *TopologicalSort) reportCycle(graph *models.GraphData, tracker models.StepTracker, reason string) *CycleError {
+	err := newCycleError(graph, findCycle(graph, true, nil))
+	tracker.SetPhase("检测到环")
+	tracker.AddStep(fmt.Sprintf("%s，图中存在环: %s", reason, err.path), graph, nodeIndices(graph, err.Cycle))
+	return err
+}
 
-		// 可视化环节点
-		cycleIndices := make([]int, 0)
-		for _, nodeID := range cycleNodes {
-			if nodeIdx, ok := idx[nodeID]; ok {
-				cycleIndices = append(cycleIndices, nodeIdx)
-			}
-		}
-		if len(cycleIndices) > 0 {
-			tracker.AddStep("检测到环，涉及节点: "+fmt.Sprintf("%v", cycleNodes), graph, cycleIndices)
-		}
-	} else {
-		resultMap["hasCycle"] = false
-		resultMap["message"] = "拓扑排序完成"
+// orderResult 拓扑排序成功时的结果
+func (t *TopologicalSort) orderResult(method string, order []string, total int) map[string]interface{} {
+	return map[string]interface{}{
+		"method":           method,
+		"topologicalOrder": order,
+		"isDAG":            true,
+		"hasCycle":         false,
+		"processedCount":   len(order),
+		"totalNodes":       total,
+		"message":          "拓扑排序完成",
 	}
+}
 
-	return resultMap, nil
+// nodeIndices 节点ID序列对应的下标
+func nodeIndices(graph *models.GraphData, ids []string) []int {
+	index := nodeIndex(graph)
+	result := make([]int, len(ids))
+	for k, id := range ids {
+		result[k] = index[id]
+	}
+	return result
 }
 
-// dfsTopologicalSort DFS算法实现拓扑排序（备用实现）
+// dfsTopologicalSort DFS算法实现拓扑排序：节点完成时前插到结果中，遇到指向递归栈中节点的边即发现环
 func (t *TopologicalSort) dfsTopologicalSort(graph *models.GraphData, tracker models.StepTracker) (interface{}, error) {
 	// 构建节点索引和邻接表
 	idx := make(map[string]int)
@@ -207,24 +272,18 @@ func (t *TopologicalSort) dfsTopologicalSort(graph *models.GraphData, tracker mo
 	// DFS状态：0-未访问，1-正在访问，2-已完成
 	visited := make(map[string]int)
 	result := make([]string, 0, len(graph.Nodes))
-	hasCycle := false
+	// 递归栈，发现环时从中截取环路
+	stack := make([]string, 0, len(graph.Nodes))
+	var cycle []string
 
 	tracker.SetPhase("DFS遍历")
 
-	// DFS递归函数
+	// DFS递归函数，返回是否发现环
 	var dfs func(nodeID string) bool
 	dfs = func(nodeID string) bool {
-		if visited[nodeID] == 1 {
-			// 发现环
-			return true
-		}
-		if visited[nodeID] == 2 {
-			// 已经处理过
-			return false
-		}
-
 		// 标记为正在访问
 		visited[nodeID] = 1
+		stack = append(stack, nodeID)
 
 		if nodeIdx, ok := idx[nodeID]; ok {
 			tracker.AddStep("开始DFS访问节点 "+graph.Nodes[nodeIdx].Label, graph, []int{nodeIdx})
@@ -233,17 +292,30 @@ func (t *TopologicalSort) dfsTopologicalSort(graph *models.GraphData, tracker mo
 
 		// 访问所有邻接节点
 		for _, neighbor := range adj[nodeID] {
-			if dfs(neighbor) {
-				return true // 发现环
+			switch visited[neighbor] {
+			case 1:
+				// 指向递归栈中的节点，栈中从该节点到当前节点的部分加上这条边构成环
+				for k := len(stack) - 1; k >= 0; k-- {
+					if stack[k] == neighbor {
+						cycle = append([]string{}, stack[k:]...)
+						break
+					}
+				}
+				return true
+			case 0:
+				if dfs(neighbor) {
+					return true // 发现环
+				}
 			}
 		}
 
 		// 标记为已完成
 		visited[nodeID] = 2
+		stack = stack[:len(stack)-1]
 		result = append([]string{nodeID}, result...) // 前插，保证拓扑顺序
 
 		if nodeIdx, ok := idx[nodeID]; ok {
-			tracker.AddStep("完成DFS访问节点 "+graph.Nodes[nodeIdx].Label, graph, []int{nodeIdx})
+			tracker.AddStep(fmt.Sprintf("完成DFS访问节点 %s，前插到结果开头: %v", graph.Nodes[nodeIdx].Label, result), graph, []int{nodeIdx})
 			tracker.AddOperation(models.OpTypeUpdate, []int{nodeIdx}, []interface{}{nodeID}, "DFS完成")
 		}
 
@@ -252,29 +324,111 @@ func (t *TopologicalSort) dfsTopologicalSort(graph *models.GraphData, tracker mo
 
 	// 对所有未访问的节点进行DFS
 	for _, node := range graph.Nodes {
-		if visited[node.ID] == 0 {
-			if dfs(node.ID) {
-				hasCycle = true
+		if visited[node.ID] == 0 && dfs(node.ID) {
+			err := newCycleError(graph, t.cycleFromNodes(graph, cycle))
+			tracker.SetPhase("检测到环")
+			tracker.AddStep(fmt.Sprintf("边 %s → %s 指向递归栈中的节点，图中存在环: %s",
+				nodeLabel(graph, idx[cycle[len(cycle)-1]]), nodeLabel(graph, idx[cycle[0]]), err.path), graph, nodeIndices(graph, cycle))
+			return nil, err
+		}
+	}
+
+	return t.orderResult(TopoMethodDFS, result, len(graph.Nodes)), nil
+}
+
+// cycleFromNodes 为按顺序首尾相连的节点补上环上的边
+func (t *TopologicalSort) cycleFromNodes(graph *models.GraphData, cycle []string) cycleSearch {
+	search := cycleSearch{nodes: nodeIndices(graph, cycle)}
+	for k, from := range cycle {
+		to := cycle[(k+1)%len(cycle)]
+		for e, edge := range graph.Edges {
+			if edge.From == from && edge.To == to {
+				search.edges = append(search.edges, e)
 				break
 			}
 		}
 	}
+	return search
+}
 
-	// 构建结果
-	resultMap := map[string]interface{}{
-		"topologicalOrder": result,
-		"isDAG":            !hasCycle,
-		"processedCount":   len(result),
-		"totalNodes":       len(graph.Nodes),
-		"hasCycle":         hasCycle,
+// allTopologicalSorts 回溯枚举所有拓扑序：每一层依次尝试当前入度为0、尚未使用的节点，最多列出 maxOrders 个
+func (t *TopologicalSort) allTopologicalSorts(graph *models.GraphData, maxOrders int, tracker models.StepTracker) (interface{}, error) {
+	// 存在环时没有任何拓扑序
+	if search := findCycle(graph, true, nil); len(search.nodes) > 0 {
+		return nil, t.reportCycle(graph, tracker, "枚举前检查")
 	}
 
-	if hasCycle {
-		resultMap["message"] = "图中存在环，无法进行拓扑排序"
-	} else {
-		resultMap["message"] = "拓扑排序完成"
+	n := len(graph.Nodes)
+	adj := buildAdjacency(graph, true)
+	inDegree := make([]int, n)
+	for _, row := range adj {
+		for _, a := range row {
+			inDegree[a.to]++
+		}
 	}
 
+	tracker.SetPhase("枚举拓扑序")
+	tracker.AddStep(fmt.Sprintf("图中无环，回溯枚举所有拓扑序（最多 %d 个）", maxOrders), graph, []int{})
+
+	used := make([]bool, n)
+	current := make([]int, 0, n)
+	orders := make([][]string, 0)
+	truncated := false
+
+	// 返回 false 表示已达到上限，停止搜索
+	var extend func() bool
+	extend = func() bool {
+		if len(current) == n {
+			if len(orders) == maxOrders {
+				truncated = true
+				return false
+			}
+			orders = append(orders, nodeIDs(graph, current))
+			tracker.AddStep(fmt.Sprintf("得到第 %d 个拓扑序: %s", len(orders), joinLabels(graph, current, " → ")), graph, append([]int{}, current...))
+			tracker.AddOperation(models.OpTypeInsert, append([]int{}, current...), []interface{}{len(orders)}, "记录拓扑序")
+			return true
+		}
+		for v := 0; v < n; v++ {
+			if used[v] || inDegree[v] != 0 {
+				continue
+			}
+			used[v] = true
+			current = append(current, v)
+			for _, a := range adj[v] {
+				inDegree[a.to]--
+			}
+			tracker.AddStep(fmt.Sprintf("第 %d 位选择入度为0的节点 %s", len(current), nodeLabel(graph, v)), graph, []int{v})
+			tracker.AddOperation(models.OpTypeAccess, []int{v}, []interface{}{len(current)}, "选择节点")
+
+			more := extend()
+
+			for _, a := range adj[v] {
+				inDegree[a.to]++
+			}
+			current = current[:len(current)-1]
+			used[v] = false
+			if !more {
+				return false
+			}
+			tracker.AddStep(fmt.Sprintf("回溯：撤销第 %d 位的节点 %s", len(current)+1, nodeLabel(graph, v)), graph, []int{v})
+		}
+		return true
+	}
+	extend()
+
+	message := fmt.Sprintf("共有 %d 个拓扑序", len(orders))
+	if truncated {
+		message = fmt.Sprintf("拓扑序多于 %d 个，只列出前 %d 个", maxOrders, maxOrders)
+	}
+	tracker.SetPhase("完成")
+	tracker.AddStep(message, graph, []int{})
+
+	resultMap := t.orderResult(TopoMethodAll, orders[0], n)
+	resultMap["orders"] = orders
+	resultMap["count"] = len(orders)
+	resultMap["truncated"] = truncated
+	resultMap["maxOrders"] = maxOrders
+	resultMap["message"] = message
 	return resultMap, nil
 }
 
@@ -0,0 +1,174 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// randomDAG 只含从小下标指向大下标的边，节点顺序随机打乱
+func randomDAG(rng *rand.Rand, n int, p float64) *models.GraphData {
+	perm := rng.Perm(n)
+	var edges [][2]int
+	for u := 0; u < n; u++ {
+		for v := u + 1; v < n; v++ {
+			if rng.Float64() < p {
+				edges = append(edges, [2]int{perm[u], perm[v]})
+			}
+		}
+	}
+	return indexedGraph("directed", n, edges)
+}
+
+// isTopologicalOrder 检查 order 是所有节点的排列且每条边都从前指向后
+func isTopologicalOrder(g *models.GraphData, order []string) bool {
+	position := make(map[string]int, len(order))
+	for k, id := range order {
+		position[id] = k
+	}
+	if len(position) != len(g.Nodes) || len(order) != len(g.Nodes) {
+		return false
+	}
+	for _, e := range g.Edges {
+		from, ok1 := position[e.From]
+		to, ok2 := position[e.To]
+		if !ok1 || !ok2 || from >= to {
+			return false
+		}
+	}
+	return true
+}
+
+// countOrders 枚举全部排列统计拓扑序的数量
+func countOrders(g *models.GraphData) int {
+	ids := make([]string, len(g.Nodes))
+	for i, node := range g.Nodes {
+		ids[i] = node.ID
+	}
+	count := 0
+	var permute func(k int)
+	permute = func(k int) {
+		if k == len(ids) {
+			if isTopologicalOrder(g, ids) {
+				count++
+			}
+			return
+		}
+		for i := k; i < len(ids); i++ {
+			ids[k], ids[i] = ids[i], ids[k]
+			permute(k + 1)
+			ids[k], ids[i] = ids[i], ids[k]
+		}
+	}
+	permute(0)
+	return count
+}
+
+func TestTopologicalSort_Methods(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 30; trial++ {
+		g := randomDAG(rng, 1+rng.Intn(20), 0.2)
+		for _, method := range []string{TopoMethodKahn, TopoMethodDFS, TopoMethodAll} {
+			result, err := NewTopologicalSort().ExecuteWithParams(g, map[string]interface{}{"method": method}, models.NewStepTracker())
+			if err != nil {
+				t.Fatalf("%s: ExecuteWithParams() error = %v", method, err)
+			}
+			output := result.(map[string]interface{})
+			if order := output["topologicalOrder"].([]string); !isTopologicalOrder(g, order) || output["method"] != method {
+				t.Fatalf("%s: %v 不是拓扑序", method, order)
+			}
+		}
+	}
+
+	// Kahn 按节点顺序处理入度为0的节点，结果确定
+	g := indexedGraph("directed", 4, [][2]int{{3, 1}, {2, 1}, {1, 0}})
+	result, _ := NewTopologicalSort().Execute(g, models.NewStepTracker())
+	if order := result.(map[string]interface{})["topologicalOrder"].([]string); !reflect.DeepEqual(order, []string{"v2", "v3", "v1", "v0"}) {
+		t.Errorf("kahn order = %v", order)
+	}
+	result, _ = NewTopologicalSort().ExecuteWithParams(g, map[string]interface{}{"method": TopoMethodDFS}, models.NewStepTracker())
+	if order := result.(map[string]interface{})["topologicalOrder"].([]string); !reflect.DeepEqual(order, []string{"v3", "v2", "v1", "v0"}) {
+		t.Errorf("dfs order = %v", order)
+	}
+}
+
+func TestTopologicalSort_EnumerateAll(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	for trial := 0; trial < 20; trial++ {
+		g := randomDAG(rng, 1+rng.Intn(7), 0.3)
+		tracker := models.NewStepTracker()
+		result, err := NewTopologicalSort().ExecuteWithParams(g, map[string]interface{}{"method": TopoMethodAll, "max_orders": 1000}, tracker)
+		if err != nil {
+			t.Fatalf("ExecuteWithParams() error = %v", err)
+		}
+		output := result.(map[string]interface{})
+		orders := output["orders"].([][]string)
+		seen := map[string]bool{}
+		for _, order := range orders {
+			if !isTopologicalOrder(g, order) {
+				t.Fatalf("%v 不是拓扑序", order)
+			}
+			seen[fmt.Sprint(order)] = true
+		}
+		if expected := countOrders(g); len(seen) != expected || output["count"] != expected || output["truncated"].(bool) {
+			t.Errorf("列出 %d 个不同的拓扑序（count = %v），实际有 %d 个", len(seen), output["count"], expected)
+		}
+	}
+
+	// 5 个孤立节点共有 120 个拓扑序，只列出前 10 个
+	result, err := NewTopologicalSort().ExecuteWithParams(indexedGraph("directed", 5, nil), map[string]interface{}{"method": TopoMethodAll, "max_orders": 10}, models.NewStepTracker())
+	if err != nil {
+		t.Fatalf("ExecuteWithParams() error = %v", err)
+	}
+	output := result.(map[string]interface{})
+	if output["count"] != 10 || !output["truncated"].(bool) {
+		t.Errorf("count = %v, truncated = %v", output["count"], output["truncated"])
+	}
+	if first := output["orders"].([][]string)[0]; !reflect.DeepEqual(first, []string{"v0", "v1", "v2", "v3", "v4"}) {
+		t.Errorf("第一个拓扑序 = %v", first)
+	}
+
+	if _, err := NewTopologicalSort().ExecuteWithParams(indexedGraph("directed", 2, nil), map[string]interface{}{"method": TopoMethodAll, "max_orders": 0}, models.NewStepTracker()); err == nil {
+		t.Error("max_orders 为0时应返回错误")
+	}
+}
+
+func TestTopologicalSort_CycleError(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	for trial := 0; trial < 20; trial++ {
+		g := randomDAG(rng, 2+rng.Intn(10), 0.3)
+		// 加入一条反向边构成环
+		u, v := rng.Intn(len(g.Nodes)), rng.Intn(len(g.Nodes))
+		g.Edges = append(g.Edges, models.GraphEdge{From: g.Nodes[u].ID, To: g.Nodes[v].ID}, models.GraphEdge{From: g.Nodes[v].ID, To: g.Nodes[u].ID})
+		for _, method := range []string{TopoMethodKahn, TopoMethodDFS, TopoMethodAll} {
+			tracker := models.NewStepTracker()
+			_, err := NewTopologicalSort().ExecuteWithParams(g, map[string]interface{}{"method": method}, tracker)
+			var cycleErr *CycleError
+			if !errors.As(err, &cycleErr) {
+				t.Fatalf("%s: error = %v，应为 CycleError", method, err)
+			}
+			var detailed algorithms.DetailedError
+			if !errors.As(err, &detailed) || detailed.Details().(map[string]interface{})["length"] != len(cycleErr.Cycle) {
+				t.Fatalf("%s: CycleError 应提供 Details", method)
+			}
+			// 环上相邻节点由对应的边相连
+			cycle := cycleErr.Cycle
+			if len(cycleErr.CycleEdges) != len(cycle) {
+				t.Fatalf("%s: cycle = %v, edges = %v", method, cycle, cycleErr.CycleEdges)
+			}
+			for k, e := range cycleErr.CycleEdges {
+				if g.Edges[e].From != cycle[k] || g.Edges[e].To != cycle[(k+1)%len(cycle)] {
+					t.Fatalf("%s: 边 %d 不连接 %s 与 %s", method, e, cycle[k], cycle[(k+1)%len(cycle)])
+				}
+			}
+			steps := tracker.GetSteps()
+			if last := steps[len(steps)-1]; last.Metadata.Phase != "检测到环" || len(last.Highlights) != len(cycle) {
+				t.Errorf("%s: 最后一步应标出环，phase = %s", method, last.Metadata.Phase)
+			}
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package graph
+
+import (
+	"gin/algorithms"
+	"gin/models"
+)
+
+// traversalParameters BFS 与 DFS 共用的参数定义
+func traversalParameters() []models.Parameter {
+	return []models.Parameter{
+		{
+			Name:         "start",
+			Type:         "string",
+			Description:  "起始节点ID或标签，默认为第一个节点",
+			DefaultValue: "",
+			Required:     false,
+		},
+		{
+			Name:         "all_components",
+			Type:         "bool",
+			Description:  "是否覆盖所有连通分量：起点所在的部分遍历完后，按节点顺序从下一个未访问的节点继续",
+			DefaultValue: false,
+			Required:     false,
+		},
+	}
+}
+
+// traversalRoots 依次尝试作为遍历起点的节点下标；不覆盖所有连通分量时只有起点
+func traversalRoots(graph *models.GraphData, params map[string]interface{}) ([]int, error) {
+	start := 0
+	if name := algorithms.StringParam(params, "start", ""); name != "" {
+		var err error
+		if start, err = findNode(graph, name); err != nil {
+			return nil, err
+		}
+	}
+	roots := []int{start}
+	if algorithms.BoolParam(params, "all_components", false) {
+		for i := range graph.Nodes {
+			if i != start {
+				roots = append(roots, i)
+			}
+		}
+	}
+	return roots, nil
+}
+
+// parentMap 父节点映射，根节点的父节点为空字符串；未访问的节点不出现
+func parentMap(graph *models.GraphData, parent []int, reached []bool) map[string]string {
+	result := make(map[string]string)
+	for i, p := range parent {
+		if !reached[i] {
+			continue
+		}
+		if p < 0 {
+			result[graph.Nodes[i].ID] = ""
+		} else {
+			result[graph.Nodes[i].ID] = graph.Nodes[p].ID
+		}
+	}
+	return result
+}
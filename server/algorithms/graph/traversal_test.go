@@ -0,0 +1,234 @@
+package graph
+
+import (
+	"gin/models"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// randomGraph 每对有序节点以概率 p 连边的随机图，可能包含自环
+func randomGraph(rng *rand.Rand, graphType string, n int, p float64) *models.GraphData {
+	var edges [][2]int
+	for u := 0; u < n; u++ {
+		for v := 0; v < n; v++ {
+			if (graphType == "directed" || u <= v) && rng.Float64() < p {
+				edges = append(edges, [2]int{u, v})
+			}
+		}
+	}
+	return indexedGraph(graphType, n, edges)
+}
+
+func TestDFS_EdgeClassification(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 60; trial++ {
+		graphType := []string{"directed", "undirected"}[trial%2]
+		g := randomGraph(rng, graphType, 2+rng.Intn(12), 0.15)
+		result, err := NewDFS().ExecuteWithParams(g, map[string]interface{}{"all_components": true}, models.NewStepTracker())
+		if err != nil {
+			t.Fatalf("ExecuteWithParams() error = %v", err)
+		}
+		output := result.(map[string]interface{})
+		d, f := output["discovery"].(map[string]int), output["finish"].(map[string]int)
+		parent := output["parent"].(map[string]string)
+		if len(d) != len(g.Nodes) || len(output["order"].([]string)) != len(g.Nodes) {
+			t.Fatalf("覆盖所有连通分量时应访问全部 %d 个节点", len(g.Nodes))
+		}
+
+		// 时间戳取遍 1..2n
+		seen := map[int]bool{}
+		for _, node := range g.Nodes {
+			seen[d[node.ID]], seen[f[node.ID]] = true, true
+		}
+		if len(seen) != 2*len(g.Nodes) {
+			t.Fatalf("时间戳有重复")
+		}
+
+		edges := output["edges"].([]classifiedEdge)
+		if len(edges) != len(g.Edges) {
+			t.Fatalf("分类了 %d 条边，图有 %d 条", len(edges), len(g.Edges))
+		}
+		trees := 0
+		for _, e := range edges {
+			u, v := e.From, e.To
+			switch e.Type {
+			case EdgeTree:
+				trees++
+				if parent[v] != u {
+					t.Errorf("树边 %s→%s 与 parent[%s] = %s 不符", u, v, v, parent[v])
+				}
+				fallthrough
+			case EdgeForward:
+				if !(d[u] < d[v] && f[v] < f[u]) {
+					t.Errorf("%s 边 %s→%s 的时间戳不嵌套", e.Type, u, v)
+				}
+			case EdgeBack:
+				if !(d[v] <= d[u] && f[u] <= f[v]) {
+					t.Errorf("回边 %s→%s 不指向祖先", u, v)
+				}
+			case EdgeCross:
+				if f[v] >= d[u] {
+					t.Errorf("横跨边 %s→%s 的终点应在起点发现前完成", u, v)
+				}
+			}
+			if graphType == "undirected" && (e.Type == EdgeForward || e.Type == EdgeCross) {
+				t.Errorf("无向图中出现了%s", edgeTypeNames[e.Type])
+			}
+		}
+		// 森林中每棵树有 size-1 条树边
+		forest := output["forest"].([]dfsTree)
+		if trees != len(g.Nodes)-len(forest) {
+			t.Errorf("树边 %d 条，%d 个节点组成 %d 棵树", trees, len(g.Nodes), len(forest))
+		}
+		for _, tree := range forest {
+			if parent[tree.Root] != "" || tree.Nodes[0] != tree.Root {
+				t.Errorf("树根 %s 的父节点为 %q", tree.Root, parent[tree.Root])
+			}
+		}
+	}
+}
+
+func TestDFS_StartAndComponents(t *testing.T) {
+	// v0→v1→v2→v0 构成环，v3→v1 只能从 v3 到达环
+	g := indexedGraph("directed", 4, [][2]int{{0, 1}, {1, 2}, {2, 0}, {3, 1}})
+	result, err := NewDFS().ExecuteWithParams(g, map[string]interface{}{"start": "v1"}, models.NewStepTracker())
+	if err != nil {
+		t.Fatalf("ExecuteWithParams() error = %v", err)
+	}
+	output := result.(map[string]interface{})
+	if order := output["order"].([]string); !reflect.DeepEqual(order, []string{"v1", "v2", "v0"}) {
+		t.Errorf("order = %v", order)
+	}
+	if counts := output["edgeCounts"].(map[string]int); counts[EdgeTree] != 2 || counts[EdgeBack] != 1 {
+		t.Errorf("edgeCounts = %v", counts)
+	}
+
+	result, _ = NewDFS().ExecuteWithParams(g, map[string]interface{}{"start": "v1", "all_components": true}, models.NewStepTracker())
+	output = result.(map[string]interface{})
+	if forest := output["forest"].([]dfsTree); len(forest) != 2 || forest[1].Root != "v3" {
+		t.Errorf("forest = %v", forest)
+	}
+	// v3→v1 指向已完成的另一棵树
+	if edges := output["edges"].([]classifiedEdge); edges[len(edges)-1].Type != EdgeCross {
+		t.Errorf("edges = %v", edges)
+	}
+
+	if _, err := NewDFS().ExecuteWithParams(g, map[string]interface{}{"start": "missing"}, models.NewStepTracker()); err == nil {
+		t.Error("起点不存在时应返回错误")
+	}
+}
+
+// shortestHops 用 Floyd-Warshall 求边数意义下的最短距离，不可达为 -1
+func shortestHops(g *models.GraphData) [][]int {
+	n := len(g.Nodes)
+	const inf = 1 << 30
+	dist := make([][]int, n)
+	for i := range dist {
+		dist[i] = make([]int, n)
+		for j := range dist[i] {
+			if i != j {
+				dist[i][j] = inf
+			}
+		}
+	}
+	index := nodeIndex(g)
+	for _, e := range g.Edges {
+		u, v := index[e.From], index[e.To]
+		if u != v {
+			dist[u][v] = 1
+			if g.Type == "undirected" {
+				dist[v][u] = 1
+			}
+		}
+	}
+	for k := 0; k < n; k++ {
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				if dist[i][k]+dist[k][j] < dist[i][j] {
+					dist[i][j] = dist[i][k] + dist[k][j]
+				}
+			}
+		}
+	}
+	for i := range dist {
+		for j := range dist[i] {
+			if dist[i][j] >= inf {
+				dist[i][j] = -1
+			}
+		}
+	}
+	return dist
+}
+
+func TestBFS_DistanceLayers(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	for trial := 0; trial < 60; trial++ {
+		graphType := []string{"directed", "undirected"}[trial%2]
+		g := randomGraph(rng, graphType, 2+rng.Intn(15), 0.12)
+		start := rng.Intn(len(g.Nodes))
+		result, err := NewBFS().ExecuteWithParams(g, map[string]interface{}{"start": g.Nodes[start].ID}, models.NewStepTracker())
+		if err != nil {
+			t.Fatalf("ExecuteWithParams() error = %v", err)
+		}
+		output := result.(map[string]interface{})
+		distance := output["distance"].(map[string]int)
+		parent := output["parent"].(map[string]string)
+		expected := shortestHops(g)[start]
+		for i, node := range g.Nodes {
+			got, ok := distance[node.ID]
+			if !ok {
+				got = -1
+			}
+			if got != expected[i] {
+				t.Fatalf("distance[%s] = %d, want %d", node.ID, got, expected[i])
+			}
+			if got > 0 && distance[parent[node.ID]] != got-1 {
+				t.Errorf("%s 的父节点 %s 距离应为 %d", node.ID, parent[node.ID], got-1)
+			}
+		}
+		trees := output["layers"].([]bfsTree)
+		if len(trees) != 1 || trees[0].Root != g.Nodes[start].ID {
+			t.Fatalf("layers = %v", trees)
+		}
+		count := 0
+		for k, layer := range trees[0].Layers {
+			for _, id := range layer {
+				if distance[id] != k {
+					t.Errorf("%s 在第 %d 层，距离却为 %d", id, k, distance[id])
+				}
+			}
+			count += len(layer)
+		}
+		if count != len(distance) || len(output["order"].([]string)) != len(distance) {
+			t.Errorf("各层共 %d 个节点，可达 %d 个", count, len(distance))
+		}
+	}
+}
+
+func TestBFS_AllComponents(t *testing.T) {
+	g := indexedGraph("undirected", 5, [][2]int{{0, 1}, {2, 3}})
+	result, err := NewBFS().ExecuteWithParams(g, map[string]interface{}{"start": "v2", "all_components": "true"}, models.NewStepTracker())
+	if err != nil {
+		t.Fatalf("ExecuteWithParams() error = %v", err)
+	}
+	output := result.(map[string]interface{})
+	if roots := output["roots"].([]string); !reflect.DeepEqual(roots, []string{"v2", "v0", "v4"}) {
+		t.Errorf("roots = %v", roots)
+	}
+	// 各连通分量的层次分开记录，距离都从各自的根算起
+	expected := []bfsTree{
+		{Root: "v2", Layers: [][]string{{"v2"}, {"v3"}}},
+		{Root: "v0", Layers: [][]string{{"v0"}, {"v1"}}},
+		{Root: "v4", Layers: [][]string{{"v4"}}},
+	}
+	if layers := output["layers"].([]bfsTree); !reflect.DeepEqual(layers, expected) {
+		t.Errorf("layers = %v", layers)
+	}
+
+	// 默认只遍历起点所在的连通分量
+	result, _ = NewBFS().Execute(g, models.NewStepTracker())
+	if order := result.(map[string]interface{})["order"].([]string); !reflect.DeepEqual(order, []string{"v0", "v1"}) {
+		t.Errorf("order = %v", order)
+	}
+}
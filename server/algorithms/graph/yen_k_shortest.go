@@ -0,0 +1,243 @@
+package graph
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+)
+
+// maxKShortestPaths Yen 算法最多求出的路径数
+const maxKShortestPaths = 100
+
+// yenState Yen 算法的步骤快照
+type yenState struct {
+	Graph        *models.GraphData `json:"graph"`                  // 图数据
+	Paths        []PathResult      `json:"paths"`                  // 已确定的前若干条最短路径
+	Candidates   int               `json:"candidates"`             // 候选集合中的路径数
+	RootPath     []int             `json:"rootPath,omitempty"`     // 当前根路径上的节点
+	SpurNode     int               `json:"spurNode"`               // 当前偏离节点，-1 表示无
+	BlockedEdges []int             `json:"blockedEdges,omitempty"` // 为避免与已有路径重复而屏蔽的边
+}
+
+// yenPath 以下标表示的路径
+type yenPath struct {
+	nodes    []int
+	edges    []int
+	distance float64
+}
+
+// YenKShortestPaths Yen的k条最短无环路径算法
+type YenKShortestPaths struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewYenKShortestPaths 创建Yen算法实例
+func NewYenKShortestPaths() *YenKShortestPaths {
+	return &YenKShortestPaths{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "graph_yen_k_shortest",
+			Name:            "Yen k条最短路径算法",
+			Category:        models.CategoryGraph,
+			Description:     "求起点到终点的前 k 条最短无环路径。先用 Dijkstra 求出最短路径，之后依次以上一条路径的每个节点为偏离节点：保留起点到偏离节点的根路径，屏蔽已有路径在该处使用的边和根路径上的其他节点，再求偏离节点到终点的最短路径，拼接成候选；每轮从候选中取出最短的一条。",
+			TimeComplexity:  "O(kV(V+E)logV)",
+			SpaceComplexity: "O(kV+E)",
+			Parameters: append(endpointParameters(), models.Parameter{
+				Name:         "k",
+				Type:         "int",
+				Description:  "需要求出的路径数量",
+				DefaultValue: 3,
+				Required:     false,
+				Min:          1,
+				Max:          maxKShortestPaths,
+			}),
+		},
+	}
+}
+
+// Execute 使用默认参数执行
+func (y *YenKShortestPaths) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return y.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 按参数执行Yen算法
+func (y *YenKShortestPaths) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	if err := y.ValidateInput(data); err != nil {
+		return nil, err
+	}
+	graph, err := toGraph(data)
+	if err != nil {
+		return nil, err
+	}
+	source, target, err := resolveEndpoints(graph, params)
+	if err != nil {
+		return nil, err
+	}
+	k := algorithms.IntParam(params, "k", 3)
+	if k < 1 || k > maxKShortestPaths {
+		return nil, fmt.Errorf("k 必须在 1 到 %d 之间", maxKShortestPaths)
+	}
+
+	weights := edgeWeights(graph)
+	adj := buildAdjacency(graph, isDirected(graph))
+	accepted := make([]yenPath, 0, k)
+	candidates := make([]yenPath, 0)
+	seen := make(map[string]bool)
+	results := func() []PathResult {
+		paths := make([]PathResult, len(accepted))
+		for i, p := range accepted {
+			paths[i] = newPathResult(graph, weights, p.nodes, p.edges)
+		}
+		return paths
+	}
+	state := func(root []int, spur int, blocked []int) yenState {
+		return yenState{Graph: graph, Paths: results(), Candidates: len(candidates), RootPath: root, SpurNode: spur, BlockedEdges: blocked}
+	}
+
+	result := map[string]interface{}{
+		"start":  graph.Nodes[source].ID,
+		"target": graph.Nodes[target].ID,
+		"k":      k,
+	}
+
+	tracker.SetPhase("初始化")
+	tracker.AddStep(fmt.Sprintf("求 %s 到 %s 的前 %d 条最短无环路径", nodeLabel(graph, source), nodeLabel(graph, target), k),
+		state(nil, -1, nil), []int{source, target})
+
+	nodes, edges, ok := shortestPath(adj, weights, source, target, nil, nil)
+	if !ok {
+		result["paths"] = []PathResult{}
+		result["count"] = 0
+		result["message"] = fmt.Sprintf("从 %s 无法到达 %s", graph.Nodes[source].ID, graph.Nodes[target].ID)
+		tracker.SetPhase("完成")
+		tracker.AddStep(result["message"].(string), state(nil, -1, nil), []int{})
+		return result, nil
+	}
+	first := yenPath{nodes: nodes, edges: edges, distance: newPathResult(graph, weights, nodes, edges).Distance}
+	accepted = append(accepted, first)
+	seen[fmt.Sprint(edges)] = true
+	tracker.SetPhase("第 1 条路径")
+	tracker.AddStep(fmt.Sprintf("Dijkstra 求得第 1 条最短路径 %s (长度: %s)", joinLabels(graph, nodes, " → "), formatWeight(first.distance)),
+		state(nil, -1, nil), nodes)
+
+	for len(accepted) < k {
+		last := accepted[len(accepted)-1]
+		tracker.SetPhase(fmt.Sprintf("第 %d 条路径", len(accepted)+1))
+
+		for i := 0; i+1 < len(last.nodes); i++ {
+			spur := last.nodes[i]
+			root, rootEdges := last.nodes[:i+1], last.edges[:i]
+
+			// 已有路径若与当前根路径重合，屏蔽它们在偏离节点之后的那条边
+			blockedEdges := make(map[int]bool)
+			blocked := make([]int, 0)
+			for _, p := range accepted {
+				if len(p.edges) > i && equalInts(p.edges[:i], rootEdges) && !blockedEdges[p.edges[i]] {
+					blockedEdges[p.edges[i]] = true
+					blocked = append(blocked, p.edges[i])
+				}
+			}
+			// 根路径上除偏离节点外的节点不能再经过，保证路径无环
+			blockedNodes := make([]bool, len(graph.Nodes))
+			for _, v := range root[:i] {
+				blockedNodes[v] = true
+			}
+
+			spurNodes, spurEdges, found := shortestPath(adj, weights, spur, target, blockedNodes, blockedEdges)
+			if !found {
+				tracker.AddStep(fmt.Sprintf("偏离节点 %s：屏蔽 %d 条边后无法到达终点", nodeLabel(graph, spur), len(blocked)),
+					state(root, spur, blocked), []int{spur})
+				continue
+			}
+			candidate := yenPath{
+				nodes: append(append([]int{}, root[:i]...), spurNodes...),
+				edges: append(append([]int{}, rootEdges...), spurEdges...),
+			}
+			key := fmt.Sprint(candidate.edges)
+			if seen[key] {
+				tracker.AddStep(fmt.Sprintf("偏离节点 %s：得到的路径 %s 之前已经得到过", nodeLabel(graph, spur), joinLabels(graph, candidate.nodes, " → ")),
+					state(root, spur, blocked), candidate.nodes)
+				continue
+			}
+			seen[key] = true
+			candidate.distance = newPathResult(graph, weights, candidate.nodes, candidate.edges).Distance
+			candidates = append(candidates, candidate)
+			tracker.AddOperation(models.OpTypeInsert, candidate.nodes, []interface{}{candidate.distance}, "加入候选")
+			tracker.AddStep(fmt.Sprintf("偏离节点 %s：屏蔽 %d 条边，得到候选路径 %s (长度: %s)",
+				nodeLabel(graph, spur), len(blocked), joinLabels(graph, candidate.nodes, " → "), formatWeight(candidate.distance)),
+				state(root, spur, blocked), candidate.nodes)
+		}
+
+		if len(candidates) == 0 {
+			tracker.AddStep(fmt.Sprintf("没有更多候选路径，共找到 %d 条", len(accepted)), state(nil, -1, nil), []int{})
+			break
+		}
+		// 取出最短的候选，长度相同时边数少的优先，再按加入候选的先后
+		best := 0
+		for j, c := range candidates {
+			if c.distance < candidates[best].distance ||
+				(c.distance == candidates[best].distance && len(c.edges) < len(candidates[best].edges)) {
+				best = j
+			}
+		}
+		next := candidates[best]
+		candidates = append(candidates[:best], candidates[best+1:]...)
+		accepted = append(accepted, next)
+		tracker.AddStep(fmt.Sprintf("从 %d 条候选中取出最短的作为第 %d 条路径 %s (长度: %s)",
+			len(candidates)+1, len(accepted), joinLabels(graph, next.nodes, " → "), formatWeight(next.distance)),
+			state(nil, -1, nil), next.nodes)
+	}
+
+	paths := results()
+	result["paths"] = paths
+	result["count"] = len(paths)
+	if len(paths) < k {
+		result["message"] = fmt.Sprintf("只存在 %d 条无环路径", len(paths))
+	}
+	tracker.SetPhase("完成")
+	tracker.AddStep(fmt.Sprintf("共求出 %d 条路径，最短 %s，最长 %s", len(paths),
+		formatWeight(paths[0].Distance), formatWeight(paths[len(paths)-1].Distance)), state(nil, -1, nil), []int{})
+
+	return result, nil
+}
+
+// equalInts 判断两个整数序列是否相同
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// ProcessGraph 处理图
+func (y *YenKShortestPaths) ProcessGraph(graph *models.GraphData, tracker models.StepTracker) (interface{}, error) {
+	return y.Execute(graph, tracker)
+}
+
+// GetGraphType 图类型
+func (y *YenKShortestPaths) GetGraphType() string { return "weighted" }
+
+// ValidateInput 验证输入
+func (y *YenKShortestPaths) ValidateInput(data interface{}) error {
+	return validateShortestPathInput(data)
+}
+
+// GetComplexity 获取复杂度信息
+func (y *YenKShortestPaths) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O((V+E)logV)", // 只求一条路径
+			Average: "O(kV(V+E)logV)",
+			Worst:   "O(kV(V+E)logV)", // 每条路径最多 V 个偏离节点，各做一次 Dijkstra
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(V+E)",
+			Average: "O(kV+E)",
+			Worst:   "O(kV+E)",
+		},
+	}
+}
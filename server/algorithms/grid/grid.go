@@ -0,0 +1,273 @@
+package grid
+
+import (
+	"errors"
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// maxGridCells 网格的单元格数上限
+const maxGridCells = 4096
+
+// 网格单元格取值：0 为通路（代价1），1 为墙，不小于2的整数为代价更高的通路
+const (
+	cellOpen = 0
+	cellWall = 1
+)
+
+// 二维高亮层中的字符
+const (
+	markWall     = '#' // 墙
+	markOpen     = '.' // 未访问的通路
+	markCostly   = '~' // 未访问的高代价通路
+	markFrontier = 'o' // 边界（待扩展）
+	markVisited  = 'x' // 已扩展
+	markScanned  = '-' // 跳点搜索中被直线扫描过的单元格
+	markCurrent  = '@' // 当前单元格
+	markPath     = '*' // 路径
+	markStart    = 'S' // 起点
+	markGoal     = 'G' // 终点
+)
+
+// layerLegend 二维高亮层的图例说明
+const layerLegend = "# 墙，. 通路，~ 高代价通路，o 边界，x 已扩展，- 已扫描，@ 当前，* 路径，S 起点，G 终点"
+
+// grid 解析后的网格地图，单元格按行优先编号
+type grid struct {
+	rows, cols int
+	wall       []bool
+	cost       []float64
+	weighted   bool
+}
+
+// 四个正交方向与四个对角方向
+var (
+	orthogonal = [][2]int{{-1, 0}, {0, 1}, {1, 0}, {0, -1}}
+	diagonals  = [][2]int{{-1, 1}, {1, 1}, {1, -1}, {-1, -1}}
+)
+
+// newGrid 由矩阵构建网格
+func newGrid(matrix *models.MatrixData) (*grid, error) {
+	if matrix == nil || len(matrix.Values) == 0 || len(matrix.Values[0]) == 0 {
+		return nil, errors.New("网格为空")
+	}
+	rows, cols := len(matrix.Values), len(matrix.Values[0])
+	if rows*cols > maxGridCells {
+		return nil, fmt.Errorf("网格单元格数不能超过%d", maxGridCells)
+	}
+
+	g := &grid{rows: rows, cols: cols, wall: make([]bool, rows*cols), cost: make([]float64, rows*cols)}
+	for r, row := range matrix.Values {
+		if len(row) != cols {
+			return nil, fmt.Errorf("网格第%d行长度为%d，应为%d", r, len(row), cols)
+		}
+		for c, v := range row {
+			value, ok := algorithms.ToFloat(v)
+			switch {
+			case !ok:
+				return nil, fmt.Errorf("单元格(%d,%d)必须为数值", r, c)
+			case value == cellWall:
+				g.wall[r*cols+c] = true
+			case value == cellOpen:
+				g.cost[r*cols+c] = 1
+			case value >= 2:
+				g.cost[r*cols+c] = value
+				g.weighted = true
+			default:
+				return nil, fmt.Errorf("单元格(%d,%d)的值 %v 无效：0 为通路，1 为墙，不小于2为通行代价", r, c, value)
+			}
+		}
+	}
+	return g, nil
+}
+
+// toGrid 从输入中取出网格矩阵
+func toGrid(data interface{}) (*grid, error) {
+	switch m := data.(type) {
+	case []*models.MatrixData:
+		if len(m) != 1 {
+			return nil, errors.New("网格寻路需要且只需要一个矩阵")
+		}
+		return newGrid(m[0])
+	case *models.MatrixData:
+		return newGrid(m)
+	case models.MatrixData:
+		return newGrid(&m)
+	}
+	return nil, algorithms.ErrInvalidInput
+}
+
+// index 坐标对应的编号
+func (g *grid) index(r, c int) int {
+	return r*g.cols + c
+}
+
+// coord 编号对应的坐标
+func (g *grid) coord(i int) (int, int) {
+	return i / g.cols, i % g.cols
+}
+
+// open 坐标是否在网格内且不是墙
+func (g *grid) open(r, c int) bool {
+	return r >= 0 && r < g.rows && c >= 0 && c < g.cols && !g.wall[r*g.cols+c]
+}
+
+// neighbors 单元格i的可达邻居及移动代价
+// 对角移动只在两侧的正交单元格都是通路时允许，避免穿过墙角
+func (g *grid) neighbors(i int, diagonal bool) ([]int, []float64) {
+	r, c := g.coord(i)
+	cells := make([]int, 0, 8)
+	costs := make([]float64, 0, 8)
+	for _, d := range orthogonal {
+		if g.open(r+d[0], c+d[1]) {
+			j := g.index(r+d[0], c+d[1])
+			cells = append(cells, j)
+			costs = append(costs, g.cost[j])
+		}
+	}
+	if diagonal {
+		for _, d := range diagonals {
+			if g.open(r+d[0], c+d[1]) && g.open(r+d[0], c) && g.open(r, c+d[1]) {
+				j := g.index(r+d[0], c+d[1])
+				cells = append(cells, j)
+				costs = append(costs, g.cost[j]*math.Sqrt2)
+			}
+		}
+	}
+	return cells, costs
+}
+
+// endpoints 解析起点和终点参数，缺省时取左上角第一个和右下角最后一个通路
+func (g *grid) endpoints(params map[string]interface{}) (int, int, error) {
+	start, goal := -1, -1
+	for i := 0; i < len(g.wall) && start < 0; i++ {
+		if !g.wall[i] {
+			start = i
+		}
+	}
+	for i := len(g.wall) - 1; i >= 0 && goal < 0; i-- {
+		if !g.wall[i] {
+			goal = i
+		}
+	}
+	if start < 0 {
+		return 0, 0, errors.New("网格中没有通路")
+	}
+
+	var err error
+	if start, err = g.cellParam(params, "start", start); err != nil {
+		return 0, 0, err
+	}
+	if goal, err = g.cellParam(params, "goal", goal); err != nil {
+		return 0, 0, err
+	}
+	return start, goal, nil
+}
+
+// cellParam 读取 "行,列" 形式的坐标参数
+func (g *grid) cellParam(params map[string]interface{}, name string, defaultCell int) (int, error) {
+	var parts []string
+	switch v := algorithms.ValueParam(params, name, nil).(type) {
+	case nil:
+		return defaultCell, nil
+	case string:
+		if strings.TrimSpace(v) == "" {
+			return defaultCell, nil
+		}
+		parts = strings.FieldsFunc(v, func(r rune) bool { return r == ',' || r == ' ' || r == '(' || r == ')' })
+	case []interface{}:
+		for _, item := range v {
+			parts = append(parts, fmt.Sprint(item))
+		}
+	default:
+		return 0, fmt.Errorf("参数 %s 应为 \"行,列\"", name)
+	}
+	if len(parts) != 2 {
+		return 0, fmt.Errorf("参数 %s 应为 \"行,列\"", name)
+	}
+	r, errR := strconv.Atoi(parts[0])
+	c, errC := strconv.Atoi(parts[1])
+	if errR != nil || errC != nil {
+		return 0, fmt.Errorf("参数 %s 应为 \"行,列\"", name)
+	}
+	if !g.open(r, c) {
+		return 0, fmt.Errorf("%s (%d,%d) 超出网格或是墙", name, r, c)
+	}
+	return g.index(r, c), nil
+}
+
+// baseLayer 只包含墙和通路的二维高亮层
+func (g *grid) baseLayer() [][]byte {
+	layer := make([][]byte, g.rows)
+	for r := range layer {
+		layer[r] = make([]byte, g.cols)
+		for c := range layer[r] {
+			i := g.index(r, c)
+			switch {
+			case g.wall[i]:
+				layer[r][c] = markWall
+			case g.cost[i] > 1:
+				layer[r][c] = markCostly
+			default:
+				layer[r][c] = markOpen
+			}
+		}
+	}
+	return layer
+}
+
+// cellPoint 编号对应的 [行, 列]
+func (g *grid) cellPoint(i int) []int {
+	r, c := g.coord(i)
+	return []int{r, c}
+}
+
+// cellLabel 编号对应的 (行,列) 文本
+func (g *grid) cellLabel(i int) string {
+	r, c := g.coord(i)
+	return fmt.Sprintf("(%d,%d)", r, c)
+}
+
+// layerRows 将二维高亮层转换为每行一个字符串
+func layerRows(layer [][]byte) []string {
+	rows := make([]string, len(layer))
+	for r, row := range layer {
+		rows[r] = string(row)
+	}
+	return rows
+}
+
+// seedParameter 随机种子参数定义
+func seedParameter() models.Parameter {
+	return models.Parameter{
+		Name:         "seed",
+		Type:         "int",
+		Description:  "随机种子，相同的种子生成相同的结果；为0时使用当前时间",
+		DefaultValue: 0,
+		Required:     false,
+	}
+}
+
+// endpointParameters 起点和终点参数定义
+func endpointParameters() []models.Parameter {
+	return []models.Parameter{
+		{
+			Name:         "start",
+			Type:         "string",
+			Description:  "起点坐标 \"行,列\"，缺省为左上角第一个通路",
+			DefaultValue: "",
+			Required:     false,
+		},
+		{
+			Name:         "goal",
+			Type:         "string",
+			Description:  "终点坐标 \"行,列\"，缺省为右下角最后一个通路",
+			DefaultValue: "",
+			Required:     false,
+		},
+	}
+}
@@ -0,0 +1,62 @@
+package grid
+
+import (
+	"gin/algorithms"
+	"gin/models"
+)
+
+// GridAStar 网格A*搜索
+type GridAStar struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewGridAStar 创建网格A*搜索算法实例
+func NewGridAStar() *GridAStar {
+	return &GridAStar{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "grid_astar",
+			Name:            "网格A*搜索",
+			Category:        models.CategoryGraph,
+			Description:     "按 f = g + h 扩展单元格，g 为到起点的累计代价，h 为到终点的曼哈顿距离（允许对角移动时为八方向距离）。启发函数可采纳，结果与 Dijkstra 一样最优，但边界朝终点方向延伸，扩展的单元格更少。输入为网格矩阵：0 为通路，1 为墙，不小于2的值为进入该单元格的代价。步骤中的二维高亮层标出边界（o）与已扩展（x）的单元格。",
+			TimeComplexity:  "O(V log V)",
+			SpaceComplexity: "O(V)",
+			Parameters:      append(endpointParameters(), diagonalParameter()),
+		},
+	}
+}
+
+// Execute 使用默认参数执行搜索
+func (gs *GridAStar) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return gs.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 按参数执行搜索
+func (gs *GridAStar) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	return runGridSearch(searchAStar, data, params, tracker)
+}
+
+// ProcessMatrices 在网格矩阵上搜索
+func (gs *GridAStar) ProcessMatrices(matrices []*models.MatrixData, tracker models.StepTracker) (interface{}, error) {
+	return runGridSearch(searchAStar, matrices, nil, tracker)
+}
+
+// ValidateInput 验证输入数据
+func (gs *GridAStar) ValidateInput(data interface{}) error {
+	return validateGrid(data)
+}
+
+// GetComplexity 获取复杂度信息
+func (gs *GridAStar) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(d)",
+			Average: "O(V log V)",
+			Worst:   "O(V log V)",
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(V)",
+			Average: "O(V)",
+			Worst:   "O(V)",
+		},
+	}
+}
@@ -0,0 +1,62 @@
+package grid
+
+import (
+	"gin/algorithms"
+	"gin/models"
+)
+
+// GridBFS 网格广度优先搜索
+type GridBFS struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewGridBFS 创建网格广度优先搜索算法实例
+func NewGridBFS() *GridBFS {
+	return &GridBFS{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "grid_bfs",
+			Name:            "网格广度优先搜索",
+			Category:        models.CategoryGraph,
+			Description:     "从起点按层扩展，先到达的单元格步数最少，忽略单元格代价，找到的是步数最少的路径。输入为网格矩阵：0 为通路，1 为墙，不小于2的值为进入该单元格的代价。步骤中的二维高亮层标出边界（o）与已扩展（x）的单元格。",
+			TimeComplexity:  "O(V)",
+			SpaceComplexity: "O(V)",
+			Parameters:      append(endpointParameters(), diagonalParameter()),
+		},
+	}
+}
+
+// Execute 使用默认参数执行搜索
+func (gs *GridBFS) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return gs.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 按参数执行搜索
+func (gs *GridBFS) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	return runGridSearch(searchBFS, data, params, tracker)
+}
+
+// ProcessMatrices 在网格矩阵上搜索
+func (gs *GridBFS) ProcessMatrices(matrices []*models.MatrixData, tracker models.StepTracker) (interface{}, error) {
+	return runGridSearch(searchBFS, matrices, nil, tracker)
+}
+
+// ValidateInput 验证输入数据
+func (gs *GridBFS) ValidateInput(data interface{}) error {
+	return validateGrid(data)
+}
+
+// GetComplexity 获取复杂度信息
+func (gs *GridBFS) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(d)",
+			Average: "O(V)",
+			Worst:   "O(V)",
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(V)",
+			Average: "O(V)",
+			Worst:   "O(V)",
+		},
+	}
+}
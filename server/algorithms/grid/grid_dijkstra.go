@@ -0,0 +1,62 @@
+package grid
+
+import (
+	"gin/algorithms"
+	"gin/models"
+)
+
+// GridDijkstra 网格Dijkstra
+type GridDijkstra struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewGridDijkstra 创建网格Dijkstra算法实例
+func NewGridDijkstra() *GridDijkstra {
+	return &GridDijkstra{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "grid_dijkstra",
+			Name:            "网格Dijkstra",
+			Category:        models.CategoryGraph,
+			Description:     "按到起点的累计代价从小到大扩展单元格，边界是以起点为中心、按代价向外扩散的等高线，能在带代价的网格上找到代价最小的路径。输入为网格矩阵：0 为通路，1 为墙，不小于2的值为进入该单元格的代价。步骤中的二维高亮层标出边界（o）与已扩展（x）的单元格。",
+			TimeComplexity:  "O(V log V)",
+			SpaceComplexity: "O(V)",
+			Parameters:      append(endpointParameters(), diagonalParameter()),
+		},
+	}
+}
+
+// Execute 使用默认参数执行搜索
+func (gs *GridDijkstra) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return gs.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 按参数执行搜索
+func (gs *GridDijkstra) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	return runGridSearch(searchDijkstra, data, params, tracker)
+}
+
+// ProcessMatrices 在网格矩阵上搜索
+func (gs *GridDijkstra) ProcessMatrices(matrices []*models.MatrixData, tracker models.StepTracker) (interface{}, error) {
+	return runGridSearch(searchDijkstra, matrices, nil, tracker)
+}
+
+// ValidateInput 验证输入数据
+func (gs *GridDijkstra) ValidateInput(data interface{}) error {
+	return validateGrid(data)
+}
+
+// GetComplexity 获取复杂度信息
+func (gs *GridDijkstra) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(d log d)",
+			Average: "O(V log V)",
+			Worst:   "O(V log V)",
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(V)",
+			Average: "O(V)",
+			Worst:   "O(V)",
+		},
+	}
+}
@@ -0,0 +1,362 @@
+package grid
+
+import (
+	"container/heap"
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"math"
+	"strconv"
+)
+
+// 网格搜索方法
+const (
+	searchBFS      = "bfs"
+	searchDijkstra = "dijkstra"
+	searchAStar    = "astar"
+)
+
+// 单元格的搜索状态
+const (
+	cellUnseen = iota
+	cellFrontier
+	cellClosed
+	cellScanned
+)
+
+// gridSearchState 网格搜索的步骤快照
+type gridSearchState struct {
+	Layer    []string `json:"layer"`             // 二维高亮层，每行一个字符串，图例见结果中的 legend
+	Current  []int    `json:"current,omitempty"` // 当前扩展的单元格 [行, 列]
+	Frontier int      `json:"frontier"`          // 边界中的单元格数
+	Visited  int      `json:"visited"`           // 已扩展的单元格数
+}
+
+// gridSearch 一次网格搜索
+type gridSearch struct {
+	g           *grid
+	tracker     models.StepTracker
+	diagonal    bool
+	start, goal int
+	dist        []float64
+	parent      []int
+	status      []int
+	frontier    int
+	visited     int
+	maxFrontier int
+}
+
+// newGridSearch 创建网格搜索上下文
+func newGridSearch(g *grid, start, goal int, diagonal bool, tracker models.StepTracker) *gridSearch {
+	s := &gridSearch{
+		g:        g,
+		tracker:  tracker,
+		diagonal: diagonal,
+		start:    start,
+		goal:     goal,
+		dist:     make([]float64, g.rows*g.cols),
+		parent:   make([]int, g.rows*g.cols),
+		status:   make([]int, g.rows*g.cols),
+	}
+	for i := range s.dist {
+		s.dist[i] = math.Inf(1)
+		s.parent[i] = -1
+	}
+	return s
+}
+
+// searchItem 优先队列元素
+type searchItem struct {
+	cell  int
+	f, h  float64
+	order int
+}
+
+// searchQueue 按 f 升序、f 相同时 h 升序（更靠近终点）、再按入队顺序出队
+type searchQueue []searchItem
+
+func (q searchQueue) Len() int { return len(q) }
+func (q searchQueue) Less(i, j int) bool {
+	if q[i].f != q[j].f {
+		return q[i].f < q[j].f
+	}
+	if q[i].h != q[j].h {
+		return q[i].h < q[j].h
+	}
+	return q[i].order < q[j].order
+}
+func (q searchQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *searchQueue) Push(x interface{}) { *q = append(*q, x.(searchItem)) }
+func (q *searchQueue) Pop() interface{} {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
+
+// run 执行 BFS、Dijkstra 或 A*
+// BFS 按步数搜索，忽略单元格代价；Dijkstra 与 A* 按代价搜索，A* 使用曼哈顿（四方向）或八方向距离作为启发函数
+func (s *gridSearch) run(method string) bool {
+	s.tracker.SetPhase("搜索")
+	s.dist[s.start] = 0
+	s.push(s.start)
+
+	if method == searchBFS {
+		return s.runBFS()
+	}
+
+	heuristic := func(int) float64 { return 0 }
+	if method == searchAStar {
+		heuristic = s.heuristic
+	}
+	queue := &searchQueue{}
+	order := 0
+	heap.Push(queue, searchItem{cell: s.start, f: heuristic(s.start), h: heuristic(s.start), order: order})
+	s.step(fmt.Sprintf("起点 %s 加入边界，终点为 %s", s.g.cellLabel(s.start), s.g.cellLabel(s.goal)), s.start, []int{s.start})
+
+	for queue.Len() > 0 {
+		item := heap.Pop(queue).(searchItem)
+		cur := item.cell
+		if s.status[cur] == cellClosed {
+			continue // 已用更短的距离扩展过
+		}
+		s.close(cur)
+		if cur == s.goal {
+			s.step(fmt.Sprintf("取出终点 %s，g=%s", s.g.cellLabel(cur), formatCost(s.dist[cur])), cur, []int{cur})
+			return true
+		}
+
+		cells, costs := s.g.neighbors(cur, s.diagonal)
+		updated := make([]int, 0, len(cells))
+		for k, next := range cells {
+			if s.status[next] == cellClosed {
+				continue
+			}
+			if d := s.dist[cur] + costs[k]; d < s.dist[next] {
+				s.dist[next] = d
+				s.parent[next] = cur
+				if s.status[next] != cellFrontier {
+					s.push(next)
+				}
+				order++
+				h := heuristic(next)
+				heap.Push(queue, searchItem{cell: next, f: d + h, h: h, order: order})
+				updated = append(updated, next)
+			}
+		}
+
+		description := fmt.Sprintf("扩展 %s，g=%s", s.g.cellLabel(cur), formatCost(s.dist[cur]))
+		if method == searchAStar {
+			description += fmt.Sprintf("，h=%s，f=%s", formatCost(item.h), formatCost(item.f))
+		}
+		s.step(fmt.Sprintf("%s，更新 %d 个邻居", description, len(updated)), cur, append([]int{cur}, updated...))
+		for _, next := range updated {
+			s.tracker.AddOperation(models.OpTypeUpdate, []int{next}, []interface{}{s.dist[next]}, "更新 "+s.g.cellLabel(next)+" 的距离")
+		}
+	}
+	return false
+}
+
+// runBFS 按层扩展，第一次取出终点时步数最少
+func (s *gridSearch) runBFS() bool {
+	queue := []int{s.start}
+	s.step(fmt.Sprintf("起点 %s 入队，终点为 %s", s.g.cellLabel(s.start), s.g.cellLabel(s.goal)), s.start, []int{s.start})
+
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		s.close(cur)
+		if cur == s.goal {
+			s.step(fmt.Sprintf("取出终点 %s，共 %s 步", s.g.cellLabel(cur), formatCost(s.dist[cur])), cur, []int{cur})
+			return true
+		}
+
+		cells, _ := s.g.neighbors(cur, s.diagonal)
+		discovered := make([]int, 0, len(cells))
+		for _, next := range cells {
+			if s.status[next] == cellUnseen {
+				s.dist[next] = s.dist[cur] + 1
+				s.parent[next] = cur
+				s.push(next)
+				queue = append(queue, next)
+				discovered = append(discovered, next)
+			}
+		}
+		s.step(fmt.Sprintf("取出 %s（第 %s 层），发现 %d 个新单元格", s.g.cellLabel(cur), formatCost(s.dist[cur]), len(discovered)),
+			cur, append([]int{cur}, discovered...))
+		for _, next := range discovered {
+			s.tracker.AddOperation(models.OpTypeInsert, []int{next}, []interface{}{s.dist[next]}, s.g.cellLabel(next)+" 入队")
+		}
+	}
+	return false
+}
+
+// heuristic 到终点的可采纳估计：四方向为曼哈顿距离，八方向为八方向距离（单元格代价至少为1）
+func (s *gridSearch) heuristic(cell int) float64 {
+	r1, c1 := s.g.coord(cell)
+	r2, c2 := s.g.coord(s.goal)
+	dr, dc := math.Abs(float64(r1-r2)), math.Abs(float64(c1-c2))
+	if !s.diagonal {
+		return dr + dc
+	}
+	return octile(dr, dc)
+}
+
+// push 单元格进入边界
+func (s *gridSearch) push(cell int) {
+	s.status[cell] = cellFrontier
+	s.frontier++
+	if s.frontier > s.maxFrontier {
+		s.maxFrontier = s.frontier
+	}
+}
+
+// close 单元格离开边界并标为已扩展
+func (s *gridSearch) close(cell int) {
+	if s.status[cell] == cellFrontier {
+		s.frontier--
+	}
+	s.status[cell] = cellClosed
+	s.visited++
+}
+
+// step 记录一个步骤，高亮为单元格编号（行 × 列数 + 列）
+func (s *gridSearch) step(description string, current int, highlights []int) {
+	s.tracker.AddStep(description, &gridSearchState{
+		Layer:    s.layer(current, nil),
+		Current:  s.g.cellPoint(current),
+		Frontier: s.frontier,
+		Visited:  s.visited,
+	}, highlights)
+}
+
+// layer 生成二维高亮层
+func (s *gridSearch) layer(current int, path []int) []string {
+	layer := s.g.baseLayer()
+	for i, status := range s.status {
+		r, c := s.g.coord(i)
+		switch status {
+		case cellFrontier:
+			layer[r][c] = markFrontier
+		case cellClosed:
+			layer[r][c] = markVisited
+		case cellScanned:
+			layer[r][c] = markScanned
+		}
+	}
+	for _, i := range path {
+		r, c := s.g.coord(i)
+		layer[r][c] = markPath
+	}
+	if current >= 0 {
+		r, c := s.g.coord(current)
+		layer[r][c] = markCurrent
+	}
+	sr, sc := s.g.coord(s.start)
+	gr, gc := s.g.coord(s.goal)
+	layer[sr][sc], layer[gr][gc] = markStart, markGoal
+	return layerRows(layer)
+}
+
+// finish 回溯路径并生成结果
+func (s *gridSearch) finish(found bool, extra map[string]interface{}) map[string]interface{} {
+	path := make([]int, 0)
+	if found {
+		for cell := s.goal; cell >= 0; cell = s.parent[cell] {
+			path = append([]int{cell}, path...)
+		}
+	}
+	return s.result(found, path, extra)
+}
+
+// result 按路径生成结果，路径代价按进入每个单元格的代价（对角移动乘以√2）累加
+func (s *gridSearch) result(found bool, path []int, extra map[string]interface{}) map[string]interface{} {
+	points := make([][]int, len(path))
+	cost := 0.0
+	for k, cell := range path {
+		points[k] = s.g.cellPoint(cell)
+		if k > 0 {
+			r1, c1 := s.g.coord(path[k-1])
+			r2, c2 := s.g.coord(cell)
+			step := s.g.cost[cell]
+			if r1 != r2 && c1 != c2 {
+				step *= math.Sqrt2
+			}
+			cost += step
+		}
+	}
+	cost = math.Round(cost*1e9) / 1e9
+
+	s.tracker.SetPhase("完成")
+	if found {
+		s.tracker.AddStep(fmt.Sprintf("找到路径：%d 步，代价 %s，扩展了 %d 个单元格", len(path)-1, formatCost(cost), s.visited),
+			&gridSearchState{Layer: s.layer(-1, path), Frontier: s.frontier, Visited: s.visited}, path)
+	} else {
+		s.tracker.AddStep(fmt.Sprintf("边界为空，%s 不可达，扩展了 %d 个单元格", s.g.cellLabel(s.goal), s.visited),
+			&gridSearchState{Layer: s.layer(-1, nil), Frontier: s.frontier, Visited: s.visited}, []int{})
+		cost = -1
+	}
+
+	result := map[string]interface{}{
+		"found":       found,
+		"path":        points,
+		"length":      len(path) - 1,
+		"cost":        cost,
+		"start":       s.g.cellPoint(s.start),
+		"goal":        s.g.cellPoint(s.goal),
+		"visited":     s.visited,
+		"maxFrontier": s.maxFrontier,
+		"legend":      layerLegend,
+	}
+	if !found {
+		result["length"] = -1
+	}
+	for k, v := range extra {
+		result[k] = v
+	}
+	return result
+}
+
+// runGridSearch 解析输入与参数并执行网格搜索
+func runGridSearch(method string, data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	g, err := toGrid(data)
+	if err != nil {
+		return nil, err
+	}
+	start, goal, err := g.endpoints(params)
+	if err != nil {
+		return nil, err
+	}
+	s := newGridSearch(g, start, goal, algorithms.BoolParam(params, "diagonal", false), tracker)
+	found := s.run(method)
+	return s.finish(found, nil), nil
+}
+
+// diagonalParameter 对角移动参数定义
+func diagonalParameter() models.Parameter {
+	return models.Parameter{
+		Name:         "diagonal",
+		Type:         "bool",
+		Description:  "是否允许对角移动（代价为√2倍，不能穿过墙角）",
+		DefaultValue: false,
+		Required:     false,
+	}
+}
+
+// validateGrid 验证网格输入
+func validateGrid(data interface{}) error {
+	if _, err := toGrid(data); err != nil {
+		return algorithms.ErrInvalidInput
+	}
+	return nil
+}
+
+// octile 八方向距离：先走对角再走直线
+func octile(dr, dc float64) float64 {
+	return math.Max(dr, dc) + (math.Sqrt2-1)*math.Min(dr, dc)
+}
+
+// formatCost 格式化代价，保留至多三位小数
+func formatCost(v float64) string {
+	return strconv.FormatFloat(math.Round(v*1000)/1000, 'f', -1, 64)
+}
@@ -0,0 +1,161 @@
+package grid
+
+import (
+	"gin/models"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// randomGrid 随机障碍网格，左上角和右下角保持为通路；weighted 时部分通路带有2~5的代价
+func randomGrid(rng *rand.Rand, rows, cols int, density float64, weighted bool) *models.MatrixData {
+	values := make([][]interface{}, rows)
+	for r := range values {
+		values[r] = make([]interface{}, cols)
+		for c := range values[r] {
+			switch {
+			case rng.Float64() < density:
+				values[r][c] = cellWall
+			case weighted && rng.Float64() < 0.3:
+				values[r][c] = 2 + rng.Intn(4)
+			default:
+				values[r][c] = cellOpen
+			}
+		}
+	}
+	values[0][0], values[rows-1][cols-1] = cellOpen, cellOpen
+	return &models.MatrixData{Values: values, Rows: rows, Cols: cols, Type: "int"}
+}
+
+type gridSearcher interface {
+	ExecuteWithParams(interface{}, map[string]interface{}, models.StepTracker) (interface{}, error)
+}
+
+func searchGrid(t *testing.T, algorithm gridSearcher, matrix *models.MatrixData, diagonal bool) map[string]interface{} {
+	t.Helper()
+	result, err := algorithm.ExecuteWithParams(matrix, map[string]interface{}{"diagonal": diagonal}, models.NewStepTracker())
+	if err != nil {
+		t.Fatalf("ExecuteWithParams() error = %v", err)
+	}
+	output := result.(map[string]interface{})
+
+	// 路径必须从起点到终点、只经过通路且每步移动到相邻单元格
+	if output["found"] == true {
+		g, _ := newGrid(matrix)
+		path := output["path"].([][]int)
+		if path[0][0] != 0 || path[0][1] != 0 || path[len(path)-1][0] != g.rows-1 || path[len(path)-1][1] != g.cols-1 {
+			t.Fatalf("path %v does not connect the corners", path)
+		}
+		for k, p := range path {
+			if !g.open(p[0], p[1]) {
+				t.Fatalf("path goes through wall %v", p)
+			}
+			if k > 0 {
+				dr, dc := abs(p[0]-path[k-1][0]), abs(p[1]-path[k-1][1])
+				if dr > 1 || dc > 1 || dr+dc == 0 || (!diagonal && dr+dc != 1) {
+					t.Fatalf("invalid move %v -> %v", path[k-1], p)
+				}
+			}
+		}
+	}
+	return output
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+func TestGridSearch_OptimalCosts(t *testing.T) {
+	rng := rand.New(rand.NewSource(38))
+	dijkstraVisited, astarVisited := 0, 0
+
+	for trial := 0; trial < 60; trial++ {
+		rows, cols := 5+rng.Intn(20), 5+rng.Intn(20)
+		weighted := trial%2 == 0
+		matrix := randomGrid(rng, rows, cols, 0.3, weighted)
+
+		for _, diagonal := range []bool{false, true} {
+			bfs := searchGrid(t, NewGridBFS(), matrix, diagonal)
+			dijkstra := searchGrid(t, NewGridDijkstra(), matrix, diagonal)
+			astar := searchGrid(t, NewGridAStar(), matrix, diagonal)
+
+			if bfs["found"] != dijkstra["found"] || astar["found"] != dijkstra["found"] {
+				t.Fatalf("trial %d: found differs: %v %v %v", trial, bfs["found"], dijkstra["found"], astar["found"])
+			}
+			if dijkstra["found"] != true {
+				continue
+			}
+			if math.Abs(astar["cost"].(float64)-dijkstra["cost"].(float64)) > 1e-9 {
+				t.Fatalf("trial %d diagonal=%v: A* cost %v, Dijkstra cost %v", trial, diagonal, astar["cost"], dijkstra["cost"])
+			}
+			// 不带代价且只能四方向移动时，步数最少即代价最小
+			if !weighted && !diagonal && bfs["length"] != dijkstra["length"] {
+				t.Fatalf("trial %d: BFS length %v, Dijkstra length %v", trial, bfs["length"], dijkstra["length"])
+			}
+			if bfs["cost"].(float64) < dijkstra["cost"].(float64)-1e-9 {
+				t.Fatalf("trial %d: BFS cost %v below optimum %v", trial, bfs["cost"], dijkstra["cost"])
+			}
+			dijkstraVisited += dijkstra["visited"].(int)
+			astarVisited += astar["visited"].(int)
+		}
+	}
+
+	if astarVisited >= dijkstraVisited {
+		t.Errorf("A* expanded %d cells, Dijkstra %d", astarVisited, dijkstraVisited)
+	}
+}
+
+func TestJumpPointSearch_MatchesAStar(t *testing.T) {
+	rng := rand.New(rand.NewSource(38))
+	jps := NewJumpPointSearch()
+	astarVisited, jpsVisited := 0, 0
+
+	for trial := 0; trial < 100; trial++ {
+		matrix := randomGrid(rng, 5+rng.Intn(30), 5+rng.Intn(30), rng.Float64()*0.4, false)
+		astar := searchGrid(t, NewGridAStar(), matrix, true)
+		result := searchGrid(t, jps, matrix, true)
+
+		if result["found"] != astar["found"] {
+			t.Fatalf("trial %d: JPS found %v, A* found %v", trial, result["found"], astar["found"])
+		}
+		if astar["found"] != true {
+			continue
+		}
+		if math.Abs(result["cost"].(float64)-astar["cost"].(float64)) > 1e-9 {
+			t.Fatalf("trial %d: JPS cost %v, A* cost %v", trial, result["cost"], astar["cost"])
+		}
+		astarVisited += astar["visited"].(int)
+		jpsVisited += result["visited"].(int)
+	}
+
+	if jpsVisited >= astarVisited {
+		t.Errorf("JPS expanded %d jump points, A* expanded %d cells", jpsVisited, astarVisited)
+	}
+}
+
+func TestGridSearch_Errors(t *testing.T) {
+	weighted := &models.MatrixData{Values: [][]interface{}{{0, 3}, {0, 0}}}
+	if _, err := NewJumpPointSearch().Execute(weighted, models.NewStepTracker()); err == nil {
+		t.Error("expected JPS to reject weighted grids")
+	}
+
+	blocked := &models.MatrixData{Values: [][]interface{}{{0, 1}, {1, 0}}}
+	result, err := NewGridAStar().Execute(blocked, models.NewStepTracker())
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if output := result.(map[string]interface{}); output["found"] != false || output["length"] != -1 {
+		t.Errorf("found/length = %v/%v, expected false/-1", output["found"], output["length"])
+	}
+
+	params := map[string]interface{}{"start": "0,1"}
+	if _, err := NewGridBFS().ExecuteWithParams(blocked, params, models.NewStepTracker()); err == nil {
+		t.Error("expected error for start on a wall")
+	}
+	if err := NewGridDijkstra().ValidateInput(&models.MatrixData{Values: [][]interface{}{{0, -1}}}); err == nil {
+		t.Error("expected error for invalid cell value")
+	}
+}
@@ -0,0 +1,297 @@
+package grid
+
+import (
+	"container/heap"
+	"errors"
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"math"
+)
+
+// JumpPointSearch 跳点搜索
+type JumpPointSearch struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewJumpPointSearch 创建跳点搜索算法实例
+func NewJumpPointSearch() *JumpPointSearch {
+	return &JumpPointSearch{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "grid_jps",
+			Name:            "跳点搜索 (JPS)",
+			Category:        models.CategoryGraph,
+			Description:     "在八方向、代价均匀的网格上对 A* 剪枝：沿当前方向直线或对角“跳跃”，只有遇到终点或存在强迫邻居（被墙挡住后只能经由当前单元格到达的邻居）时才停下作为跳点加入开放表。扫描过的单元格标为 -，只有跳点进入边界，扩展的节点远少于 A*。对角移动不能穿过墙角。",
+			TimeComplexity:  "O(E log V)",
+			SpaceComplexity: "O(V)",
+			Parameters:      endpointParameters(),
+		},
+	}
+}
+
+// Execute 使用默认参数执行跳点搜索
+func (j *JumpPointSearch) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return j.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 按参数执行跳点搜索
+func (j *JumpPointSearch) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	g, err := toGrid(data)
+	if err != nil {
+		return nil, err
+	}
+	if g.weighted {
+		return nil, errors.New("跳点搜索要求所有通路的代价相同，网格中只能包含0和1")
+	}
+	start, goal, err := g.endpoints(params)
+	if err != nil {
+		return nil, err
+	}
+
+	s := newGridSearch(g, start, goal, true, tracker)
+	found, jumpPoints, scanned := s.runJPS()
+
+	// 相邻跳点之间沿直线或对角线补全路径
+	path := make([]int, 0)
+	if found {
+		for cell := goal; cell >= 0; cell = s.parent[cell] {
+			if len(path) > 0 {
+				path = append(path, interpolate(g, path[len(path)-1], cell)...)
+			}
+			path = append(path, cell)
+		}
+		for a, b := 0, len(path)-1; a < b; a, b = a+1, b-1 {
+			path[a], path[b] = path[b], path[a]
+		}
+	}
+
+	points := make([][]int, len(jumpPoints))
+	for k, cell := range jumpPoints {
+		points[k] = g.cellPoint(cell)
+	}
+	return s.result(found, path, map[string]interface{}{
+		"jumpPoints": points,
+		"scanned":    scanned,
+	}), nil
+}
+
+// ProcessMatrices 在网格矩阵上执行跳点搜索
+func (j *JumpPointSearch) ProcessMatrices(matrices []*models.MatrixData, tracker models.StepTracker) (interface{}, error) {
+	return j.ExecuteWithParams(matrices, nil, tracker)
+}
+
+// runJPS 以八方向距离为启发函数、只在跳点之间转移的 A*
+func (s *gridSearch) runJPS() (bool, []int, int) {
+	s.tracker.SetPhase("搜索")
+	s.dist[s.start] = 0
+	s.push(s.start)
+
+	jumpPoints := []int{s.start}
+	scanned := 0
+	queue := &searchQueue{}
+	order := 0
+	h := s.heuristic(s.start)
+	heap.Push(queue, searchItem{cell: s.start, f: h, h: h, order: order})
+	s.step(fmt.Sprintf("起点 %s 加入开放表，终点为 %s", s.g.cellLabel(s.start), s.g.cellLabel(s.goal)), s.start, []int{s.start})
+
+	for queue.Len() > 0 {
+		item := heap.Pop(queue).(searchItem)
+		cur := item.cell
+		if s.status[cur] == cellClosed {
+			continue
+		}
+		s.close(cur)
+		if cur == s.goal {
+			s.step(fmt.Sprintf("取出终点 %s，g=%s", s.g.cellLabel(cur), formatCost(s.dist[cur])), cur, []int{cur})
+			return true, jumpPoints, scanned
+		}
+
+		r, c := s.g.coord(cur)
+		found := make([]int, 0, 8)
+		for _, d := range s.prunedDirections(cur) {
+			jp := s.jump(r+d[0], c+d[1], d[0], d[1], &scanned)
+			if jp < 0 || s.status[jp] == cellClosed {
+				continue
+			}
+			jr, jc := s.g.coord(jp)
+			g := s.dist[cur] + octile(math.Abs(float64(jr-r)), math.Abs(float64(jc-c)))
+			if g < s.dist[jp] {
+				if math.IsInf(s.dist[jp], 1) {
+					jumpPoints = append(jumpPoints, jp)
+				}
+				s.dist[jp] = g
+				s.parent[jp] = cur
+				if s.status[jp] != cellFrontier {
+					s.push(jp)
+				}
+				order++
+				h := s.heuristic(jp)
+				heap.Push(queue, searchItem{cell: jp, f: g + h, h: h, order: order})
+				found = append(found, jp)
+			}
+		}
+
+		s.step(fmt.Sprintf("扩展跳点 %s，g=%s，h=%s，沿剪枝后的方向跳跃得到 %d 个后继跳点",
+			s.g.cellLabel(cur), formatCost(s.dist[cur]), formatCost(item.h), len(found)), cur, append([]int{cur}, found...))
+		for _, jp := range found {
+			s.tracker.AddOperation(models.OpTypeUpdate, []int{jp}, []interface{}{s.dist[jp]}, "跳点 "+s.g.cellLabel(jp)+" 的距离")
+		}
+	}
+	return false, jumpPoints, scanned
+}
+
+// prunedDirections 剪枝后需要搜索的方向：起点搜索全部方向，其余只保留自然邻居和强迫邻居的方向
+func (s *gridSearch) prunedDirections(cell int) [][2]int {
+	r, c := s.g.coord(cell)
+	g := s.g
+	dirs := make([][2]int, 0, 8)
+	parent := s.parent[cell]
+	if parent < 0 {
+		for _, d := range orthogonal {
+			if g.open(r+d[0], c+d[1]) {
+				dirs = append(dirs, d)
+			}
+		}
+		for _, d := range diagonals {
+			if g.open(r+d[0], c+d[1]) && g.open(r+d[0], c) && g.open(r, c+d[1]) {
+				dirs = append(dirs, d)
+			}
+		}
+		return dirs
+	}
+
+	pr, pc := g.coord(parent)
+	dr, dc := sign(r-pr), sign(c-pc)
+	switch {
+	case dr != 0 && dc != 0:
+		vertical, horizontal := g.open(r+dr, c), g.open(r, c+dc)
+		if vertical {
+			dirs = append(dirs, [2]int{dr, 0})
+		}
+		if horizontal {
+			dirs = append(dirs, [2]int{0, dc})
+		}
+		if vertical && horizontal && g.open(r+dr, c+dc) {
+			dirs = append(dirs, [2]int{dr, dc})
+		}
+	case dc != 0:
+		next, down, up := g.open(r, c+dc), g.open(r+1, c), g.open(r-1, c)
+		if next {
+			dirs = append(dirs, [2]int{0, dc})
+			if down && g.open(r+1, c+dc) {
+				dirs = append(dirs, [2]int{1, dc})
+			}
+			if up && g.open(r-1, c+dc) {
+				dirs = append(dirs, [2]int{-1, dc})
+			}
+		}
+		if down {
+			dirs = append(dirs, [2]int{1, 0})
+		}
+		if up {
+			dirs = append(dirs, [2]int{-1, 0})
+		}
+	default:
+		next, right, left := g.open(r+dr, c), g.open(r, c+1), g.open(r, c-1)
+		if next {
+			dirs = append(dirs, [2]int{dr, 0})
+			if right && g.open(r+dr, c+1) {
+				dirs = append(dirs, [2]int{dr, 1})
+			}
+			if left && g.open(r+dr, c-1) {
+				dirs = append(dirs, [2]int{dr, -1})
+			}
+		}
+		if right {
+			dirs = append(dirs, [2]int{0, 1})
+		}
+		if left {
+			dirs = append(dirs, [2]int{0, -1})
+		}
+	}
+	return dirs
+}
+
+// jump 从 (r,c) 沿方向 (dr,dc) 跳跃，返回遇到的跳点，没有则返回-1
+func (s *gridSearch) jump(r, c, dr, dc int, scanned *int) int {
+	g := s.g
+	for {
+		if !g.open(r, c) {
+			return -1
+		}
+		i := g.index(r, c)
+		if s.status[i] == cellUnseen {
+			s.status[i] = cellScanned
+			*scanned++
+		}
+		if i == s.goal {
+			return i
+		}
+
+		switch {
+		case dr != 0 && dc != 0:
+			// 对角移动时，若水平或竖直方向能跳到跳点，当前单元格也是跳点
+			if s.jump(r, c+dc, 0, dc, scanned) >= 0 || s.jump(r+dr, c, dr, 0, scanned) >= 0 {
+				return i
+			}
+		case dc != 0:
+			if (g.open(r-1, c) && !g.open(r-1, c-dc)) || (g.open(r+1, c) && !g.open(r+1, c-dc)) {
+				return i
+			}
+		default:
+			if (g.open(r, c-1) && !g.open(r-dr, c-1)) || (g.open(r, c+1) && !g.open(r-dr, c+1)) {
+				return i
+			}
+		}
+
+		// 继续前进，对角移动不能穿过墙角
+		if !g.open(r+dr, c) || !g.open(r, c+dc) {
+			return -1
+		}
+		r, c = r+dr, c+dc
+	}
+}
+
+// interpolate 两个跳点之间（不含端点）的单元格，按从 from 到 to 的顺序
+func interpolate(g *grid, from, to int) []int {
+	r1, c1 := g.coord(from)
+	r2, c2 := g.coord(to)
+	dr, dc := sign(r2-r1), sign(c2-c1)
+	cells := make([]int, 0)
+	for r, c := r1+dr, c1+dc; r != r2 || c != c2; r, c = r+dr, c+dc {
+		cells = append(cells, g.index(r, c))
+	}
+	return cells
+}
+
+// sign 符号函数
+func sign(x int) int {
+	switch {
+	case x > 0:
+		return 1
+	case x < 0:
+		return -1
+	}
+	return 0
+}
+
+// ValidateInput 验证输入数据
+func (j *JumpPointSearch) ValidateInput(data interface{}) error {
+	return validateGrid(data)
+}
+
+// GetComplexity 获取复杂度信息
+func (j *JumpPointSearch) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(d)", // 直线可达时只扫描路径上的单元格
+			Average: "O(E log V)",
+			Worst:   "O(E log V)",
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(V)",
+			Average: "O(V)",
+			Worst:   "O(V)",
+		},
+	}
+}
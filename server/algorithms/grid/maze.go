@@ -0,0 +1,431 @@
+package grid
+
+import (
+	"errors"
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"math/rand"
+	"time"
+)
+
+// maxMazeSide 迷宫每边的房间数上限，对应网格边长 2*31+1=63
+const maxMazeSide = 31
+
+// 迷宫生成方法
+const (
+	MazeBacktracker = "backtracker" // 递归回溯
+	MazePrim        = "prim"        // 随机Prim
+	MazeKruskal     = "kruskal"     // 随机Kruskal
+)
+
+// mazeState 迷宫生成的步骤快照
+type mazeState struct {
+	Layer    []string `json:"layer"`             // 二维高亮层，o 为栈中/边界上的房间，@ 为当前位置
+	Current  []int    `json:"current,omitempty"` // 当前位置 [行, 列]（网格坐标）
+	Frontier int      `json:"frontier"`          // 回溯栈大小 / 边界房间数 / 剩余集合数
+	Carved   int      `json:"carved"`            // 已打通的墙数
+}
+
+// mazeRun 一次迷宫生成
+// rows×cols 个房间排布在 (2rows+1)×(2cols+1) 的网格中，房间 (i,j) 位于 (2i+1, 2j+1)，其余为墙
+type mazeRun struct {
+	rows, cols int
+	cells      [][]int
+	rng        *rand.Rand
+	tracker    models.StepTracker
+	carved     int
+}
+
+// GenerateMaze 用指定方法生成 rows×cols 个房间的完美迷宫（任意两个房间之间恰有一条通路）
+// 返回的矩阵中 1 为墙、0 为通路，起点为 (1,1)，终点为右下角的房间
+func GenerateMaze(method string, rows, cols int, rng *rand.Rand) (*models.MatrixData, error) {
+	m, err := newMazeRun(rows, cols, rng, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.generate(method); err != nil {
+		return nil, err
+	}
+	return m.matrix(), nil
+}
+
+// newMazeRun 创建迷宫生成上下文，tracker 为nil时不记录步骤
+func newMazeRun(rows, cols int, rng *rand.Rand, tracker models.StepTracker) (*mazeRun, error) {
+	if rows < 1 || cols < 1 || rows > maxMazeSide || cols > maxMazeSide {
+		return nil, fmt.Errorf("迷宫的行数和列数必须在1到%d之间", maxMazeSide)
+	}
+	cells := make([][]int, 2*rows+1)
+	for r := range cells {
+		cells[r] = make([]int, 2*cols+1)
+		for c := range cells[r] {
+			cells[r][c] = cellWall
+		}
+	}
+	return &mazeRun{rows: rows, cols: cols, cells: cells, rng: rng, tracker: tracker}, nil
+}
+
+// generate 按方法生成迷宫
+func (m *mazeRun) generate(method string) error {
+	switch method {
+	case MazeBacktracker:
+		m.backtracker()
+	case MazePrim:
+		m.prim()
+	case MazeKruskal:
+		m.kruskal()
+	default:
+		return fmt.Errorf("不支持的迷宫生成方法: %s", method)
+	}
+	return nil
+}
+
+// backtracker 递归回溯：随机走向未访问的相邻房间，无路可走时沿栈回退
+func (m *mazeRun) backtracker() {
+	visited := make([]bool, m.rows*m.cols)
+	start := m.rng.Intn(m.rows * m.cols)
+	visited[start] = true
+	m.openRoom(start)
+	stack := []int{start}
+	m.step(fmt.Sprintf("从房间 %s 开始深度优先地打通墙壁", m.roomLabel(start)), m.roomPoint(start), stack, len(stack))
+
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		candidates := make([]int, 0, 4)
+		for _, next := range m.adjacentRooms(current) {
+			if !visited[next] {
+				candidates = append(candidates, next)
+			}
+		}
+
+		if len(candidates) == 0 {
+			stack = stack[:len(stack)-1]
+			if len(stack) > 0 {
+				m.step(fmt.Sprintf("房间 %s 周围都已访问，回溯到 %s", m.roomLabel(current), m.roomLabel(stack[len(stack)-1])),
+					m.roomPoint(stack[len(stack)-1]), stack, len(stack))
+			}
+			continue
+		}
+
+		next := candidates[m.rng.Intn(len(candidates))]
+		visited[next] = true
+		m.carve(current, next)
+		stack = append(stack, next)
+		m.step(fmt.Sprintf("打通 %s 与 %s 之间的墙，进入 %s", m.roomLabel(current), m.roomLabel(next), m.roomLabel(next)),
+			m.roomPoint(next), stack, len(stack))
+	}
+}
+
+// prim 随机Prim：维护与迷宫相邻的边界房间，每次随机取一个接入迷宫
+func (m *mazeRun) prim() {
+	const (
+		outside = iota
+		frontier
+		inside
+	)
+	status := make([]int, m.rows*m.cols)
+	frontierRooms := make([]int, 0)
+	addFrontier := func(room int) {
+		for _, next := range m.adjacentRooms(room) {
+			if status[next] == outside {
+				status[next] = frontier
+				frontierRooms = append(frontierRooms, next)
+			}
+		}
+	}
+
+	start := m.rng.Intn(m.rows * m.cols)
+	status[start] = inside
+	m.openRoom(start)
+	addFrontier(start)
+	m.step(fmt.Sprintf("从房间 %s 开始，相邻的 %d 个房间成为边界", m.roomLabel(start), len(frontierRooms)),
+		m.roomPoint(start), frontierRooms, len(frontierRooms))
+
+	for len(frontierRooms) > 0 {
+		// 随机取出一个边界房间（与末尾交换后删除）
+		k := m.rng.Intn(len(frontierRooms))
+		room := frontierRooms[k]
+		frontierRooms[k] = frontierRooms[len(frontierRooms)-1]
+		frontierRooms = frontierRooms[:len(frontierRooms)-1]
+
+		neighbors := make([]int, 0, 4)
+		for _, next := range m.adjacentRooms(room) {
+			if status[next] == inside {
+				neighbors = append(neighbors, next)
+			}
+		}
+		from := neighbors[m.rng.Intn(len(neighbors))]
+		status[room] = inside
+		m.carve(from, room)
+		addFrontier(room)
+		m.step(fmt.Sprintf("随机选取边界房间 %s，与迷宫中的 %s 打通，边界还有 %d 个房间",
+			m.roomLabel(room), m.roomLabel(from), len(frontierRooms)), m.roomPoint(room), frontierRooms, len(frontierRooms))
+	}
+}
+
+// kruskal 随机Kruskal：随机顺序考察每堵墙，两侧房间不连通时打通并合并集合
+func (m *mazeRun) kruskal() {
+	n := m.rows * m.cols
+	parent := make([]int, n)
+	for i := range parent {
+		parent[i] = i
+		m.openRoom(i)
+	}
+	var find func(x int) int
+	find = func(x int) int {
+		if parent[x] != x {
+			parent[x] = find(parent[x])
+		}
+		return parent[x]
+	}
+
+	walls := make([][2]int, 0, 2*n)
+	for room := 0; room < n; room++ {
+		for _, next := range m.adjacentRooms(room) {
+			if room < next {
+				walls = append(walls, [2]int{room, next})
+			}
+		}
+	}
+	m.rng.Shuffle(len(walls), func(i, j int) { walls[i], walls[j] = walls[j], walls[i] })
+
+	sets := n
+	m.step(fmt.Sprintf("%d 个房间各自成为一个集合，随机打乱 %d 堵内墙", n, len(walls)), nil, nil, sets)
+	skipped := 0
+	for _, wall := range walls {
+		a, b := find(wall[0]), find(wall[1])
+		if a == b {
+			skipped++
+			continue
+		}
+		parent[a] = b
+		sets--
+		m.carve(wall[0], wall[1])
+		r1, c1 := m.roomCell(wall[0])
+		r2, c2 := m.roomCell(wall[1])
+		m.step(fmt.Sprintf("%s 与 %s 属于不同集合，打通中间的墙，剩余 %d 个集合（已跳过 %d 堵会成环的墙）",
+			m.roomLabel(wall[0]), m.roomLabel(wall[1]), sets, skipped), []int{(r1 + r2) / 2, (c1 + c2) / 2}, nil, sets)
+		if sets == 1 {
+			break
+		}
+	}
+}
+
+// adjacentRooms 上下左右相邻的房间
+func (m *mazeRun) adjacentRooms(room int) []int {
+	i, j := room/m.cols, room%m.cols
+	rooms := make([]int, 0, 4)
+	for _, d := range orthogonal {
+		ni, nj := i+d[0], j+d[1]
+		if ni >= 0 && ni < m.rows && nj >= 0 && nj < m.cols {
+			rooms = append(rooms, ni*m.cols+nj)
+		}
+	}
+	return rooms
+}
+
+// roomCell 房间在网格中的坐标
+func (m *mazeRun) roomCell(room int) (int, int) {
+	return 2*(room/m.cols) + 1, 2*(room%m.cols) + 1
+}
+
+// roomPoint 房间在网格中的 [行, 列]
+func (m *mazeRun) roomPoint(room int) []int {
+	r, c := m.roomCell(room)
+	return []int{r, c}
+}
+
+// roomLabel 房间的网格坐标文本
+func (m *mazeRun) roomLabel(room int) string {
+	r, c := m.roomCell(room)
+	return fmt.Sprintf("(%d,%d)", r, c)
+}
+
+// openRoom 将房间设为通路
+func (m *mazeRun) openRoom(room int) {
+	r, c := m.roomCell(room)
+	m.cells[r][c] = cellOpen
+}
+
+// carve 打通两个相邻房间及其间的墙
+func (m *mazeRun) carve(a, b int) {
+	r1, c1 := m.roomCell(a)
+	r2, c2 := m.roomCell(b)
+	m.cells[r1][c1] = cellOpen
+	m.cells[r2][c2] = cellOpen
+	m.cells[(r1+r2)/2][(c1+c2)/2] = cellOpen
+	m.carved++
+}
+
+// step 记录一个步骤，marked 中的房间在高亮层中标为边界
+func (m *mazeRun) step(description string, current []int, marked []int, frontier int) {
+	if m.tracker == nil {
+		return
+	}
+	layer := make([][]byte, len(m.cells))
+	for r, row := range m.cells {
+		layer[r] = make([]byte, len(row))
+		for c, v := range row {
+			if v == cellWall {
+				layer[r][c] = markWall
+			} else {
+				layer[r][c] = markOpen
+			}
+		}
+	}
+	highlights := make([]int, 0, len(marked)+1)
+	for _, room := range marked {
+		r, c := m.roomCell(room)
+		layer[r][c] = markFrontier
+		highlights = append(highlights, r*len(m.cells[0])+c)
+	}
+	if current != nil {
+		layer[current[0]][current[1]] = markCurrent
+		highlights = append(highlights, current[0]*len(m.cells[0])+current[1])
+	}
+	m.tracker.AddStep(description, &mazeState{Layer: layerRows(layer), Current: current, Frontier: frontier, Carved: m.carved}, highlights)
+}
+
+// matrix 生成迷宫矩阵
+func (m *mazeRun) matrix() *models.MatrixData {
+	values := make([][]interface{}, len(m.cells))
+	for r, row := range m.cells {
+		values[r] = make([]interface{}, len(row))
+		for c, v := range row {
+			values[r][c] = v
+		}
+	}
+	return &models.MatrixData{Values: values, Rows: len(values), Cols: len(values[0]), Type: "int"}
+}
+
+// stats 统计死胡同、岔路口数量和起点到终点的路径长度
+func (m *mazeRun) stats() (deadEnds, junctions, solution int) {
+	for room := 0; room < m.rows*m.cols; room++ {
+		r, c := m.roomCell(room)
+		doors := 0
+		for _, d := range orthogonal {
+			if m.cells[r+d[0]][c+d[1]] == cellOpen {
+				doors++
+			}
+		}
+		switch {
+		case doors == 1:
+			deadEnds++
+		case doors >= 3:
+			junctions++
+		}
+	}
+
+	// 网格上的BFS：(1,1) 到右下角房间
+	g, _ := newGrid(m.matrix())
+	start, goal := g.index(1, 1), g.index(2*m.rows-1, 2*m.cols-1)
+	dist := make([]int, g.rows*g.cols)
+	for i := range dist {
+		dist[i] = -1
+	}
+	dist[start] = 0
+	queue := []int{start}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		next, _ := g.neighbors(cur, false)
+		for _, j := range next {
+			if dist[j] < 0 {
+				dist[j] = dist[cur] + 1
+				queue = append(queue, j)
+			}
+		}
+	}
+	return deadEnds, junctions, dist[goal]
+}
+
+// runMaze 执行迷宫生成算法
+func runMaze(method string, data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	rows, cols, err := mazeSize(data)
+	if err != nil {
+		return nil, err
+	}
+	seed := int64(algorithms.IntParam(params, "seed", 0))
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+
+	m, err := newMazeRun(rows, cols, rand.New(rand.NewSource(seed)), tracker)
+	if err != nil {
+		return nil, err
+	}
+	tracker.SetPhase("生成")
+	if err := m.generate(method); err != nil {
+		return nil, err
+	}
+
+	deadEnds, junctions, solution := m.stats()
+	maze := m.matrix()
+	tracker.SetPhase("完成")
+	m.step(fmt.Sprintf("迷宫生成完成：打通 %d 堵墙，%d 个死胡同，%d 个岔路口，起点到终点 %d 步",
+		m.carved, deadEnds, junctions, solution), nil, nil, 0)
+
+	return map[string]interface{}{
+		"maze":           maze,
+		"rows":           maze.Rows,
+		"cols":           maze.Cols,
+		"start":          []int{1, 1},
+		"goal":           []int{maze.Rows - 2, maze.Cols - 2},
+		"passages":       m.carved,
+		"deadEnds":       deadEnds,
+		"junctions":      junctions,
+		"solutionLength": solution,
+		"seed":           seed,
+		"legend":         layerLegend,
+	}, nil
+}
+
+// mazeSize 从输入读取房间的行列数：n 表示 n×n，[rows, cols] 或 {"rows": r, "cols": c}
+func mazeSize(data interface{}) (int, int, error) {
+	switch v := data.(type) {
+	case map[string]interface{}:
+		rows, okR := algorithms.ToFloat(v["rows"])
+		cols, okC := algorithms.ToFloat(v["cols"])
+		if okR && okC {
+			return int(rows), int(cols), nil
+		}
+	case []interface{}:
+		if len(v) == 2 {
+			rows, okR := algorithms.ToFloat(v[0])
+			cols, okC := algorithms.ToFloat(v[1])
+			if okR && okC {
+				return int(rows), int(cols), nil
+			}
+		}
+	default:
+		if n, ok := algorithms.ToFloat(v); ok {
+			return int(n), int(n), nil
+		}
+	}
+	return 0, 0, errors.New("迷宫尺寸应为 n、[rows, cols] 或 {\"rows\": r, \"cols\": c}")
+}
+
+// validateMazeSize 验证迷宫尺寸输入
+func validateMazeSize(data interface{}) error {
+	rows, cols, err := mazeSize(data)
+	if err != nil || rows < 1 || cols < 1 || rows > maxMazeSide || cols > maxMazeSide {
+		return algorithms.ErrInvalidInput
+	}
+	return nil
+}
+
+// mazeComplexity 迷宫生成的复杂度（n 为房间数）
+func mazeComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(n)",
+			Average: "O(n)",
+			Worst:   "O(n)",
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(n)",
+			Average: "O(n)",
+			Worst:   "O(n)",
+		},
+	}
+}
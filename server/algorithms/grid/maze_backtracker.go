@@ -0,0 +1,48 @@
+package grid
+
+import (
+	"gin/algorithms"
+	"gin/models"
+)
+
+// MazeRecursiveBacktracker 递归回溯迷宫生成
+type MazeRecursiveBacktracker struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewMazeRecursiveBacktracker 创建递归回溯迷宫生成算法实例
+func NewMazeRecursiveBacktracker() *MazeRecursiveBacktracker {
+	return &MazeRecursiveBacktracker{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "maze_backtracker",
+			Name:            "递归回溯迷宫生成",
+			Category:        models.CategoryGraph,
+			Description:     "从随机房间出发做随机深度优先搜索：每次随机走向一个未访问的相邻房间并打通中间的墙，无路可走时沿栈回溯。生成的迷宫走廊长、岔路少。输入为房间的行列数（n、[rows, cols] 或 {\"rows\": r, \"cols\": c}），输出 (2rows+1)×(2cols+1) 的矩阵，1 为墙、0 为通路，可直接作为网格寻路的地图。",
+			TimeComplexity:  "O(n)",
+			SpaceComplexity: "O(n)",
+			Parameters: []models.Parameter{
+				seedParameter(),
+			},
+		},
+	}
+}
+
+// Execute 使用默认参数生成迷宫
+func (mg *MazeRecursiveBacktracker) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return mg.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 按参数生成迷宫
+func (mg *MazeRecursiveBacktracker) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	return runMaze(MazeBacktracker, data, params, tracker)
+}
+
+// ValidateInput 验证输入数据
+func (mg *MazeRecursiveBacktracker) ValidateInput(data interface{}) error {
+	return validateMazeSize(data)
+}
+
+// GetComplexity 获取复杂度信息
+func (mg *MazeRecursiveBacktracker) GetComplexity() algorithms.ComplexityInfo {
+	return mazeComplexity()
+}
@@ -0,0 +1,48 @@
+package grid
+
+import (
+	"gin/algorithms"
+	"gin/models"
+)
+
+// MazeRandomizedKruskal 随机Kruskal迷宫生成
+type MazeRandomizedKruskal struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewMazeRandomizedKruskal 创建随机Kruskal迷宫生成算法实例
+func NewMazeRandomizedKruskal() *MazeRandomizedKruskal {
+	return &MazeRandomizedKruskal{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "maze_kruskal",
+			Name:            "随机Kruskal迷宫生成",
+			Category:        models.CategoryGraph,
+			Description:     "每个房间初始各成一个集合，按随机顺序考察内墙：两侧房间属于不同集合时打通这堵墙并用并查集合并，否则跳过以免成环。输入为房间的行列数（n、[rows, cols] 或 {\"rows\": r, \"cols\": c}），输出 (2rows+1)×(2cols+1) 的矩阵，1 为墙、0 为通路，可直接作为网格寻路的地图。",
+			TimeComplexity:  "O(n α(n))",
+			SpaceComplexity: "O(n)",
+			Parameters: []models.Parameter{
+				seedParameter(),
+			},
+		},
+	}
+}
+
+// Execute 使用默认参数生成迷宫
+func (mg *MazeRandomizedKruskal) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return mg.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 按参数生成迷宫
+func (mg *MazeRandomizedKruskal) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	return runMaze(MazeKruskal, data, params, tracker)
+}
+
+// ValidateInput 验证输入数据
+func (mg *MazeRandomizedKruskal) ValidateInput(data interface{}) error {
+	return validateMazeSize(data)
+}
+
+// GetComplexity 获取复杂度信息
+func (mg *MazeRandomizedKruskal) GetComplexity() algorithms.ComplexityInfo {
+	return mazeComplexity()
+}
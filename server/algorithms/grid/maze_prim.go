@@ -0,0 +1,48 @@
+package grid
+
+import (
+	"gin/algorithms"
+	"gin/models"
+)
+
+// MazeRandomizedPrim 随机Prim迷宫生成
+type MazeRandomizedPrim struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewMazeRandomizedPrim 创建随机Prim迷宫生成算法实例
+func NewMazeRandomizedPrim() *MazeRandomizedPrim {
+	return &MazeRandomizedPrim{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "maze_prim",
+			Name:            "随机Prim迷宫生成",
+			Category:        models.CategoryGraph,
+			Description:     "维护与已生成区域相邻的边界房间，每次随机取出一个边界房间，与一个已在迷宫中的相邻房间打通。相当于在随机权重的网格图上求最小生成树，生成的迷宫岔路多、死胡同短。输入为房间的行列数（n、[rows, cols] 或 {\"rows\": r, \"cols\": c}），输出 (2rows+1)×(2cols+1) 的矩阵，1 为墙、0 为通路，可直接作为网格寻路的地图。",
+			TimeComplexity:  "O(n)",
+			SpaceComplexity: "O(n)",
+			Parameters: []models.Parameter{
+				seedParameter(),
+			},
+		},
+	}
+}
+
+// Execute 使用默认参数生成迷宫
+func (mg *MazeRandomizedPrim) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return mg.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 按参数生成迷宫
+func (mg *MazeRandomizedPrim) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	return runMaze(MazePrim, data, params, tracker)
+}
+
+// ValidateInput 验证输入数据
+func (mg *MazeRandomizedPrim) ValidateInput(data interface{}) error {
+	return validateMazeSize(data)
+}
+
+// GetComplexity 获取复杂度信息
+func (mg *MazeRandomizedPrim) GetComplexity() algorithms.ComplexityInfo {
+	return mazeComplexity()
+}
@@ -0,0 +1,100 @@
+package grid
+
+import (
+	"gin/models"
+	"reflect"
+	"testing"
+)
+
+func TestMazeGenerators_PerfectMaze(t *testing.T) {
+	generators := map[string]interface {
+		ExecuteWithParams(interface{}, map[string]interface{}, models.StepTracker) (interface{}, error)
+	}{
+		MazeBacktracker: NewMazeRecursiveBacktracker(),
+		MazePrim:        NewMazeRandomizedPrim(),
+		MazeKruskal:     NewMazeRandomizedKruskal(),
+	}
+
+	sizes := [][2]int{{1, 1}, {1, 7}, {5, 5}, {8, 13}, {31, 31}}
+	for method, generator := range generators {
+		for _, size := range sizes {
+			data := []interface{}{float64(size[0]), float64(size[1])}
+			params := map[string]interface{}{"seed": float64(38)}
+			tracker := models.NewStepTracker()
+			result, err := generator.ExecuteWithParams(data, params, tracker)
+			if err != nil {
+				t.Fatalf("%s %v: error = %v", method, size, err)
+			}
+			output := result.(map[string]interface{})
+			maze := output["maze"].(*models.MatrixData)
+			rooms := size[0] * size[1]
+
+			if maze.Rows != 2*size[0]+1 || maze.Cols != 2*size[1]+1 {
+				t.Fatalf("%s %v: maze is %dx%d", method, size, maze.Rows, maze.Cols)
+			}
+			// 完美迷宫是房间的生成树：恰好打通 rooms-1 堵墙，且所有通路连通
+			if output["passages"] != rooms-1 {
+				t.Errorf("%s %v: passages = %v, expected %d", method, size, output["passages"], rooms-1)
+			}
+			g, err := newGrid(maze)
+			if err != nil {
+				t.Fatalf("%s %v: invalid maze: %v", method, size, err)
+			}
+			open := 0
+			for r := 0; r < g.rows; r++ {
+				for c := 0; c < g.cols; c++ {
+					border := r == 0 || c == 0 || r == g.rows-1 || c == g.cols-1
+					if border && g.open(r, c) {
+						t.Fatalf("%s %v: border cell (%d,%d) is open", method, size, r, c)
+					}
+					if g.open(r, c) {
+						open++
+					}
+				}
+			}
+			if open != 2*rooms-1 {
+				t.Errorf("%s %v: %d open cells, expected %d", method, size, open, 2*rooms-1)
+			}
+
+			// 迷宫可以直接作为网格寻路的输入，BFS 的步数与统计的路径长度一致
+			search, err := NewGridBFS().Execute(maze, models.NewStepTracker())
+			if err != nil {
+				t.Fatalf("%s %v: BFS error = %v", method, size, err)
+			}
+			found := search.(map[string]interface{})
+			if found["found"] != true || found["length"] != output["solutionLength"] {
+				t.Errorf("%s %v: BFS length = %v, solutionLength = %v", method, size, found["length"], output["solutionLength"])
+			}
+			if found["visited"].(int) > open {
+				t.Errorf("%s %v: BFS visited %v of %d open cells", method, size, found["visited"], open)
+			}
+
+			for _, step := range tracker.GetSteps() {
+				state := step.Data.(*mazeState)
+				if len(state.Layer) != maze.Rows || len(state.Layer[0]) != maze.Cols {
+					t.Fatalf("%s %v: step %d layer has wrong size", method, size, step.StepID)
+				}
+			}
+		}
+	}
+}
+
+func TestMazeGenerators_Seed(t *testing.T) {
+	params := map[string]interface{}{"seed": float64(7)}
+	data := map[string]interface{}{"rows": float64(6), "cols": float64(9)}
+
+	first, err := NewMazeRandomizedPrim().ExecuteWithParams(data, params, models.NewStepTracker())
+	if err != nil {
+		t.Fatalf("ExecuteWithParams() error = %v", err)
+	}
+	second, _ := NewMazeRandomizedPrim().ExecuteWithParams(data, params, models.NewStepTracker())
+	if !reflect.DeepEqual(first.(map[string]interface{})["maze"], second.(map[string]interface{})["maze"]) {
+		t.Error("same seed generated different mazes")
+	}
+
+	for _, invalid := range []interface{}{float64(0), float64(32), "5", []interface{}{float64(3)}} {
+		if err := NewMazeRandomizedKruskal().ValidateInput(invalid); err == nil {
+			t.Errorf("expected validation error for %v", invalid)
+		}
+	}
+}
@@ -36,6 +36,24 @@ type ComplexityCase struct {
 	Worst   string `json:"worst"`   // 最坏情况
 }
 
+// ParameterizedAlgorithm 支持运行参数的算法接口
+// 实现该接口的算法会收到请求中的 parameters，Execute 等价于使用默认参数执行
+type ParameterizedAlgorithm interface {
+	Algorithm
+
+	// ExecuteWithParams 按参数执行算法
+	ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error)
+}
+
+// ConcurrentAlgorithm 使用多个goroutine并发执行的算法接口
+// 服务层会为其创建并发安全的步骤追踪器，使每个步骤带有工作者ID
+type ConcurrentAlgorithm interface {
+	Algorithm
+
+	// IsConcurrent 是否并发执行
+	IsConcurrent() bool
+}
+
 // SortingAlgorithm 排序算法接口
 type SortingAlgorithm interface {
 	Algorithm
@@ -86,6 +104,30 @@ type TreeAlgorithm interface {
 	GetTreeType() string // "binary", "n-ary", "both"
 }
 
+// PointSetAlgorithm 点集算法接口
+type PointSetAlgorithm interface {
+	Algorithm
+
+	// ProcessPoints 处理二维点集
+	ProcessPoints(points *models.PointSetData, tracker models.StepTracker) (interface{}, error)
+}
+
+// MatrixAlgorithm 矩阵算法接口
+type MatrixAlgorithm interface {
+	Algorithm
+
+	// ProcessMatrices 处理矩阵操作数
+	ProcessMatrices(matrices []*models.MatrixData, tracker models.StepTracker) (interface{}, error)
+}
+
+// SchedulingAlgorithm 进程调度算法接口
+type SchedulingAlgorithm interface {
+	Algorithm
+
+	// Schedule 调度进程集合
+	Schedule(processes *models.ProcessData, tracker models.StepTracker) (interface{}, error)
+}
+
 // BaseAlgorithm 基础算法结构
 type BaseAlgorithm struct {
 	ID              string             `json:"id"`
@@ -172,6 +214,12 @@ func (r *AlgorithmRegistry) GetByCategory(category string) []Algorithm {
 	return algorithms
 }
 
+// DetailedError 携带结构化信息的错误，接口层会把 Details 随错误消息一起返回
+type DetailedError interface {
+	error
+	Details() interface{}
+}
+
 // 错误定义
 var (
 	ErrInvalidInput     = errors.New("输入数据无效")
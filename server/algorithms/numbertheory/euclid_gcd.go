@@ -0,0 +1,139 @@
+package numbertheory
+
+import (
+	"errors"
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"math/big"
+)
+
+// maxGCDOperands 最大公约数输入的整数个数上限
+const maxGCDOperands = 1000
+
+// EuclidGCD 欧几里得算法求最大公约数
+type EuclidGCD struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewEuclidGCD 创建欧几里得算法实例
+func NewEuclidGCD() *EuclidGCD {
+	return &EuclidGCD{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "gcd",
+			Name:            "欧几里得算法 (GCD)",
+			Category:        models.CategoryMath,
+			Description:     "辗转相除：gcd(a, b) = gcd(b, a mod b)，直到余数为0。输入为两个或多个整数组成的数组，多个数时依次合并 gcd(gcd(a1, a2), a3)…；大整数以字符串传入。除法次数不超过较小数十进制位数的5倍（Lamé 定理）。",
+			TimeComplexity:  "O(log min(a,b))",
+			SpaceComplexity: "O(1)",
+			Parameters:      []models.Parameter{},
+		},
+	}
+}
+
+// euclidState 欧几里得算法的步骤快照
+type euclidState struct {
+	Index     int    `json:"index"`               // 正在合并的输入下标
+	A         string `json:"a"`                   // 被除数
+	B         string `json:"b"`                   // 除数
+	Quotient  string `json:"quotient,omitempty"`  // 商
+	Remainder string `json:"remainder,omitempty"` // 余数
+	GCD       string `json:"gcd,omitempty"`       // 已合并部分的最大公约数
+}
+
+// Execute 执行欧几里得算法
+func (e *EuclidGCD) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	nums, err := parseGCDOperands(data)
+	if err != nil {
+		return nil, err
+	}
+
+	tracker.SetPhase("初始化")
+	g := new(big.Int).Abs(nums[0])
+	tracker.AddStep(fmt.Sprintf("共 %d 个整数，从 |%s| = %s 开始依次合并", len(nums), nums[0].String(), g.String()),
+		euclidState{Index: 0, A: g.String(), B: "0", GCD: g.String()}, []int{0})
+
+	divisions := 0
+	lcm := new(big.Int).Set(g)
+	for k := 1; k < len(nums); k++ {
+		tracker.SetPhase(fmt.Sprintf("合并第 %d 个数", k+1))
+		a, b := new(big.Int).Set(g), new(big.Int).Abs(nums[k])
+		tracker.AddStep(fmt.Sprintf("计算 gcd(%s, %s)", a.String(), b.String()),
+			euclidState{Index: k, A: a.String(), B: b.String(), GCD: g.String()}, []int{k})
+
+		for b.Sign() != 0 {
+			q, r := new(big.Int).QuoRem(a, b, new(big.Int))
+			divisions++
+			tracker.AddStep(fmt.Sprintf("%s = %s × %s + %s", a.String(), q.String(), b.String(), r.String()),
+				euclidState{Index: k, A: a.String(), B: b.String(), Quotient: q.String(), Remainder: r.String(), GCD: g.String()}, []int{k})
+			a, b = b, r
+		}
+		g = a
+
+		// lcm(x, y) = x / gcd(x, y) · y，任一数为0时最小公倍数为0
+		if x := new(big.Int).Abs(nums[k]); lcm.Sign() != 0 && x.Sign() != 0 {
+			lcm.Quo(lcm, new(big.Int).GCD(nil, nil, lcm, x))
+			lcm.Mul(lcm, x)
+		} else {
+			lcm.SetInt64(0)
+		}
+		tracker.AddStep(fmt.Sprintf("余数为0，前 %d 个数的最大公约数为 %s", k+1, g.String()),
+			euclidState{Index: k, A: a.String(), B: "0", GCD: g.String()}, []int{k})
+		tracker.AddOperation(models.OpTypeUpdate, []int{k}, []interface{}{g.String()}, "更新最大公约数")
+	}
+
+	// 与标准库结果交叉验证
+	expected := new(big.Int).Abs(nums[0])
+	for _, n := range nums[1:] {
+		expected.GCD(nil, nil, expected, new(big.Int).Abs(n))
+	}
+
+	tracker.SetPhase("完成")
+	tracker.AddStep(fmt.Sprintf("最大公约数为 %s，共 %d 次带余除法", g.String(), divisions),
+		euclidState{Index: len(nums) - 1, A: g.String(), B: "0", GCD: g.String()}, []int{})
+
+	return map[string]interface{}{
+		"gcd":       g.String(),
+		"lcm":       lcm.String(),
+		"coprime":   g.Cmp(big.NewInt(1)) == 0,
+		"divisions": divisions,
+		"verified":  g.Cmp(expected) == 0,
+	}, nil
+}
+
+// parseGCDOperands 解析两个或多个整数
+func parseGCDOperands(data interface{}) ([]*big.Int, error) {
+	nums, err := parseIntegerList(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(nums) < 2 {
+		return nil, errors.New("至少需要两个整数")
+	}
+	if len(nums) > maxGCDOperands {
+		return nil, fmt.Errorf("整数个数不能超过%d", maxGCDOperands)
+	}
+	return nums, nil
+}
+
+// ValidateInput 验证输入数据
+func (e *EuclidGCD) ValidateInput(data interface{}) error {
+	_, err := parseGCDOperands(data)
+	return err
+}
+
+// GetComplexity 获取复杂度信息
+func (e *EuclidGCD) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(1)",
+			Average: "O(log min(a,b))",
+			Worst:   "O(log min(a,b))", // 相邻斐波那契数
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(1)",
+			Average: "O(1)",
+			Worst:   "O(1)",
+		},
+	}
+}
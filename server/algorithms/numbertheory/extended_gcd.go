@@ -0,0 +1,171 @@
+package numbertheory
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"math/big"
+	"strings"
+)
+
+// ExtendedGCD 扩展欧几里得算法
+type ExtendedGCD struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewExtendedGCD 创建扩展欧几里得算法实例
+func NewExtendedGCD() *ExtendedGCD {
+	return &ExtendedGCD{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "extended_gcd",
+			Name:            "扩展欧几里得算法",
+			Category:        models.CategoryMath,
+			Description:     "在辗转相除的同时维护系数 s、t，使每个余数都满足 r = s·a + t·b，余数为0时上一行即给出裴蜀等式 gcd(a, b) = x·a + y·b。两个数互素时 x 就是 a 模 b 的乘法逆元。多个数时依次合并并缩放之前的系数。",
+			TimeComplexity:  "O(log min(a,b))",
+			SpaceComplexity: "O(1)",
+			Parameters:      []models.Parameter{},
+		},
+	}
+}
+
+// bezoutRow 扩展欧几里得表中的一行：r = s·a + t·b
+type bezoutRow struct {
+	Quotient  string `json:"quotient,omitempty"` // 得到本行时使用的商
+	Remainder string `json:"remainder"`
+	S         string `json:"s"`
+	T         string `json:"t"`
+}
+
+// extendedGCDState 扩展欧几里得算法的步骤快照，只保留递推需要的最后两行
+type extendedGCDState struct {
+	Index    int       `json:"index"` // 正在合并的输入下标
+	A        string    `json:"a"`
+	B        string    `json:"b"`
+	Previous bezoutRow `json:"previous"`
+	Current  bezoutRow `json:"current"`
+}
+
+// Execute 执行扩展欧几里得算法
+func (e *ExtendedGCD) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	nums, err := parseGCDOperands(data)
+	if err != nil {
+		return nil, err
+	}
+
+	// coeffs[i] 为第 i 个数在裴蜀等式中的系数，按绝对值计算，最后再修正符号
+	coeffs := make([]*big.Int, len(nums))
+	coeffs[0] = big.NewInt(1)
+	g := new(big.Int).Abs(nums[0])
+
+	tracker.SetPhase("初始化")
+	tracker.AddStep(fmt.Sprintf("共 %d 个整数，从 %s = 1·%s 开始依次合并", len(nums), g.String(), g.String()),
+		extendedGCDState{Index: 0, A: g.String(), B: "0",
+			Previous: bezoutRow{Remainder: g.String(), S: "1", T: "0"}, Current: bezoutRow{Remainder: "0", S: "0", T: "1"}}, []int{0})
+
+	divisions := 0
+	var rows []bezoutRow
+	for k := 1; k < len(nums); k++ {
+		tracker.SetPhase(fmt.Sprintf("合并第 %d 个数", k+1))
+		a, b := new(big.Int).Set(g), new(big.Int).Abs(nums[k])
+		r0, r1 := new(big.Int).Set(a), new(big.Int).Set(b)
+		s0, s1 := big.NewInt(1), big.NewInt(0)
+		t0, t1 := big.NewInt(0), big.NewInt(1)
+
+		rows = []bezoutRow{newBezoutRow(nil, r0, s0, t0), newBezoutRow(nil, r1, s1, t1)}
+		tracker.AddStep(fmt.Sprintf("求 gcd(%s, %s)：初始两行 %s = 1·a + 0·b，%s = 0·a + 1·b", a.String(), b.String(), r0.String(), r1.String()),
+			extendedGCDState{Index: k, A: a.String(), B: b.String(), Previous: rows[0], Current: rows[1]}, []int{k})
+
+		for r1.Sign() != 0 {
+			q, r := new(big.Int).QuoRem(r0, r1, new(big.Int))
+			s := new(big.Int).Sub(s0, new(big.Int).Mul(q, s1))
+			t := new(big.Int).Sub(t0, new(big.Int).Mul(q, t1))
+			divisions++
+
+			row := newBezoutRow(q, r, s, t)
+			rows = append(rows, row)
+			tracker.AddStep(fmt.Sprintf("q = %s，r = %s - %s×%s = %s，s = %s，t = %s",
+				q.String(), r0.String(), q.String(), r1.String(), r.String(), s.String(), t.String()),
+				extendedGCDState{Index: k, A: a.String(), B: b.String(), Previous: rows[len(rows)-2], Current: row}, []int{k})
+			tracker.AddOperation(models.OpTypeAssign, []int{k}, []interface{}{r.String(), s.String(), t.String()}, "r = s·a + t·b")
+
+			r0, r1 = r1, r
+			s0, s1 = s1, s
+			t0, t1 = t1, t
+		}
+
+		// 新的最大公约数 r0 = s0·g + t0·|nums[k]|，之前的系数整体乘以 s0
+		for i := 0; i < k; i++ {
+			coeffs[i].Mul(coeffs[i], s0)
+		}
+		coeffs[k] = new(big.Int).Set(t0)
+		g = r0
+
+		tracker.AddStep(fmt.Sprintf("余数为0，%s = %s·%s + %s·%s", g.String(), s0.String(), a.String(), t0.String(), b.String()),
+			extendedGCDState{Index: k, A: a.String(), B: b.String(), Previous: rows[len(rows)-2], Current: rows[len(rows)-1]}, []int{k})
+		tracker.AddOperation(models.OpTypeUpdate, []int{k}, []interface{}{g.String()}, "更新最大公约数和系数")
+	}
+
+	// 负数的系数取反，使等式对原始输入成立
+	sum := new(big.Int)
+	terms := make([]string, len(nums))
+	coefficients := make([]string, len(nums))
+	for i, n := range nums {
+		if n.Sign() < 0 {
+			coeffs[i].Neg(coeffs[i])
+		}
+		sum.Add(sum, new(big.Int).Mul(coeffs[i], n))
+		coefficients[i] = coeffs[i].String()
+		terms[i] = fmt.Sprintf("(%s)·(%s)", coeffs[i].String(), n.String())
+	}
+
+	tracker.SetPhase("完成")
+	tracker.AddStep(fmt.Sprintf("裴蜀等式：%s = %s", g.String(), strings.Join(terms, " + ")),
+		extendedGCDState{Index: len(nums) - 1, A: nums[0].String(), B: nums[len(nums)-1].String(),
+			Previous: rows[len(rows)-2], Current: rows[len(rows)-1]}, []int{})
+
+	result := map[string]interface{}{
+		"gcd":          g.String(),
+		"coefficients": coefficients,
+		"divisions":    divisions,
+		"verified":     sum.Cmp(g) == 0,
+	}
+	if len(nums) == 2 {
+		// 两个数时给出完整的扩展欧几里得表，互素时给出 a 模 |b| 的逆元
+		result["table"] = rows
+		if m := new(big.Int).Abs(nums[1]); g.Cmp(big.NewInt(1)) == 0 && m.Cmp(big.NewInt(1)) > 0 {
+			result["inverse"] = new(big.Int).Mod(coeffs[0], m).String()
+		}
+	}
+	return result, nil
+}
+
+// newBezoutRow 构造扩展欧几里得表的一行
+func newBezoutRow(q, r, s, t *big.Int) bezoutRow {
+	row := bezoutRow{Remainder: r.String(), S: s.String(), T: t.String()}
+	if q != nil {
+		row.Quotient = q.String()
+	}
+	return row
+}
+
+// ValidateInput 验证输入数据
+func (e *ExtendedGCD) ValidateInput(data interface{}) error {
+	_, err := parseGCDOperands(data)
+	return err
+}
+
+// GetComplexity 获取复杂度信息
+func (e *ExtendedGCD) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(1)",
+			Average: "O(log min(a,b))",
+			Worst:   "O(log min(a,b))",
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(1)",
+			Average: "O(1)",
+			Worst:   "O(1)",
+		},
+	}
+}
@@ -0,0 +1,95 @@
+package numbertheory
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"gin/algorithms"
+	"math"
+	"math/big"
+	"strings"
+)
+
+// maxIntegerDigits 大整数输入的十进制位数上限
+const maxIntegerDigits = 2000
+
+// parseBigInt 将输入解析为大整数，支持十进制数字串和JSON数值
+func parseBigInt(value interface{}) (*big.Int, error) {
+	switch v := value.(type) {
+	case string:
+		s := strings.TrimPrefix(strings.TrimSpace(v), "+")
+		s = strings.ReplaceAll(s, "_", "")
+		if len(strings.TrimPrefix(s, "-")) > maxIntegerDigits {
+			return nil, fmt.Errorf("整数位数不能超过%d", maxIntegerDigits)
+		}
+		n, ok := new(big.Int).SetString(s, 10)
+		if !ok {
+			return nil, fmt.Errorf("无效的整数字符串: %q", v)
+		}
+		return n, nil
+	case float64:
+		// JSON数值只在 2^53 以内能精确表示整数，更大的数应以字符串传入
+		if v != math.Trunc(v) || math.Abs(v) > 1<<53 {
+			return nil, fmt.Errorf("%v 不是可精确表示的整数，大整数请以字符串传入", v)
+		}
+		return big.NewInt(int64(v)), nil
+	case int:
+		return big.NewInt(int64(v)), nil
+	case int64:
+		return big.NewInt(v), nil
+	case json.Number:
+		return parseBigInt(v.String())
+	case *big.Int:
+		return new(big.Int).Set(v), nil
+	}
+	return nil, algorithms.ErrInvalidInput
+}
+
+// parseIntegerList 解析整数或整数数组
+func parseIntegerList(data interface{}) ([]*big.Int, error) {
+	values, ok := data.([]interface{})
+	if !ok {
+		values = []interface{}{data}
+	}
+	if len(values) == 0 {
+		return nil, errors.New("至少需要一个整数")
+	}
+	result := make([]*big.Int, len(values))
+	for i, v := range values {
+		n, err := parseBigInt(v)
+		if err != nil {
+			return nil, fmt.Errorf("第%d个整数: %v", i+1, err)
+		}
+		result[i] = n
+	}
+	return result, nil
+}
+
+// parseNamedIntegers 解析固定个数的整数：可以是数组，也可以是按名称给出的对象
+func parseNamedIntegers(data interface{}, names ...string) ([]*big.Int, error) {
+	if m, ok := data.(map[string]interface{}); ok {
+		values := make([]interface{}, len(names))
+		for i, name := range names {
+			v, exists := m[name]
+			if !exists {
+				return nil, fmt.Errorf("缺少字段 %s", name)
+			}
+			values[i] = v
+		}
+		data = values
+	}
+
+	values, ok := data.([]interface{})
+	if !ok || len(values) != len(names) {
+		return nil, fmt.Errorf("需要 %d 个整数: %s", len(names), strings.Join(names, ", "))
+	}
+	return parseIntegerList(values)
+}
+
+// smallInt 将大整数转换为 int，超出 [min, max] 时报错
+func smallInt(n *big.Int, min, max int64, name string) (int, error) {
+	if !n.IsInt64() || n.Int64() < min || n.Int64() > max {
+		return 0, fmt.Errorf("%s 必须在%d到%d之间", name, min, max)
+	}
+	return int(n.Int64()), nil
+}
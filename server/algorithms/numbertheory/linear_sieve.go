@@ -0,0 +1,103 @@
+package numbertheory
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+)
+
+// LinearSieve 线性筛（欧拉筛）
+type LinearSieve struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewLinearSieve 创建线性筛实例
+func NewLinearSieve() *LinearSieve {
+	return &LinearSieve{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "linear_sieve",
+			Name:            "线性筛（欧拉筛）",
+			Category:        models.CategoryMath,
+			Description:     "依次处理每个 i，未被划掉则记为素数；再用不超过 i 的最小素因子的每个素数 p 划掉 i·p。每个合数只会被它的最小素因子划掉一次，划掉次数恰好等于合数个数，同时得到每个数的最小素因子。步骤中只记录本步划掉的数，状态串只在首尾步骤给出。输入与埃拉托斯特尼筛法相同。",
+			TimeComplexity:  "O(n)",
+			SpaceComplexity: "O(n)",
+			Parameters:      []models.Parameter{},
+		},
+	}
+}
+
+// Execute 执行线性筛
+func (l *LinearSieve) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	in, err := parseSieveInput(data)
+	if err != nil {
+		return nil, err
+	}
+	n := in.n
+	marks := newSieveMarks(n)
+	spf := make([]int, n+1) // 最小素因子，0 和 1 为0
+
+	tracker.SetPhase("初始化")
+	tracker.AddStep(fmt.Sprintf("筛出 %d 以内的素数，0 和 1 不是素数", n),
+		sieveState{N: n, Crossed: []int{}, Marks: string(marks)}, []int{})
+
+	tracker.SetPhase("筛选")
+	primes := make([]int, 0)
+	crossings := 0
+	for i := 2; i <= n; i++ {
+		if marks[i] == markUnknown {
+			marks[i] = markPrime
+			spf[i] = i
+			primes = append(primes, i)
+		}
+		if 2*i > n {
+			continue // 之后不会再划掉任何数，剩余的数在最后一步统一确认
+		}
+
+		crossed := make([]int, 0)
+		for _, p := range primes {
+			if p > spf[i] || i*p > n {
+				break
+			}
+			marks[i*p] = markComposite
+			spf[i*p] = p
+			crossed = append(crossed, i*p)
+			crossings++
+		}
+
+		description := fmt.Sprintf("%d 是素数", i)
+		if spf[i] != i {
+			description = fmt.Sprintf("%d 是合数，最小素因子为 %d", i, spf[i])
+		}
+		tracker.AddStep(fmt.Sprintf("%s，用不超过 %d 的素数划掉 %d 个数", description, spf[i], len(crossed)),
+			sieveState{N: n, Current: i, Crossed: crossed, Primes: len(primes)}, append([]int{i}, crossed...))
+		if len(crossed) > 0 {
+			tracker.AddOperation(models.OpTypeUpdate, crossed, []interface{}{i}, fmt.Sprintf("划掉 %d 与素数的乘积", i))
+		}
+	}
+
+	result := sieveResult(in, marks, crossings, tracker)
+	result["smallestPrimeFactor"] = spf
+	return result, nil
+}
+
+// ValidateInput 验证输入数据
+func (l *LinearSieve) ValidateInput(data interface{}) error {
+	_, err := parseSieveInput(data)
+	return err
+}
+
+// GetComplexity 获取复杂度信息
+func (l *LinearSieve) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(n)",
+			Average: "O(n)",
+			Worst:   "O(n)",
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(n)",
+			Average: "O(n)",
+			Worst:   "O(n)",
+		},
+	}
+}
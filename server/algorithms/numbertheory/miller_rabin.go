@@ -0,0 +1,282 @@
+package numbertheory
+
+import (
+	"errors"
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"math/big"
+	"math/rand"
+	"time"
+)
+
+// maxPrimalityInputs 一次判断的整数个数上限
+const maxPrimalityInputs = 10000
+
+// primeBases 固定使用的素数底
+var primeBases = []int64{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37, 41}
+
+// deterministicBounds[k-1] 为只用前 k 个素数底即可确定判断的上界（不含）
+var deterministicBounds = []string{
+	"2047",
+	"1373653",
+	"25326001",
+	"3215031751",
+	"2152302898747",
+	"3474749660383",
+	"341550071728321",
+	"341550071728321",
+	"3825123056546413051",
+	"3825123056546413051",
+	"3825123056546413051",
+	"318665857834031151167461",
+	"3317044064679887385961981",
+}
+
+// MillerRabin Miller-Rabin素性测试
+type MillerRabin struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewMillerRabin 创建Miller-Rabin素性测试实例
+func NewMillerRabin() *MillerRabin {
+	return &MillerRabin{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "miller_rabin",
+			Name:            "Miller-Rabin素性测试",
+			Category:        models.CategoryMath,
+			Description:     "将 n-1 写成 d·2^s，对每个底 a 计算 a^d mod n 并连续平方 s-1 次：若序列从1开始或中途出现 n-1 则 a 不是证据，否则 a 证明 n 是合数。先用前13个素数作底，n < 3.3×10²⁴ 时结果是确定的；更多轮次使用随机底，每轮把误判概率降到原来的1/4以下。输入为单个整数或整数数组，大整数以字符串传入。",
+			TimeComplexity:  "O(k log³ n)",
+			SpaceComplexity: "O(log n)",
+			Parameters: []models.Parameter{
+				{
+					Name:         "rounds",
+					Type:         "int",
+					Description:  "测试的底数个数，前13个为固定的素数底，其余为随机底",
+					DefaultValue: len(primeBases),
+					Required:     false,
+					Min:          1,
+					Max:          64,
+				},
+				{
+					Name:         "seed",
+					Type:         "int",
+					Description:  "随机底的种子，相同的种子选出相同的底；为0时使用当前时间",
+					DefaultValue: 0,
+					Required:     false,
+				},
+			},
+		},
+	}
+}
+
+// millerRabinState Miller-Rabin的步骤快照
+type millerRabinState struct {
+	Index   int      `json:"index"`             // 正在判断的输入下标
+	N       string   `json:"n"`                 // 被测数
+	D       string   `json:"d,omitempty"`       // n-1 = d·2^s 中的奇数部分
+	S       int      `json:"s"`                 // n-1 = d·2^s 中的指数
+	Base    string   `json:"base,omitempty"`    // 当前的底
+	Chain   []string `json:"chain,omitempty"`   // a^d, a^(2d), … mod n
+	Verdict string   `json:"verdict,omitempty"` // 当前结论：pass、composite 或 prime
+}
+
+// Execute 使用默认参数执行Miller-Rabin素性测试
+func (m *MillerRabin) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return m.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 按参数执行Miller-Rabin素性测试
+func (m *MillerRabin) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	nums, err := parsePrimalityInput(data)
+	if err != nil {
+		return nil, err
+	}
+	rounds := algorithms.IntParam(params, "rounds", len(primeBases))
+	if rounds < 1 || rounds > 64 {
+		return nil, errors.New("rounds 必须在1到64之间")
+	}
+	seed := int64(algorithms.IntParam(params, "seed", 0))
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	rng := rand.New(rand.NewSource(seed))
+
+	results := make([]map[string]interface{}, len(nums))
+	primeCount := 0
+	verified := true
+	for i, n := range nums {
+		tracker.SetPhase(fmt.Sprintf("测试第 %d 个数", i+1))
+		results[i] = millerRabin(i, n, rounds, rng, tracker)
+		if results[i]["probablePrime"].(bool) {
+			primeCount++
+		}
+		verified = verified && results[i]["verified"].(bool)
+	}
+
+	tracker.SetPhase("完成")
+	if _, isArray := data.([]interface{}); !isArray {
+		tracker.AddStep(fmt.Sprintf("%s %s", nums[0].String(), verdictText(results[0])),
+			millerRabinState{N: nums[0].String(), Verdict: verdictOf(results[0])}, []int{})
+		results[0]["seed"] = seed
+		return results[0], nil
+	}
+
+	primeIndices := make([]int, 0, primeCount)
+	for i, r := range results {
+		if r["probablePrime"].(bool) {
+			primeIndices = append(primeIndices, i)
+		}
+	}
+	tracker.AddStep(fmt.Sprintf("%d 个数中有 %d 个（可能）是素数", len(nums), primeCount),
+		millerRabinState{Index: len(nums) - 1, N: nums[len(nums)-1].String()}, primeIndices)
+	return map[string]interface{}{
+		"results":    results,
+		"primeCount": primeCount,
+		"verified":   verified,
+		"seed":       seed,
+	}, nil
+}
+
+// millerRabin 判断单个整数，步骤高亮为输入下标
+func millerRabin(index int, n *big.Int, rounds int, rng *rand.Rand, tracker models.StepTracker) map[string]interface{} {
+	ns := n.String()
+	expected := n.ProbablyPrime(20) // 标准库的判断，用于交叉验证
+	result := map[string]interface{}{
+		"n":             ns,
+		"probablePrime": false,
+		"certain":       true,
+		"bases":         []string{},
+		"verified":      !expected,
+	}
+	conclude := func(prime bool, verdict, description string) map[string]interface{} {
+		result["probablePrime"] = prime
+		result["verified"] = prime == expected
+		tracker.AddStep(description, millerRabinState{Index: index, N: ns, Verdict: verdict}, []int{index})
+		return result
+	}
+
+	// 小于2的数和小素数的倍数不需要进入测试
+	if n.Cmp(big.NewInt(2)) < 0 {
+		return conclude(false, "composite", fmt.Sprintf("%s 小于2，不是素数", ns))
+	}
+	for _, p := range primeBases {
+		bp := big.NewInt(p)
+		if n.Cmp(bp) == 0 {
+			return conclude(true, "prime", fmt.Sprintf("%s 是小素数", ns))
+		}
+		if new(big.Int).Mod(n, bp).Sign() == 0 {
+			result["witness"] = bp.String()
+			return conclude(false, "composite", fmt.Sprintf("%s 能被 %d 整除，是合数", ns, p))
+		}
+	}
+
+	// n-1 = d·2^s
+	nMinusOne := new(big.Int).Sub(n, big.NewInt(1))
+	s := int(nMinusOne.TrailingZeroBits())
+	d := new(big.Int).Rsh(nMinusOne, uint(s))
+	result["d"], result["s"] = d.String(), s
+	tracker.AddStep(fmt.Sprintf("%s - 1 = %s × 2^%d", ns, d.String(), s),
+		millerRabinState{Index: index, N: ns, D: d.String(), S: s}, []int{index})
+
+	bases := make([]*big.Int, 0, rounds)
+	for k := 0; k < rounds && k < len(primeBases); k++ {
+		bases = append(bases, big.NewInt(primeBases[k]))
+	}
+	span := new(big.Int).Sub(n, big.NewInt(3)) // 随机底取自 [2, n-2]
+	for len(bases) < rounds {
+		bases = append(bases, new(big.Int).Add(new(big.Int).Rand(rng, span), big.NewInt(2)))
+	}
+
+	tested := make([]string, 0, len(bases))
+	for _, a := range bases {
+		tested = append(tested, a.String())
+		result["bases"] = tested
+
+		x := new(big.Int).Exp(a, d, n)
+		chain := []string{x.String()}
+		pass := x.Cmp(big.NewInt(1)) == 0 || x.Cmp(nMinusOne) == 0
+		for r := 1; r < s && !pass; r++ {
+			x.Mul(x, x).Mod(x, n)
+			chain = append(chain, x.String())
+			if x.Cmp(nMinusOne) == 0 {
+				pass = true
+			} else if x.Cmp(big.NewInt(1)) == 0 {
+				break // 1 的非平凡平方根，n 必为合数
+			}
+		}
+
+		state := millerRabinState{Index: index, N: ns, D: d.String(), S: s, Base: a.String(), Chain: chain, Verdict: "pass"}
+		if !pass {
+			state.Verdict = "composite"
+			result["witness"] = a.String()
+			tracker.AddStep(fmt.Sprintf("底 %s：序列 %v 既不以1开始也没有出现 n-1，%s 是合数", a.String(), chain, ns), state, []int{index})
+			tracker.AddOperation(models.OpTypeCompare, []int{index}, []interface{}{a.String()}, "找到合数证据")
+			return result
+		}
+		tracker.AddStep(fmt.Sprintf("底 %s：序列 %v，不是合数证据", a.String(), chain), state, []int{index})
+	}
+
+	// 只有使用了足够多的素数底且 n 低于对应上界时，结论才是确定的
+	primeRounds := rounds
+	if primeRounds > len(primeBases) {
+		primeRounds = len(primeBases)
+	}
+	bound, _ := new(big.Int).SetString(deterministicBounds[primeRounds-1], 10)
+	result["certain"] = n.Cmp(bound) < 0
+	result["probablePrime"] = true
+	return conclude(true, "prime", fmt.Sprintf("%d 个底都不是合数证据，%s %s", len(bases), ns, verdictText(result)))
+}
+
+// verdictOf 结果对应的结论
+func verdictOf(result map[string]interface{}) string {
+	if result["probablePrime"].(bool) {
+		return "prime"
+	}
+	return "composite"
+}
+
+// verdictText 结果的文字描述
+func verdictText(result map[string]interface{}) string {
+	switch {
+	case !result["probablePrime"].(bool):
+		return "是合数"
+	case result["certain"].(bool):
+		return "是素数"
+	}
+	return "可能是素数"
+}
+
+// parsePrimalityInput 解析单个整数或整数数组
+func parsePrimalityInput(data interface{}) ([]*big.Int, error) {
+	nums, err := parseIntegerList(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(nums) > maxPrimalityInputs {
+		return nil, fmt.Errorf("整数个数不能超过%d", maxPrimalityInputs)
+	}
+	return nums, nil
+}
+
+// ValidateInput 验证输入数据
+func (m *MillerRabin) ValidateInput(data interface{}) error {
+	_, err := parsePrimalityInput(data)
+	return err
+}
+
+// GetComplexity 获取复杂度信息
+func (m *MillerRabin) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(1)", // 能被小素数整除
+			Average: "O(k log³ n)",
+			Worst:   "O(k log³ n)",
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(1)",
+			Average: "O(log n)",
+			Worst:   "O(log n)",
+		},
+	}
+}
@@ -0,0 +1,141 @@
+package numbertheory
+
+import (
+	"errors"
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"math/big"
+)
+
+// maxExponentBits 指数的二进制位数上限，每一位对应一个步骤
+const maxExponentBits = 4096
+
+// ModPow 快速模幂
+type ModPow struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewModPow 创建快速模幂实例
+func NewModPow() *ModPow {
+	return &ModPow{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "mod_pow",
+			Name:            "快速模幂",
+			Category:        models.CategoryMath,
+			Description:     "从低位到高位扫描指数的二进制表示，底数每一步平方一次（得到 b^(2^i) mod m），遇到为1的位就把它乘进结果，共 ⌊log₂e⌋ 次平方和 popcount(e) 次乘法。输入为 [底数, 指数, 模数] 或 {base, exponent, modulus}，大整数以字符串传入。",
+			TimeComplexity:  "O(log e)",
+			SpaceComplexity: "O(1)",
+			Parameters:      []models.Parameter{},
+		},
+	}
+}
+
+// modPowState 快速模幂的步骤快照
+type modPowState struct {
+	Bits   string `json:"bits"`   // 指数的二进制表示（高位在前）
+	Bit    int    `json:"bit"`    // 当前处理的位，从低位起编号
+	Set    bool   `json:"set"`    // 当前位是否为1
+	Power  string `json:"power"`  // 当前位对应的 b^(2^bit) mod m
+	Result string `json:"result"` // 已处理低位部分的结果
+}
+
+// Execute 执行快速模幂
+func (p *ModPow) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	nums, err := parseModPowInput(data)
+	if err != nil {
+		return nil, err
+	}
+	base, exponent, modulus := nums[0], nums[1], nums[2]
+	bits := exponent.Text(2)
+
+	tracker.SetPhase("初始化")
+	power := new(big.Int).Mod(base, modulus)
+	result := new(big.Int).Mod(big.NewInt(1), modulus)
+	tracker.AddStep(fmt.Sprintf("计算 %s^%s mod %s，指数的二进制为 %s（%d 位），底数化简为 %s",
+		base.String(), exponent.String(), modulus.String(), bits, exponent.BitLen(), power.String()),
+		modPowState{Bits: bits, Power: power.String(), Result: result.String()}, []int{})
+
+	tracker.SetPhase("逐位计算")
+	squarings, multiplications := 0, 0
+	for i := 0; i < exponent.BitLen(); i++ {
+		if i > 0 {
+			power.Mul(power, power).Mod(power, modulus)
+			squarings++
+		}
+		set := exponent.Bit(i) == 1
+		description := fmt.Sprintf("第 %d 位为0，b^(2^%d) = %s，结果不变", i, i, power.String())
+		if set {
+			result.Mul(result, power).Mod(result, modulus)
+			multiplications++
+			description = fmt.Sprintf("第 %d 位为1，b^(2^%d) = %s，结果乘以它得到 %s", i, i, power.String(), result.String())
+		}
+
+		// 高亮二进制串中当前位的下标
+		tracker.AddStep(description,
+			modPowState{Bits: bits, Bit: i, Set: set, Power: power.String(), Result: result.String()}, []int{len(bits) - 1 - i})
+		if set {
+			tracker.AddOperation(models.OpTypeUpdate, []int{len(bits) - 1 - i}, []interface{}{result.String()}, "结果乘以当前幂")
+		}
+	}
+
+	// 与标准库结果交叉验证
+	expected := new(big.Int).Exp(base, exponent, modulus)
+
+	last := exponent.BitLen() - 1
+	if last < 0 {
+		last = 0
+	}
+	tracker.SetPhase("完成")
+	tracker.AddStep(fmt.Sprintf("%s^%s mod %s = %s，共 %d 次平方、%d 次乘法",
+		base.String(), exponent.String(), modulus.String(), result.String(), squarings, multiplications),
+		modPowState{Bits: bits, Bit: last, Set: exponent.Bit(last) == 1, Power: power.String(), Result: result.String()}, []int{})
+
+	return map[string]interface{}{
+		"result":          result.String(),
+		"bits":            bits,
+		"squarings":       squarings,
+		"multiplications": multiplications,
+		"verified":        result.Cmp(expected) == 0,
+	}, nil
+}
+
+// parseModPowInput 解析底数、指数和模数
+func parseModPowInput(data interface{}) ([]*big.Int, error) {
+	nums, err := parseNamedIntegers(data, "base", "exponent", "modulus")
+	if err != nil {
+		return nil, err
+	}
+	if nums[1].Sign() < 0 {
+		return nil, errors.New("指数不能为负数")
+	}
+	if nums[1].BitLen() > maxExponentBits {
+		return nil, fmt.Errorf("指数的二进制位数不能超过%d", maxExponentBits)
+	}
+	if nums[2].Sign() <= 0 {
+		return nil, errors.New("模数必须为正整数")
+	}
+	return nums, nil
+}
+
+// ValidateInput 验证输入数据
+func (p *ModPow) ValidateInput(data interface{}) error {
+	_, err := parseModPowInput(data)
+	return err
+}
+
+// GetComplexity 获取复杂度信息
+func (p *ModPow) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(log e)",
+			Average: "O(log e)",
+			Worst:   "O(log e)",
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(1)",
+			Average: "O(1)",
+			Worst:   "O(1)",
+		},
+	}
+}
@@ -0,0 +1,283 @@
+package numbertheory
+
+import (
+	"gin/models"
+	"math/big"
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+// bruteForcePrimes 试除法求 n 以内的素数
+func bruteForcePrimes(n int) []int {
+	primes := make([]int, 0)
+	for i := 2; i <= n; i++ {
+		prime := true
+		for d := 2; d*d <= i; d++ {
+			if i%d == 0 {
+				prime = false
+				break
+			}
+		}
+		if prime {
+			primes = append(primes, i)
+		}
+	}
+	return primes
+}
+
+// randomDigits 随机生成指定位数的十进制数字串
+func randomDigits(rng *rand.Rand, digits int) string {
+	b := make([]byte, digits)
+	for i := range b {
+		b[i] = byte('0' + rng.Intn(10))
+	}
+	if b[0] == '0' {
+		b[0] = '1'
+	}
+	return string(b)
+}
+
+func TestEuclidGCD(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      interface{}
+		gcd, lcm  string
+		divisions int
+	}{
+		{"两个数", []interface{}{252.0, 105.0}, "21", "1260", 3},
+		{"斐波那契相邻项", []interface{}{89.0, 55.0}, "1", "4895", 9},
+		{"负数", []interface{}{-48.0, "18"}, "6", "144", 3},
+		{"含0", []interface{}{0.0, 7.0}, "7", "0", 1},
+		{"多个数", []interface{}{12.0, 18.0, 30.0}, "6", "180", 5},
+		{"大整数", []interface{}{"123456789012345678901234567890", "987654321098765432109876543210"}, "9000000000900000000090", "", -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewEuclidGCD().Execute(tt.data, models.NewStepTracker())
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			output := result.(map[string]interface{})
+			if output["gcd"] != tt.gcd || output["verified"] != true {
+				t.Errorf("gcd = %v (verified %v), want %s", output["gcd"], output["verified"], tt.gcd)
+			}
+			if tt.lcm != "" && output["lcm"] != tt.lcm {
+				t.Errorf("lcm = %v, want %s", output["lcm"], tt.lcm)
+			}
+			if tt.divisions >= 0 && output["divisions"] != tt.divisions {
+				t.Errorf("divisions = %v, want %d", output["divisions"], tt.divisions)
+			}
+		})
+	}
+
+	for _, data := range []interface{}{[]interface{}{5.0}, []interface{}{1.5, 2.0}, []interface{}{"12a", "3"}, "abc"} {
+		if _, err := NewEuclidGCD().Execute(data, models.NewStepTracker()); err == nil {
+			t.Errorf("Execute(%v) expected error", data)
+		}
+	}
+}
+
+func TestExtendedGCD(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 100; trial++ {
+		count := 2 + rng.Intn(3)
+		data := make([]interface{}, count)
+		nums := make([]*big.Int, count)
+		for i := range data {
+			s := randomDigits(rng, 1+rng.Intn(30))
+			if rng.Intn(4) == 0 {
+				s = "-" + s
+			}
+			data[i] = s
+			nums[i], _ = new(big.Int).SetString(s, 10)
+		}
+
+		result, err := NewExtendedGCD().Execute(data, models.NewStepTracker())
+		if err != nil {
+			t.Fatalf("Execute(%v) error = %v", data, err)
+		}
+		output := result.(map[string]interface{})
+
+		expected := new(big.Int).Abs(nums[0])
+		sum := new(big.Int)
+		for i, n := range nums {
+			expected.GCD(nil, nil, expected, new(big.Int).Abs(n))
+			x, _ := new(big.Int).SetString(output["coefficients"].([]string)[i], 10)
+			sum.Add(sum, x.Mul(x, n))
+		}
+		if output["gcd"] != expected.String() || sum.Cmp(expected) != 0 || output["verified"] != true {
+			t.Fatalf("%v: gcd = %v, bezout sum = %s, want %s", data, output["gcd"], sum.String(), expected.String())
+		}
+
+		// 两个数互素时给出模逆元
+		if inv, ok := output["inverse"].(string); ok {
+			x, _ := new(big.Int).SetString(inv, 10)
+			m := new(big.Int).Abs(nums[1])
+			if new(big.Int).Mod(x.Mul(x, nums[0]), m).Cmp(big.NewInt(1)) != 0 {
+				t.Fatalf("%v: %s is not an inverse modulo %s", data, inv, m.String())
+			}
+		}
+	}
+
+	result, _ := NewExtendedGCD().Execute([]interface{}{240.0, 46.0}, models.NewStepTracker())
+	output := result.(map[string]interface{})
+	if output["gcd"] != "2" || output["coefficients"].([]string)[0] != "-9" || output["coefficients"].([]string)[1] != "47" {
+		t.Errorf("extended_gcd(240, 46) = %v, %v", output["gcd"], output["coefficients"])
+	}
+	if rows := output["table"].([]bezoutRow); len(rows) != 7 || rows[len(rows)-1].Remainder != "0" {
+		t.Errorf("table has %d rows", len(rows))
+	}
+}
+
+func TestSieves(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 10, 97, 100, 1000, maxSieveBound} {
+		want := bruteForcePrimes(n)
+		for _, algorithm := range []interface {
+			Execute(interface{}, models.StepTracker) (interface{}, error)
+		}{NewSieveOfEratosthenes(), NewLinearSieve()} {
+			result, err := algorithm.Execute(float64(n), models.NewStepTracker())
+			if err != nil {
+				t.Fatalf("n=%d: Execute() error = %v", n, err)
+			}
+			output := result.(map[string]interface{})
+			primes := output["primes"].([]int)
+			if len(primes) != len(want) {
+				t.Fatalf("n=%d: got %d primes, want %d", n, len(primes), len(want))
+			}
+			for i := range want {
+				if primes[i] != want[i] {
+					t.Fatalf("n=%d: primes[%d] = %d, want %d", n, i, primes[i], want[i])
+				}
+			}
+		}
+	}
+
+	// 线性筛每个合数只划一次，埃氏筛会重复划掉
+	linear, _ := NewLinearSieve().Execute(1000.0, models.NewStepTracker())
+	eratosthenes, _ := NewSieveOfEratosthenes().Execute(1000.0, models.NewStepTracker())
+	lo, eo := linear.(map[string]interface{}), eratosthenes.(map[string]interface{})
+	if lo["crossings"] != lo["composites"] || lo["composites"] != 1000-1-168 {
+		t.Errorf("linear sieve crossings = %v, composites = %v", lo["crossings"], lo["composites"])
+	}
+	if eo["crossings"].(int) <= eo["composites"].(int) {
+		t.Errorf("eratosthenes crossings = %v, composites = %v", eo["crossings"], eo["composites"])
+	}
+	spf := lo["smallestPrimeFactor"].([]int)
+	for i := 2; i <= 1000; i++ {
+		smallest := 2
+		for i%smallest != 0 {
+			smallest++
+		}
+		if spf[i] != smallest {
+			t.Fatalf("smallestPrimeFactor[%d] = %d, want %d", i, spf[i], smallest)
+		}
+	}
+
+	// 数组输入以最大值为上界并判断每个数
+	result, err := NewSieveOfEratosthenes().Execute([]interface{}{4.0, 17.0, "29", 1.0}, models.NewStepTracker())
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	output := result.(map[string]interface{})
+	isPrime := output["isPrime"].([]bool)
+	if output["n"] != 29 || isPrime[0] || !isPrime[1] || !isPrime[2] || isPrime[3] {
+		t.Errorf("n = %v, isPrime = %v", output["n"], isPrime)
+	}
+
+	if _, err := NewLinearSieve().Execute(float64(maxSieveBound+1), models.NewStepTracker()); err == nil {
+		t.Error("expected error for bound above limit")
+	}
+}
+
+func TestModPow(t *testing.T) {
+	tests := []struct {
+		data                       interface{}
+		result                     string
+		squarings, multiplications int
+	}{
+		{[]interface{}{3.0, 13.0, 7.0}, "3", 3, 3},
+		{[]interface{}{-2.0, 5.0, 13.0}, "7", 2, 2},
+		{[]interface{}{5.0, 0.0, 1.0}, "0", 0, 0},
+		{map[string]interface{}{"base": "2", "exponent": "1024", "modulus": 1000.0}, "216", 10, 1},
+	}
+	for _, tt := range tests {
+		result, err := NewModPow().Execute(tt.data, models.NewStepTracker())
+		if err != nil {
+			t.Fatalf("Execute(%v) error = %v", tt.data, err)
+		}
+		output := result.(map[string]interface{})
+		if output["result"] != tt.result || output["squarings"] != tt.squarings || output["multiplications"] != tt.multiplications {
+			t.Errorf("Execute(%v) = %v, %v squarings, %v multiplications", tt.data, output["result"], output["squarings"], output["multiplications"])
+		}
+	}
+
+	rng := rand.New(rand.NewSource(2))
+	for trial := 0; trial < 50; trial++ {
+		data := []interface{}{randomDigits(rng, 40), randomDigits(rng, 60), randomDigits(rng, 30)}
+		result, err := NewModPow().Execute(data, models.NewStepTracker())
+		if err != nil || result.(map[string]interface{})["verified"] != true {
+			t.Fatalf("Execute(%v) = %v, %v", data, result, err)
+		}
+	}
+
+	for _, data := range []interface{}{[]interface{}{2.0, -1.0, 5.0}, []interface{}{2.0, 3.0, 0.0}, []interface{}{2.0, 3.0}} {
+		if _, err := NewModPow().Execute(data, models.NewStepTracker()); err == nil {
+			t.Errorf("Execute(%v) expected error", data)
+		}
+	}
+}
+
+func TestMillerRabin(t *testing.T) {
+	// 强伪素数与大素数
+	tests := []struct {
+		n       string
+		prime   bool
+		certain bool
+	}{
+		{"2047", false, true},                // 以2为底的强伪素数
+		{"3215031751", false, true},          // 以2,3,5,7为底的强伪素数
+		{"3825123056546413051", false, true}, // 以前9个素数为底的强伪素数
+		{"561", false, true},                 // 卡迈克尔数
+		{"1000000007", true, true},
+		{"170141183460469231731687303715884105727", true, false}, // 2^127-1
+	}
+	for _, tt := range tests {
+		result, err := NewMillerRabin().ExecuteWithParams(tt.n, map[string]interface{}{"seed": 1}, models.NewStepTracker())
+		if err != nil {
+			t.Fatalf("ExecuteWithParams(%s) error = %v", tt.n, err)
+		}
+		output := result.(map[string]interface{})
+		if output["probablePrime"] != tt.prime || output["certain"] != tt.certain || output["verified"] != true {
+			t.Errorf("%s: probablePrime = %v, certain = %v, verified = %v", tt.n, output["probablePrime"], output["certain"], output["verified"])
+		}
+	}
+
+	// 8321 = 53 × 157 是以2为底的强伪素数，只用底2时会被误判
+	result, _ := NewMillerRabin().ExecuteWithParams("8321", map[string]interface{}{"rounds": 1}, models.NewStepTracker())
+	if output := result.(map[string]interface{}); output["probablePrime"] != true || output["certain"] != false {
+		t.Errorf("8321 with base 2: probablePrime = %v, certain = %v", output["probablePrime"], output["certain"])
+	}
+	result, _ = NewMillerRabin().ExecuteWithParams("8321", nil, models.NewStepTracker())
+	if output := result.(map[string]interface{}); output["probablePrime"] != false || output["witness"] != "3" {
+		t.Errorf("8321: probablePrime = %v, witness = %v", output["probablePrime"], output["witness"])
+	}
+
+	// 数组输入与试除法一致
+	data := make([]interface{}, 0, 500)
+	for i := 0; i < 500; i++ {
+		data = append(data, strconv.Itoa(i))
+	}
+	result, err := NewMillerRabin().ExecuteWithParams(data, map[string]interface{}{"rounds": 20, "seed": 3}, models.NewStepTracker())
+	if err != nil {
+		t.Fatalf("ExecuteWithParams() error = %v", err)
+	}
+	output := result.(map[string]interface{})
+	if output["primeCount"] != len(bruteForcePrimes(499)) || output["verified"] != true {
+		t.Errorf("primeCount = %v, want %d", output["primeCount"], len(bruteForcePrimes(499)))
+	}
+
+	if _, err := NewMillerRabin().ExecuteWithParams(7.0, map[string]interface{}{"rounds": 0}, models.NewStepTracker()); err == nil {
+		t.Error("expected error for rounds = 0")
+	}
+}
@@ -0,0 +1,106 @@
+package numbertheory
+
+import (
+	"fmt"
+	"gin/models"
+)
+
+// maxSieveBound 筛法上界的上限
+const maxSieveBound = 10000
+
+// 筛法状态串中的字符
+const (
+	markUnknown   = '.' // 尚未确定
+	markPrime     = 'P' // 素数
+	markComposite = 'x' // 合数
+	markUnit      = '-' // 0 和 1，既不是素数也不是合数
+)
+
+// sieveState 筛法的步骤快照，下标即对应的整数
+type sieveState struct {
+	N       int    `json:"n"`               // 筛法上界
+	Current int    `json:"current"`         // 当前处理的数
+	Crossed []int  `json:"crossed"`         // 本步划掉的合数
+	Primes  int    `json:"primes"`          // 已确认的素数个数
+	Marks   string `json:"marks,omitempty"` // 0..n 每个数的状态，图例见结果中的 legend
+}
+
+// sieveLegend 状态串的图例说明
+const sieveLegend = ". 未定，P 素数，x 合数，- 0和1"
+
+// sieveInput 筛法的输入：上界以及（数组输入时）需要判断的数
+type sieveInput struct {
+	n      int
+	values []int
+}
+
+// parseSieveInput 解析筛法输入：单个上界 n，或整数数组（以最大值为上界并判断数组中的每个数）
+func parseSieveInput(data interface{}) (*sieveInput, error) {
+	_, isArray := data.([]interface{})
+	nums, err := parseIntegerList(data)
+	if err != nil {
+		return nil, err
+	}
+
+	in := &sieveInput{}
+	for _, v := range nums {
+		x, err := smallInt(v, -maxSieveBound, maxSieveBound, "筛法的数")
+		if err != nil {
+			return nil, err
+		}
+		if x > in.n {
+			in.n = x
+		}
+		if isArray {
+			in.values = append(in.values, x)
+		}
+	}
+	return in, nil
+}
+
+// newSieveMarks 初始状态：0 和 1 单独标记，其余未定
+func newSieveMarks(n int) []byte {
+	marks := make([]byte, n+1)
+	for i := range marks {
+		marks[i] = markUnknown
+	}
+	for i := 0; i <= n && i < 2; i++ {
+		marks[i] = markUnit
+	}
+	return marks
+}
+
+// sieveResult 生成筛法结果
+func sieveResult(in *sieveInput, marks []byte, crossings int, tracker models.StepTracker) map[string]interface{} {
+	primes := make([]int, 0)
+	for i, m := range marks {
+		if m == markPrime {
+			primes = append(primes, i)
+		}
+	}
+
+	tracker.SetPhase("完成")
+	tracker.AddStep(fmt.Sprintf("%d 以内共有 %d 个素数，划掉合数 %d 次", in.n, len(primes), crossings),
+		sieveState{N: in.n, Current: in.n, Crossed: []int{}, Primes: len(primes), Marks: string(marks)}, primes)
+
+	composites := 0
+	if in.n >= 2 {
+		composites = in.n - 1 - len(primes)
+	}
+	result := map[string]interface{}{
+		"n":          in.n,
+		"primes":     primes,
+		"count":      len(primes),
+		"composites": composites,
+		"crossings":  crossings,
+		"legend":     sieveLegend,
+	}
+	if in.values != nil {
+		isPrime := make([]bool, len(in.values))
+		for i, v := range in.values {
+			isPrime[i] = v >= 0 && marks[v] == markPrime
+		}
+		result["isPrime"] = isPrime
+	}
+	return result
+}
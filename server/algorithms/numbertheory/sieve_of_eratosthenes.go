@@ -0,0 +1,100 @@
+package numbertheory
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+)
+
+// SieveOfEratosthenes 埃拉托斯特尼筛法
+type SieveOfEratosthenes struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewSieveOfEratosthenes 创建埃拉托斯特尼筛法实例
+func NewSieveOfEratosthenes() *SieveOfEratosthenes {
+	return &SieveOfEratosthenes{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "sieve_of_eratosthenes",
+			Name:            "埃拉托斯特尼筛法",
+			Category:        models.CategoryMath,
+			Description:     "从2开始，每遇到一个未被划掉的数 p 就确认它是素数，并从 p² 起划掉它的所有倍数；p² 超过上界后剩下的数都是素数。一个合数会被它的每个不超过√n的素因子各划一次，结果中的 crossings 与 composites 之差就是重复划掉的次数。输入为上界 n，或整数数组（以最大值为上界并判断数组中的每个数）。",
+			TimeComplexity:  "O(n log log n)",
+			SpaceComplexity: "O(n)",
+			Parameters:      []models.Parameter{},
+		},
+	}
+}
+
+// Execute 执行埃拉托斯特尼筛法
+func (s *SieveOfEratosthenes) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	in, err := parseSieveInput(data)
+	if err != nil {
+		return nil, err
+	}
+	n := in.n
+	marks := newSieveMarks(n)
+
+	tracker.SetPhase("初始化")
+	tracker.AddStep(fmt.Sprintf("筛出 %d 以内的素数，0 和 1 不是素数", n),
+		sieveState{N: n, Crossed: []int{}, Marks: string(marks)}, []int{})
+
+	tracker.SetPhase("筛选")
+	crossings, primes := 0, 0
+	for p := 2; p*p <= n; p++ {
+		if marks[p] != markUnknown {
+			continue
+		}
+		marks[p] = markPrime
+		primes++
+
+		crossed := make([]int, 0, n/p)
+		for m := p * p; m <= n; m += p {
+			if marks[m] == markUnknown {
+				marks[m] = markComposite
+			}
+			crossed = append(crossed, m)
+			crossings++
+		}
+		tracker.AddStep(fmt.Sprintf("%d 未被划掉，是素数；从 %d² = %d 起划掉它的 %d 个倍数", p, p, p*p, len(crossed)),
+			sieveState{N: n, Current: p, Crossed: crossed, Primes: primes, Marks: string(marks)}, append([]int{p}, crossed...))
+		tracker.AddOperation(models.OpTypeUpdate, crossed, []interface{}{p}, fmt.Sprintf("划掉 %d 的倍数", p))
+	}
+
+	// p² 超过上界后，剩余未被划掉的数都是素数
+	rest := make([]int, 0)
+	for i := 2; i <= n; i++ {
+		if marks[i] == markUnknown {
+			marks[i] = markPrime
+			rest = append(rest, i)
+		}
+	}
+	if len(rest) > 0 {
+		tracker.AddStep(fmt.Sprintf("下一个数的平方已超过 %d，剩余 %d 个未被划掉的数都是素数", n, len(rest)),
+			sieveState{N: n, Current: n, Crossed: []int{}, Primes: primes + len(rest), Marks: string(marks)}, rest)
+	}
+
+	return sieveResult(in, marks, crossings, tracker), nil
+}
+
+// ValidateInput 验证输入数据
+func (s *SieveOfEratosthenes) ValidateInput(data interface{}) error {
+	_, err := parseSieveInput(data)
+	return err
+}
+
+// GetComplexity 获取复杂度信息
+func (s *SieveOfEratosthenes) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(n log log n)",
+			Average: "O(n log log n)",
+			Worst:   "O(n log log n)",
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(n)",
+			Average: "O(n)",
+			Worst:   "O(n)",
+		},
+	}
+}
@@ -0,0 +1,46 @@
+package operatingsystem
+
+import (
+	"gin/algorithms"
+	"gin/models"
+)
+
+// FCFSScheduling 先来先服务调度
+type FCFSScheduling struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewFCFSScheduling 创建先来先服务调度实例
+func NewFCFSScheduling() *FCFSScheduling {
+	return &FCFSScheduling{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "fcfs",
+			Name:            "先来先服务调度 (FCFS)",
+			Category:        models.CategoryOperatingSys,
+			Description:     "按到达顺序运行进程，每个进程一直运行到结束。实现简单且不会饥饿，但长作业先到时后面的短作业都要等待（护航效应），平均等待时间往往较长。输入为进程数组，每个进程包含到达时间 arrival、执行时间 burst 和优先级 priority，也可写成 [到达时间, 执行时间, 优先级]。",
+			TimeComplexity:  "O(n log n)",
+			SpaceComplexity: "O(n)",
+			Parameters:      []models.Parameter{},
+		},
+	}
+}
+
+// Execute 执行先来先服务调度
+func (f *FCFSScheduling) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return runScheduler(scheduleFCFS, data, nil, tracker)
+}
+
+// Schedule 调度进程集合
+func (f *FCFSScheduling) Schedule(processes *models.ProcessData, tracker models.StepTracker) (interface{}, error) {
+	return f.Execute(processes, tracker)
+}
+
+// ValidateInput 验证输入数据
+func (f *FCFSScheduling) ValidateInput(data interface{}) error {
+	return validateProcesses(data)
+}
+
+// GetComplexity 获取复杂度信息
+func (f *FCFSScheduling) GetComplexity() algorithms.ComplexityInfo {
+	return schedulingComplexity("O(n log n)")
+}
@@ -0,0 +1,60 @@
+package operatingsystem
+
+import (
+	"gin/algorithms"
+	"gin/models"
+)
+
+// PriorityScheduling 优先级调度
+type PriorityScheduling struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewPriorityScheduling 创建优先级调度实例
+func NewPriorityScheduling() *PriorityScheduling {
+	return &PriorityScheduling{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "priority_scheduling",
+			Name:            "优先级调度",
+			Category:        models.CategoryOperatingSys,
+			Description:     "从已到达的进程中选择优先级最高（priority 数值最小）的运行，优先级相同时先到达者优先。非抢占式一直运行到结束；抢占式在新进程到达时重新比较，优先级更高者抢占 CPU。低优先级进程可能饥饿，实际系统常配合老化提升等待进程的优先级。",
+			TimeComplexity:  "O(n²)",
+			SpaceComplexity: "O(n)",
+			Parameters: []models.Parameter{
+				{
+					Name:         "preemptive",
+					Type:         "bool",
+					Description:  "是否允许高优先级的新进程抢占正在运行的进程",
+					DefaultValue: false,
+					Required:     false,
+				},
+			},
+		},
+	}
+}
+
+// Execute 使用默认参数（非抢占式）执行优先级调度
+func (p *PriorityScheduling) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return p.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 按参数执行优先级调度
+func (p *PriorityScheduling) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	preemptive := algorithms.BoolParam(params, "preemptive", false)
+	return runScheduler(schedulePriority, data, func(s *scheduler) { s.preemptive = preemptive }, tracker)
+}
+
+// Schedule 调度进程集合
+func (p *PriorityScheduling) Schedule(processes *models.ProcessData, tracker models.StepTracker) (interface{}, error) {
+	return p.Execute(processes, tracker)
+}
+
+// ValidateInput 验证输入数据
+func (p *PriorityScheduling) ValidateInput(data interface{}) error {
+	return validateProcesses(data)
+}
+
+// GetComplexity 获取复杂度信息
+func (p *PriorityScheduling) GetComplexity() algorithms.ComplexityInfo {
+	return schedulingComplexity("O(n²)")
+}
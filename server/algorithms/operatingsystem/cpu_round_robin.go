@@ -0,0 +1,69 @@
+package operatingsystem
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+)
+
+// maxQuantum 时间片长度上限
+const maxQuantum = 1000
+
+// RoundRobinScheduling 时间片轮转调度
+type RoundRobinScheduling struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewRoundRobinScheduling 创建时间片轮转调度实例
+func NewRoundRobinScheduling() *RoundRobinScheduling {
+	return &RoundRobinScheduling{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "round_robin",
+			Name:            "时间片轮转调度 (RR)",
+			Category:        models.CategoryOperatingSys,
+			Description:     "就绪队列为先进先出队列，队首进程最多运行一个时间片；时间片用完仍未结束则回到队尾，时间片内到达的进程排在它前面。响应时间短且公平，时间片过大退化为 FCFS，过小则上下文切换开销大。",
+			TimeComplexity:  "O(n + Σburst/q)",
+			SpaceComplexity: "O(n)",
+			Parameters: []models.Parameter{
+				{
+					Name:         "quantum",
+					Type:         "int",
+					Description:  "时间片长度",
+					DefaultValue: 2,
+					Required:     false,
+					Min:          1,
+					Max:          maxQuantum,
+				},
+			},
+		},
+	}
+}
+
+// Execute 使用默认时间片执行时间片轮转调度
+func (r *RoundRobinScheduling) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return r.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 按参数执行时间片轮转调度
+func (r *RoundRobinScheduling) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	quantum := algorithms.IntParam(params, "quantum", 2)
+	if quantum < 1 || quantum > maxQuantum {
+		return nil, fmt.Errorf("时间片长度必须在1到%d之间", maxQuantum)
+	}
+	return runScheduler(scheduleRR, data, func(s *scheduler) { s.quantum = quantum }, tracker)
+}
+
+// Schedule 调度进程集合
+func (r *RoundRobinScheduling) Schedule(processes *models.ProcessData, tracker models.StepTracker) (interface{}, error) {
+	return r.Execute(processes, tracker)
+}
+
+// ValidateInput 验证输入数据
+func (r *RoundRobinScheduling) ValidateInput(data interface{}) error {
+	return validateProcesses(data)
+}
+
+// GetComplexity 获取复杂度信息
+func (r *RoundRobinScheduling) GetComplexity() algorithms.ComplexityInfo {
+	return schedulingComplexity("O(n + Σburst/q)")
+}
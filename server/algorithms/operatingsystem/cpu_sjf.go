@@ -0,0 +1,46 @@
+package operatingsystem
+
+import (
+	"gin/algorithms"
+	"gin/models"
+)
+
+// SJFScheduling 短作业优先调度
+type SJFScheduling struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewSJFScheduling 创建短作业优先调度实例
+func NewSJFScheduling() *SJFScheduling {
+	return &SJFScheduling{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "sjf",
+			Name:            "短作业优先调度 (SJF)",
+			Category:        models.CategoryOperatingSys,
+			Description:     "非抢占式：CPU 空闲时从已到达的进程中选择执行时间最短的一个运行到结束，执行时间相同时先到达者优先。在所有进程同时到达时平均等待时间最小，但长作业可能饥饿，且需要预先知道执行时间。",
+			TimeComplexity:  "O(n²)",
+			SpaceComplexity: "O(n)",
+			Parameters:      []models.Parameter{},
+		},
+	}
+}
+
+// Execute 执行短作业优先调度
+func (j *SJFScheduling) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return runScheduler(scheduleSJF, data, nil, tracker)
+}
+
+// Schedule 调度进程集合
+func (j *SJFScheduling) Schedule(processes *models.ProcessData, tracker models.StepTracker) (interface{}, error) {
+	return j.Execute(processes, tracker)
+}
+
+// ValidateInput 验证输入数据
+func (j *SJFScheduling) ValidateInput(data interface{}) error {
+	return validateProcesses(data)
+}
+
+// GetComplexity 获取复杂度信息
+func (j *SJFScheduling) GetComplexity() algorithms.ComplexityInfo {
+	return schedulingComplexity("O(n²)")
+}
@@ -0,0 +1,46 @@
+package operatingsystem
+
+import (
+	"gin/algorithms"
+	"gin/models"
+)
+
+// SRTFScheduling 最短剩余时间优先调度
+type SRTFScheduling struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewSRTFScheduling 创建最短剩余时间优先调度实例
+func NewSRTFScheduling() *SRTFScheduling {
+	return &SRTFScheduling{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "srtf",
+			Name:            "最短剩余时间优先调度 (SRTF)",
+			Category:        models.CategoryOperatingSys,
+			Description:     "SJF 的抢占式版本：每当有新进程到达就重新比较剩余执行时间，剩余时间更短的进程抢占 CPU，剩余时间相同时不抢占先到达的进程。平均等待时间在所有调度算法中最小，但上下文切换更多，长作业更容易饥饿。",
+			TimeComplexity:  "O(n²)",
+			SpaceComplexity: "O(n)",
+			Parameters:      []models.Parameter{},
+		},
+	}
+}
+
+// Execute 执行最短剩余时间优先调度
+func (r *SRTFScheduling) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return runScheduler(scheduleSRTF, data, func(s *scheduler) { s.preemptive = true }, tracker)
+}
+
+// Schedule 调度进程集合
+func (r *SRTFScheduling) Schedule(processes *models.ProcessData, tracker models.StepTracker) (interface{}, error) {
+	return r.Execute(processes, tracker)
+}
+
+// ValidateInput 验证输入数据
+func (r *SRTFScheduling) ValidateInput(data interface{}) error {
+	return validateProcesses(data)
+}
+
+// GetComplexity 获取复杂度信息
+func (r *SRTFScheduling) GetComplexity() algorithms.ComplexityInfo {
+	return schedulingComplexity("O(n²)")
+}
@@ -0,0 +1,46 @@
+package operatingsystem
+
+import (
+	"gin/algorithms"
+	"gin/models"
+)
+
+// ClockPageReplacement Clock页面置换（第二次机会）
+type ClockPageReplacement struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewClockPageReplacement 创建Clock页面置换实例
+func NewClockPageReplacement() *ClockPageReplacement {
+	return &ClockPageReplacement{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "page_clock",
+			Name:            "Clock页面置换（第二次机会）",
+			Category:        models.CategoryOperatingSys,
+			Description:     "页框排成环形，每页有一个使用位，命中或装入时置1。缺页时指针顺时针扫描：使用位为1的页获得第二次机会，清零后跳过；换出第一个使用位为0的页，指针停在新装入页的下一个页框。以很小的开销近似 LRU。",
+			TimeComplexity:  "O(nk)",
+			SpaceComplexity: "O(k)",
+			Parameters:      []models.Parameter{framesParameter()},
+		},
+	}
+}
+
+// Execute 使用默认页框数执行Clock页面置换
+func (c *ClockPageReplacement) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return c.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 按参数执行Clock页面置换
+func (c *ClockPageReplacement) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	return simulatePaging(policyClock, data, params, tracker)
+}
+
+// ValidateInput 验证输入数据
+func (c *ClockPageReplacement) ValidateInput(data interface{}) error {
+	return validateReferenceString(data)
+}
+
+// GetComplexity 获取复杂度信息
+func (c *ClockPageReplacement) GetComplexity() algorithms.ComplexityInfo {
+	return pagingComplexity()
+}
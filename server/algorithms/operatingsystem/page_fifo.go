@@ -0,0 +1,46 @@
+package operatingsystem
+
+import (
+	"gin/algorithms"
+	"gin/models"
+)
+
+// FIFOPageReplacement FIFO页面置换
+type FIFOPageReplacement struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewFIFOPageReplacement 创建FIFO页面置换实例
+func NewFIFOPageReplacement() *FIFOPageReplacement {
+	return &FIFOPageReplacement{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "page_fifo",
+			Name:            "FIFO页面置换",
+			Category:        models.CategoryOperatingSys,
+			Description:     "缺页且页框已满时换出最早装入的页，不考虑它是否仍被频繁使用。实现简单，但会出现 Belady 异常：增加页框反而可能使缺页增多，如引用串 1,2,3,4,1,2,5,1,2,3,4,5 在3个和4个页框下分别缺页9次和10次。输入为页号数组（引用串），参数 frames 为页框数。",
+			TimeComplexity:  "O(nk)",
+			SpaceComplexity: "O(k)",
+			Parameters:      []models.Parameter{framesParameter()},
+		},
+	}
+}
+
+// Execute 使用默认页框数执行FIFO页面置换
+func (f *FIFOPageReplacement) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return f.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 按参数执行FIFO页面置换
+func (f *FIFOPageReplacement) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	return simulatePaging(policyFIFO, data, params, tracker)
+}
+
+// ValidateInput 验证输入数据
+func (f *FIFOPageReplacement) ValidateInput(data interface{}) error {
+	return validateReferenceString(data)
+}
+
+// GetComplexity 获取复杂度信息
+func (f *FIFOPageReplacement) GetComplexity() algorithms.ComplexityInfo {
+	return pagingComplexity()
+}
@@ -0,0 +1,46 @@
+package operatingsystem
+
+import (
+	"gin/algorithms"
+	"gin/models"
+)
+
+// LFUPageReplacement LFU页面置换
+type LFUPageReplacement struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewLFUPageReplacement 创建LFU页面置换实例
+func NewLFUPageReplacement() *LFUPageReplacement {
+	return &LFUPageReplacement{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "page_lfu",
+			Name:            "LFU页面置换",
+			Category:        models.CategoryOperatingSys,
+			Description:     "换出装入以来使用次数最少的页，次数相同时换出最早装入的页。早期频繁使用、之后不再使用的页会因计数较高而长期驻留。页框附加信息为使用次数，页被换出后计数清零。",
+			TimeComplexity:  "O(nk)",
+			SpaceComplexity: "O(k)",
+			Parameters:      []models.Parameter{framesParameter()},
+		},
+	}
+}
+
+// Execute 使用默认页框数执行LFU页面置换
+func (l *LFUPageReplacement) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return l.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 按参数执行LFU页面置换
+func (l *LFUPageReplacement) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	return simulatePaging(policyLFU, data, params, tracker)
+}
+
+// ValidateInput 验证输入数据
+func (l *LFUPageReplacement) ValidateInput(data interface{}) error {
+	return validateReferenceString(data)
+}
+
+// GetComplexity 获取复杂度信息
+func (l *LFUPageReplacement) GetComplexity() algorithms.ComplexityInfo {
+	return pagingComplexity()
+}
@@ -0,0 +1,46 @@
+package operatingsystem
+
+import (
+	"gin/algorithms"
+	"gin/models"
+)
+
+// LRUPageReplacement LRU页面置换
+type LRUPageReplacement struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewLRUPageReplacement 创建LRU页面置换实例
+func NewLRUPageReplacement() *LRUPageReplacement {
+	return &LRUPageReplacement{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "page_lru",
+			Name:            "LRU页面置换",
+			Category:        models.CategoryOperatingSys,
+			Description:     "换出最久未被使用的页，用最近的过去预测最近的将来，是对 OPT 的近似。LRU 是栈式算法，页框增加时缺页次数不会增加，不存在 Belady 异常。页框附加信息为最近一次使用的时间。",
+			TimeComplexity:  "O(nk)",
+			SpaceComplexity: "O(k)",
+			Parameters:      []models.Parameter{framesParameter()},
+		},
+	}
+}
+
+// Execute 使用默认页框数执行LRU页面置换
+func (l *LRUPageReplacement) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return l.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 按参数执行LRU页面置换
+func (l *LRUPageReplacement) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	return simulatePaging(policyLRU, data, params, tracker)
+}
+
+// ValidateInput 验证输入数据
+func (l *LRUPageReplacement) ValidateInput(data interface{}) error {
+	return validateReferenceString(data)
+}
+
+// GetComplexity 获取复杂度信息
+func (l *LRUPageReplacement) GetComplexity() algorithms.ComplexityInfo {
+	return pagingComplexity()
+}
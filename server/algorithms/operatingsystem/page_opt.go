@@ -0,0 +1,46 @@
+package operatingsystem
+
+import (
+	"gin/algorithms"
+	"gin/models"
+)
+
+// OptimalPageReplacement OPT页面置换（Belady最优）
+type OptimalPageReplacement struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewOptimalPageReplacement 创建OPT页面置换实例
+func NewOptimalPageReplacement() *OptimalPageReplacement {
+	return &OptimalPageReplacement{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "page_opt",
+			Name:            "OPT页面置换（Belady最优）",
+			Category:        models.CategoryOperatingSys,
+			Description:     "换出之后最久才会被使用或不再使用的页，缺页次数是所有置换算法中最少的。它需要预知完整的引用串，无法在真实系统中实现，用作衡量其他算法的基准。页框附加信息为下一次使用的时间。",
+			TimeComplexity:  "O(nk)",
+			SpaceComplexity: "O(k)",
+			Parameters:      []models.Parameter{framesParameter()},
+		},
+	}
+}
+
+// Execute 使用默认页框数执行OPT页面置换
+func (o *OptimalPageReplacement) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return o.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 按参数执行OPT页面置换
+func (o *OptimalPageReplacement) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	return simulatePaging(policyOPT, data, params, tracker)
+}
+
+// ValidateInput 验证输入数据
+func (o *OptimalPageReplacement) ValidateInput(data interface{}) error {
+	return validateReferenceString(data)
+}
+
+// GetComplexity 获取复杂度信息
+func (o *OptimalPageReplacement) GetComplexity() algorithms.ComplexityInfo {
+	return pagingComplexity()
+}
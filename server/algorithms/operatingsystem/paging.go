@@ -0,0 +1,375 @@
+package operatingsystem
+
+import (
+	"errors"
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// 引用串长度与页框数上限
+const (
+	maxReferences = 10000
+	maxFrames     = 64
+)
+
+// 页面置换策略
+const (
+	policyFIFO  = "fifo"
+	policyLRU   = "lru"
+	policyLFU   = "lfu"
+	policyClock = "clock"
+	policyOPT   = "opt"
+)
+
+// metaLabels 各策略下页框附加信息的含义
+var metaLabels = map[string]string{
+	policyFIFO:  "装入时间",
+	policyLRU:   "最近一次使用的时间",
+	policyLFU:   "装入以来的使用次数",
+	policyClock: "使用位",
+	policyOPT:   "下一次使用的时间，-1 表示之后不再使用",
+}
+
+// pagingState 页面置换的步骤快照，时间即引用在引用串中的下标
+type pagingState struct {
+	Time   int   `json:"time"`   // 当前引用的下标
+	Page   int   `json:"page"`   // 当前引用的页
+	Frames []int `json:"frames"` // 各页框中的页，-1 为空
+	Meta   []int `json:"meta"`   // 各页框的附加信息，含义见结果中的 metaLabel
+	Hand   int   `json:"hand"`   // 时钟指针，其他策略为 -1
+	Hit    bool  `json:"hit"`    // 是否命中
+	Victim int   `json:"victim"` // 被换出的页，-1 表示没有换出
+	Hits   int   `json:"hits"`   // 累计命中次数
+	Faults int   `json:"faults"` // 累计缺页次数
+}
+
+// pager 一次页面置换模拟
+type pager struct {
+	policy string
+	frames []int
+	meta   []int
+	loaded []int // 各页框装入当前页的时间
+	next   []int // next[t] 为时间 t 引用的页下一次被引用的时间，不再引用时为-1
+	hand   int
+}
+
+// simulatePaging 按策略模拟页面置换
+func simulatePaging(policy string, data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	refs, err := parseReferenceString(data)
+	if err != nil {
+		return nil, err
+	}
+	frameCount := algorithms.IntParam(params, "frames", 3)
+	if frameCount < 1 || frameCount > maxFrames {
+		return nil, fmt.Errorf("页框数必须在1到%d之间", maxFrames)
+	}
+
+	p := &pager{
+		policy: policy,
+		frames: make([]int, frameCount),
+		meta:   make([]int, frameCount),
+		loaded: make([]int, frameCount),
+	}
+	for i := range p.frames {
+		p.frames[i] = -1
+	}
+	if policy == policyOPT {
+		p.next = nextUses(refs)
+	}
+	hand := -1
+	if policy == policyClock {
+		hand = 0
+	}
+
+	tracker.SetPhase("初始化")
+	tracker.AddStep(fmt.Sprintf("引用串长度 %d，%d 个页框，全部为空", len(refs), frameCount),
+		pagingState{Time: -1, Page: -1, Frames: cloneInts(p.frames), Meta: cloneInts(p.meta), Hand: hand, Victim: -1}, []int{})
+
+	tracker.SetPhase("模拟")
+	hits, faults, evictions := 0, 0, 0
+	table := make([][]int, len(refs))
+	faultAt := make([]int, 0)
+	for t, page := range refs {
+		frame := p.find(page)
+		hit := frame >= 0
+		victim := -1
+		var description string
+
+		if hit {
+			hits++
+			p.touch(frame, t)
+			description = fmt.Sprintf("t=%d 引用页 %d：命中，位于页框 %d", t, page, frame)
+		} else {
+			faults++
+			faultAt = append(faultAt, t)
+			var reason string
+			frame, reason = p.victim()
+			if p.frames[frame] >= 0 {
+				victim = p.frames[frame]
+				evictions++
+				description = fmt.Sprintf("t=%d 引用页 %d：缺页，换出页框 %d 中的页 %d（%s）", t, page, frame, victim, reason)
+			} else {
+				description = fmt.Sprintf("t=%d 引用页 %d：缺页，装入空闲页框 %d", t, page, frame)
+			}
+			p.load(frame, page, t)
+		}
+		table[t] = cloneInts(p.frames)
+
+		if p.policy == policyClock {
+			hand = p.hand
+		}
+		tracker.AddStep(description, pagingState{
+			Time: t, Page: page, Frames: table[t], Meta: cloneInts(p.meta), Hand: hand,
+			Hit: hit, Victim: victim, Hits: hits, Faults: faults,
+		}, []int{frame})
+		if hit {
+			tracker.AddOperation(models.OpTypeAccess, []int{frame}, []interface{}{page}, "命中")
+			continue
+		}
+		if victim >= 0 {
+			tracker.AddOperation(models.OpTypeDelete, []int{frame}, []interface{}{victim}, "换出页 "+strconv.Itoa(victim))
+		}
+		tracker.AddOperation(models.OpTypeDiskRead, []int{frame}, []interface{}{page}, "调入页 "+strconv.Itoa(page))
+	}
+
+	hitRate, faultRate := 0.0, 0.0
+	if len(refs) > 0 {
+		hitRate = algorithms.Round4(float64(hits) / float64(len(refs)))
+		faultRate = algorithms.Round4(float64(faults) / float64(len(refs)))
+	}
+
+	tracker.SetPhase("完成")
+	tracker.AddStep(fmt.Sprintf("共 %d 次引用，命中 %d 次，缺页 %d 次，缺页率 %.2f%%", len(refs), hits, faults, faultRate*100),
+		pagingState{Time: len(refs) - 1, Page: -1, Frames: cloneInts(p.frames), Meta: cloneInts(p.meta), Hand: hand,
+			Victim: -1, Hits: hits, Faults: faults}, []int{})
+
+	return map[string]interface{}{
+		"references": len(refs),
+		"frames":     frameCount,
+		"hits":       hits,
+		"faults":     faults,
+		"evictions":  evictions,
+		"hitRate":    hitRate,
+		"faultRate":  faultRate,
+		"faultAt":    faultAt,
+		"table":      table,
+		"final":      cloneInts(p.frames),
+		"metaLabel":  metaLabels[policy],
+	}, nil
+}
+
+// find 页所在的页框，不在内存中时返回-1
+func (p *pager) find(page int) int {
+	for i, f := range p.frames {
+		if f == page {
+			return i
+		}
+	}
+	return -1
+}
+
+// touch 命中时更新页框的附加信息
+func (p *pager) touch(frame, t int) {
+	switch p.policy {
+	case policyLRU:
+		p.meta[frame] = t
+	case policyLFU:
+		p.meta[frame]++
+	case policyClock:
+		p.meta[frame] = 1
+	case policyOPT:
+		p.meta[frame] = p.next[t]
+	}
+}
+
+// load 将页装入页框
+func (p *pager) load(frame, page, t int) {
+	p.frames[frame] = page
+	p.loaded[frame] = t
+	switch p.policy {
+	case policyFIFO, policyLRU:
+		p.meta[frame] = t
+	case policyLFU, policyClock:
+		p.meta[frame] = 1
+	case policyOPT:
+		p.meta[frame] = p.next[t]
+	}
+	if p.policy == policyClock {
+		p.hand = (frame + 1) % len(p.frames)
+	}
+}
+
+// victim 选择装入新页的页框：优先使用空闲页框，否则按策略选出被换出的页
+func (p *pager) victim() (int, string) {
+	for i, f := range p.frames {
+		if f < 0 {
+			return i, ""
+		}
+	}
+
+	best := 0
+	switch p.policy {
+	case policyFIFO:
+		for i := range p.frames {
+			if p.loaded[i] < p.loaded[best] {
+				best = i
+			}
+		}
+		return best, fmt.Sprintf("最早装入，t=%d", p.loaded[best])
+	case policyLRU:
+		for i := range p.frames {
+			if p.meta[i] < p.meta[best] {
+				best = i
+			}
+		}
+		return best, fmt.Sprintf("最久未使用，上次使用 t=%d", p.meta[best])
+	case policyLFU:
+		// 使用次数相同时换出最早装入的页
+		for i := range p.frames {
+			if p.meta[i] < p.meta[best] || (p.meta[i] == p.meta[best] && p.loaded[i] < p.loaded[best]) {
+				best = i
+			}
+		}
+		return best, fmt.Sprintf("使用次数最少，%d 次", p.meta[best])
+	case policyClock:
+		// 指针扫过使用位为1的页框时清零（第二次机会），停在第一个使用位为0的页框
+		cleared := 0
+		for p.meta[p.hand] == 1 {
+			p.meta[p.hand] = 0
+			p.hand = (p.hand + 1) % len(p.frames)
+			cleared++
+		}
+		return p.hand, fmt.Sprintf("指针清除了 %d 个使用位后停在此处", cleared)
+	default:
+		// 最久以后才会用到或不再使用的页，相同时换出最早装入的页
+		for i := range p.frames {
+			if useKey(p.meta[i]) > useKey(p.meta[best]) || (useKey(p.meta[i]) == useKey(p.meta[best]) && p.loaded[i] < p.loaded[best]) {
+				best = i
+			}
+		}
+		if p.meta[best] < 0 {
+			return best, "之后不再使用"
+		}
+		return best, fmt.Sprintf("下一次使用最晚，t=%d", p.meta[best])
+	}
+}
+
+// nextUses 从后向前扫描，求每次引用的页下一次被引用的时间
+func nextUses(refs []int) []int {
+	next := make([]int, len(refs))
+	seen := make(map[int]int)
+	for t := len(refs) - 1; t >= 0; t-- {
+		if k, ok := seen[refs[t]]; ok {
+			next[t] = k
+		} else {
+			next[t] = -1
+		}
+		seen[refs[t]] = t
+	}
+	return next
+}
+
+// useKey 将“不再使用”视为无穷远
+func useKey(next int) int {
+	if next < 0 {
+		return math.MaxInt32
+	}
+	return next
+}
+
+// cloneInts 复制切片
+func cloneInts(values []int) []int {
+	return append([]int(nil), values...)
+}
+
+// parseReferenceString 解析引用串：整数数组、{"values": [...]}，或以空格、逗号分隔的字符串
+func parseReferenceString(data interface{}) ([]int, error) {
+	var values []interface{}
+	switch v := data.(type) {
+	case []interface{}:
+		values = v
+	case *models.ArrayData:
+		values = v.Values
+	case map[string]interface{}:
+		arr, ok := v["values"].([]interface{})
+		if !ok {
+			return nil, algorithms.ErrInvalidInput
+		}
+		values = arr
+	case string:
+		for _, field := range strings.FieldsFunc(v, func(r rune) bool { return r == ',' || r == ' ' || r == '\t' || r == '\n' }) {
+			values = append(values, field)
+		}
+	default:
+		return nil, algorithms.ErrInvalidInput
+	}
+
+	if len(values) == 0 {
+		return nil, errors.New("引用串不能为空")
+	}
+	if len(values) > maxReferences {
+		return nil, fmt.Errorf("引用串长度不能超过%d", maxReferences)
+	}
+	refs := make([]int, len(values))
+	for i, v := range values {
+		page, ok := toPage(v)
+		if !ok {
+			return nil, fmt.Errorf("第%d个引用 %v 不是非负整数页号", i+1, v)
+		}
+		refs[i] = page
+	}
+	return refs, nil
+}
+
+// toPage 将引用转换为页号
+func toPage(v interface{}) (int, bool) {
+	switch n := v.(type) {
+	case int:
+		return n, n >= 0
+	case float64:
+		return int(n), n >= 0 && n == math.Trunc(n) && n <= math.MaxInt32
+	case string:
+		page, err := strconv.Atoi(strings.TrimSpace(n))
+		return page, err == nil && page >= 0
+	}
+	return 0, false
+}
+
+// framesParameter 页框数参数定义
+func framesParameter() models.Parameter {
+	return models.Parameter{
+		Name:         "frames",
+		Type:         "int",
+		Description:  "物理页框数",
+		DefaultValue: 3,
+		Required:     false,
+		Min:          1,
+		Max:          maxFrames,
+	}
+}
+
+// validateReferenceString 验证引用串输入
+func validateReferenceString(data interface{}) error {
+	_, err := parseReferenceString(data)
+	return err
+}
+
+// pagingComplexity 页面置换模拟的复杂度，n 为引用串长度，k 为页框数
+func pagingComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(nk)",
+			Average: "O(nk)",
+			Worst:   "O(nk)",
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(k)",
+			Average: "O(k)",
+			Worst:   "O(k)",
+		},
+	}
+}
@@ -0,0 +1,131 @@
+package operatingsystem
+
+import (
+	"gin/models"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// textbookReferences 教材中的经典引用串
+var textbookReferences = []interface{}{7, 0, 1, 2, 0, 3, 0, 4, 2, 3, 0, 3, 2, 1, 2, 0, 1, 7, 0, 1}
+
+// pagingAlgorithm 页面置换算法的公共方法
+type pagingAlgorithm interface {
+	ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error)
+}
+
+// runPaging 执行页面置换并返回结果
+func runPaging(t *testing.T, algo pagingAlgorithm, data interface{}, frames int) map[string]interface{} {
+	t.Helper()
+	result, err := algo.ExecuteWithParams(data, map[string]interface{}{"frames": frames}, models.NewStepTracker())
+	if err != nil {
+		t.Fatalf("ExecuteWithParams() error = %v", err)
+	}
+	return result.(map[string]interface{})
+}
+
+func TestPageReplacementTextbook(t *testing.T) {
+	tests := []struct {
+		name   string
+		algo   pagingAlgorithm
+		faults int
+		final  []int
+	}{
+		{"FIFO", NewFIFOPageReplacement(), 15, []int{7, 0, 1}},
+		{"LRU", NewLRUPageReplacement(), 12, []int{1, 0, 7}},
+		{"OPT", NewOptimalPageReplacement(), 9, []int{7, 0, 1}},
+		{"Clock", NewClockPageReplacement(), 14, nil},
+		{"LFU", NewLFUPageReplacement(), 13, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := runPaging(t, tt.algo, textbookReferences, 3)
+			if res["faults"] != tt.faults {
+				t.Errorf("faults = %v, want %d", res["faults"], tt.faults)
+			}
+			if res["hits"].(int)+res["faults"].(int) != len(textbookReferences) {
+				t.Errorf("hits + faults = %v + %v", res["hits"], res["faults"])
+			}
+			if len(res["faultAt"].([]int)) != tt.faults {
+				t.Errorf("len(faultAt) = %d", len(res["faultAt"].([]int)))
+			}
+			if res["evictions"] != tt.faults-3 {
+				t.Errorf("evictions = %v, want %d", res["evictions"], tt.faults-3)
+			}
+			if tt.final != nil && !reflect.DeepEqual(res["final"], tt.final) {
+				t.Errorf("final = %v, want %v", res["final"], tt.final)
+			}
+		})
+	}
+}
+
+func TestFIFOBeladyAnomaly(t *testing.T) {
+	refs := "1 2 3 4 1 2 5 1 2 3 4 5"
+	if faults := runPaging(t, NewFIFOPageReplacement(), refs, 3)["faults"]; faults != 9 {
+		t.Errorf("3 个页框 faults = %v, want 9", faults)
+	}
+	if faults := runPaging(t, NewFIFOPageReplacement(), refs, 4)["faults"]; faults != 10 {
+		t.Errorf("4 个页框 faults = %v, want 10", faults)
+	}
+}
+
+func TestOptimalIsLowerBound(t *testing.T) {
+	rng := rand.New(rand.NewSource(7))
+	others := map[string]pagingAlgorithm{
+		"FIFO":  NewFIFOPageReplacement(),
+		"LRU":   NewLRUPageReplacement(),
+		"LFU":   NewLFUPageReplacement(),
+		"Clock": NewClockPageReplacement(),
+	}
+	for trial := 0; trial < 50; trial++ {
+		refs := make([]interface{}, 10+rng.Intn(60))
+		for i := range refs {
+			refs[i] = float64(rng.Intn(8))
+		}
+		frames := 1 + rng.Intn(5)
+		best := runPaging(t, NewOptimalPageReplacement(), refs, frames)["faults"].(int)
+		for name, algo := range others {
+			if faults := runPaging(t, algo, refs, frames)["faults"].(int); faults < best {
+				t.Fatalf("%s faults = %d 少于 OPT 的 %d，refs = %v, frames = %d", name, faults, best, refs, frames)
+			}
+		}
+	}
+}
+
+func TestPageReplacementDiskReads(t *testing.T) {
+	tracker := models.NewStepTracker()
+	result, err := NewLRUPageReplacement().ExecuteWithParams(textbookReferences, nil, tracker)
+	if err != nil {
+		t.Fatalf("ExecuteWithParams() error = %v", err)
+	}
+	reads := 0
+	for _, step := range tracker.GetSteps() {
+		for _, op := range step.Operations {
+			if op.Type == models.OpTypeDiskRead {
+				reads++
+			}
+		}
+	}
+	if reads != result.(map[string]interface{})["faults"] {
+		t.Errorf("DiskRead 操作数 = %d, faults = %v", reads, result.(map[string]interface{})["faults"])
+	}
+}
+
+func TestPageReplacementInvalidInput(t *testing.T) {
+	algo := NewFIFOPageReplacement()
+	for name, data := range map[string]interface{}{
+		"空引用串": []interface{}{},
+		"负页号":  []interface{}{1.0, -2.0},
+		"小数页号": []interface{}{1.5},
+		"非法字符": "1 2 x",
+		"非法类型": 42,
+	} {
+		if err := algo.ValidateInput(data); err == nil {
+			t.Errorf("%s: ValidateInput() 应返回错误", name)
+		}
+	}
+	if _, err := algo.ExecuteWithParams(textbookReferences, map[string]interface{}{"frames": 0}, models.NewStepTracker()); err == nil {
+		t.Error("页框数为0时应返回错误")
+	}
+}
@@ -0,0 +1,401 @@
+package operatingsystem
+
+import (
+	"errors"
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"sort"
+	"strings"
+)
+
+// 进程数与执行时间总和的上限，以及步骤快照中展示的就绪队列长度上限
+// 时间片轮转每个时间片记录一步，执行时间总和决定了步骤数
+const (
+	maxProcesses  = 10000
+	maxTotalBurst = 100000
+	maxReadyShown = 32
+)
+
+// 调度策略
+const (
+	scheduleFCFS     = "fcfs"
+	scheduleSJF      = "sjf"
+	scheduleSRTF     = "srtf"
+	scheduleRR       = "rr"
+	schedulePriority = "priority"
+)
+
+// ganttSegment 甘特图中的一段：[Start, End) 内 CPU 运行的进程
+type ganttSegment struct {
+	Process string `json:"process"`        // 进程ID，空闲时为空
+	Idle    bool   `json:"idle,omitempty"` // CPU 是否空闲
+	Start   int    `json:"start"`
+	End     int    `json:"end"`
+}
+
+// processMetrics 单个进程的调度指标
+type processMetrics struct {
+	ID         string `json:"id"`
+	Arrival    int    `json:"arrival"`
+	Burst      int    `json:"burst"`
+	Priority   int    `json:"priority"`
+	Start      int    `json:"start"`      // 首次运行的时间
+	Completion int    `json:"completion"` // 完成时间
+	Turnaround int    `json:"turnaround"` // 周转时间 = 完成时间 - 到达时间
+	Waiting    int    `json:"waiting"`    // 等待时间 = 周转时间 - 执行时间
+	Response   int    `json:"response"`   // 响应时间 = 首次运行时间 - 到达时间
+}
+
+// schedulingState 进程调度的步骤快照
+type schedulingState struct {
+	Time      int           `json:"time"`              // 当前时刻
+	Running   string        `json:"running,omitempty"` // 本步运行的进程
+	Ready     []string      `json:"ready"`             // 本步结束时就绪队列的前若干个进程
+	Queued    int           `json:"queued"`            // 就绪队列中的进程总数
+	Remaining int           `json:"remaining"`         // 本步运行的进程的剩余执行时间
+	Segment   *ganttSegment `json:"segment,omitempty"` // 本步追加到甘特图的片段
+	Completed int           `json:"completed"`         // 已完成的进程数
+}
+
+// scheduler 一次进程调度模拟
+type scheduler struct {
+	policy     string
+	quantum    int
+	preemptive bool
+	procs      []models.Process
+	order      []int // 按到达时间排序的进程下标
+	admitted   int   // 已进入就绪队列的进程数（按 order）
+	ready      []int
+	remaining  []int
+	firstRun   []int
+	completion []int
+	time       int
+	gantt      []ganttSegment
+	switches   int
+	last       int // 上一个运行的进程，-1 表示还没有
+	tracker    models.StepTracker
+}
+
+// newScheduler 创建调度模拟
+func newScheduler(policy string, data interface{}, tracker models.StepTracker) (*scheduler, error) {
+	procs, err := toProcesses(data)
+	if err != nil {
+		return nil, err
+	}
+	n := len(procs)
+	s := &scheduler{
+		policy:     policy,
+		quantum:    1,
+		procs:      procs,
+		order:      make([]int, n),
+		remaining:  make([]int, n),
+		firstRun:   make([]int, n),
+		completion: make([]int, n),
+		gantt:      make([]ganttSegment, 0),
+		last:       -1,
+		tracker:    tracker,
+	}
+	for i, p := range procs {
+		s.order[i] = i
+		s.remaining[i] = p.Burst
+		s.firstRun[i] = -1
+	}
+	// 同时到达时按输入顺序
+	sort.SliceStable(s.order, func(a, b int) bool { return procs[s.order[a]].Arrival < procs[s.order[b]].Arrival })
+	return s, nil
+}
+
+// run 执行调度并生成结果
+func (s *scheduler) run() map[string]interface{} {
+	n := len(s.procs)
+	s.tracker.SetPhase("初始化")
+	s.tracker.AddStep(fmt.Sprintf("%d 个进程，按到达时间依次进入就绪队列", n), s.state(-1, nil, 0), []int{})
+
+	s.tracker.SetPhase("调度")
+	completed := 0
+	for completed < n {
+		s.admit()
+		if len(s.ready) == 0 {
+			// 没有就绪进程，CPU 空闲到下一个进程到达
+			next := s.procs[s.order[s.admitted]].Arrival
+			segment := s.appendSegment(-1, s.time, next)
+			s.time = next
+			s.tracker.AddStep(fmt.Sprintf("t=%d 就绪队列为空，CPU 空闲到 t=%d", segment.Start, next), s.state(-1, segment, completed), []int{})
+			continue
+		}
+
+		idx, reason := s.pick()
+		slice := s.remaining[idx]
+		switch {
+		case s.policy == scheduleRR && s.quantum < slice:
+			slice = s.quantum
+		case s.preemptive && s.admitted < n:
+			// 抢占式调度在下一个进程到达时重新选择
+			if until := s.procs[s.order[s.admitted]].Arrival - s.time; until < slice {
+				slice = until
+			}
+		}
+
+		if s.firstRun[idx] < 0 {
+			s.firstRun[idx] = s.time
+		}
+		start := s.time
+		s.time += slice
+		s.remaining[idx] -= slice
+		segment := s.appendSegment(idx, start, s.time)
+		switched := s.last >= 0 && s.last != idx
+		if switched {
+			s.switches++
+		}
+		previous := s.last
+		s.last = idx
+
+		// 时间片内到达的进程先于被抢占的进程进入就绪队列
+		s.admit()
+		id := s.procs[idx].ID
+		description := fmt.Sprintf("t=%d 选择 %s（%s），运行到 t=%d", start, id, reason, s.time)
+		if s.remaining[idx] == 0 {
+			s.completion[idx] = s.time
+			completed++
+			description += "，执行完毕"
+		} else {
+			s.ready = append(s.ready, idx)
+			if s.policy == scheduleRR {
+				description += fmt.Sprintf("，时间片用完，回到队尾，剩余 %d", s.remaining[idx])
+			} else {
+				description += fmt.Sprintf("，有新进程到达，重新调度，剩余 %d", s.remaining[idx])
+			}
+		}
+
+		s.tracker.AddStep(description, s.state(idx, segment, completed), []int{idx})
+		if switched {
+			s.tracker.AddOperation(models.OpTypeSwap, []int{previous, idx}, []interface{}{s.procs[previous].ID, id}, "上下文切换")
+		}
+		s.tracker.AddOperation(models.OpTypeMove, []int{idx}, []interface{}{slice}, id+" 运行")
+	}
+
+	return s.result()
+}
+
+// admit 将已到达的进程加入就绪队列
+func (s *scheduler) admit() {
+	for s.admitted < len(s.order) && s.procs[s.order[s.admitted]].Arrival <= s.time {
+		s.ready = append(s.ready, s.order[s.admitted])
+		s.admitted++
+	}
+}
+
+// pick 按策略从就绪队列中取出下一个运行的进程
+func (s *scheduler) pick() (int, string) {
+	best := 0
+	if s.policy != scheduleFCFS && s.policy != scheduleRR {
+		for k := 1; k < len(s.ready); k++ {
+			if s.less(s.ready[k], s.ready[best]) {
+				best = k
+			}
+		}
+	}
+	idx := s.ready[best]
+	s.ready = append(s.ready[:best], s.ready[best+1:]...)
+
+	switch s.policy {
+	case scheduleSJF:
+		return idx, fmt.Sprintf("执行时间最短，%d", s.procs[idx].Burst)
+	case scheduleSRTF:
+		return idx, fmt.Sprintf("剩余时间最短，%d", s.remaining[idx])
+	case schedulePriority:
+		return idx, fmt.Sprintf("优先级最高，%d", s.procs[idx].Priority)
+	case scheduleRR:
+		return idx, "队首"
+	}
+	return idx, "最先到达"
+}
+
+// less 进程 a 是否应先于进程 b 运行，关键字相同时先到达者优先，再按输入顺序
+func (s *scheduler) less(a, b int) bool {
+	var ka, kb int
+	switch s.policy {
+	case scheduleSJF:
+		ka, kb = s.procs[a].Burst, s.procs[b].Burst
+	case scheduleSRTF:
+		ka, kb = s.remaining[a], s.remaining[b]
+	case schedulePriority:
+		ka, kb = s.procs[a].Priority, s.procs[b].Priority
+	}
+	if ka != kb {
+		return ka < kb
+	}
+	if s.procs[a].Arrival != s.procs[b].Arrival {
+		return s.procs[a].Arrival < s.procs[b].Arrival
+	}
+	return a < b
+}
+
+// appendSegment 追加甘特图片段，与上一段是同一进程且相邻时合并
+func (s *scheduler) appendSegment(idx, start, end int) *ganttSegment {
+	segment := ganttSegment{Idle: idx < 0, Start: start, End: end}
+	if idx >= 0 {
+		segment.Process = s.procs[idx].ID
+	}
+	if k := len(s.gantt) - 1; k >= 0 && s.gantt[k].End == start && s.gantt[k].Idle == segment.Idle && s.gantt[k].Process == segment.Process {
+		s.gantt[k].End = end
+	} else {
+		s.gantt = append(s.gantt, segment)
+	}
+	return &segment
+}
+
+// state 生成步骤快照，running 为本步运行的进程，-1 表示没有
+func (s *scheduler) state(running int, segment *ganttSegment, completed int) schedulingState {
+	shown := len(s.ready)
+	if shown > maxReadyShown {
+		shown = maxReadyShown
+	}
+	ready := make([]string, shown)
+	for k := range ready {
+		ready[k] = s.procs[s.ready[k]].ID
+	}
+	state := schedulingState{
+		Time:      s.time,
+		Ready:     ready,
+		Queued:    len(s.ready),
+		Segment:   segment,
+		Completed: completed,
+	}
+	if running >= 0 {
+		state.Running = s.procs[running].ID
+		state.Remaining = s.remaining[running]
+	}
+	return state
+}
+
+// result 计算各进程指标与平均值
+func (s *scheduler) result() map[string]interface{} {
+	n := len(s.procs)
+	metrics := make([]processMetrics, n)
+	totalWaiting, totalTurnaround, totalResponse, busy := 0, 0, 0, 0
+	for i, p := range s.procs {
+		m := processMetrics{
+			ID: p.ID, Arrival: p.Arrival, Burst: p.Burst, Priority: p.Priority,
+			Start: s.firstRun[i], Completion: s.completion[i],
+		}
+		m.Turnaround = m.Completion - p.Arrival
+		m.Waiting = m.Turnaround - p.Burst
+		m.Response = m.Start - p.Arrival
+		metrics[i] = m
+		totalWaiting += m.Waiting
+		totalTurnaround += m.Turnaround
+		totalResponse += m.Response
+		busy += p.Burst
+	}
+
+	// 完成顺序
+	finished := make([]int, n)
+	for i := range finished {
+		finished[i] = i
+	}
+	sort.SliceStable(finished, func(a, b int) bool { return s.completion[finished[a]] < s.completion[finished[b]] })
+	completionOrder := make([]string, n)
+	for k, idx := range finished {
+		completionOrder[k] = s.procs[idx].ID
+	}
+
+	// 甘特图从 t=0 开始，首个进程到达前的空闲也计入
+	span := s.time
+	avgWaiting := algorithms.Round4(float64(totalWaiting) / float64(n))
+	avgTurnaround := algorithms.Round4(float64(totalTurnaround) / float64(n))
+
+	s.tracker.SetPhase("完成")
+	s.tracker.AddStep(fmt.Sprintf("全部进程在 t=%d 完成，平均等待时间 %g，平均周转时间 %g，上下文切换 %d 次",
+		s.time, avgWaiting, avgTurnaround, s.switches), s.state(-1, nil, n), []int{})
+
+	return map[string]interface{}{
+		"gantt":             s.gantt,
+		"processes":         metrics,
+		"completionOrder":   completionOrder,
+		"averageWaiting":    avgWaiting,
+		"averageTurnaround": avgTurnaround,
+		"averageResponse":   algorithms.Round4(float64(totalResponse) / float64(n)),
+		"makespan":          s.time,
+		"throughput":        algorithms.Round4(float64(n) / float64(span)),
+		"cpuUtilization":    algorithms.Round4(float64(busy) / float64(span)),
+		"contextSwitches":   s.switches,
+	}
+}
+
+// toProcesses 取出进程列表并验证
+func toProcesses(data interface{}) ([]models.Process, error) {
+	var procs []models.Process
+	switch p := data.(type) {
+	case *models.ProcessData:
+		if p == nil {
+			return nil, algorithms.ErrInvalidInput
+		}
+		procs = p.Processes
+	case models.ProcessData:
+		procs = p.Processes
+	default:
+		return nil, algorithms.ErrInvalidInput
+	}
+
+	if len(procs) == 0 {
+		return nil, errors.New("进程集合不能为空")
+	}
+	if len(procs) > maxProcesses {
+		return nil, fmt.Errorf("进程数不能超过%d", maxProcesses)
+	}
+	ids := make(map[string]bool, len(procs))
+	totalBurst := 0
+	for _, p := range procs {
+		switch {
+		case strings.TrimSpace(p.ID) == "":
+			return nil, errors.New("进程ID不能为空")
+		case ids[p.ID]:
+			return nil, fmt.Errorf("进程ID重复: %s", p.ID)
+		case p.Arrival < 0:
+			return nil, fmt.Errorf("进程 %s 的到达时间不能为负数", p.ID)
+		case p.Burst <= 0:
+			return nil, fmt.Errorf("进程 %s 的执行时间必须为正整数", p.ID)
+		case p.Burst > maxTotalBurst-totalBurst:
+			return nil, fmt.Errorf("所有进程的执行时间之和不能超过%d", maxTotalBurst)
+		}
+		ids[p.ID] = true
+		totalBurst += p.Burst
+	}
+	return procs, nil
+}
+
+// runScheduler 按策略执行进程调度
+func runScheduler(policy string, data interface{}, configure func(*scheduler), tracker models.StepTracker) (interface{}, error) {
+	s, err := newScheduler(policy, data, tracker)
+	if err != nil {
+		return nil, err
+	}
+	if configure != nil {
+		configure(s)
+	}
+	return s.run(), nil
+}
+
+// validateProcesses 验证进程集合输入
+func validateProcesses(data interface{}) error {
+	_, err := toProcesses(data)
+	return err
+}
+
+// schedulingComplexity 进程调度模拟的复杂度，n 为进程数
+func schedulingComplexity(time string) algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    time,
+			Average: time,
+			Worst:   time,
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(n)",
+			Average: "O(n)",
+			Worst:   "O(n)",
+		},
+	}
+}
@@ -0,0 +1,200 @@
+package operatingsystem
+
+import (
+	"gin/models"
+	"reflect"
+	"testing"
+)
+
+// classicProcesses 教材中的经典进程集合
+func classicProcesses() *models.ProcessData {
+	return &models.ProcessData{Processes: []models.Process{
+		{ID: "P1", Arrival: 0, Burst: 8},
+		{ID: "P2", Arrival: 1, Burst: 4},
+		{ID: "P3", Arrival: 2, Burst: 9},
+		{ID: "P4", Arrival: 3, Burst: 5},
+	}}
+}
+
+// runSchedule 执行调度并返回结果
+func runSchedule(t *testing.T, algo models.StepTracker, run func(models.StepTracker) (interface{}, error)) map[string]interface{} {
+	t.Helper()
+	result, err := run(algo)
+	if err != nil {
+		t.Fatalf("调度出错: %v", err)
+	}
+	return result.(map[string]interface{})
+}
+
+// checkGantt 检查甘特图片段首尾相接，且每个进程的运行总时长等于执行时间
+func checkGantt(t *testing.T, res map[string]interface{}, data *models.ProcessData) {
+	t.Helper()
+	gantt := res["gantt"].([]ganttSegment)
+	ran := make(map[string]int)
+	for i, seg := range gantt {
+		if seg.End <= seg.Start {
+			t.Errorf("片段 %d 长度非正: %+v", i, seg)
+		}
+		if i > 0 && gantt[i-1].End != seg.Start {
+			t.Errorf("片段 %d 与前一片段不相接: %+v", i, seg)
+		}
+		if !seg.Idle {
+			ran[seg.Process] += seg.End - seg.Start
+		}
+	}
+	for _, p := range data.Processes {
+		if ran[p.ID] != p.Burst {
+			t.Errorf("进程 %s 运行 %d, want %d", p.ID, ran[p.ID], p.Burst)
+		}
+	}
+}
+
+func TestSchedulingClassic(t *testing.T) {
+	tests := []struct {
+		name       string
+		run        func(models.StepTracker) (interface{}, error)
+		avgWaiting float64
+		order      []string
+	}{
+		{"FCFS", func(tr models.StepTracker) (interface{}, error) {
+			return NewFCFSScheduling().Schedule(classicProcesses(), tr)
+		}, 8.75, []string{"P1", "P2", "P3", "P4"}},
+		{"SJF", func(tr models.StepTracker) (interface{}, error) {
+			return NewSJFScheduling().Schedule(classicProcesses(), tr)
+		}, 7.75, []string{"P1", "P2", "P4", "P3"}},
+		{"SRTF", func(tr models.StepTracker) (interface{}, error) {
+			return NewSRTFScheduling().Schedule(classicProcesses(), tr)
+		}, 6.5, []string{"P2", "P4", "P1", "P3"}},
+		{"RR q=4", func(tr models.StepTracker) (interface{}, error) {
+			return NewRoundRobinScheduling().ExecuteWithParams(classicProcesses(), map[string]interface{}{"quantum": 4}, tr)
+		}, 11.75, []string{"P2", "P1", "P4", "P3"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := runSchedule(t, models.NewStepTracker(), tt.run)
+			if res["averageWaiting"] != tt.avgWaiting {
+				t.Errorf("averageWaiting = %v, want %v", res["averageWaiting"], tt.avgWaiting)
+			}
+			if !reflect.DeepEqual(res["completionOrder"], tt.order) {
+				t.Errorf("completionOrder = %v, want %v", res["completionOrder"], tt.order)
+			}
+			if res["makespan"] != 26 {
+				t.Errorf("makespan = %v, want 26", res["makespan"])
+			}
+			checkGantt(t, res, classicProcesses())
+		})
+	}
+}
+
+func TestSchedulingIdleGap(t *testing.T) {
+	data := &models.ProcessData{Processes: []models.Process{
+		{ID: "A", Arrival: 2, Burst: 3},
+		{ID: "B", Arrival: 10, Burst: 2},
+	}}
+	res := runSchedule(t, models.NewStepTracker(), func(tr models.StepTracker) (interface{}, error) {
+		return NewFCFSScheduling().Schedule(data, tr)
+	})
+	gantt := res["gantt"].([]ganttSegment)
+	want := []ganttSegment{
+		{Idle: true, Start: 0, End: 2},
+		{Process: "A", Start: 2, End: 5},
+		{Idle: true, Start: 5, End: 10},
+		{Process: "B", Start: 10, End: 12},
+	}
+	if !reflect.DeepEqual(gantt, want) {
+		t.Errorf("gantt = %+v, want %+v", gantt, want)
+	}
+	if res["cpuUtilization"] != 0.4167 {
+		t.Errorf("cpuUtilization = %v, want 0.4167", res["cpuUtilization"])
+	}
+	checkGantt(t, res, data)
+}
+
+func TestRoundRobinQuantum(t *testing.T) {
+	data := &models.ProcessData{Processes: []models.Process{
+		{ID: "P1", Burst: 5},
+		{ID: "P2", Burst: 3},
+	}}
+	run := func(quantum int) map[string]interface{} {
+		return runSchedule(t, models.NewStepTracker(), func(tr models.StepTracker) (interface{}, error) {
+			return NewRoundRobinScheduling().ExecuteWithParams(data, map[string]interface{}{"quantum": quantum}, tr)
+		})
+	}
+
+	res := run(2)
+	gantt := res["gantt"].([]ganttSegment)
+	if len(gantt) != 5 || gantt[4].Process != "P1" || gantt[4].Start != 7 {
+		t.Errorf("q=2 gantt = %+v", gantt)
+	}
+	if res["contextSwitches"] != 4 {
+		t.Errorf("q=2 contextSwitches = %v, want 4", res["contextSwitches"])
+	}
+	checkGantt(t, res, data)
+
+	// 时间片不小于最长执行时间时退化为 FCFS
+	if gantt := run(10)["gantt"].([]ganttSegment); len(gantt) != 2 {
+		t.Errorf("q=10 gantt = %+v", gantt)
+	}
+
+	if _, err := NewRoundRobinScheduling().ExecuteWithParams(data, map[string]interface{}{"quantum": 0}, models.NewStepTracker()); err == nil {
+		t.Error("时间片为0时应返回错误")
+	}
+}
+
+func TestPriorityScheduling(t *testing.T) {
+	allAtZero := &models.ProcessData{Processes: []models.Process{
+		{ID: "P1", Burst: 10, Priority: 3},
+		{ID: "P2", Burst: 1, Priority: 1},
+		{ID: "P3", Burst: 2, Priority: 4},
+		{ID: "P4", Burst: 1, Priority: 5},
+		{ID: "P5", Burst: 5, Priority: 2},
+	}}
+	res := runSchedule(t, models.NewStepTracker(), func(tr models.StepTracker) (interface{}, error) {
+		return NewPriorityScheduling().Schedule(allAtZero, tr)
+	})
+	if res["averageWaiting"] != 8.2 {
+		t.Errorf("averageWaiting = %v, want 8.2", res["averageWaiting"])
+	}
+
+	data := &models.ProcessData{Processes: []models.Process{
+		{ID: "Low", Arrival: 0, Burst: 5, Priority: 3},
+		{ID: "High", Arrival: 1, Burst: 2, Priority: 1},
+	}}
+	tests := []struct {
+		preemptive bool
+		want       []ganttSegment
+	}{
+		{false, []ganttSegment{{Process: "Low", Start: 0, End: 5}, {Process: "High", Start: 5, End: 7}}},
+		{true, []ganttSegment{{Process: "Low", Start: 0, End: 1}, {Process: "High", Start: 1, End: 3}, {Process: "Low", Start: 3, End: 7}}},
+	}
+	for _, tt := range tests {
+		res := runSchedule(t, models.NewStepTracker(), func(tr models.StepTracker) (interface{}, error) {
+			return NewPriorityScheduling().ExecuteWithParams(data, map[string]interface{}{"preemptive": tt.preemptive}, tr)
+		})
+		if gantt := res["gantt"]; !reflect.DeepEqual(gantt, tt.want) {
+			t.Errorf("preemptive=%v gantt = %+v, want %+v", tt.preemptive, gantt, tt.want)
+		}
+	}
+}
+
+func TestSchedulingInvalidInput(t *testing.T) {
+	algo := NewFCFSScheduling()
+	for name, data := range map[string]interface{}{
+		"空集合":    &models.ProcessData{},
+		"执行时间为0": &models.ProcessData{Processes: []models.Process{{ID: "P1", Burst: 0}}},
+		"到达时间为负": &models.ProcessData{Processes: []models.Process{{ID: "P1", Arrival: -1, Burst: 1}}},
+		"ID重复":   &models.ProcessData{Processes: []models.Process{{ID: "P1", Burst: 1}, {ID: "P1", Burst: 2}}},
+		"非法类型":   []interface{}{1.0, 2.0},
+		"执行时间过长": &models.ProcessData{Processes: []models.Process{{ID: "P1", Burst: 3000000}, {ID: "P2", Burst: 3000000}}},
+	} {
+		if err := algo.ValidateInput(data); err == nil {
+			t.Errorf("%s: ValidateInput() 应返回错误", name)
+		}
+	}
+
+	// 时间片轮转在模拟之前就拒绝执行时间总和超限的输入
+	data := &models.ProcessData{Processes: []models.Process{{ID: "P1", Burst: maxTotalBurst}, {ID: "P2", Burst: 1}}}
+	if _, err := NewRoundRobinScheduling().ExecuteWithParams(data, map[string]interface{}{"quantum": 1}, models.NewStepTracker()); err == nil {
+		t.Error("执行时间之和超过上限时应返回错误")
+	}
+}
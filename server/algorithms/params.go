@@ -0,0 +1,98 @@
+package algorithms
+
+import (
+	"strconv"
+	"strings"
+)
+
+// 参数读取辅助函数
+// 前端传入的参数经过JSON解码，数值统一为float64，这里做宽松转换并在缺失或类型不符时回退到默认值
+
+// IntParam 读取整数参数
+func IntParam(params map[string]interface{}, name string, defaultValue int) int {
+	if params == nil {
+		return defaultValue
+	}
+	switch v := params[name].(type) {
+	case int:
+		return v
+	case int64:
+		return int(v)
+	case float64:
+		return int(v)
+	case string:
+		if n, err := strconv.Atoi(strings.TrimSpace(v)); err == nil {
+			return n
+		}
+	}
+	return defaultValue
+}
+
+// FloatParam 读取浮点数参数
+func FloatParam(params map[string]interface{}, name string, defaultValue float64) float64 {
+	if params == nil {
+		return defaultValue
+	}
+	switch v := params[name].(type) {
+	case float64:
+		return v
+	case int:
+		return float64(v)
+	case int64:
+		return float64(v)
+	case string:
+		if f, err := strconv.ParseFloat(strings.TrimSpace(v), 64); err == nil {
+			return f
+		}
+	}
+	return defaultValue
+}
+
+// StringParam 读取字符串参数
+func StringParam(params map[string]interface{}, name string, defaultValue string) string {
+	if params == nil {
+		return defaultValue
+	}
+	if v, ok := params[name].(string); ok && strings.TrimSpace(v) != "" {
+		return strings.TrimSpace(v)
+	}
+	return defaultValue
+}
+
+// BoolParam 读取布尔参数
+func BoolParam(params map[string]interface{}, name string, defaultValue bool) bool {
+	if params == nil {
+		return defaultValue
+	}
+	switch v := params[name].(type) {
+	case bool:
+		return v
+	case string:
+		if b, err := strconv.ParseBool(strings.TrimSpace(v)); err == nil {
+			return b
+		}
+	}
+	return defaultValue
+}
+
+// ValueParam 读取任意类型参数
+func ValueParam(params map[string]interface{}, name string, defaultValue interface{}) interface{} {
+	if params == nil {
+		return defaultValue
+	}
+	if v, ok := params[name]; ok && v != nil {
+		return v
+	}
+	return defaultValue
+}
+
+// OptionParam 读取枚举参数，不在可选值中时回退到默认值
+func OptionParam(params map[string]interface{}, name string, options []string, defaultValue string) string {
+	value := strings.ToLower(StringParam(params, name, defaultValue))
+	for _, option := range options {
+		if value == option {
+			return value
+		}
+	}
+	return defaultValue
+}
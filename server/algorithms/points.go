@@ -0,0 +1,71 @@
+package algorithms
+
+import (
+	"fmt"
+	"gin/models"
+	"math"
+)
+
+// 点集输入辅助函数
+// 点集算法共用同一套输入转换与验证，不在各算法包中重复实现
+
+// ToPointSet 将输入转换为点集
+func ToPointSet(data interface{}) (*models.PointSetData, error) {
+	switch p := data.(type) {
+	case *models.PointSetData:
+		return p, nil
+	case models.PointSetData:
+		return &p, nil
+	default:
+		return nil, ErrInvalidInput
+	}
+}
+
+// ValidatePointSet 验证点集输入：非空、不超过 maxPoints 个点且坐标均为有限数值，返回转换后的点集
+// 各算法对最少点数的要求不同，由调用方另行检查
+func ValidatePointSet(data interface{}, maxPoints int) (*models.PointSetData, error) {
+	if data == nil {
+		return nil, ErrInvalidInput
+	}
+	points, err := ToPointSet(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(points.Points) == 0 {
+		return nil, fmt.Errorf("点集不能为空")
+	}
+	if len(points.Points) > maxPoints {
+		return nil, ErrInvalidInput
+	}
+	if err := ValidateCoordinates(points.Points); err != nil {
+		return nil, err
+	}
+	return points, nil
+}
+
+// ValidateCoordinates 检查所有坐标均为有限数值
+func ValidateCoordinates(points []models.Point2D) error {
+	for _, p := range points {
+		if math.IsNaN(p.X) || math.IsNaN(p.Y) || math.IsInf(p.X, 0) || math.IsInf(p.Y, 0) {
+			return fmt.Errorf("坐标必须为有限数值")
+		}
+	}
+	return nil
+}
+
+// DistanceSquared 两点距离的平方
+func DistanceSquared(a, b models.Point2D) float64 {
+	dx, dy := a.X-b.X, a.Y-b.Y
+	return dx*dx + dy*dy
+}
+
+// PointName 点的显示名称：优先使用标签，其次是ID，都没有时用下标
+func PointName(points []models.Point2D, i int) string {
+	if points[i].Label != "" {
+		return points[i].Label
+	}
+	if points[i].ID != "" {
+		return points[i].ID
+	}
+	return fmt.Sprintf("#%d", i)
+}
@@ -3,7 +3,9 @@ package searching
 import (
 	"gin/algorithms"
 	"gin/models"
+	"math"
 	"strconv"
+	"strings"
 )
 
 // HashSearch 哈希搜索算法
@@ -18,7 +20,7 @@ func NewHashSearch() *HashSearch {
 			ID:              "hash_search",
 			Name:            "哈希搜索",
 			Category:        models.CategorySearching,
-			Description:     "哈希搜索使用哈希表数据结构来实现快速搜索。通过将元素存储在哈希表中，可以在平均情况下实现O(1)的搜索时间复杂度。",
+			Description:     "哈希搜索使用哈希表数据结构来实现快速搜索。通过将元素存储在哈希表中，可以在平均情况下实现O(1)的搜索时间复杂度。支持链地址法、线性探测、二次探测、双重哈希、Robin Hood哈希与布谷鸟哈希等冲突处理策略，装载因子超限时自动扩容重哈希。",
 			TimeComplexity:  "O(1)",
 			SpaceComplexity: "O(n)",
 			Parameters: []models.Parameter{
@@ -29,6 +31,40 @@ func NewHashSearch() *HashSearch {
 					DefaultValue: nil,
 					Required:     true,
 				},
+				{
+					Name:         "strategy",
+					Type:         "string",
+					Description:  "冲突处理策略",
+					DefaultValue: StrategyChaining,
+					Required:     false,
+					Options:      []string{StrategyChaining, StrategyLinear, StrategyQuadratic, StrategyDouble, StrategyRobinHood, StrategyCuckoo},
+				},
+				{
+					Name:         "hash_function",
+					Type:         "string",
+					Description:  "哈希函数 (division: 除法, multiplication: 乘法, fnv: FNV-1a)",
+					DefaultValue: HashDivision,
+					Required:     false,
+					Options:      []string{HashDivision, HashMultiplication, HashFNV},
+				},
+				{
+					Name:         "initial_capacity",
+					Type:         "int",
+					Description:  "初始桶/槽位数量（向上取整为2的幂）",
+					DefaultValue: 8,
+					Required:     false,
+					Min:          1,
+					Max:          4096,
+				},
+				{
+					Name:         "max_load_factor",
+					Type:         "float",
+					Description:  "触发扩容的最大装载因子（开放寻址不超过0.9，布谷鸟哈希不超过0.5）",
+					DefaultValue: 0.75,
+					Required:     false,
+					Min:          0.1,
+					Max:          4.0,
+				},
 			},
 			Stable:   true,
 			InPlace:  false,
@@ -37,28 +73,75 @@ func NewHashSearch() *HashSearch {
 	}
 }
 
-// hashTable 简单的哈希表实现
-type hashTable struct {
-	buckets [][]hashEntry // 使用链表法处理冲突
-	size    int
+// hashSearchConfig 哈希搜索配置
+type hashSearchConfig struct {
+	strategy      string
+	hashFunction  string
+	capacity      int
+	maxLoadFactor float64
+}
+
+// defaultHashSearchConfig 默认配置：链地址法 + 除法哈希
+func defaultHashSearchConfig() hashSearchConfig {
+	return hashSearchConfig{
+		strategy:      StrategyChaining,
+		hashFunction:  HashDivision,
+		capacity:      8,
+		maxLoadFactor: 0.75,
+	}
 }
 
-// hashEntry 哈希表条目
-type hashEntry struct {
-	key   interface{}
-	value int // 存储原数组中的索引
+// parseHashSearchConfig 从参数解析配置
+func parseHashSearchConfig(params map[string]interface{}) hashSearchConfig {
+	config := defaultHashSearchConfig()
+	config.strategy = algorithms.OptionParam(params, "strategy",
+		[]string{StrategyChaining, StrategyLinear, StrategyQuadratic, StrategyDouble, StrategyRobinHood, StrategyCuckoo},
+		config.strategy)
+	config.hashFunction = algorithms.OptionParam(params, "hash_function",
+		[]string{HashDivision, HashMultiplication, HashFNV}, config.hashFunction)
+	config.capacity = algorithms.IntParam(params, "initial_capacity", config.capacity)
+	config.maxLoadFactor = algorithms.FloatParam(params, "max_load_factor", config.maxLoadFactor)
+
+	// 容量取2的幂，保证二次探测与双重哈希能遍历所有槽位
+	if config.capacity < 1 {
+		config.capacity = 1
+	}
+	if config.capacity > 4096 {
+		config.capacity = 4096
+	}
+	capacity := 1
+	for capacity < config.capacity {
+		capacity *= 2
+	}
+	config.capacity = capacity
+
+	// 装载因子上限：开放寻址必须留有空槽，布谷鸟哈希超过0.5后踢出循环概率急剧上升
+	switch config.strategy {
+	case StrategyChaining:
+		config.maxLoadFactor = math.Min(math.Max(config.maxLoadFactor, 0.1), 4.0)
+	case StrategyCuckoo:
+		config.maxLoadFactor = math.Min(math.Max(config.maxLoadFactor, 0.1), 0.5)
+	default:
+		config.maxLoadFactor = math.Min(math.Max(config.maxLoadFactor, 0.1), 0.9)
+	}
+
+	return config
 }
 
 // Execute 执行哈希搜索
 func (hs *HashSearch) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return hs.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 按参数执行哈希搜索
+func (hs *HashSearch) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
 	// 验证输入
 	if err := hs.ValidateInput(data); err != nil {
 		return nil, err
 	}
 
-	// 这里需要从参数中获取目标值，暂时使用默认实现
-	// 在实际使用中，target应该从parameters中获取
-	target := 5 // 示例目标值
+	// 未指定目标值时沿用示例目标值5
+	target := algorithms.ValueParam(params, "target", 5)
 
 	// 转换数据类型
 	arr, ok := data.([]interface{})
@@ -66,25 +149,74 @@ func (hs *HashSearch) Execute(data interface{}, tracker models.StepTracker) (int
 		return nil, algorithms.ErrInvalidInput
 	}
 
-	// 执行搜索
-	index, err := hs.Search(arr, target, tracker)
+	config := parseHashSearchConfig(params)
+	run, err := hs.search(arr, target, config, tracker)
 	if err != nil {
 		return nil, err
 	}
 
-	return map[string]interface{}{
-		"index":  index,
-		"found":  index != -1,
-		"target": target,
-	}, nil
+	result := map[string]interface{}{
+		"index":          run.index,
+		"found":          run.index != -1,
+		"target":         target,
+		"strategy":       config.strategy,
+		"hashFunction":   config.hashFunction,
+		"probeSequence":  run.searchProbes,
+		"insertProbes":   run.insertProbes,
+		"resizes":        run.resizes,
+		"capacity":       run.table.capacity,
+		"size":           run.table.count,
+		"loadFactor":     run.table.loadFactor(),
+		"totalProbes":    run.table.totalProbes,
+		"maxProbes":      run.table.maxProbes,
+		"longestCluster": run.table.longestCluster(),
+		"occupancy":      run.table.occupancy(),
+	}
+	if run.table.count > 0 {
+		result["averageProbes"] = float64(run.table.totalProbes) / float64(run.table.count)
+	}
+	switch config.strategy {
+	case StrategyRobinHood:
+		result["robinHoodSwaps"] = run.table.swaps
+	case StrategyCuckoo:
+		result["evictions"] = run.table.evictions
+	}
+
+	return result, nil
 }
 
-// Search 哈希搜索实现
+// hashSearchRun 单次哈希搜索的执行结果
+type hashSearchRun struct {
+	table        *hashTable
+	index        int
+	resizes      int
+	insertProbes []map[string]interface{}
+	searchProbes []int
+}
+
+// Search 哈希搜索实现（默认链地址法）
 func (hs *HashSearch) Search(data []interface{}, target interface{}, tracker models.StepTracker) (int, error) {
+	run, err := hs.search(data, target, defaultHashSearchConfig(), tracker)
+	if err != nil {
+		return -1, err
+	}
+	return run.index, nil
+}
+
+// search 按配置构建哈希表并搜索目标值
+func (hs *HashSearch) search(data []interface{}, target interface{}, config hashSearchConfig, tracker models.StepTracker) (*hashSearchRun, error) {
+	run := &hashSearchRun{
+		table:        newHashTable(config.strategy, config.hashFunction, config.capacity, tracker),
+		index:        -1,
+		insertProbes: make([]map[string]interface{}, 0, len(data)),
+		searchProbes: []int{},
+	}
+	table := run.table
+
 	n := len(data)
 	if n == 0 {
 		tracker.AddStep("数组为空，搜索结束", data, []int{})
-		return -1, nil
+		return run, nil
 	}
 
 	tracker.SetPhase("初始化")
@@ -92,110 +224,122 @@ func (hs *HashSearch) Search(data []interface{}, target interface{}, tracker mod
 
 	// 第一阶段：构建哈希表
 	tracker.SetPhase("构建哈希表")
+	tracker.AddStep("创建哈希表，策略: "+config.strategy+"，哈希函数: "+config.hashFunction+
+		"，容量: "+strconv.Itoa(table.capacity), data, []int{})
 	tracker.AddNote("为了快速搜索，先将所有元素加入哈希表")
-
-	// 创建哈希表，大小为数组长度的2倍以减少冲突
-	hashSize := max(n*2, 16)
-	table := hs.createHashTable(hashSize)
-
-	tracker.AddStep("创建哈希表，大小: "+strconv.Itoa(hashSize), data, []int{})
+	if config.strategy == StrategyCuckoo {
+		tracker.AddNote("布谷鸟哈希使用两张各 " + strconv.Itoa(table.capacity) + " 个槽位的表，探测序列中表2的槽位编号加上 " + strconv.Itoa(table.capacity))
+	}
 
 	// 将所有元素插入哈希表
 	for i, element := range data {
+		// 重复键只保留首次出现的位置
+		if existing, _ := table.lookup(element); existing != -1 {
+			tracker.AddStep("元素 "+hs.toString(element)+" 已在哈希表中，保留首次出现的位置 "+strconv.Itoa(existing), data, []int{i, existing})
+			continue
+		}
+
+		// 插入前检查装载因子，超限则扩容重哈希
+		if float64(table.count+1)/float64(hs.slotCount(table)) > config.maxLoadFactor {
+			if err := hs.resize(run, table.capacity*2, data, tracker); err != nil {
+				return nil, err
+			}
+		}
+
 		tracker.AddStep("插入元素 "+hs.toString(element)+" 到哈希表", data, []int{i})
+		tracker.AddNote("元素 " + hs.toString(element) + " 的哈希值: " + strconv.Itoa(table.primaryHash(element)))
 
-		hash := hs.hash(element, hashSize)
-		tracker.AddNote("元素 " + hs.toString(element) + " 的哈希值: " + strconv.Itoa(hash))
+		probes, err := table.insert(hashEntry{key: element, value: i})
+		if err == errCuckooCycle {
+			tracker.AddNote("踢出次数超过上限，出现循环，需要扩容重哈希")
+			if err := hs.resize(run, table.capacity*2, data, tracker); err != nil {
+				return nil, err
+			}
+		}
+		// 布谷鸟踢出和重哈希都会移动元素，探测序列的最后一个槽位不一定是它的位置，以表中的实际位置为准
+		_, found := table.lookup(element)
+		slot := found[len(found)-1]
 
-		// 检查是否有冲突
-		if len(table.buckets[hash]) > 0 {
-			tracker.AddNote("哈希冲突！桶 " + strconv.Itoa(hash) + " 已有元素")
+		tracker.AddOperation(models.OpTypeProbe, probes, []interface{}{element},
+			"探测序列 "+formatProbes(probes))
+		tracker.AddOperation(models.OpTypeInsert, []int{i},
+			[]interface{}{element}, "插入元素到槽位 "+strconv.Itoa(slot))
+		if len(probes) > 1 {
+			tracker.AddNote("共探测 " + strconv.Itoa(len(probes)) + " 次")
 		}
 
-		// 插入到哈希表
-		table.buckets[hash] = append(table.buckets[hash], hashEntry{
-			key:   element,
-			value: i,
+		run.insertProbes = append(run.insertProbes, map[string]interface{}{
+			"key":    element,
+			"probes": probes,
 		})
-
-		tracker.AddOperation(models.OpTypeInsert, []int{i},
-			[]interface{}{element}, "插入元素到哈希表桶 "+strconv.Itoa(hash))
 	}
 
-	tracker.AddStep("哈希表构建完成", data, []int{})
+	tracker.AddStep("哈希表构建完成，装载因子: "+strconv.FormatFloat(table.loadFactor(), 'f', 2, 64)+
+		"，最长聚集: "+strconv.Itoa(table.longestCluster()), data, []int{})
 
 	// 第二阶段：搜索目标值
 	tracker.SetPhase("哈希搜索")
 	tracker.AddStep("在哈希表中搜索目标值: "+hs.toString(target), data, []int{})
+	tracker.AddNote("目标值的哈希值: " + strconv.Itoa(table.primaryHash(target)))
 
-	// 计算目标值的哈希值
-	targetHash := hs.hash(target, hashSize)
-	tracker.AddStep("目标值的哈希值: "+strconv.Itoa(targetHash), data, []int{})
-	tracker.AddNote("直接访问桶 " + strconv.Itoa(targetHash))
-
-	// 在对应的桶中搜索
-	bucket := table.buckets[targetHash]
-	if len(bucket) == 0 {
-		// 桶为空，目标不存在
-		tracker.AddStep("桶 "+strconv.Itoa(targetHash)+" 为空，目标值不存在", data, []int{})
-		tracker.AddNote("搜索失败")
-		return -1, nil
-	}
-
-	// 在桶中线性搜索
-	tracker.AddStep("在桶 "+strconv.Itoa(targetHash)+" 中搜索，桶大小: "+strconv.Itoa(len(bucket)),
-		data, []int{})
+	index, probes := table.lookup(target)
+	run.index = index
+	run.searchProbes = probes
 
-	for i, entry := range bucket {
-		tracker.AddStep("检查桶中第 "+strconv.Itoa(i+1)+" 个元素: "+hs.toString(entry.key),
-			data, []int{entry.value})
+	// 逐个展示探测过的槽位
+	for i, slot := range probes {
+		tracker.AddStep("第 "+strconv.Itoa(i+1)+" 次探测: 槽位 "+strconv.Itoa(slot), data, []int{})
+		tracker.AddOperation(models.OpTypeProbe, []int{slot}, []interface{}{target}, "探测槽位 "+strconv.Itoa(slot))
+	}
 
-		if hs.compare(entry.key, target) == 0 {
-			// 找到目标元素
-			tracker.AddStep("找到目标元素! 位置: "+strconv.Itoa(entry.value), data, []int{entry.value})
-			tracker.AddNote("搜索成功，哈希表提供了O(1)平均时间复杂度")
-			return entry.value, nil
-		}
+	if index != -1 {
+		// 找到目标元素
+		tracker.AddComparison(index, -1, 0)
+		tracker.AddStep("找到目标元素! 位置: "+strconv.Itoa(index), data, []int{index})
+		tracker.AddNote("搜索成功，共探测 " + strconv.Itoa(len(probes)) + " 次")
+		return run, nil
 	}
 
-	// 桶中没有找到目标
-	tracker.AddStep("桶 "+strconv.Itoa(targetHash)+" 中未找到目标值", data, []int{})
+	tracker.AddStep("探测序列 "+formatProbes(probes)+" 中未找到目标值", data, []int{})
 	tracker.AddNote("搜索失败")
-	return -1, nil
+	return run, nil
 }
 
-// createHashTable 创建哈希表
-func (hs *HashSearch) createHashTable(size int) *hashTable {
-	return &hashTable{
-		buckets: make([][]hashEntry, size),
-		size:    size,
+// slotCount 哈希表的槽位总数
+func (hs *HashSearch) slotCount(table *hashTable) int {
+	if table.strategy == StrategyCuckoo {
+		return 2 * table.capacity
 	}
+	return table.capacity
 }
 
-// hash 简单的哈希函数
-func (hs *HashSearch) hash(value interface{}, tableSize int) int {
-	var hashValue int
+// resize 扩容并重哈希
+func (hs *HashSearch) resize(run *hashSearchRun, newCapacity int, data []interface{}, tracker models.StepTracker) error {
+	table := run.table
+	oldCapacity := table.capacity
+	oldLoad := table.loadFactor()
 
-	switch v := value.(type) {
-	case int:
-		hashValue = v
-	case float64:
-		hashValue = int(v)
-	case string:
-		// 简单的字符串哈希
-		hashValue = 0
-		for _, char := range v {
-			hashValue = hashValue*31 + int(char)
-		}
-	default:
-		hashValue = 0
+	tracker.SetPhase("扩容重哈希")
+	if err := table.rehash(newCapacity); err != nil {
+		return err
 	}
+	run.resizes++
 
-	// 确保哈希值为正数并在表大小范围内
-	if hashValue < 0 {
-		hashValue = -hashValue
+	tracker.AddStep("装载因子 "+strconv.FormatFloat(oldLoad, 'f', 2, 64)+" 触发扩容: "+
+		strconv.Itoa(oldCapacity)+" -> "+strconv.Itoa(table.capacity)+"，重新插入 "+strconv.Itoa(table.count)+" 个元素", data, []int{})
+	tracker.AddOperation(models.OpTypeUpdate, []int{}, []interface{}{oldCapacity, table.capacity}, "扩容重哈希")
+	tracker.AddNote("扩容后所有元素需按新容量重新计算哈希值")
+	tracker.SetPhase("构建哈希表")
+	return nil
+}
+
+// formatProbes 格式化探测序列
+func formatProbes(probes []int) string {
+	parts := make([]string, len(probes))
+	for i, p := range probes {
+		parts[i] = strconv.Itoa(p)
 	}
-	return hashValue % tableSize
+	return "[" + strings.Join(parts, " -> ") + "]"
 }
 
 // max 返回两个整数中的较大值
@@ -206,38 +350,35 @@ func max(a, b int) int {
 	return b
 }
 
-// compare 比较两个元素
-func (hs *HashSearch) compare(a, b interface{}) int {
-	switch va := a.(type) {
-	case int:
-		if vb, ok := b.(int); ok {
-			if va < vb {
-				return -1
-			} else if va > vb {
-				return 1
-			}
-			return 0
+// compareValues 比较两个键，整数与浮点数按数值比较，类型不同的键视为不相等
+func compareValues(a, b interface{}) int {
+	fa, okA := algorithms.ToFloat(a)
+	fb, okB := algorithms.ToFloat(b)
+	if okA && okB {
+		if fa < fb {
+			return -1
+		} else if fa > fb {
+			return 1
 		}
-	case float64:
-		if vb, ok := b.(float64); ok {
-			if va < vb {
-				return -1
-			} else if va > vb {
-				return 1
-			}
-			return 0
-		}
-	case string:
-		if vb, ok := b.(string); ok {
-			if va < vb {
-				return -1
-			} else if va > vb {
-				return 1
-			}
-			return 0
+		return 0
+	}
+
+	sa, okA := a.(string)
+	sb, okB := b.(string)
+	if okA && okB {
+		if sa < sb {
+			return -1
+		} else if sa > sb {
+			return 1
 		}
+		return 0
+	}
+
+	// 类型不同或不可比较
+	if okA {
+		return 1
 	}
-	return 0
+	return -1
 }
 
 // toString 将元素转换为字符串
@@ -0,0 +1,180 @@
+package searching
+
+import (
+	"gin/models"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestHashSearch_Strategies(t *testing.T) {
+	hs := NewHashSearch()
+	data := []interface{}{17, 3, 25, 9, 41, 33, 1, 57, 12, 65, 8, 73, 3}
+
+	strategies := []string{StrategyChaining, StrategyLinear, StrategyQuadratic, StrategyDouble, StrategyRobinHood, StrategyCuckoo}
+	hashFunctions := []string{HashDivision, HashMultiplication, HashFNV}
+
+	for _, strategy := range strategies {
+		for _, hashFunction := range hashFunctions {
+			t.Run(strategy+"/"+hashFunction, func(t *testing.T) {
+				for i, value := range data {
+					params := map[string]interface{}{
+						"target":           float64(value.(int)),
+						"strategy":         strategy,
+						"hash_function":    hashFunction,
+						"initial_capacity": float64(2),
+					}
+					result, err := hs.ExecuteWithParams(data, params, models.NewStepTracker())
+					if err != nil {
+						t.Fatalf("ExecuteWithParams() error = %v", err)
+					}
+
+					output := result.(map[string]interface{})
+					expected := i
+					if value == 3 {
+						expected = 1 // 重复键保留首次出现的位置
+					}
+					if output["index"] != expected {
+						t.Errorf("target %v: index = %v, expected %v", value, output["index"], expected)
+					}
+					if output["size"] != 12 {
+						t.Errorf("size = %v, expected 12", output["size"])
+					}
+					if output["loadFactor"].(float64) > 1 && strategy != StrategyChaining {
+						t.Errorf("loadFactor = %v exceeds open addressing capacity", output["loadFactor"])
+					}
+				}
+
+				result, err := hs.ExecuteWithParams(data, map[string]interface{}{
+					"target":        100,
+					"strategy":      strategy,
+					"hash_function": hashFunction,
+				}, models.NewStepTracker())
+				if err != nil {
+					t.Fatalf("ExecuteWithParams() error = %v", err)
+				}
+				if index := result.(map[string]interface{})["index"]; index != -1 {
+					t.Errorf("missing target: index = %v, expected -1", index)
+				}
+			})
+		}
+	}
+}
+
+func TestHashSearch_Resize(t *testing.T) {
+	hs := NewHashSearch()
+	data := make([]interface{}, 40)
+	for i := range data {
+		data[i] = i * 7
+	}
+
+	result, err := hs.ExecuteWithParams(data, map[string]interface{}{
+		"target":           float64(21),
+		"strategy":         StrategyLinear,
+		"initial_capacity": 4,
+		"max_load_factor":  0.5,
+	}, models.NewStepTracker())
+	if err != nil {
+		t.Fatalf("ExecuteWithParams() error = %v", err)
+	}
+
+	output := result.(map[string]interface{})
+	if output["index"] != 3 {
+		t.Errorf("index = %v, expected 3", output["index"])
+	}
+	if output["resizes"].(int) == 0 {
+		t.Error("expected at least one resize")
+	}
+	if output["loadFactor"].(float64) > 0.5 {
+		t.Errorf("loadFactor = %v, expected <= 0.5", output["loadFactor"])
+	}
+}
+
+func TestHashSearch_CuckooInsertSlot(t *testing.T) {
+	// 除法哈希下 16 的倍数在小容量时落在同一槽位，很快出现踢出循环
+	data := make([]interface{}, 12)
+	for i := range data {
+		data[i] = i * 16
+	}
+	tracker := models.NewStepTracker()
+	if _, err := NewHashSearch().ExecuteWithParams(data, map[string]interface{}{
+		"target":           float64(32),
+		"strategy":         StrategyCuckoo,
+		"hash_function":    HashDivision,
+		"initial_capacity": 2,
+	}, tracker); err != nil {
+		t.Fatalf("ExecuteWithParams() error = %v", err)
+	}
+
+	// 按操作顺序跟踪容量，插入的槽位必须是元素在当前容量下的两个候选位置之一
+	table := newHashTable(StrategyCuckoo, HashDivision, 2, tracker)
+	cycles := 0
+	for _, step := range tracker.GetSteps() {
+		for _, note := range step.Metadata.Notes {
+			if strings.Contains(note, "出现循环") {
+				cycles++
+			}
+		}
+		for _, op := range step.Operations {
+			switch op.Type {
+			case models.OpTypeUpdate:
+				table.allocate(op.Values[1].(int))
+			case models.OpTypeInsert:
+				key := op.Values[0]
+				slot, _ := strconv.Atoi(strings.TrimPrefix(op.Description, "插入元素到槽位 "))
+				if slot != table.cuckooSlot(key, 0) && slot != table.capacity+table.cuckooSlot(key, 1) {
+					t.Errorf("元素 %v 插入到槽位 %d，容量 %d 时不是它的候选位置", key, slot, table.capacity)
+				}
+			}
+		}
+	}
+	if cycles == 0 {
+		t.Error("expected at least one cuckoo cycle")
+	}
+}
+
+func TestHashSearch_NegativeKeys(t *testing.T) {
+	data := []interface{}{-1, -2, -3, -4, -17, -64, 5, -1000003}
+	strategies := []string{StrategyChaining, StrategyLinear, StrategyQuadratic, StrategyDouble, StrategyRobinHood, StrategyCuckoo}
+	for _, strategy := range strategies {
+		for _, hashFunction := range []string{HashDivision, HashMultiplication, HashFNV} {
+			for i, value := range data {
+				result, err := NewHashSearch().ExecuteWithParams(data, map[string]interface{}{
+					"target":           value,
+					"strategy":         strategy,
+					"hash_function":    hashFunction,
+					"initial_capacity": 2,
+				}, models.NewStepTracker())
+				if err != nil {
+					t.Fatalf("%s/%s: ExecuteWithParams() error = %v", strategy, hashFunction, err)
+				}
+				if index := result.(map[string]interface{})["index"]; index != i {
+					t.Errorf("%s/%s: index of %v = %v, expected %d", strategy, hashFunction, value, index, i)
+				}
+			}
+		}
+	}
+
+	// 负数键的乘法哈希不会全部落在同一个槽位
+	table := newHashTable(StrategyChaining, HashMultiplication, 16, models.NewStepTracker())
+	slots := map[int]bool{}
+	for _, key := range []interface{}{-1, -2, -3, -4} {
+		slots[table.primaryHash(key)] = true
+		slots[table.secondaryHash(key)+table.capacity] = true
+	}
+	if len(slots) < 6 {
+		t.Errorf("negative keys hash to only %d distinct slots", len(slots))
+	}
+}
+
+func TestHashSearch_CuckooRehashLimit(t *testing.T) {
+	// 三个不同的键位模式相同，两张表的候选位置始终相同，无论怎样扩容都无法安置
+	data := []interface{}{97, "a", "\x00a"}
+	_, err := NewHashSearch().ExecuteWithParams(data, map[string]interface{}{
+		"target":   97,
+		"strategy": StrategyCuckoo,
+	}, models.NewStepTracker())
+	if err == nil {
+		t.Error("expected an error when cuckoo hashing cannot place every key")
+	}
+}
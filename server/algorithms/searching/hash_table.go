@@ -0,0 +1,515 @@
+package searching
+
+import (
+	"fmt"
+	"gin/models"
+	"hash/fnv"
+	"math"
+	"math/bits"
+	"strconv"
+)
+
+// 冲突处理策略
+const (
+	StrategyChaining  = "chaining"
+	StrategyLinear    = "linear"
+	StrategyQuadratic = "quadratic"
+	StrategyDouble    = "double"
+	StrategyRobinHood = "robin_hood"
+	StrategyCuckoo    = "cuckoo"
+)
+
+// 哈希函数
+const (
+	HashDivision       = "division"
+	HashMultiplication = "multiplication"
+	HashFNV            = "fnv"
+)
+
+// 乘法哈希使用的64位奇数乘数：2^64 除以黄金分割比例（Fibonacci 哈希），以及次哈希使用的另一个乘数
+const (
+	goldenRatioMultiplier = 0x9E3779B97F4A7C15
+	secondaryMultiplier   = 0xC2B2AE3D27D4EB4F
+)
+
+// maxRehashDoublings 布谷鸟哈希重哈希时为消除踢出循环最多连续扩容的次数
+const maxRehashDoublings = 8
+
+// hashEntry 哈希表条目
+type hashEntry struct {
+	key   interface{}
+	value int // 存储原数组中的索引
+}
+
+// hashSlot 开放寻址表的槽位
+type hashSlot struct {
+	entry    hashEntry
+	occupied bool
+	distance int // 距离理想槽位的探测距离（Robin Hood使用）
+}
+
+// hashTable 支持多种冲突处理策略的哈希表
+// 链地址法使用 buckets，开放寻址使用 slots，布谷鸟哈希使用两张表 tables
+type hashTable struct {
+	strategy string
+	hashFunc string
+	capacity int
+	count    int
+	buckets  [][]hashEntry
+	slots    []hashSlot
+	tables   [2][]hashSlot
+
+	tracker models.StepTracker
+	quiet   bool        // 重哈希期间不记录逐条备注
+	pending []hashEntry // 布谷鸟哈希踢出循环后待安置的条目
+
+	totalProbes int
+	maxProbes   int
+	evictions   int
+	swaps       int
+}
+
+// errCuckooCycle 布谷鸟哈希踢出次数超过上限，需要扩容重哈希
+var errCuckooCycle = fmt.Errorf("布谷鸟哈希出现踢出循环")
+
+// errRehashFailed 连续扩容后仍无法安置所有键（例如多个键的位模式相同）
+var errRehashFailed = fmt.Errorf("布谷鸟哈希连续扩容 %d 次后仍出现踢出循环", maxRehashDoublings)
+
+// newHashTable 创建哈希表
+func newHashTable(strategy, hashFunc string, capacity int, tracker models.StepTracker) *hashTable {
+	table := &hashTable{
+		strategy: strategy,
+		hashFunc: hashFunc,
+		tracker:  tracker,
+	}
+	table.allocate(capacity)
+	return table
+}
+
+// allocate 按容量分配存储空间
+func (t *hashTable) allocate(capacity int) {
+	t.capacity = capacity
+	t.count = 0
+	switch t.strategy {
+	case StrategyChaining:
+		t.buckets = make([][]hashEntry, capacity)
+	case StrategyCuckoo:
+		t.tables = [2][]hashSlot{make([]hashSlot, capacity), make([]hashSlot, capacity)}
+	default:
+		t.slots = make([]hashSlot, capacity)
+	}
+}
+
+// loadFactor 当前装载因子
+func (t *hashTable) loadFactor() float64 {
+	if t.strategy == StrategyCuckoo {
+		return float64(t.count) / float64(2*t.capacity)
+	}
+	return float64(t.count) / float64(t.capacity)
+}
+
+// entries 按存储顺序返回所有条目
+func (t *hashTable) entries() []hashEntry {
+	result := make([]hashEntry, 0, t.count)
+	switch t.strategy {
+	case StrategyChaining:
+		for _, bucket := range t.buckets {
+			result = append(result, bucket...)
+		}
+	case StrategyCuckoo:
+		for _, table := range t.tables {
+			for _, slot := range table {
+				if slot.occupied {
+					result = append(result, slot.entry)
+				}
+			}
+		}
+	default:
+		for _, slot := range t.slots {
+			if slot.occupied {
+				result = append(result, slot.entry)
+			}
+		}
+	}
+	return result
+}
+
+// keyBits 将键转换为整数位模式
+func keyBits(key interface{}) uint64 {
+	switch v := key.(type) {
+	case int:
+		return uint64(int64(v))
+	case float64:
+		if v == math.Trunc(v) {
+			return uint64(int64(v))
+		}
+		return math.Float64bits(v)
+	case string:
+		var h uint64
+		for _, char := range v {
+			h = h*31 + uint64(char)
+		}
+		return h
+	default:
+		return 0
+	}
+}
+
+// primaryHash 主哈希函数
+func (t *hashTable) primaryHash(key interface{}) int {
+	return applyHash(t.hashFunc, key, t.capacity)
+}
+
+// secondaryHash 次哈希函数（双重哈希步长、布谷鸟第二张表），与主哈希相互独立
+func (t *hashTable) secondaryHash(key interface{}) int {
+	return multiplicativeHash(keyBits(key), secondaryMultiplier, t.capacity)
+}
+
+// multiplicativeHash 整数乘法哈希：k·A 在 64 位上溢出取模后取高 log2(m) 位
+// 全程使用整数运算，负数键转换得到的大整数也能均匀分布
+func multiplicativeHash(k, multiplier uint64, capacity int) int {
+	shift := 64 - bits.Len(uint(capacity-1))
+	return int(((k * multiplier) >> uint(shift)) % uint64(capacity))
+}
+
+// applyHash 按指定哈希函数计算槽位
+func applyHash(hashFunc string, key interface{}, capacity int) int {
+	switch hashFunc {
+	case HashMultiplication:
+		// 乘法哈希: (k * A mod 2^64) >> (64 - log2(m))，容量为2的幂
+		return multiplicativeHash(keyBits(key), goldenRatioMultiplier, capacity)
+	case HashFNV:
+		h := fnv.New64a()
+		h.Write([]byte(keyString(key)))
+		return int(h.Sum64() % uint64(capacity))
+	default:
+		// 除法哈希: k mod m
+		return int(keyBits(key) % uint64(capacity))
+	}
+}
+
+// keyString 键的字符串表示
+func keyString(key interface{}) string {
+	switch v := key.(type) {
+	case int:
+		return strconv.Itoa(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case string:
+		return v
+	default:
+		return "unknown"
+	}
+}
+
+// probeSlot 计算开放寻址第 i 次探测的槽位
+func (t *hashTable) probeSlot(key interface{}, home, i int) int {
+	switch t.strategy {
+	case StrategyQuadratic:
+		// 三角数探测 h + (i + i²)/2，容量为2的幂时可遍历所有槽位
+		return (home + (i+i*i)/2) % t.capacity
+	case StrategyDouble:
+		// 步长取奇数，与2的幂容量互素，保证遍历所有槽位
+		step := t.secondaryHash(key)/2*2 + 1
+		return (home + i*step) % t.capacity
+	default:
+		return (home + i) % t.capacity
+	}
+}
+
+// insert 插入条目，返回探测过的槽位序列
+func (t *hashTable) insert(entry hashEntry) ([]int, error) {
+	var probes []int
+	var err error
+
+	switch t.strategy {
+	case StrategyChaining:
+		probes = t.insertChaining(entry)
+	case StrategyRobinHood:
+		probes = t.insertRobinHood(entry)
+	case StrategyCuckoo:
+		probes, err = t.insertCuckoo(entry)
+	default:
+		probes = t.insertOpenAddressing(entry)
+	}
+
+	if err == nil {
+		t.count++
+		t.totalProbes += len(probes)
+		if len(probes) > t.maxProbes {
+			t.maxProbes = len(probes)
+		}
+	}
+	return probes, err
+}
+
+// insertChaining 链地址法插入
+func (t *hashTable) insertChaining(entry hashEntry) []int {
+	home := t.primaryHash(entry.key)
+	if len(t.buckets[home]) > 0 {
+		t.note("哈希冲突！桶 " + strconv.Itoa(home) + " 已有 " + strconv.Itoa(len(t.buckets[home])) + " 个元素，追加到链尾")
+	}
+	t.buckets[home] = append(t.buckets[home], entry)
+	return []int{home}
+}
+
+// insertOpenAddressing 线性/二次/双重哈希探测插入
+func (t *hashTable) insertOpenAddressing(entry hashEntry) []int {
+	home := t.primaryHash(entry.key)
+	probes := make([]int, 0)
+	for i := 0; i < t.capacity; i++ {
+		slot := t.probeSlot(entry.key, home, i)
+		probes = append(probes, slot)
+		if !t.slots[slot].occupied {
+			t.slots[slot] = hashSlot{entry: entry, occupied: true, distance: i}
+			return probes
+		}
+		t.note(fmt.Sprintf("槽位 %d 已被 %s 占用，继续探测", slot, keyString(t.slots[slot].entry.key)))
+	}
+	return probes
+}
+
+// insertRobinHood Robin Hood哈希插入：探测距离更短的元素让位给距离更长的元素
+func (t *hashTable) insertRobinHood(entry hashEntry) []int {
+	current := hashSlot{entry: entry, occupied: true}
+	slot := t.primaryHash(entry.key)
+	probes := make([]int, 0)
+
+	for i := 0; i < t.capacity; i++ {
+		probes = append(probes, slot)
+		if !t.slots[slot].occupied {
+			t.slots[slot] = current
+			return probes
+		}
+		if t.slots[slot].distance < current.distance {
+			// 劫富济贫：交换后继续为被换出的元素寻找位置
+			t.swaps++
+			t.note(fmt.Sprintf("槽位 %d: %s(距离%d) 比 %s(距离%d) 更靠近理想位置，交换",
+				slot, keyString(t.slots[slot].entry.key), t.slots[slot].distance,
+				keyString(current.entry.key), current.distance))
+			t.operation(models.OpTypeSwap, []int{t.slots[slot].entry.value, current.entry.value},
+				[]interface{}{slot}, "Robin Hood交换")
+			t.slots[slot], current = current, t.slots[slot]
+		}
+		current.distance++
+		slot = (slot + 1) % t.capacity
+	}
+	return probes
+}
+
+// insertCuckoo 布谷鸟哈希插入：两张表各有一个候选位置，冲突时踢出原有元素
+func (t *hashTable) insertCuckoo(entry hashEntry) ([]int, error) {
+	current := entry
+	probes := make([]int, 0)
+	maxKicks := t.capacity
+	if maxKicks < 8 {
+		maxKicks = 8
+	}
+
+	for kick := 0; kick <= maxKicks; kick++ {
+		// 依次尝试两张表中的候选位置
+		for table := 0; table < 2; table++ {
+			slot := t.cuckooSlot(current.key, table)
+			probes = append(probes, table*t.capacity+slot)
+			if !t.tables[table][slot].occupied {
+				t.tables[table][slot] = hashSlot{entry: current, occupied: true}
+				return probes, nil
+			}
+		}
+
+		// 两个位置都被占用，踢出表 kick%2 中的元素
+		table := kick % 2
+		slot := t.cuckooSlot(current.key, table)
+		evicted := t.tables[table][slot].entry
+		t.tables[table][slot] = hashSlot{entry: current, occupied: true}
+		t.evictions++
+		t.note(fmt.Sprintf("表%d槽位 %d: %s 踢出 %s", table+1, slot, keyString(current.key), keyString(evicted.key)))
+		t.operation(models.OpTypeMove, []int{evicted.value}, []interface{}{table, slot}, "布谷鸟踢出")
+		current = evicted
+	}
+
+	// 踢出次数超过上限：记下最后被踢出的元素，由调用方扩容后重新插入
+	t.pending = append(t.pending, current)
+	return probes, errCuckooCycle
+}
+
+// rehash 扩容到 newCapacity 并重新插入所有条目（包括待安置的条目）
+// 布谷鸟哈希在重哈希过程中再次出现循环时继续扩容，超过 maxRehashDoublings 次仍失败则返回错误
+func (t *hashTable) rehash(newCapacity int) error {
+	t.quiet = true
+	defer func() { t.quiet = false }()
+
+	pending := append(t.entries(), t.pending...)
+	for attempt := 0; ; attempt++ {
+		t.pending = nil
+		t.allocate(newCapacity)
+
+		failed := -1
+		for i, entry := range pending {
+			if _, err := t.insert(entry); err != nil {
+				failed = i
+				break
+			}
+		}
+		if failed < 0 {
+			return nil
+		}
+		if attempt == maxRehashDoublings {
+			return errRehashFailed
+		}
+
+		// 重哈希过程中再次出现循环，继续扩容
+		pending = append(append(t.entries(), t.pending...), pending[failed+1:]...)
+		newCapacity *= 2
+	}
+}
+
+// note 记录备注（重哈希期间忽略）
+func (t *hashTable) note(note string) {
+	if !t.quiet {
+		t.tracker.AddNote(note)
+	}
+}
+
+// operation 记录操作（重哈希期间忽略）
+func (t *hashTable) operation(opType string, indices []int, values []interface{}, description string) {
+	if !t.quiet {
+		t.tracker.AddOperation(opType, indices, values, description)
+	}
+}
+
+// cuckooSlot 布谷鸟哈希第 table 张表中的候选位置
+func (t *hashTable) cuckooSlot(key interface{}, table int) int {
+	if table == 0 {
+		return t.primaryHash(key)
+	}
+	return t.secondaryHash(key)
+}
+
+// lookup 查找键，返回原数组索引（未找到为-1）和探测过的槽位序列
+func (t *hashTable) lookup(key interface{}) (int, []int) {
+	probes := make([]int, 0)
+
+	switch t.strategy {
+	case StrategyChaining:
+		home := t.primaryHash(key)
+		probes = append(probes, home)
+		for _, entry := range t.buckets[home] {
+			if compareValues(entry.key, key) == 0 {
+				return entry.value, probes
+			}
+		}
+		return -1, probes
+
+	case StrategyCuckoo:
+		for table := 0; table < 2; table++ {
+			slot := t.cuckooSlot(key, table)
+			probes = append(probes, table*t.capacity+slot)
+			if t.tables[table][slot].occupied && compareValues(t.tables[table][slot].entry.key, key) == 0 {
+				return t.tables[table][slot].entry.value, probes
+			}
+		}
+		return -1, probes
+
+	default:
+		home := t.primaryHash(key)
+		for i := 0; i < t.capacity; i++ {
+			slot := t.probeSlot(key, home, i)
+			if t.strategy == StrategyRobinHood {
+				slot = (home + i) % t.capacity
+			}
+			probes = append(probes, slot)
+
+			s := t.slots[slot]
+			if !s.occupied {
+				return -1, probes
+			}
+			if compareValues(s.entry.key, key) == 0 {
+				return s.entry.value, probes
+			}
+			// Robin Hood 提前终止：当前元素的探测距离小于已探测次数时目标不可能在后面
+			if t.strategy == StrategyRobinHood && s.distance < i {
+				return -1, probes
+			}
+		}
+		return -1, probes
+	}
+}
+
+// occupancy 返回最终的桶/槽位占用情况
+func (t *hashTable) occupancy() interface{} {
+	switch t.strategy {
+	case StrategyChaining:
+		buckets := make([][]interface{}, t.capacity)
+		for i, bucket := range t.buckets {
+			buckets[i] = make([]interface{}, 0, len(bucket))
+			for _, entry := range bucket {
+				buckets[i] = append(buckets[i], entry.key)
+			}
+		}
+		return buckets
+	case StrategyCuckoo:
+		tables := make([][]interface{}, 2)
+		for i, table := range t.tables {
+			tables[i] = slotKeys(table)
+		}
+		return tables
+	default:
+		return slotKeys(t.slots)
+	}
+}
+
+// slotKeys 返回槽位中的键，空槽位为nil
+func slotKeys(slots []hashSlot) []interface{} {
+	keys := make([]interface{}, len(slots))
+	for i, slot := range slots {
+		if slot.occupied {
+			keys[i] = slot.entry.key
+		}
+	}
+	return keys
+}
+
+// longestCluster 最长的连续占用槽位（聚集）长度；链地址法返回最长链长度
+func (t *hashTable) longestCluster() int {
+	switch t.strategy {
+	case StrategyChaining:
+		longest := 0
+		for _, bucket := range t.buckets {
+			if len(bucket) > longest {
+				longest = len(bucket)
+			}
+		}
+		return longest
+	case StrategyCuckoo:
+		longest := 0
+		for _, table := range t.tables {
+			if c := clusterLength(table); c > longest {
+				longest = c
+			}
+		}
+		return longest
+	default:
+		return clusterLength(t.slots)
+	}
+}
+
+// clusterLength 计算环形槽位数组中最长的连续占用长度
+func clusterLength(slots []hashSlot) int {
+	n := len(slots)
+	longest, current := 0, 0
+	for i := 0; i < 2*n; i++ {
+		if slots[i%n].occupied {
+			current++
+			if current > longest {
+				longest = current
+			}
+		} else {
+			current = 0
+		}
+	}
+	if longest > n {
+		longest = n
+	}
+	return longest
+}
@@ -0,0 +1,70 @@
+package searching
+
+import (
+	"gin/algorithms"
+	"gin/models"
+)
+
+// IntroSelect 内省选择算法
+type IntroSelect struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewIntroSelect 创建内省选择算法实例
+func NewIntroSelect() *IntroSelect {
+	return &IntroSelect{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "intro_select",
+			Name:            "内省选择",
+			Category:        models.CategorySearching,
+			Description:     "内省选择以三数取中的快速选择开始，当分区轮数超过深度限制时切换为中位数的中位数选择基准，兼顾快速选择的平均性能与线性的最坏情况。",
+			TimeComplexity:  "O(n)",
+			SpaceComplexity: "O(log n)",
+			Parameters: []models.Parameter{
+				kParameter(),
+				{
+					Name:         "depth_limit",
+					Type:         "int",
+					Description:  "切换为中位数的中位数前允许的分区轮数（0 表示 2·log₂n）",
+					DefaultValue: 0,
+					Required:     false,
+					Min:          0,
+				},
+			},
+			Stable:   false,
+			InPlace:  true,
+			Adaptive: false,
+		},
+	}
+}
+
+// Execute 执行内省选择（默认查找中位数）
+func (is *IntroSelect) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return is.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 按参数执行内省选择
+func (is *IntroSelect) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	return executeSelection(data, params, pivotIntro, "内省选择", tracker)
+}
+
+// ValidateInput 验证输入数据
+func (is *IntroSelect) ValidateInput(data interface{}) error {
+	return validateSelectionInput(data)
+}
+
+// GetComplexity 获取复杂度信息
+func (is *IntroSelect) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(n)",
+			Average: "O(n)",
+			Worst:   "O(n)",
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(1)",
+			Average: "O(log n)",
+			Worst:   "O(log n)",
+		},
+	}
+}
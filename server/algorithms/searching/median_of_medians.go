@@ -0,0 +1,62 @@
+package searching
+
+import (
+	"gin/algorithms"
+	"gin/models"
+)
+
+// MedianOfMedians 中位数的中位数算法
+type MedianOfMedians struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewMedianOfMedians 创建中位数的中位数算法实例
+func NewMedianOfMedians() *MedianOfMedians {
+	return &MedianOfMedians{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "median_of_medians",
+			Name:            "中位数的中位数",
+			Category:        models.CategorySearching,
+			Description:     "中位数的中位数（BFPRT）算法是确定性的线性时间选择算法。将元素每五个分为一组，取各组中位数，再递归选出这些中位数的中位数作为分区基准，保证每轮至少丢弃约30%的元素。",
+			TimeComplexity:  "O(n)",
+			SpaceComplexity: "O(log n)",
+			Parameters: []models.Parameter{
+				kParameter(),
+			},
+			Stable:   false,
+			InPlace:  true,
+			Adaptive: false,
+		},
+	}
+}
+
+// Execute 执行中位数的中位数（默认查找中位数）
+func (mm *MedianOfMedians) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return mm.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 按参数执行中位数的中位数
+func (mm *MedianOfMedians) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	return executeSelection(data, params, pivotMedianOfMedians, "中位数的中位数", tracker)
+}
+
+// ValidateInput 验证输入数据
+func (mm *MedianOfMedians) ValidateInput(data interface{}) error {
+	return validateSelectionInput(data)
+}
+
+// GetComplexity 获取复杂度信息
+func (mm *MedianOfMedians) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(n)",
+			Average: "O(n)",
+			Worst:   "O(n)",
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(1)",
+			Average: "O(log n)",
+			Worst:   "O(log n)",
+		},
+	}
+}
@@ -0,0 +1,70 @@
+package searching
+
+import (
+	"gin/algorithms"
+	"gin/models"
+)
+
+// QuickSelect 快速选择算法
+type QuickSelect struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewQuickSelect 创建快速选择算法实例
+func NewQuickSelect() *QuickSelect {
+	return &QuickSelect{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "quick_select",
+			Name:            "快速选择",
+			Category:        models.CategorySearching,
+			Description:     "快速选择用于查找数组中第k小的元素。随机选择基准并进行与快速排序相同的分区，之后只在目标所在的一侧继续查找，平均时间复杂度为O(n)。",
+			TimeComplexity:  "O(n)",
+			SpaceComplexity: "O(1)",
+			Parameters: []models.Parameter{
+				kParameter(),
+				{
+					Name:         "seed",
+					Type:         "int",
+					Description:  "随机种子（0 表示每次随机）",
+					DefaultValue: 0,
+					Required:     false,
+					Min:          0,
+				},
+			},
+			Stable:   false,
+			InPlace:  true,
+			Adaptive: false,
+		},
+	}
+}
+
+// Execute 执行快速选择（默认查找中位数）
+func (qs *QuickSelect) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return qs.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 按参数执行快速选择
+func (qs *QuickSelect) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	return executeSelection(data, params, pivotRandom, "快速选择", tracker)
+}
+
+// ValidateInput 验证输入数据
+func (qs *QuickSelect) ValidateInput(data interface{}) error {
+	return validateSelectionInput(data)
+}
+
+// GetComplexity 获取复杂度信息
+func (qs *QuickSelect) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(n)",
+			Average: "O(n)",
+			Worst:   "O(n²)", // 每次都选到最大或最小元素作为基准
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(1)",
+			Average: "O(1)",
+			Worst:   "O(1)",
+		},
+	}
+}
@@ -0,0 +1,340 @@
+package searching
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/algorithms/sorting"
+	"gin/models"
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 选择算法的基准选择策略
+const (
+	pivotRandom          = "random"            // 随机基准（快速选择）
+	pivotIntro           = "intro"             // 三数取中，超出深度限制后改用中位数的中位数（内省选择）
+	pivotMedianOfMedians = "median_of_medians" // 中位数的中位数（确定性选择）
+)
+
+// kParameter 选择算法共用的k参数
+func kParameter() models.Parameter {
+	return models.Parameter{
+		Name:         "k",
+		Type:         "int",
+		Description:  "要查找第k小的元素（从1开始，默认取中位数）",
+		DefaultValue: nil,
+		Required:     false,
+		Min:          1,
+	}
+}
+
+// selector 第k小元素选择过程的状态
+type selector struct {
+	data       []interface{}
+	tracker    models.StepTracker
+	strategy   string
+	rng        *rand.Rand
+	depthLimit int // 内省选择切换到中位数的中位数前允许的分区轮数
+
+	partitions int             // 分区次数
+	rounds     int             // 顶层选择的分区轮数
+	maxDepth   int             // 中位数的中位数递归的最大深度
+	pivots     []interface{}   // 顶层每轮选出的基准
+	groups     [][]interface{} // 首次中位数的中位数分组（排序后）
+	medians    []interface{}   // 首次中位数的中位数分组得到的各组中位数
+	switchedAt int             // 内省选择切换策略时的轮数，-1 表示未切换
+}
+
+// newSelector 创建选择器
+func newSelector(data []interface{}, strategy string, seed int64, tracker models.StepTracker) *selector {
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+
+	n := len(data)
+	return &selector{
+		data:       data,
+		tracker:    tracker,
+		strategy:   strategy,
+		rng:        rand.New(rand.NewSource(seed)),
+		depthLimit: 2 * int(math.Log2(float64(n)+1)),
+		switchedAt: -1,
+	}
+}
+
+// parseK 解析k参数并校验范围，缺省时取下中位数
+func parseK(params map[string]interface{}, n int) (int, error) {
+	k := algorithms.IntParam(params, "k", (n+1)/2)
+	if k < 1 || k > n {
+		return 0, fmt.Errorf("k必须在1到%d之间", n)
+	}
+	return k, nil
+}
+
+// run 执行选择并返回结果
+func (s *selector) run(k int, name string) map[string]interface{} {
+	tracker := s.tracker
+	n := len(s.data)
+
+	tracker.SetPhase("初始化")
+	tracker.AddStep("开始"+name+"，查找第 "+strconv.Itoa(k)+" 小的元素", s.data, []int{})
+
+	index := s.selectKth(0, n-1, k-1, 0)
+
+	tracker.SetPhase("完成")
+	tracker.AddStep("第 "+strconv.Itoa(k)+" 小的元素为 "+formatValue(s.data[index])+"，位于位置 "+strconv.Itoa(index), s.data, []int{index})
+	tracker.AddNote("左侧元素均不大于它，右侧元素均不小于它")
+
+	result := make([]interface{}, n)
+	copy(result, s.data)
+
+	output := map[string]interface{}{
+		"k":          k,
+		"value":      s.data[index],
+		"index":      index,
+		"result":     result,
+		"partitions": s.partitions,
+		"rounds":     s.rounds,
+		"pivots":     s.pivots,
+	}
+	if s.strategy != pivotRandom {
+		output["maxDepth"] = s.maxDepth
+	}
+	if len(s.groups) > 0 {
+		output["groups"] = s.groups
+		output["medians"] = s.medians
+	}
+	if s.strategy == pivotIntro {
+		output["depthLimit"] = s.depthLimit
+		output["switched"] = s.switchedAt != -1
+		if s.switchedAt != -1 {
+			output["switchedAtRound"] = s.switchedAt
+		}
+	}
+	return output
+}
+
+// selectKth 在[low, high]范围内查找排序后位于位置target的元素，返回其下标
+func (s *selector) selectKth(low, high, target, depth int) int {
+	tracker := s.tracker
+	round := 0
+
+	for low < high {
+		round++
+		if depth == 0 {
+			s.rounds++
+			tracker.SetPhase("第 " + strconv.Itoa(round) + " 轮分区")
+		} else {
+			tracker.SetPhase("递归深度 " + strconv.Itoa(depth) + " - 选择基准")
+		}
+
+		tracker.AddStep("在子数组 ["+strconv.Itoa(low)+", "+strconv.Itoa(high)+"] 中查找位置 "+strconv.Itoa(target)+" 的元素",
+			s.data, algorithms.IndexRange(low, high))
+
+		pivotIndex := s.choosePivot(low, high, depth, round)
+		if depth == 0 {
+			s.pivots = append(s.pivots, s.data[pivotIndex])
+		}
+
+		// 基准换到子数组末尾，与快速排序共用三路分区
+		if pivotIndex != high {
+			s.data[pivotIndex], s.data[high] = s.data[high], s.data[pivotIndex]
+			tracker.AddOperation(models.OpTypeSwap, []int{pivotIndex, high}, []interface{}{s.data[pivotIndex], s.data[high]}, "将基准交换到子数组末尾")
+		}
+		lt, gt := sorting.Partition3(s.data, low, high, tracker)
+		s.partitions++
+		tracker.AddStep("三路分区完成，等于基准的区间: ["+strconv.Itoa(lt)+", "+strconv.Itoa(gt)+"]", s.data, algorithms.IndexRange(lt, gt))
+
+		switch {
+		case lt <= target && target <= gt:
+			tracker.AddNote("目标位置 " + strconv.Itoa(target) + " 落在等于基准的区间内")
+			return target
+		case target < lt:
+			tracker.AddNote("目标位置 " + strconv.Itoa(target) + " 在基准左侧，丢弃右侧 " + strconv.Itoa(high-lt+1) + " 个元素")
+			high = lt - 1
+		default:
+			tracker.AddNote("目标位置 " + strconv.Itoa(target) + " 在基准右侧，丢弃左侧 " + strconv.Itoa(gt-low+1) + " 个元素")
+			low = gt + 1
+		}
+	}
+
+	return low
+}
+
+// choosePivot 按策略选择基准，返回基准下标
+func (s *selector) choosePivot(low, high, depth, round int) int {
+	tracker := s.tracker
+
+	switch s.strategy {
+	case pivotRandom:
+		index := low + s.rng.Intn(high-low+1)
+		tracker.AddStep("随机选择基准: "+formatValue(s.data[index]), s.data, []int{index})
+		return index
+	case pivotIntro:
+		if round <= s.depthLimit && s.switchedAt == -1 {
+			return s.medianOfThree(low, high)
+		}
+		if s.switchedAt == -1 {
+			s.switchedAt = round
+			tracker.AddStep("分区轮数超过深度限制 "+strconv.Itoa(s.depthLimit)+"，切换为中位数的中位数选择基准", s.data, algorithms.IndexRange(low, high))
+			tracker.AddNote("切换后最坏情况仍为线性时间")
+		}
+		return s.medianOfMedians(low, high, depth)
+	default:
+		return s.medianOfMedians(low, high, depth)
+	}
+}
+
+// medianOfThree 三数取中：取首、中、尾三个元素的中位数
+func (s *selector) medianOfThree(low, high int) int {
+	mid := low + (high-low)/2
+	a, b, c := s.data[low], s.data[mid], s.data[high]
+	s.tracker.AddStep("三数取中: "+formatValue(a)+", "+formatValue(b)+", "+formatValue(c), s.data, []int{low, mid, high})
+
+	var index int
+	switch {
+	case compareValues(a, b) <= 0 && compareValues(b, c) <= 0, compareValues(c, b) <= 0 && compareValues(b, a) <= 0:
+		index = mid
+	case compareValues(b, a) <= 0 && compareValues(a, c) <= 0, compareValues(c, a) <= 0 && compareValues(a, b) <= 0:
+		index = low
+	default:
+		index = high
+	}
+
+	s.tracker.AddStep("选择基准: "+formatValue(s.data[index]), s.data, []int{index})
+	return index
+}
+
+// medianOfMedians 中位数的中位数：每五个元素一组，取各组中位数，再递归选出中位数的中位数
+func (s *selector) medianOfMedians(low, high, depth int) int {
+	tracker := s.tracker
+	if depth+1 > s.maxDepth {
+		s.maxDepth = depth + 1
+	}
+
+	// 不足五个元素时直接排序取中位数
+	if high-low < 5 {
+		s.insertionSort(low, high)
+		median := low + (high-low)/2
+		tracker.AddStep("元素不足五个，直接取中位数: "+formatValue(s.data[median]), s.data, []int{median})
+		return median
+	}
+
+	record := len(s.groups) == 0
+	groupCount := 0
+	for start := low; start <= high; start += 5 {
+		end := start + 4
+		if end > high {
+			end = high
+		}
+
+		s.insertionSort(start, end)
+		median := start + (end-start)/2
+
+		group := make([]interface{}, end-start+1)
+		copy(group, s.data[start:end+1])
+		tracker.AddStep("第 "+strconv.Itoa(groupCount+1)+" 组 "+formatValues(group)+" 的中位数: "+formatValue(s.data[median]),
+			s.data, algorithms.IndexRange(start, end))
+		if record {
+			s.groups = append(s.groups, group)
+			s.medians = append(s.medians, s.data[median])
+		}
+
+		// 将组中位数移动到子数组前部
+		destination := low + groupCount
+		if median != destination {
+			s.data[median], s.data[destination] = s.data[destination], s.data[median]
+			tracker.AddOperation(models.OpTypeSwap, []int{destination, median},
+				[]interface{}{s.data[destination], s.data[median]}, "将组中位数移动到前部")
+		}
+		groupCount++
+	}
+
+	medianEnd := low + groupCount - 1
+	tracker.AddStep("共 "+strconv.Itoa(groupCount)+" 组，各组中位数已集中到 ["+strconv.Itoa(low)+", "+strconv.Itoa(medianEnd)+"]",
+		s.data, algorithms.IndexRange(low, medianEnd))
+	tracker.AddNote("递归选出各组中位数的中位数作为基准")
+
+	// 递归选择中位数的中位数
+	target := low + (groupCount-1)/2
+	pivot := s.selectKth(low, medianEnd, target, depth+1)
+
+	tracker.AddStep("中位数的中位数: "+formatValue(s.data[pivot])+"，作为基准", s.data, []int{pivot})
+	tracker.AddNote("至少约30%的元素小于基准，至少约30%的元素大于基准")
+	return pivot
+}
+
+// insertionSort 对小分组做插入排序
+func (s *selector) insertionSort(low, high int) {
+	for i := low + 1; i <= high; i++ {
+		for j := i; j > low; j-- {
+			result := compareValues(s.data[j-1], s.data[j])
+			s.tracker.AddComparison(j-1, j, result)
+			if result <= 0 {
+				break
+			}
+			s.data[j-1], s.data[j] = s.data[j], s.data[j-1]
+			s.tracker.AddOperation(models.OpTypeSwap, []int{j - 1, j},
+				[]interface{}{s.data[j-1], s.data[j]}, "组内排序")
+		}
+	}
+}
+
+// validateSelectionInput 选择算法的输入验证
+func validateSelectionInput(data interface{}) error {
+	if data == nil {
+		return algorithms.ErrInvalidInput
+	}
+
+	arr, ok := data.([]interface{})
+	if !ok || len(arr) == 0 {
+		return algorithms.ErrInvalidInput
+	}
+
+	// 检查数组大小限制
+	if len(arr) > 10000 {
+		return algorithms.ErrInvalidInput
+	}
+
+	return nil
+}
+
+// executeSelection 选择算法的公共执行流程
+func executeSelection(data interface{}, params map[string]interface{}, strategy, name string, tracker models.StepTracker) (interface{}, error) {
+	if err := validateSelectionInput(data); err != nil {
+		return nil, err
+	}
+
+	arr := data.([]interface{})
+	k, err := parseK(params, len(arr))
+	if err != nil {
+		return nil, err
+	}
+
+	// 复制数组以避免修改原数据
+	working := make([]interface{}, len(arr))
+	copy(working, arr)
+
+	s := newSelector(working, strategy, int64(algorithms.IntParam(params, "seed", 0)), tracker)
+	if limit := algorithms.IntParam(params, "depth_limit", 0); limit > 0 {
+		s.depthLimit = limit
+	}
+	return s.run(k, name), nil
+}
+
+// formatValue 将元素转换为字符串
+func formatValue(value interface{}) string {
+	return keyString(value)
+}
+
+// formatValues 将元素列表格式化为字符串
+func formatValues(values []interface{}) string {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		parts[i] = formatValue(v)
+	}
+	return "[" + strings.Join(parts, ", ") + "]"
+}
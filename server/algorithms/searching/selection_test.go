@@ -0,0 +1,116 @@
+package searching
+
+import (
+	"gin/algorithms"
+	"gin/models"
+	"sort"
+	"testing"
+)
+
+func TestSelection_Execute(t *testing.T) {
+	data := []interface{}{
+		float64(31), float64(7), float64(52), float64(7), float64(18), float64(96), float64(3), float64(44),
+		float64(61), float64(25), float64(12), float64(88), float64(39), float64(70), float64(5), float64(27),
+		float64(9), float64(64), float64(50), float64(21), float64(80), float64(14), float64(35),
+	}
+	sorted := make([]float64, len(data))
+	for i, v := range data {
+		sorted[i] = v.(float64)
+	}
+	sort.Float64s(sorted)
+
+	selectors := []algorithms.ParameterizedAlgorithm{NewQuickSelect(), NewIntroSelect(), NewMedianOfMedians()}
+	for _, algorithm := range selectors {
+		t.Run(algorithm.GetInfo().ID, func(t *testing.T) {
+			for k := 1; k <= len(data); k++ {
+				params := map[string]interface{}{"k": float64(k), "seed": float64(7), "depth_limit": float64(1)}
+				result, err := algorithm.ExecuteWithParams(data, params, models.NewStepTracker())
+				if err != nil {
+					t.Fatalf("ExecuteWithParams() error = %v", err)
+				}
+
+				output := result.(map[string]interface{})
+				if output["value"] != sorted[k-1] {
+					t.Errorf("k = %d: value = %v, expected %v", k, output["value"], sorted[k-1])
+				}
+				if output["index"] != k-1 {
+					t.Errorf("k = %d: index = %v, expected %d", k, output["index"], k-1)
+				}
+			}
+		})
+	}
+
+	if _, err := NewQuickSelect().ExecuteWithParams(data, map[string]interface{}{"k": 0}, models.NewStepTracker()); err == nil {
+		t.Error("ExecuteWithParams() should reject k out of range")
+	}
+}
+
+func TestMedianOfMedians_Groups(t *testing.T) {
+	data := []interface{}{9, 1, 8, 2, 7, 3, 6, 4, 5, 0, 15, 11, 14, 12, 13}
+
+	result, err := NewMedianOfMedians().Execute(data, models.NewStepTracker())
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	output := result.(map[string]interface{})
+	if output["value"] != 7 {
+		t.Errorf("median = %v, expected 7", output["value"])
+	}
+	groups := output["groups"].([][]interface{})
+	if len(groups) != 3 {
+		t.Fatalf("groups = %d, expected 3", len(groups))
+	}
+	medians := output["medians"].([]interface{})
+	expected := []interface{}{7, 4, 13}
+	for i := range expected {
+		if medians[i] != expected[i] {
+			t.Errorf("medians[%d] = %v, expected %v", i, medians[i], expected[i])
+		}
+	}
+	if pivots := output["pivots"].([]interface{}); pivots[0] != 7 {
+		t.Errorf("first pivot = %v, expected 7", pivots[0])
+	}
+}
+
+func TestSelection_Duplicates(t *testing.T) {
+	// 全部相等时第一次三路分区就让目标落在等于基准的区间内
+	data := make([]interface{}, 200)
+	for i := range data {
+		data[i] = 5
+	}
+	for _, algorithm := range []algorithms.ParameterizedAlgorithm{NewQuickSelect(), NewIntroSelect(), NewMedianOfMedians()} {
+		result, err := algorithm.ExecuteWithParams(data, map[string]interface{}{"k": 150}, models.NewStepTracker())
+		if err != nil {
+			t.Fatalf("%s: ExecuteWithParams() error = %v", algorithm.GetInfo().ID, err)
+		}
+		if output := result.(map[string]interface{}); output["value"] != 5 || output["rounds"] != 1 {
+			t.Errorf("%s: value = %v, rounds = %v", algorithm.GetInfo().ID, output["value"], output["rounds"])
+		}
+	}
+}
+
+func TestQuickSelect_ComparisonSteps(t *testing.T) {
+	// 与快速排序共用分区：每次比较都记录在自己的"比较元素"步骤上，且对方始终是末尾的基准
+	data := []interface{}{4, 9, 4, 1, 7, 4, 3, 8, 2, 4, 6}
+	tracker := models.NewStepTracker()
+	if _, err := NewQuickSelect().ExecuteWithParams(data, map[string]interface{}{"k": 6, "seed": 3}, tracker); err != nil {
+		t.Fatalf("ExecuteWithParams() error = %v", err)
+	}
+
+	comparisons := 0
+	for _, step := range tracker.GetSteps() {
+		for _, c := range step.Comparisons {
+			comparisons++
+			if step.Description != "比较元素" {
+				t.Fatalf("comparison attached to step %q", step.Description)
+			}
+			if len(step.Highlights) != 2 || c.Index1 != step.Highlights[0] || c.Index2 != step.Highlights[1] {
+				t.Errorf("comparison %d-%d does not match highlights %v", c.Index1, c.Index2, step.Highlights)
+			}
+		}
+	}
+	if comparisons == 0 {
+		t.Error("expected comparisons to be recorded")
+	}
+}
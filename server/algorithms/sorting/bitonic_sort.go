@@ -0,0 +1,81 @@
+package sorting
+
+import (
+	"gin/algorithms"
+	"gin/models"
+)
+
+// BitonicSort 双调排序算法
+type BitonicSort struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewBitonicSort 创建双调排序算法实例
+func NewBitonicSort() *BitonicSort {
+	return &BitonicSort{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "bitonic_sort",
+			Name:            "双调排序",
+			Category:        models.CategorySorting,
+			Description:     "一种数据无关的排序网络：反复把两个有序段拼成双调序列再用半清洁器合并。比较器序列只取决于数组长度，同一层的比较器互不相交，可以完全并行执行。长度不是2的幂时视为在末尾补+∞。",
+			TimeComplexity:  "O(n log² n)",
+			SpaceComplexity: "O(1)",
+			Stable:          false,
+			InPlace:         true,
+			Adaptive:        false,
+			Parameters:      []models.Parameter{},
+		},
+	}
+}
+
+// Execute 执行双调排序，返回排序结果以及网络深度、比较器数量
+func (bs *BitonicSort) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	// 验证输入
+	if err := bs.ValidateInput(data); err != nil {
+		return nil, err
+	}
+
+	return runNetwork(data, "双调排序", bitonicNetwork, tracker)
+}
+
+// Sort 双调排序实现
+func (bs *BitonicSort) Sort(data []interface{}, tracker models.StepTracker) error {
+	sortWithNetwork(data, "双调排序", bitonicNetwork(len(data)), tracker)
+	return nil
+}
+
+// ValidateInput 验证输入数据
+func (bs *BitonicSort) ValidateInput(data interface{}) error {
+	return validateNetworkInput(data)
+}
+
+// IsStable 双调排序不是稳定的
+func (bs *BitonicSort) IsStable() bool {
+	return false
+}
+
+// IsInPlace 双调排序是原地的
+func (bs *BitonicSort) IsInPlace() bool {
+	return true
+}
+
+// IsAdaptive 双调排序不是自适应的，比较次数与输入无关
+func (bs *BitonicSort) IsAdaptive() bool {
+	return false
+}
+
+// GetComplexity 获取复杂度信息
+func (bs *BitonicSort) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(n log² n)",
+			Average: "O(n log² n)", // 网络深度 O(log² n)
+			Worst:   "O(n log² n)",
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(1)",
+			Average: "O(1)",
+			Worst:   "O(1)",
+		},
+	}
+}
@@ -0,0 +1,411 @@
+package sorting
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"sort"
+	"strconv"
+)
+
+// ExternalMergeSort 外部归并排序算法（模拟）
+type ExternalMergeSort struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewExternalMergeSort 创建外部归并排序算法实例
+func NewExternalMergeSort() *ExternalMergeSort {
+	return &ExternalMergeSort{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "external_merge_sort",
+			Name:            "外部归并排序",
+			Category:        models.CategorySorting,
+			Description:     "模拟数据无法一次装入内存时的排序过程。先按内存容量逐段读入数据、在内存中排序后写回磁盘形成有序归并段，再用败者树对多个归并段进行多路归并，直到只剩一个归并段。磁盘以块为单位读写，统计中给出读写块数。",
+			TimeComplexity:  "O(n log n)",
+			SpaceComplexity: "O(M)",
+			Parameters: []models.Parameter{
+				{
+					Name:         "memory_budget",
+					Type:         "int",
+					Description:  "内存可容纳的元素个数",
+					DefaultValue: 6,
+					Required:     false,
+					Min:          3,
+					Max:          1000,
+				},
+				{
+					Name:         "block_size",
+					Type:         "int",
+					Description:  "每个磁盘块包含的元素个数",
+					DefaultValue: 1,
+					Required:     false,
+					Min:          1,
+					Max:          100,
+				},
+			},
+			Stable:   true,
+			InPlace:  false,
+			Adaptive: false,
+		},
+	}
+}
+
+// externalSortState 外部排序可视化状态
+type externalSortState struct {
+	Pass       int             `json:"pass"`       // 当前归并趟数，0 表示生成归并段
+	Runs       [][]interface{} `json:"runs"`       // 磁盘上的归并段
+	Memory     []interface{}   `json:"memory"`     // 内存中的数据
+	LoserTree  []int           `json:"loserTree"`  // 败者树，[0] 为胜者，其余为各内部结点记录的败者（归并段编号）
+	Output     []interface{}   `json:"output"`     // 正在写出的归并段
+	DiskReads  int             `json:"diskReads"`  // 累计读块数
+	DiskWrites int             `json:"diskWrites"` // 累计写块数
+}
+
+// externalSortRun 外部排序的执行上下文
+type externalSortRun struct {
+	tracker    models.StepTracker
+	memory     int
+	blockSize  int
+	fanIn      int
+	pass       int
+	runs       [][]interface{}
+	diskReads  int
+	diskWrites int
+	pendingIO  []models.Operation // 尚未记录到步骤上的磁盘读写
+}
+
+// Execute 执行外部归并排序
+func (es *ExternalMergeSort) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return es.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 按参数执行外部归并排序
+func (es *ExternalMergeSort) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	// 验证输入
+	if err := es.ValidateInput(data); err != nil {
+		return nil, err
+	}
+
+	arr := data.([]interface{})
+	memory := algorithms.IntParam(params, "memory_budget", 6)
+	blockSize := algorithms.IntParam(params, "block_size", 1)
+	if blockSize < 1 {
+		blockSize = 1
+	}
+
+	// 多路归并时每个输入段和输出各占一个块缓冲区，至少需要三个块
+	if memory/blockSize < 3 {
+		return nil, fmt.Errorf("内存容量至少需要容纳3个磁盘块（当前 %d 个元素，块大小 %d）", memory, blockSize)
+	}
+
+	run := &externalSortRun{
+		tracker:   tracker,
+		memory:    memory,
+		blockSize: blockSize,
+		fanIn:     memory/blockSize - 1,
+	}
+	result := run.sort(arr)
+
+	return map[string]interface{}{
+		"sorted":        result,
+		"memoryBudget":  memory,
+		"blockSize":     blockSize,
+		"fanIn":         run.fanIn,
+		"initialRuns":   (len(arr) + memory - 1) / memory,
+		"mergePasses":   run.pass,
+		"diskReads":     run.diskReads,
+		"diskWrites":    run.diskWrites,
+		"totalIO":       run.diskReads + run.diskWrites,
+		"elementsCount": len(arr),
+	}, nil
+}
+
+// Sort 外部归并排序实现（默认内存容量与块大小）
+func (es *ExternalMergeSort) Sort(data []interface{}, tracker models.StepTracker) error {
+	run := &externalSortRun{tracker: tracker, memory: 6, blockSize: 1, fanIn: 5}
+	copy(data, run.sort(data))
+	return nil
+}
+
+// sort 执行完整的外部排序：生成归并段，然后逐趟多路归并
+func (r *externalSortRun) sort(input []interface{}) []interface{} {
+	n := len(input)
+	if n <= 1 {
+		r.tracker.AddStep("数组长度小于等于1，无需排序", r.state(nil, nil, nil), []int{})
+		result := make([]interface{}, n)
+		copy(result, input)
+		return result
+	}
+
+	r.tracker.SetPhase("初始化")
+	r.tracker.AddStep(fmt.Sprintf("开始外部归并排序: 共 %d 个元素，内存容量 %d，块大小 %d，归并路数 %d",
+		n, r.memory, r.blockSize, r.fanIn), r.state(nil, nil, nil), []int{})
+
+	r.generateRuns(input)
+
+	for len(r.runs) > 1 {
+		r.pass++
+		r.tracker.SetPhase("第 " + strconv.Itoa(r.pass) + " 趟归并")
+		r.tracker.AddStep(fmt.Sprintf("第 %d 趟归并: %d 个归并段，每次最多归并 %d 个", r.pass, len(r.runs), r.fanIn),
+			r.state(nil, nil, nil), []int{})
+
+		merged := make([][]interface{}, 0, (len(r.runs)+r.fanIn-1)/r.fanIn)
+		for start := 0; start < len(r.runs); start += r.fanIn {
+			end := start + r.fanIn
+			if end > len(r.runs) {
+				end = len(r.runs)
+			}
+			merged = append(merged, r.mergeGroup(start, end))
+		}
+		r.runs = merged
+	}
+
+	r.tracker.SetPhase("完成")
+	r.tracker.AddStep(fmt.Sprintf("外部归并排序完成，共读 %d 块、写 %d 块", r.diskReads, r.diskWrites),
+		r.state(nil, nil, nil), []int{})
+	return r.runs[0]
+}
+
+// generateRuns 生成初始归并段：每次读入内存容量的数据，内存排序后写回磁盘
+func (r *externalSortRun) generateRuns(input []interface{}) {
+	r.tracker.SetPhase("生成归并段")
+
+	for start := 0; start < len(input); start += r.memory {
+		end := start + r.memory
+		if end > len(input) {
+			end = len(input)
+		}
+
+		// 按块读入内存
+		memory := make([]interface{}, 0, end-start)
+		for block := start; block < end; block += r.blockSize {
+			blockEnd := block + r.blockSize
+			if blockEnd > end {
+				blockEnd = end
+			}
+			memory = append(memory, input[block:blockEnd]...)
+			r.read(algorithms.IndexRange(block, blockEnd-1), input[block:blockEnd], "读入输入文件的块")
+		}
+		r.tracker.AddStep(fmt.Sprintf("读入元素 [%d, %d] 到内存", start, end-1), r.state(memory, nil, nil), algorithms.IndexRange(start, end-1))
+		r.flushIO()
+
+		// 内存中排序
+		sort.SliceStable(memory, func(i, j int) bool {
+			result := compareElements(memory[i], memory[j])
+			r.tracker.AddComparison(i, j, result)
+			return result < 0
+		})
+		r.tracker.AddStep("在内存中排序: "+formatElements(memory), r.state(memory, nil, nil), []int{})
+
+		// 按块写出为一个归并段
+		runIndex := len(r.runs)
+		r.runs = append(r.runs, memory)
+		for block := 0; block < len(memory); block += r.blockSize {
+			blockEnd := block + r.blockSize
+			if blockEnd > len(memory) {
+				blockEnd = len(memory)
+			}
+			r.write([]int{runIndex}, memory[block:blockEnd], "写出归并段 "+strconv.Itoa(runIndex)+" 的块")
+		}
+		r.tracker.AddStep(fmt.Sprintf("写出归并段 %d，长度 %d", runIndex, len(memory)), r.state(nil, nil, nil), []int{runIndex})
+		r.tracker.AddOperation(models.OpTypeSplit, []int{runIndex}, []interface{}{len(memory)}, "生成归并段")
+		r.flushIO()
+	}
+
+	r.tracker.AddNote(fmt.Sprintf("共生成 %d 个初始归并段", len(r.runs)))
+}
+
+// mergeGroup 使用败者树归并 runs[start:end]，返回新的归并段
+func (r *externalSortRun) mergeGroup(start, end int) []interface{} {
+	k := end - start
+	group := r.runs[start:end]
+	output := make([]interface{}, 0)
+
+	// 只有一个归并段时直接保留，无需读写
+	if k == 1 {
+		r.tracker.AddStep(fmt.Sprintf("归并段 %d 单独成组，直接进入下一趟", start), r.state(nil, nil, nil), []int{start})
+		return group[0]
+	}
+
+	r.tracker.AddStep(fmt.Sprintf("用败者树归并归并段 [%d, %d]", start, end-1), r.state(nil, nil, nil), algorithms.IndexRange(start, end-1))
+	r.tracker.AddOperation(models.OpTypeMerge, algorithms.IndexRange(start, end-1), []interface{}{k}, "多路归并")
+
+	// 每个输入段读入第一块
+	buffers := make([][]interface{}, k)
+	positions := make([]int, k)
+	for i := 0; i < k; i++ {
+		buffers[i] = r.readBlock(start+i, group[i], 0)
+	}
+
+	tree := newLoserTree(k, func(i int) *interface{} {
+		if len(buffers[i]) == 0 {
+			return nil
+		}
+		return &buffers[i][0]
+	}, r.tracker)
+	r.tracker.AddStep("构建败者树，胜者为归并段 "+strconv.Itoa(start+tree.winner()), r.state(r.memoryView(buffers), tree.snapshot(start), output), []int{start + tree.winner()})
+	r.flushIO()
+
+	outputBuffer := 0
+	for {
+		winner := tree.winner()
+		if len(buffers[winner]) == 0 {
+			break
+		}
+
+		// 胜者输出到输出缓冲区
+		value := buffers[winner][0]
+		buffers[winner] = buffers[winner][1:]
+		positions[winner]++
+		output = append(output, value)
+		outputBuffer++
+
+		// 输出缓冲区写满一块时写回磁盘
+		if outputBuffer == r.blockSize {
+			r.write([]int{len(output) - outputBuffer}, output[len(output)-outputBuffer:], "写出输出缓冲区")
+			outputBuffer = 0
+		}
+
+		// 输入缓冲区耗尽时读入该段的下一块
+		if len(buffers[winner]) == 0 && positions[winner] < len(group[winner]) {
+			buffers[winner] = r.readBlock(start+winner, group[winner], positions[winner])
+		}
+
+		r.tracker.AddStep(fmt.Sprintf("输出归并段 %d 的最小元素 %s", start+winner, formatElement(value)),
+			r.state(r.memoryView(buffers), tree.snapshot(start), output), []int{start + winner})
+		r.flushIO()
+
+		// 胜者所在叶子的元素已变化，沿路径重新比赛
+		tree.adjust(winner)
+	}
+
+	if outputBuffer > 0 {
+		r.write([]int{len(output) - outputBuffer}, output[len(output)-outputBuffer:], "写出输出缓冲区剩余元素")
+	}
+	r.tracker.AddStep(fmt.Sprintf("归并完成，新归并段长度 %d", len(output)), r.state(nil, nil, output), []int{})
+	r.flushIO()
+	return output
+}
+
+// readBlock 读入归并段从 offset 开始的一块
+func (r *externalSortRun) readBlock(runIndex int, run []interface{}, offset int) []interface{} {
+	end := offset + r.blockSize
+	if end > len(run) {
+		end = len(run)
+	}
+	block := make([]interface{}, end-offset)
+	copy(block, run[offset:end])
+	r.read([]int{runIndex}, block, "读入归并段 "+strconv.Itoa(runIndex)+" 的下一块")
+	return block
+}
+
+// read 记录一次读块操作
+func (r *externalSortRun) read(indices []int, values []interface{}, description string) {
+	r.diskReads++
+	r.pendingIO = append(r.pendingIO, models.Operation{
+		Type:        models.OpTypeDiskRead,
+		Indices:     indices,
+		Values:      values,
+		Description: description,
+	})
+}
+
+// write 记录一次写块操作
+func (r *externalSortRun) write(indices []int, values []interface{}, description string) {
+	r.diskWrites++
+	block := make([]interface{}, len(values))
+	copy(block, values)
+	r.pendingIO = append(r.pendingIO, models.Operation{
+		Type:        models.OpTypeDiskWrite,
+		Indices:     indices,
+		Values:      block,
+		Description: description,
+	})
+}
+
+// flushIO 将待记录的磁盘读写附加到最近的步骤上
+func (r *externalSortRun) flushIO() {
+	for _, op := range r.pendingIO {
+		r.tracker.AddOperation(op.Type, op.Indices, op.Values, op.Description)
+	}
+	r.pendingIO = r.pendingIO[:0]
+}
+
+// memoryView 内存中各输入缓冲区内容的拼接
+func (r *externalSortRun) memoryView(buffers [][]interface{}) []interface{} {
+	memory := make([]interface{}, 0)
+	for _, buffer := range buffers {
+		memory = append(memory, buffer...)
+	}
+	return memory
+}
+
+// state 构建当前可视化状态（复制数据，避免后续修改影响已记录的步骤）
+func (r *externalSortRun) state(memory []interface{}, tree []int, output []interface{}) externalSortState {
+	runs := make([][]interface{}, len(r.runs))
+	for i, run := range r.runs {
+		runs[i] = append([]interface{}{}, run...)
+	}
+	if tree == nil {
+		tree = []int{}
+	}
+	return externalSortState{
+		Pass:       r.pass,
+		Runs:       runs,
+		Memory:     append([]interface{}{}, memory...),
+		LoserTree:  tree,
+		Output:     append([]interface{}{}, output...),
+		DiskReads:  r.diskReads,
+		DiskWrites: r.diskWrites,
+	}
+}
+
+// ValidateInput 验证输入数据
+func (es *ExternalMergeSort) ValidateInput(data interface{}) error {
+	if data == nil {
+		return algorithms.ErrInvalidInput
+	}
+
+	arr, ok := data.([]interface{})
+	if !ok {
+		return algorithms.ErrInvalidInput
+	}
+
+	// 每个步骤都保存磁盘快照，限制数组大小
+	if len(arr) > 1000 {
+		return algorithms.ErrInvalidInput
+	}
+
+	return nil
+}
+
+// IsStable 外部归并排序是稳定的（段内稳定排序，败者树中相等元素取编号小的段）
+func (es *ExternalMergeSort) IsStable() bool {
+	return true
+}
+
+// IsInPlace 外部归并排序不是原地的
+func (es *ExternalMergeSort) IsInPlace() bool {
+	return false
+}
+
+// IsAdaptive 外部归并排序不是自适应的
+func (es *ExternalMergeSort) IsAdaptive() bool {
+	return false
+}
+
+// GetComplexity 获取复杂度信息
+func (es *ExternalMergeSort) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(n log n)",
+			Average: "O(n log n)", // 磁盘读写 O((n/B)·log_k(n/M)) 块
+			Worst:   "O(n log n)",
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(M)",
+			Average: "O(M)",
+			Worst:   "O(M)",
+		},
+	}
+}
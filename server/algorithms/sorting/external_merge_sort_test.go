@@ -0,0 +1,108 @@
+package sorting
+
+import (
+	"gin/models"
+	"testing"
+)
+
+func TestExternalMergeSort_Execute(t *testing.T) {
+	es := NewExternalMergeSort()
+
+	tests := []struct {
+		name       string
+		input      []interface{}
+		memory     int
+		blockSize  int
+		passes     int
+		diskReads  int
+		diskWrites int
+	}{
+		{
+			name:   "Two passes with binary merge",
+			input:  []interface{}{8, 3, 5, 1, 7, 2, 6, 4},
+			memory: 3, blockSize: 1,
+			// 3 个初始段；第1趟归并前两段（6个元素），第2趟归并全部8个元素
+			passes: 2, diskReads: 8 + 6 + 8, diskWrites: 8 + 6 + 8,
+		},
+		{
+			name:   "Single pass with blocks",
+			input:  []interface{}{9, 4, 7, 1, 8, 2, 6, 3, 5, 0},
+			memory: 8, blockSize: 2,
+			// 2 个初始段（8 + 2）；归并路数 3，一趟完成
+			passes: 1, diskReads: 5 + 5, diskWrites: 5 + 5,
+		},
+		{
+			name:   "Fits in memory",
+			input:  []interface{}{3, 2, 1},
+			memory: 4, blockSize: 1,
+			passes: 0, diskReads: 3, diskWrites: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tracker := models.NewStepTracker()
+			params := map[string]interface{}{"memory_budget": float64(tt.memory), "block_size": float64(tt.blockSize)}
+			result, err := es.ExecuteWithParams(tt.input, params, tracker)
+			if err != nil {
+				t.Fatalf("ExecuteWithParams() error = %v", err)
+			}
+
+			output := result.(map[string]interface{})
+			sorted := output["sorted"].([]interface{})
+			for i := 1; i < len(sorted); i++ {
+				if compareElements(sorted[i-1], sorted[i]) > 0 {
+					t.Fatalf("result is not sorted: %v", sorted)
+				}
+			}
+			if len(sorted) != len(tt.input) {
+				t.Fatalf("result length = %d, expected %d", len(sorted), len(tt.input))
+			}
+
+			if output["mergePasses"] != tt.passes {
+				t.Errorf("mergePasses = %v, expected %d", output["mergePasses"], tt.passes)
+			}
+			if output["diskReads"] != tt.diskReads || output["diskWrites"] != tt.diskWrites {
+				t.Errorf("I/O = %v reads / %v writes, expected %d / %d",
+					output["diskReads"], output["diskWrites"], tt.diskReads, tt.diskWrites)
+			}
+
+			stats := tracker.GetStats()
+			if stats.DiskReads != tt.diskReads || stats.DiskWrites != tt.diskWrites {
+				t.Errorf("tracker I/O = %d / %d, expected %d / %d", stats.DiskReads, stats.DiskWrites, tt.diskReads, tt.diskWrites)
+			}
+		})
+	}
+
+	if _, err := es.ExecuteWithParams([]interface{}{1, 2}, map[string]interface{}{"memory_budget": 4, "block_size": 2}, models.NewStepTracker()); err == nil {
+		t.Error("ExecuteWithParams() should reject a budget smaller than three blocks")
+	}
+}
+
+func TestLoserTree(t *testing.T) {
+	runs := [][]interface{}{{1, 4, 9}, {2, 3, 10}, {}, {0, 5}, {6, 7, 8}}
+	heads := make([]int, len(runs))
+
+	tree := newLoserTree(len(runs), func(i int) *interface{} {
+		if heads[i] >= len(runs[i]) {
+			return nil
+		}
+		return &runs[i][heads[i]]
+	}, models.NewStepTracker())
+
+	for expected := 0; expected <= 10; expected++ {
+		winner := tree.winner()
+		if heads[winner] >= len(runs[winner]) {
+			t.Fatalf("tree exhausted before %d", expected)
+		}
+		if value := runs[winner][heads[winner]]; value != expected {
+			t.Fatalf("winner value = %v, expected %d", value, expected)
+		}
+		heads[winner]++
+		tree.adjust(winner)
+	}
+
+	if winner := tree.winner(); heads[winner] < len(runs[winner]) {
+		t.Errorf("tree should be exhausted, winner run %d still has elements", winner)
+	}
+}
@@ -0,0 +1,83 @@
+package sorting
+
+import "gin/models"
+
+// loserTree 败者树，用于k路归并
+// nodes[0] 记录当前胜者，nodes[1..k-1] 记录各内部结点比赛的败者，叶子即k个归并段的当前元素
+type loserTree struct {
+	k       int
+	nodes   []int
+	key     func(leaf int) *interface{} // 叶子当前元素，nil 表示该段已耗尽（视为+∞）
+	tracker models.StepTracker
+}
+
+// newLoserTree 构建败者树
+func newLoserTree(k int, key func(leaf int) *interface{}, tracker models.StepTracker) *loserTree {
+	t := &loserTree{
+		k:       k,
+		nodes:   make([]int, k),
+		key:     key,
+		tracker: tracker,
+	}
+
+	// 以编号k的虚拟叶子（-∞）初始化所有结点，再从后向前逐个调整叶子
+	for i := range t.nodes {
+		t.nodes[i] = k
+	}
+	for leaf := k - 1; leaf >= 0; leaf-- {
+		t.adjust(leaf)
+	}
+	return t
+}
+
+// winner 当前胜者（最小元素所在的叶子）
+func (t *loserTree) winner() int {
+	return t.nodes[0]
+}
+
+// adjust 叶子的元素变化后，沿到根的路径重新比赛
+func (t *loserTree) adjust(leaf int) {
+	winner := leaf
+	for parent := (leaf + t.k) / 2; parent > 0; parent /= 2 {
+		// 结点上记录的败者若胜过当前胜者，二者交换
+		if t.beats(t.nodes[parent], winner) {
+			winner, t.nodes[parent] = t.nodes[parent], winner
+		}
+	}
+	t.nodes[0] = winner
+}
+
+// beats 叶子a是否胜过叶子b，元素相等时编号小的获胜以保持稳定
+func (t *loserTree) beats(a, b int) bool {
+	if a == t.k {
+		return true
+	}
+	if b == t.k {
+		return false
+	}
+
+	keyA, keyB := t.key(a), t.key(b)
+	if keyA == nil {
+		return false
+	}
+	if keyB == nil {
+		return true
+	}
+
+	result := compareElements(*keyA, *keyB)
+	t.tracker.AddComparison(a, b, result)
+	return result < 0 || (result == 0 && a < b)
+}
+
+// snapshot 败者树的结点内容，叶子编号加上偏移量，虚拟叶子记为-1
+func (t *loserTree) snapshot(offset int) []int {
+	nodes := make([]int, len(t.nodes))
+	for i, leaf := range t.nodes {
+		if leaf == t.k {
+			nodes[i] = -1
+		} else {
+			nodes[i] = leaf + offset
+		}
+	}
+	return nodes
+}
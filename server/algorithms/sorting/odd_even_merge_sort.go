@@ -0,0 +1,81 @@
+package sorting
+
+import (
+	"gin/algorithms"
+	"gin/models"
+)
+
+// OddEvenMergeSort Batcher奇偶归并排序算法
+type OddEvenMergeSort struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewOddEvenMergeSort 创建奇偶归并排序算法实例
+func NewOddEvenMergeSort() *OddEvenMergeSort {
+	return &OddEvenMergeSort{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "odd_even_merge_sort",
+			Name:            "奇偶归并排序",
+			Category:        models.CategorySorting,
+			Description:     "Batcher提出的排序网络：递归地分别归并奇数位和偶数位子序列，再用一层相邻比较器修正。与双调排序深度相同，但比较器更少。比较器序列只取决于数组长度。",
+			TimeComplexity:  "O(n log² n)",
+			SpaceComplexity: "O(1)",
+			Stable:          false,
+			InPlace:         true,
+			Adaptive:        false,
+			Parameters:      []models.Parameter{},
+		},
+	}
+}
+
+// Execute 执行奇偶归并排序，返回排序结果以及网络深度、比较器数量
+func (oe *OddEvenMergeSort) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	// 验证输入
+	if err := oe.ValidateInput(data); err != nil {
+		return nil, err
+	}
+
+	return runNetwork(data, "奇偶归并排序", oddEvenMergeNetwork, tracker)
+}
+
+// Sort 奇偶归并排序实现
+func (oe *OddEvenMergeSort) Sort(data []interface{}, tracker models.StepTracker) error {
+	sortWithNetwork(data, "奇偶归并排序", oddEvenMergeNetwork(len(data)), tracker)
+	return nil
+}
+
+// ValidateInput 验证输入数据
+func (oe *OddEvenMergeSort) ValidateInput(data interface{}) error {
+	return validateNetworkInput(data)
+}
+
+// IsStable 奇偶归并排序不是稳定的
+func (oe *OddEvenMergeSort) IsStable() bool {
+	return false
+}
+
+// IsInPlace 奇偶归并排序是原地的
+func (oe *OddEvenMergeSort) IsInPlace() bool {
+	return true
+}
+
+// IsAdaptive 奇偶归并排序不是自适应的，比较次数与输入无关
+func (oe *OddEvenMergeSort) IsAdaptive() bool {
+	return false
+}
+
+// GetComplexity 获取复杂度信息
+func (oe *OddEvenMergeSort) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(n log² n)",
+			Average: "O(n log² n)", // 网络深度 O(log² n)
+			Worst:   "O(n log² n)",
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(1)",
+			Average: "O(1)",
+			Worst:   "O(1)",
+		},
+	}
+}
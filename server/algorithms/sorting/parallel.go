@@ -0,0 +1,99 @@
+package sorting
+
+import (
+	"gin/algorithms"
+	"gin/models"
+)
+
+// 并发排序的工作者数量限制
+const (
+	defaultWorkers = 4
+	maxWorkers     = 16
+)
+
+// workersParameter 并发排序共用的工作者数量参数
+func workersParameter() models.Parameter {
+	return models.Parameter{
+		Name:         "workers",
+		Type:         "int",
+		Description:  "并发工作者（goroutine）数量",
+		DefaultValue: defaultWorkers,
+		Required:     false,
+		Min:          1,
+		Max:          maxWorkers,
+	}
+}
+
+// parseWorkers 解析工作者数量参数
+func parseWorkers(params map[string]interface{}) int {
+	workers := algorithms.IntParam(params, "workers", defaultWorkers)
+	if workers < 1 {
+		workers = 1
+	}
+	if workers > maxWorkers {
+		workers = maxWorkers
+	}
+	return workers
+}
+
+// workerPool 空闲工作者池，限制同时运行的goroutine数量
+// 工作者1由调用方直接占用，池中存放其余空闲的工作者ID
+type workerPool struct {
+	idle chan int
+}
+
+// newWorkerPool 创建工作者池
+func newWorkerPool(workers int) *workerPool {
+	pool := &workerPool{idle: make(chan int, workers)}
+	for id := 2; id <= workers; id++ {
+		pool.idle <- id
+	}
+	return pool
+}
+
+// tryAcquire 尝试获取一个空闲工作者，没有空闲工作者时立即返回false
+func (p *workerPool) tryAcquire() (int, bool) {
+	select {
+	case id := <-p.idle:
+		return id, true
+	default:
+		return 0, false
+	}
+}
+
+// release 归还工作者
+func (p *workerPool) release(id int) {
+	p.idle <- id
+}
+
+// withWorkerTracker 以支持工作者的追踪器执行并发排序
+// 普通追踪器不是并发安全的，此时先记录到并发追踪器，结束后按顺序重放
+func withWorkerTracker(tracker models.StepTracker, run func(models.WorkerStepTracker)) {
+	if workerTracker, ok := tracker.(models.WorkerStepTracker); ok {
+		run(workerTracker)
+		return
+	}
+
+	concurrent := models.NewConcurrentStepTracker()
+	run(concurrent)
+	concurrent.ReplayInto(tracker)
+}
+
+// validateParallelInput 并发排序的输入验证
+func validateParallelInput(data interface{}) error {
+	if data == nil {
+		return algorithms.ErrInvalidInput
+	}
+
+	arr, ok := data.([]interface{})
+	if !ok {
+		return algorithms.ErrInvalidInput
+	}
+
+	// 检查数组大小限制
+	if len(arr) > 10000 {
+		return algorithms.ErrInvalidInput
+	}
+
+	return nil
+}
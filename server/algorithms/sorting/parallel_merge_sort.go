@@ -0,0 +1,164 @@
+package sorting
+
+import (
+	"gin/algorithms"
+	"gin/models"
+	"strconv"
+	"sync"
+)
+
+// ParallelMergeSort 并行归并排序算法
+type ParallelMergeSort struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewParallelMergeSort 创建并行归并排序算法实例
+func NewParallelMergeSort() *ParallelMergeSort {
+	return &ParallelMergeSort{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "parallel_merge_sort",
+			Name:            "并行归并排序",
+			Category:        models.CategorySorting,
+			Description:     "在归并排序的分解阶段，若有空闲工作者就把左半部分交给新的goroutine处理，当前工作者继续处理右半部分，两边都完成后再合并。并发度受工作者数量限制。",
+			TimeComplexity:  "O(n log n)",
+			SpaceComplexity: "O(n)",
+			Parameters: []models.Parameter{
+				workersParameter(),
+			},
+			Stable:   true,
+			InPlace:  false,
+			Adaptive: false,
+		},
+	}
+}
+
+// Execute 执行并行归并排序
+func (pm *ParallelMergeSort) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return pm.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 按参数执行并行归并排序
+func (pm *ParallelMergeSort) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	// 验证输入
+	if err := pm.ValidateInput(data); err != nil {
+		return nil, err
+	}
+
+	arr := data.([]interface{})
+
+	// 复制数组以避免修改原数据
+	result := make([]interface{}, len(arr))
+	copy(result, arr)
+
+	pm.sort(result, parseWorkers(params), tracker)
+	return result, nil
+}
+
+// Sort 并行归并排序实现（默认工作者数量）
+func (pm *ParallelMergeSort) Sort(data []interface{}, tracker models.StepTracker) error {
+	pm.sort(data, defaultWorkers, tracker)
+	return nil
+}
+
+// sort 使用指定数量的工作者排序
+func (pm *ParallelMergeSort) sort(data []interface{}, workers int, tracker models.StepTracker) {
+	n := len(data)
+	if n <= 1 {
+		tracker.AddStep("数组长度小于等于1，无需排序", data, []int{})
+		return
+	}
+
+	withWorkerTracker(tracker, func(tracker models.WorkerStepTracker) {
+		tracker.SetPhase("初始化")
+		tracker.AddStep("开始并行归并排序，工作者数量: "+strconv.Itoa(workers), data, []int{})
+
+		pool := newWorkerPool(workers)
+		pm.sortRange(data, 0, n-1, 0, 1, pool, tracker)
+
+		tracker.SetPhase("完成")
+		tracker.AddStep("并行归并排序完成", data, []int{})
+	})
+}
+
+// sortRange 由指定工作者排序 [left, right]，有空闲工作者时把左半部分交给它
+func (pm *ParallelMergeSort) sortRange(data []interface{}, left, right, depth, workerID int, pool *workerPool, tracker models.WorkerStepTracker) {
+	if left >= right {
+		return
+	}
+
+	worker := tracker.ForWorker(workerID)
+	worker.SetPhase("工作者 " + strconv.Itoa(workerID) + " - 递归深度 " + strconv.Itoa(depth) + " - 分解")
+	worker.AddStep("分解子数组 ["+strconv.Itoa(left)+", "+strconv.Itoa(right)+"]", data, algorithms.IndexRange(left, right))
+
+	mid := left + (right-left)/2
+
+	if helper, ok := pool.tryAcquire(); ok {
+		// 左半部分交给空闲工作者并发排序
+		worker.AddOperation(models.OpTypeSplit, algorithms.IndexRange(left, mid), []interface{}{helper},
+			"将左半部分交给工作者 "+strconv.Itoa(helper))
+		worker.AddNote("工作者 " + strconv.Itoa(helper) + " 负责 [" + strconv.Itoa(left) + ", " + strconv.Itoa(mid) + "]")
+
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer pool.release(helper)
+			pm.sortRange(data, left, mid, depth+1, helper, pool, tracker)
+		}()
+
+		pm.sortRange(data, mid+1, right, depth+1, workerID, pool, tracker)
+		wg.Wait()
+
+		worker.SetPhase("工作者 " + strconv.Itoa(workerID) + " - 递归深度 " + strconv.Itoa(depth) + " - 合并")
+		worker.AddStep("工作者 "+strconv.Itoa(helper)+" 已完成左半部分，开始合并", data, algorithms.IndexRange(left, right))
+	} else {
+		// 没有空闲工作者，由当前工作者顺序处理
+		pm.sortRange(data, left, mid, depth+1, workerID, pool, tracker)
+		pm.sortRange(data, mid+1, right, depth+1, workerID, pool, tracker)
+		worker.SetPhase("工作者 " + strconv.Itoa(workerID) + " - 递归深度 " + strconv.Itoa(depth) + " - 合并")
+	}
+
+	// 复用归并排序的合并过程
+	(&MergeSort{}).merge(data, left, mid, right, worker)
+}
+
+// ValidateInput 验证输入数据
+func (pm *ParallelMergeSort) ValidateInput(data interface{}) error {
+	return validateParallelInput(data)
+}
+
+// IsConcurrent 并行归并排序使用多个goroutine
+func (pm *ParallelMergeSort) IsConcurrent() bool {
+	return true
+}
+
+// IsStable 并行归并排序是稳定的
+func (pm *ParallelMergeSort) IsStable() bool {
+	return true
+}
+
+// IsInPlace 并行归并排序不是原地的
+func (pm *ParallelMergeSort) IsInPlace() bool {
+	return false
+}
+
+// IsAdaptive 并行归并排序不是自适应的
+func (pm *ParallelMergeSort) IsAdaptive() bool {
+	return false
+}
+
+// GetComplexity 获取复杂度信息
+func (pm *ParallelMergeSort) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(n log n / p + n)", // p 为工作者数量，顶层合并仍是串行的
+			Average: "O(n log n / p + n)",
+			Worst:   "O(n log n)",
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(n)",
+			Average: "O(n)",
+			Worst:   "O(n)",
+		},
+	}
+}
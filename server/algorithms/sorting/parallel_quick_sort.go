@@ -0,0 +1,163 @@
+package sorting
+
+import (
+	"gin/algorithms"
+	"gin/models"
+	"strconv"
+	"sync"
+)
+
+// ParallelQuickSort 并行快速排序算法
+type ParallelQuickSort struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewParallelQuickSort 创建并行快速排序算法实例
+func NewParallelQuickSort() *ParallelQuickSort {
+	return &ParallelQuickSort{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "parallel_quick_sort",
+			Name:            "并行快速排序",
+			Category:        models.CategorySorting,
+			Description:     "分区完成后，基准两侧的子数组互不重叠，可以同时排序。若有空闲工作者就把左侧子数组交给新的goroutine，当前工作者继续处理右侧子数组。",
+			TimeComplexity:  "O(n log n)",
+			SpaceComplexity: "O(log n)",
+			Parameters: []models.Parameter{
+				workersParameter(),
+			},
+			Stable:   false,
+			InPlace:  true,
+			Adaptive: false,
+		},
+	}
+}
+
+// Execute 执行并行快速排序
+func (pq *ParallelQuickSort) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return pq.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 按参数执行并行快速排序
+func (pq *ParallelQuickSort) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	// 验证输入
+	if err := pq.ValidateInput(data); err != nil {
+		return nil, err
+	}
+
+	arr := data.([]interface{})
+
+	// 复制数组以避免修改原数据
+	result := make([]interface{}, len(arr))
+	copy(result, arr)
+
+	pq.sort(result, parseWorkers(params), tracker)
+	return result, nil
+}
+
+// Sort 并行快速排序实现（默认工作者数量）
+func (pq *ParallelQuickSort) Sort(data []interface{}, tracker models.StepTracker) error {
+	pq.sort(data, defaultWorkers, tracker)
+	return nil
+}
+
+// sort 使用指定数量的工作者排序
+func (pq *ParallelQuickSort) sort(data []interface{}, workers int, tracker models.StepTracker) {
+	n := len(data)
+	if n <= 1 {
+		tracker.AddStep("数组长度小于等于1，无需排序", data, []int{})
+		return
+	}
+
+	withWorkerTracker(tracker, func(tracker models.WorkerStepTracker) {
+		tracker.SetPhase("初始化")
+		tracker.AddStep("开始并行快速排序，工作者数量: "+strconv.Itoa(workers), data, []int{})
+
+		pool := newWorkerPool(workers)
+		pq.sortRange(data, 0, n-1, 0, 1, pool, tracker)
+
+		tracker.SetPhase("完成")
+		tracker.AddStep("并行快速排序完成", data, []int{})
+	})
+}
+
+// sortRange 由指定工作者排序 [low, high]，有空闲工作者时把左侧子数组交给它
+func (pq *ParallelQuickSort) sortRange(data []interface{}, low, high, depth, workerID int, pool *workerPool, tracker models.WorkerStepTracker) {
+	if low >= high {
+		return
+	}
+
+	worker := tracker.ForWorker(workerID)
+	worker.SetPhase("工作者 " + strconv.Itoa(workerID) + " - 递归深度 " + strconv.Itoa(depth) + " - 分区")
+	worker.AddStep("处理子数组 ["+strconv.Itoa(low)+", "+strconv.Itoa(high)+"]", data, algorithms.IndexRange(low, high))
+
+	// 复用快速排序的分区过程
+	pivotIndex := Partition(data, low, high, worker)
+	worker.AddStep("分区完成，基准位置: "+strconv.Itoa(pivotIndex), data, []int{pivotIndex})
+	worker.AddNote("基准元素 " + formatElement(data[pivotIndex]) + " 已就位")
+
+	// 左侧子数组至少有两个元素时才值得交给其他工作者
+	if pivotIndex-1 > low {
+		if helper, ok := pool.tryAcquire(); ok {
+			worker.AddOperation(models.OpTypeSplit, algorithms.IndexRange(low, pivotIndex-1), []interface{}{helper},
+				"将左侧子数组交给工作者 "+strconv.Itoa(helper))
+			worker.AddNote("工作者 " + strconv.Itoa(helper) + " 负责 [" + strconv.Itoa(low) + ", " + strconv.Itoa(pivotIndex-1) + "]")
+
+			var wg sync.WaitGroup
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer pool.release(helper)
+				pq.sortRange(data, low, pivotIndex-1, depth+1, helper, pool, tracker)
+			}()
+
+			pq.sortRange(data, pivotIndex+1, high, depth+1, workerID, pool, tracker)
+			wg.Wait()
+			return
+		}
+	}
+
+	// 没有空闲工作者，由当前工作者顺序处理
+	pq.sortRange(data, low, pivotIndex-1, depth+1, workerID, pool, tracker)
+	pq.sortRange(data, pivotIndex+1, high, depth+1, workerID, pool, tracker)
+}
+
+// ValidateInput 验证输入数据
+func (pq *ParallelQuickSort) ValidateInput(data interface{}) error {
+	return validateParallelInput(data)
+}
+
+// IsConcurrent 并行快速排序使用多个goroutine
+func (pq *ParallelQuickSort) IsConcurrent() bool {
+	return true
+}
+
+// IsStable 并行快速排序不是稳定的
+func (pq *ParallelQuickSort) IsStable() bool {
+	return false
+}
+
+// IsInPlace 并行快速排序是原地的
+func (pq *ParallelQuickSort) IsInPlace() bool {
+	return true
+}
+
+// IsAdaptive 并行快速排序不是自适应的
+func (pq *ParallelQuickSort) IsAdaptive() bool {
+	return false
+}
+
+// GetComplexity 获取复杂度信息
+func (pq *ParallelQuickSort) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(n log n / p + n)", // p 为工作者数量，首次分区仍是串行的
+			Average: "O(n log n / p + n)",
+			Worst:   "O(n²)",
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(log n)",
+			Average: "O(log n)",
+			Worst:   "O(n)",
+		},
+	}
+}
@@ -0,0 +1,93 @@
+package sorting
+
+import (
+	"gin/algorithms"
+	"gin/models"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestParallelSorts_Execute(t *testing.T) {
+	sorts := []algorithms.ParameterizedAlgorithm{NewParallelMergeSort(), NewParallelQuickSort(), NewSampleSort()}
+
+	rng := rand.New(rand.NewSource(1))
+	inputs := map[string][]interface{}{
+		"Empty array":     {},
+		"Single element":  {5},
+		"Few elements":    {3, 1, 2},
+		"Many duplicates": {4, 1, 4, 1, 4, 1, 4, 1, 2, 2, 2, 3, 3, 3, 0, 0},
+	}
+	random := make([]interface{}, 200)
+	for i := range random {
+		random[i] = float64(rng.Intn(1000))
+	}
+	inputs["Random floats"] = random
+
+	for _, algorithm := range sorts {
+		for name, input := range inputs {
+			for _, workers := range []int{1, 2, 3, 8} {
+				t.Run(algorithm.GetInfo().ID+"/"+name, func(t *testing.T) {
+					tracker := models.NewConcurrentStepTracker()
+					result, err := algorithm.ExecuteWithParams(input, map[string]interface{}{"workers": float64(workers)}, tracker)
+					if err != nil {
+						t.Fatalf("ExecuteWithParams() error = %v", err)
+					}
+
+					resultArray := result.([]interface{})
+					if len(resultArray) != len(input) {
+						t.Fatalf("result length = %d, expected %d", len(resultArray), len(input))
+					}
+					if !sort.SliceIsSorted(resultArray, func(i, j int) bool {
+						return compareElements(resultArray[i], resultArray[j]) < 0
+					}) {
+						t.Errorf("result is not sorted: %v", resultArray)
+					}
+
+					// 工作者ID不能超过工作者数量
+					for _, step := range tracker.GetSteps() {
+						if step.WorkerID < 0 || step.WorkerID > workers {
+							t.Fatalf("step %d has worker ID %d, expected at most %d", step.StepID, step.WorkerID, workers)
+						}
+					}
+				})
+			}
+		}
+	}
+}
+
+func TestParallelSorts_WorkerAttribution(t *testing.T) {
+	input := make([]interface{}, 64)
+	for i := range input {
+		input[i] = (i * 37) % 64
+	}
+
+	for _, algorithm := range []algorithms.ParameterizedAlgorithm{NewParallelMergeSort(), NewParallelQuickSort(), NewSampleSort()} {
+		t.Run(algorithm.GetInfo().ID, func(t *testing.T) {
+			tracker := models.NewConcurrentStepTracker()
+			if _, err := algorithm.ExecuteWithParams(input, map[string]interface{}{"workers": 4}, tracker); err != nil {
+				t.Fatalf("ExecuteWithParams() error = %v", err)
+			}
+
+			workers := make(map[int]bool)
+			for i, step := range tracker.GetSteps() {
+				if step.StepID != i {
+					t.Fatalf("step %d has ID %d", i, step.StepID)
+				}
+				workers[step.WorkerID] = true
+			}
+			if len(workers) < 3 {
+				t.Errorf("expected steps from several workers, got %v", workers)
+			}
+
+			// 普通追踪器按顺序重放，统计保持一致
+			plain := models.NewStepTracker()
+			if _, err := algorithm.ExecuteWithParams(input, map[string]interface{}{"workers": 4}, plain); err != nil {
+				t.Fatalf("ExecuteWithParams() error = %v", err)
+			}
+			if len(plain.GetSteps()) == 0 {
+				t.Error("plain tracker received no steps")
+			}
+		})
+	}
+}
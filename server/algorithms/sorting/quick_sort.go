@@ -96,32 +96,86 @@ func (qs *QuickSort) quickSortRecursive(data []interface{}, low, high int, track
 		}
 		tracker.AddStep("处理子数组 ["+strconv.Itoa(low)+", "+strconv.Itoa(high)+"]", data, highlights)
 
-		// 分区操作
-		pivotIndex := qs.partition(data, low, high, tracker)
+		// 三路分区：等于基准的元素整体就位，不再参与递归
+		lt, gt := qs.partition(data, low, high, tracker)
 
 		// 显示分区结果
-		tracker.AddStep("分区完成，基准位置: "+strconv.Itoa(pivotIndex), data, []int{pivotIndex})
-		tracker.AddNote("基准元素 " + qs.toString(data[pivotIndex]) + " 已就位")
+		tracker.AddStep("分区完成，等于基准的区间: ["+strconv.Itoa(lt)+", "+strconv.Itoa(gt)+"]", data, algorithms.IndexRange(lt, gt))
+		tracker.AddNote("基准元素 " + qs.toString(data[lt]) + " 已就位")
 
 		// 递归排序左半部分
-		if pivotIndex-1 > low {
+		if lt-1 > low {
 			tracker.SetPhase("递归深度 " + strconv.Itoa(depth+1) + " - 左子数组")
-			qs.quickSortRecursive(data, low, pivotIndex-1, tracker, depth+1)
+			qs.quickSortRecursive(data, low, lt-1, tracker, depth+1)
 		}
 
 		// 递归排序右半部分
-		if pivotIndex+1 < high {
+		if gt+1 < high {
 			tracker.SetPhase("递归深度 " + strconv.Itoa(depth+1) + " - 右子数组")
-			qs.quickSortRecursive(data, pivotIndex+1, high, tracker, depth+1)
+			qs.quickSortRecursive(data, gt+1, high, tracker, depth+1)
 		}
 	}
 }
 
 // partition 分区操作
-func (qs *QuickSort) partition(data []interface{}, low, high int, tracker models.StepTracker) int {
+func (qs *QuickSort) partition(data []interface{}, low, high int, tracker models.StepTracker) (int, int) {
+	return Partition3(data, low, high, tracker)
+}
+
+// Partition3 三路分区：以data[high]为基准，把[low, high]分成小于、等于、大于基准三段，返回等于段的首尾下标
+// 快速排序与快速选择等选择算法共用这一套分区可视化步骤；扫描期间基准留在末尾，比较的高亮始终指向基准
+func Partition3(data []interface{}, low, high int, tracker models.StepTracker) (int, int) {
+	pivot := data[high]
+	tracker.AddStep("选择基准元素: "+formatElement(pivot), data, []int{high})
+
+	// [low, lt) 小于基准，[lt, i) 等于基准，(gt, high) 大于基准
+	lt, i, gt := low, low, high-1
+	for i <= gt {
+		tracker.AddStep("比较元素", data, []int{i, high})
+		result := compareElements(data[i], pivot)
+		tracker.AddComparison(i, high, result)
+
+		switch {
+		case result < 0:
+			if lt != i {
+				data[lt], data[i] = data[i], data[lt]
+				tracker.AddOperation(models.OpTypeSwap, []int{lt, i},
+					[]interface{}{data[lt], data[i]}, "移动小于基准的元素")
+				tracker.AddStep("交换元素", data, []int{lt, i})
+			}
+			lt++
+			i++
+		case result > 0:
+			if i != gt {
+				data[i], data[gt] = data[gt], data[i]
+				tracker.AddOperation(models.OpTypeSwap, []int{i, gt},
+					[]interface{}{data[i], data[gt]}, "移动大于基准的元素")
+				tracker.AddStep("交换元素", data, []int{i, gt})
+			}
+			gt--
+		default:
+			i++
+		}
+	}
+
+	// 基准与大于段的第一个元素交换，接在等于段之后
+	gt++
+	if gt != high {
+		data[gt], data[high] = data[high], data[gt]
+		tracker.AddOperation(models.OpTypeSwap, []int{gt, high},
+			[]interface{}{data[gt], data[high]}, "将基准元素放到正确位置")
+		tracker.AddStep("基准元素就位", data, algorithms.IndexRange(lt, gt))
+	}
+
+	return lt, gt
+}
+
+// Partition Lomuto分区：以data[high]为基准，返回基准最终位置
+// 并行快速排序按子数组分派任务时使用
+func Partition(data []interface{}, low, high int, tracker models.StepTracker) int {
 	// 选择最后一个元素作为基准
 	pivot := data[high]
-	tracker.AddStep("选择基准元素: "+qs.toString(pivot), data, []int{high})
+	tracker.AddStep("选择基准元素: "+formatElement(pivot), data, []int{high})
 
 	i := low - 1 // 小于基准的元素的索引
 
@@ -129,7 +183,7 @@ func (qs *QuickSort) partition(data []interface{}, low, high int, tracker models
 		// 比较当前元素与基准
 		tracker.AddStep("比较元素", data, []int{j, high})
 		
-		if qs.compare(data[j], pivot) <= 0 {
+		if compareElements(data[j], pivot) <= 0 {
 			// 当前元素小于等于基准
 			tracker.AddComparison(j, high, -1)
 			
@@ -193,6 +247,38 @@ func (qs *QuickSort) compare(a, b interface{}) int {
 	return 0
 }
 
+// compareElements 比较两个元素，整数与浮点数按数值比较
+func compareElements(a, b interface{}) int {
+	fa, okA := numericElement(a)
+	fb, okB := numericElement(b)
+	if okA && okB {
+		if fa < fb {
+			return -1
+		} else if fa > fb {
+			return 1
+		}
+		return 0
+	}
+	return (&QuickSort{}).compare(a, b)
+}
+
+// numericElement 将数值元素转换为float64
+func numericElement(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case float64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
+
+// formatElement 将元素格式化为字符串
+func formatElement(value interface{}) string {
+	return (&QuickSort{}).toString(value)
+}
+
 // toString 将元素转换为字符串
 func (qs *QuickSort) toString(value interface{}) string {
 	switch v := value.(type) {
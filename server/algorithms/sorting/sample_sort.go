@@ -0,0 +1,321 @@
+package sorting
+
+import (
+	"gin/algorithms"
+	"gin/models"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// SampleSort 样本排序算法（正则采样并行排序 PSRS）
+type SampleSort struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewSampleSort 创建样本排序算法实例
+func NewSampleSort() *SampleSort {
+	return &SampleSort{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "sample_sort",
+			Name:            "样本排序",
+			Category:        models.CategorySorting,
+			Description:     "采用正则采样并行排序（PSRS）：p 个工作者先各自排序一块数据并等距采样，由样本选出 p-1 个分割元素；随后每个工作者按分割元素把自己的块划分为 p 段，第 j 个工作者归并所有块的第 j 段，拼接即为有序结果。",
+			TimeComplexity:  "O(n log n)",
+			SpaceComplexity: "O(n)",
+			Parameters: []models.Parameter{
+				workersParameter(),
+			},
+			Stable:   false,
+			InPlace:  false,
+			Adaptive: false,
+		},
+	}
+}
+
+// Execute 执行样本排序
+func (ss *SampleSort) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return ss.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 按参数执行样本排序
+func (ss *SampleSort) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	// 验证输入
+	if err := ss.ValidateInput(data); err != nil {
+		return nil, err
+	}
+
+	arr := data.([]interface{})
+
+	// 复制数组以避免修改原数据
+	result := make([]interface{}, len(arr))
+	copy(result, arr)
+
+	ss.sort(result, parseWorkers(params), tracker)
+	return result, nil
+}
+
+// Sort 样本排序实现（默认工作者数量）
+func (ss *SampleSort) Sort(data []interface{}, tracker models.StepTracker) error {
+	ss.sort(data, defaultWorkers, tracker)
+	return nil
+}
+
+// sampleSortRun 样本排序的执行上下文
+type sampleSortRun struct {
+	data    []interface{}
+	output  []interface{}
+	p       int
+	blocks  [][2]int // 每个工作者负责的块 [start, end)
+	bounds  [][]int  // bounds[i][j] 为块i中第j段的起始下标，bounds[i][p] 为块末尾
+	tracker models.WorkerStepTracker
+}
+
+// sort 使用指定数量的工作者排序
+func (ss *SampleSort) sort(data []interface{}, workers int, tracker models.StepTracker) {
+	n := len(data)
+	if n <= 1 {
+		tracker.AddStep("数组长度小于等于1，无需排序", data, []int{})
+		return
+	}
+
+	// 每个工作者至少分到一个元素
+	p := workers
+	if p > n {
+		p = n
+	}
+
+	withWorkerTracker(tracker, func(tracker models.WorkerStepTracker) {
+		run := &sampleSortRun{
+			data:    data,
+			output:  make([]interface{}, n),
+			p:       p,
+			blocks:  make([][2]int, p),
+			bounds:  make([][]int, p),
+			tracker: tracker,
+		}
+		for i := 0; i < p; i++ {
+			run.blocks[i] = [2]int{i * n / p, (i + 1) * n / p}
+		}
+
+		tracker.SetPhase("初始化")
+		tracker.AddStep("开始样本排序，将数组均分为 "+strconv.Itoa(p)+" 块", data, []int{})
+
+		run.sortBlocks()
+		splitters := run.chooseSplitters()
+		run.partitionBlocks(splitters)
+		run.mergeBuckets()
+
+		// 将归并结果写回原数组
+		copy(data, run.output)
+		tracker.SetPhase("完成")
+		tracker.AddStep("样本排序完成", data, []int{})
+	})
+}
+
+// parallel 为每个块启动一个工作者并等待全部完成
+func (r *sampleSortRun) parallel(task func(block int, worker models.StepTracker)) {
+	var wg sync.WaitGroup
+	for i := 0; i < r.p; i++ {
+		wg.Add(1)
+		go func(block int) {
+			defer wg.Done()
+			task(block, r.tracker.ForWorker(block+1))
+		}(i)
+	}
+	wg.Wait()
+}
+
+// sortBlocks 第一阶段：各工作者并发排序自己的块
+func (r *sampleSortRun) sortBlocks() {
+	r.tracker.SetPhase("局部排序")
+	r.tracker.AddStep("各工作者并发排序自己的块", r.data, []int{})
+
+	r.parallel(func(block int, worker models.StepTracker) {
+		start, end := r.blocks[block][0], r.blocks[block][1]
+		worker.SetPhase("工作者 " + strconv.Itoa(block+1) + " - 局部排序")
+		worker.AddStep("排序块 ["+strconv.Itoa(start)+", "+strconv.Itoa(end-1)+"]", r.data, algorithms.IndexRange(start, end-1))
+		if end-start > 1 {
+			// 复用归并排序的递归过程
+			(&MergeSort{}).mergeSortRecursive(r.data, start, end-1, worker, 0)
+		}
+		worker.AddStep("块 ["+strconv.Itoa(start)+", "+strconv.Itoa(end-1)+"] 已有序", r.data, algorithms.IndexRange(start, end-1))
+	})
+}
+
+// chooseSplitters 第二阶段：各块等距采样 p 个样本，排序后选出 p-1 个分割元素
+func (r *sampleSortRun) chooseSplitters() []interface{} {
+	r.tracker.SetPhase("正则采样")
+
+	samples := make([]interface{}, 0, r.p*r.p)
+	sampleIndices := make([]int, 0, r.p*r.p)
+	for block := 0; block < r.p; block++ {
+		start, end := r.blocks[block][0], r.blocks[block][1]
+		size := end - start
+		for j := 0; j < r.p; j++ {
+			index := start + j*size/r.p
+			samples = append(samples, r.data[index])
+			sampleIndices = append(sampleIndices, index)
+		}
+	}
+	r.tracker.AddStep("每块等距采样 "+strconv.Itoa(r.p)+" 个样本: "+formatElements(samples), r.data, sampleIndices)
+	r.tracker.AddOperation(models.OpTypeAccess, sampleIndices, samples, "正则采样")
+
+	sort.SliceStable(samples, func(i, j int) bool {
+		return compareElements(samples[i], samples[j]) < 0
+	})
+
+	// 以 p 为间隔从有序样本中选出分割元素
+	splitters := make([]interface{}, 0, r.p-1)
+	for j := 1; j < r.p; j++ {
+		splitters = append(splitters, samples[j*r.p+r.p/2-1])
+	}
+	r.tracker.AddStep("样本排序后为 "+formatElements(samples)+"，选出分割元素 "+formatElements(splitters), r.data, []int{})
+	r.tracker.AddNote("分割元素把值域划分为 " + strconv.Itoa(r.p) + " 段，第 j 段由工作者 j 归并")
+	return splitters
+}
+
+// partitionBlocks 第三阶段：各工作者按分割元素划分自己的块
+func (r *sampleSortRun) partitionBlocks(splitters []interface{}) {
+	r.tracker.SetPhase("划分")
+	r.tracker.AddStep("各工作者按分割元素划分自己的块", r.data, []int{})
+
+	r.parallel(func(block int, worker models.StepTracker) {
+		start, end := r.blocks[block][0], r.blocks[block][1]
+		worker.SetPhase("工作者 " + strconv.Itoa(block+1) + " - 划分")
+
+		bounds := make([]int, r.p+1)
+		bounds[0] = start
+		for j, splitter := range splitters {
+			// 二分查找第一个大于分割元素的位置
+			low, high := bounds[j], end
+			for low < high {
+				mid := low + (high-low)/2
+				result := compareElements(r.data[mid], splitter)
+				worker.AddStep("比较 "+formatElement(r.data[mid])+" 与分割元素 "+formatElement(splitter), r.data, []int{mid})
+				worker.AddComparison(mid, -1, result)
+				if result <= 0 {
+					low = mid + 1
+				} else {
+					high = mid
+				}
+			}
+			bounds[j+1] = low
+		}
+		bounds[r.p] = end
+		r.bounds[block] = bounds
+
+		for j := 0; j < r.p; j++ {
+			if bounds[j] < bounds[j+1] {
+				worker.AddStep("块 "+strconv.Itoa(block+1)+" 的第 "+strconv.Itoa(j+1)+" 段: ["+strconv.Itoa(bounds[j])+", "+
+					strconv.Itoa(bounds[j+1]-1)+"]", r.data, algorithms.IndexRange(bounds[j], bounds[j+1]-1))
+				worker.AddOperation(models.OpTypePartition, algorithms.IndexRange(bounds[j], bounds[j+1]-1), []interface{}{j + 1}, "划分到第 "+strconv.Itoa(j+1)+" 段")
+			}
+		}
+	})
+}
+
+// mergeBuckets 第四阶段：工作者 j 归并所有块的第 j 段，写入结果数组的对应区间
+func (r *sampleSortRun) mergeBuckets() {
+	r.tracker.SetPhase("归并")
+
+	// 前缀和计算每段在结果数组中的起始位置
+	offsets := make([]int, r.p+1)
+	for j := 0; j < r.p; j++ {
+		size := 0
+		for block := 0; block < r.p; block++ {
+			size += r.bounds[block][j+1] - r.bounds[block][j]
+		}
+		offsets[j+1] = offsets[j] + size
+	}
+	r.tracker.AddStep("按前缀和计算各段在结果中的位置，各工作者并发归并", r.output, []int{})
+
+	r.parallel(func(bucket int, worker models.StepTracker) {
+		worker.SetPhase("工作者 " + strconv.Itoa(bucket+1) + " - 归并")
+
+		// 各块中第 bucket 段的当前读取位置
+		heads := make([]int, r.p)
+		for block := 0; block < r.p; block++ {
+			heads[block] = r.bounds[block][bucket]
+		}
+
+		for k := offsets[bucket]; k < offsets[bucket+1]; k++ {
+			// 在各段头部中选出最小元素
+			best := -1
+			for block := 0; block < r.p; block++ {
+				if heads[block] >= r.bounds[block][bucket+1] {
+					continue
+				}
+				if best == -1 {
+					best = block
+					continue
+				}
+				result := compareElements(r.data[heads[block]], r.data[heads[best]])
+				worker.AddComparison(heads[block], heads[best], result)
+				if result < 0 {
+					best = block
+				}
+			}
+
+			r.output[k] = r.data[heads[best]]
+			worker.AddStep("工作者 "+strconv.Itoa(bucket+1)+" 写入 "+formatElement(r.output[k])+" 到位置 "+strconv.Itoa(k), r.output, []int{k})
+			worker.AddOperation(models.OpTypeMove, []int{k}, []interface{}{r.output[k]}, "从块 "+strconv.Itoa(best+1)+" 归并")
+			heads[best]++
+		}
+
+		if offsets[bucket] < offsets[bucket+1] {
+			worker.AddStep("第 "+strconv.Itoa(bucket+1)+" 段归并完成", r.output, algorithms.IndexRange(offsets[bucket], offsets[bucket+1]-1))
+		}
+	})
+}
+
+// formatElements 将元素列表格式化为字符串
+func formatElements(values []interface{}) string {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		parts[i] = formatElement(v)
+	}
+	return "[" + strings.Join(parts, ", ") + "]"
+}
+
+// ValidateInput 验证输入数据
+func (ss *SampleSort) ValidateInput(data interface{}) error {
+	return validateParallelInput(data)
+}
+
+// IsConcurrent 样本排序使用多个goroutine
+func (ss *SampleSort) IsConcurrent() bool {
+	return true
+}
+
+// IsStable 样本排序不是稳定的
+func (ss *SampleSort) IsStable() bool {
+	return false
+}
+
+// IsInPlace 样本排序不是原地的
+func (ss *SampleSort) IsInPlace() bool {
+	return false
+}
+
+// IsAdaptive 样本排序不是自适应的
+func (ss *SampleSort) IsAdaptive() bool {
+	return false
+}
+
+// GetComplexity 获取复杂度信息
+func (ss *SampleSort) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(n log n / p)", // p 为工作者数量
+			Average: "O(n log n / p + p² log p)",
+			Worst:   "O(n log n)", // 数据高度重复时某一段可能包含大部分元素
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(n)",
+			Average: "O(n)",
+			Worst:   "O(n)",
+		},
+	}
+}
@@ -0,0 +1,193 @@
+package sorting
+
+import (
+	"gin/algorithms"
+	"gin/models"
+	"strconv"
+)
+
+// comparator 比较器，将较小值放到下标较小的线上
+type comparator [2]int
+
+// networkLayer 一层互不相交、可并行执行的比较器
+type networkLayer struct {
+	label       string
+	comparators []comparator
+}
+
+// sortingNetwork 排序网络
+// 所有比较器都是升序的，长度不是2的幂时视为在末尾补+∞，与补位线相连的比较器不会交换，可以直接删去
+type sortingNetwork struct {
+	layers []networkLayer
+}
+
+// addLayer 添加一层比较器，空层忽略
+func (nw *sortingNetwork) addLayer(label string, comparators []comparator) {
+	if len(comparators) > 0 {
+		nw.layers = append(nw.layers, networkLayer{label: label, comparators: comparators})
+	}
+}
+
+// bitonicNetwork 构建双调排序网络（全部使用升序比较器的形式）
+func bitonicNetwork(n int) *sortingNetwork {
+	nw := &sortingNetwork{}
+	size := nextPowerOfTwo(n)
+
+	for k := 2; k <= size; k *= 2 {
+		for j := k / 2; j > 0; j /= 2 {
+			comparators := make([]comparator, 0)
+			for i := 0; i < size; i++ {
+				// 每轮合并的第一层把前半段与后半段镜像比较，相当于把后半段翻转后构成双调序列
+				partner := i ^ j
+				if j == k/2 {
+					partner = i ^ (k - 1)
+				}
+				if partner > i && partner < n {
+					comparators = append(comparators, comparator{i, partner})
+				}
+			}
+			label := "合并规模 " + strconv.Itoa(k) + "，间距 " + strconv.Itoa(j)
+			if j == k/2 {
+				label = "合并规模 " + strconv.Itoa(k) + "，镜像比较"
+			}
+			nw.addLayer(label, comparators)
+		}
+	}
+	return nw
+}
+
+// oddEvenMergeNetwork 构建Batcher奇偶归并排序网络
+func oddEvenMergeNetwork(n int) *sortingNetwork {
+	nw := &sortingNetwork{}
+
+	for p := 1; p < n; p *= 2 {
+		for k := p; k > 0; k /= 2 {
+			comparators := make([]comparator, 0)
+			for j := k % p; j+k < n; j += 2 * k {
+				for i := 0; i < k && i+j+k < n; i++ {
+					// 只比较属于同一个待归并块（大小 2p）的两条线
+					if (i+j)/(2*p) == (i+j+k)/(2*p) {
+						comparators = append(comparators, comparator{i + j, i + j + k})
+					}
+				}
+			}
+			nw.addLayer("归并块大小 "+strconv.Itoa(2*p)+"，间距 "+strconv.Itoa(k), comparators)
+		}
+	}
+	return nw
+}
+
+// comparatorCount 比较器总数
+func (nw *sortingNetwork) comparatorCount() int {
+	count := 0
+	for _, layer := range nw.layers {
+		count += len(layer.comparators)
+	}
+	return count
+}
+
+// apply 逐层执行排序网络，每层记录为一个步骤，返回交换次数
+func (nw *sortingNetwork) apply(data []interface{}, tracker models.StepTracker) int {
+	exchanges := 0
+	for depth, layer := range nw.layers {
+		tracker.SetPhase("第 " + strconv.Itoa(depth+1) + " 层")
+
+		wires := make([]int, 0, 2*len(layer.comparators))
+		for _, c := range layer.comparators {
+			wires = append(wires, c[0], c[1])
+		}
+		tracker.AddStep("第 "+strconv.Itoa(depth+1)+" 层（"+layer.label+"）: "+
+			strconv.Itoa(len(layer.comparators))+" 个比较器并行执行", data, wires)
+
+		swapped := 0
+		for _, c := range layer.comparators {
+			result := compareElements(data[c[0]], data[c[1]])
+			tracker.AddComparison(c[0], c[1], result)
+			if result > 0 {
+				data[c[0]], data[c[1]] = data[c[1]], data[c[0]]
+				tracker.AddOperation(models.OpTypeSwap, []int{c[0], c[1]},
+					[]interface{}{data[c[0]], data[c[1]]}, "比较器交换")
+				swapped++
+			}
+		}
+		tracker.AddNote("本层交换 " + strconv.Itoa(swapped) + " 次")
+		exchanges += swapped
+	}
+	return exchanges
+}
+
+// layerList 网络结构，供前端绘制比较器
+func (nw *sortingNetwork) layerList() [][]comparator {
+	layers := make([][]comparator, len(nw.layers))
+	for i, layer := range nw.layers {
+		layers[i] = layer.comparators
+	}
+	return layers
+}
+
+// runNetwork 排序网络算法的公共执行流程
+func runNetwork(data interface{}, name string, build func(n int) *sortingNetwork, tracker models.StepTracker) (interface{}, error) {
+	arr := data.([]interface{})
+
+	// 复制数组以避免修改原数据
+	result := make([]interface{}, len(arr))
+	copy(result, arr)
+
+	nw := build(len(result))
+	exchanges := sortWithNetwork(result, name, nw, tracker)
+
+	return map[string]interface{}{
+		"sorted":      result,
+		"depth":       len(nw.layers),
+		"comparators": nw.comparatorCount(),
+		"exchanges":   exchanges,
+		"network":     nw.layerList(),
+	}, nil
+}
+
+// sortWithNetwork 用排序网络排序并记录步骤
+func sortWithNetwork(data []interface{}, name string, nw *sortingNetwork, tracker models.StepTracker) int {
+	if len(data) <= 1 {
+		tracker.AddStep("数组长度小于等于1，无需排序", data, []int{})
+		return 0
+	}
+
+	tracker.SetPhase("初始化")
+	tracker.AddStep("开始"+name+"，网络深度 "+strconv.Itoa(len(nw.layers))+"，比较器 "+
+		strconv.Itoa(nw.comparatorCount())+" 个", data, []int{})
+	tracker.AddNote("比较器序列与输入无关，无论数据是否有序都执行相同的比较")
+
+	exchanges := nw.apply(data, tracker)
+
+	tracker.SetPhase("完成")
+	tracker.AddStep(name+"完成，共交换 "+strconv.Itoa(exchanges)+" 次", data, []int{})
+	return exchanges
+}
+
+// nextPowerOfTwo 不小于n的最小2的幂
+func nextPowerOfTwo(n int) int {
+	size := 1
+	for size < n {
+		size *= 2
+	}
+	return size
+}
+
+// validateNetworkInput 排序网络的输入验证
+func validateNetworkInput(data interface{}) error {
+	if data == nil {
+		return algorithms.ErrInvalidInput
+	}
+
+	arr, ok := data.([]interface{})
+	if !ok {
+		return algorithms.ErrInvalidInput
+	}
+
+	// 比较器数量为 O(n log² n)，限制数组大小
+	if len(arr) > 1024 {
+		return algorithms.ErrInvalidInput
+	}
+
+	return nil
+}
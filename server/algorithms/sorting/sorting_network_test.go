@@ -0,0 +1,89 @@
+package sorting
+
+import (
+	"gin/models"
+	"testing"
+)
+
+func TestSortingNetworks_ZeroOnePrinciple(t *testing.T) {
+	builders := map[string]func(n int) *sortingNetwork{
+		"bitonic":        bitonicNetwork,
+		"odd_even_merge": oddEvenMergeNetwork,
+	}
+
+	// 0-1原理：能排序所有0-1序列的比较器网络能排序任意序列
+	for name, build := range builders {
+		for n := 1; n <= 12; n++ {
+			nw := build(n)
+			for mask := 0; mask < 1<<n; mask++ {
+				data := make([]interface{}, n)
+				for i := range data {
+					data[i] = (mask >> i) & 1
+				}
+				nw.apply(data, models.NewStepTracker())
+				for i := 1; i < n; i++ {
+					if data[i-1].(int) > data[i].(int) {
+						t.Fatalf("%s network for n=%d fails on mask %b: %v", name, n, mask, data)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestSortingNetworks_Execute(t *testing.T) {
+	tests := []struct {
+		name        string
+		execute     func(interface{}, models.StepTracker) (interface{}, error)
+		input       []interface{}
+		depth       int
+		comparators int
+	}{
+		{"Bitonic n=8", NewBitonicSort().Execute, []interface{}{5, 7, 1, 3, 8, 2, 6, 4}, 6, 24},
+		{"Bitonic n=16", NewBitonicSort().Execute, descending(16), 10, 80},
+		{"Odd-even merge n=8", NewOddEvenMergeSort().Execute, []interface{}{5, 7, 1, 3, 8, 2, 6, 4}, 6, 19},
+		{"Odd-even merge n=16", NewOddEvenMergeSort().Execute, descending(16), 10, 63},
+		{"Odd-even merge n=6", NewOddEvenMergeSort().Execute, []interface{}{3.5, 1.0, 2.0, 6.0, 0.5, 4.0}, 6, 12},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tracker := models.NewStepTracker()
+			result, err := tt.execute(tt.input, tracker)
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+
+			output := result.(map[string]interface{})
+			sorted := output["sorted"].([]interface{})
+			for i := 1; i < len(sorted); i++ {
+				if compareElements(sorted[i-1], sorted[i]) > 0 {
+					t.Fatalf("result is not sorted: %v", sorted)
+				}
+			}
+
+			if output["depth"] != tt.depth || output["comparators"] != tt.comparators {
+				t.Errorf("depth/comparators = %v/%v, expected %d/%d",
+					output["depth"], output["comparators"], tt.depth, tt.comparators)
+			}
+
+			// 每层一个步骤，加上开始和完成两个步骤；比较次数等于比较器数量
+			if steps := len(tracker.GetSteps()); steps != tt.depth+2 {
+				t.Errorf("steps = %d, expected %d", steps, tt.depth+2)
+			}
+			if stats := tracker.GetStats(); stats.Comparisons != tt.comparators || stats.Swaps != output["exchanges"] {
+				t.Errorf("stats = %d comparisons / %d swaps, expected %d / %v",
+					stats.Comparisons, stats.Swaps, tt.comparators, output["exchanges"])
+			}
+		})
+	}
+}
+
+// descending 生成 n-1 到 0 的降序数组
+func descending(n int) []interface{} {
+	data := make([]interface{}, n)
+	for i := range data {
+		data[i] = n - 1 - i
+	}
+	return data
+}
@@ -0,0 +1,82 @@
+package tree
+
+import (
+	"gin/algorithms"
+	"gin/models"
+)
+
+// AlphaBeta α-β 剪枝搜索
+type AlphaBeta struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewAlphaBeta 创建 α-β 剪枝搜索算法实例
+func NewAlphaBeta() *AlphaBeta {
+	return &AlphaBeta{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "alpha_beta",
+			Name:            "α-β剪枝",
+			Category:        models.CategoryTree,
+			Description:     "在极小化极大搜索中维护窗口 (α, β)：α 是 MAX 方已能保证的下界，β 是 MIN 方已能保证的上界。一旦 α ≥ β，当前节点剩余的子树不会影响根节点的值，直接剪去。开启走法排序后按内部节点的静态估值先搜索更好的走法，使剪枝更早发生。",
+			TimeComplexity:  "O(b^(d/2)) ~ O(b^d)",
+			SpaceComplexity: "O(d)",
+			Parameters: []models.Parameter{
+				rootPlayerParameter(),
+				{
+					Name:         "move_ordering",
+					Type:         "bool",
+					Description:  "是否按内部节点的静态估值（value）排序子节点：MAX 节点降序、MIN 节点升序",
+					DefaultValue: false,
+					Required:     false,
+				},
+			},
+		},
+	}
+}
+
+// Execute 使用默认参数执行 α-β 剪枝搜索
+func (ab *AlphaBeta) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return ab.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 按参数执行 α-β 剪枝搜索
+func (ab *AlphaBeta) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	tree, err := toTreeData(data)
+	if err != nil {
+		return nil, err
+	}
+	rootPlayer := algorithms.OptionParam(params, "root_player", []string{"max", "min"}, "max")
+	ordering := algorithms.BoolParam(params, "move_ordering", false)
+	return searchGameTree(tree, rootPlayer, true, ordering, tracker)
+}
+
+// ProcessTree 处理博弈树
+func (ab *AlphaBeta) ProcessTree(tree *models.TreeData, tracker models.StepTracker) (interface{}, error) {
+	return searchGameTree(tree, "max", true, false, tracker)
+}
+
+// GetTreeType 获取支持的树类型
+func (ab *AlphaBeta) GetTreeType() string {
+	return "both"
+}
+
+// ValidateInput 验证输入数据
+func (ab *AlphaBeta) ValidateInput(data interface{}) error {
+	return validateGameTree(data)
+}
+
+// GetComplexity 获取复杂度信息
+func (ab *AlphaBeta) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(b^(d/2))", // 子节点恰好按最优顺序搜索
+			Average: "O(b^(3d/4))",
+			Worst:   "O(b^d)", // 子节点按最差顺序搜索，无法剪枝
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(d)",
+			Average: "O(d)",
+			Worst:   "O(d)",
+		},
+	}
+}
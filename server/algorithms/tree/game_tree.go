@@ -0,0 +1,457 @@
+package tree
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// maxGameTreeNodes 博弈树的节点数上限
+const maxGameTreeNodes = 2000
+
+// 节点在搜索过程中的状态
+const (
+	statusPending = "pending" // 尚未访问
+	statusActive  = "active"  // 正在搜索其子树
+	statusDone    = "done"    // 已得到返回值
+	statusPruned  = "pruned"  // 被剪枝，不会访问
+)
+
+// gameNode 预处理后的博弈树节点，按先序编号存放
+type gameNode struct {
+	id          string
+	children    []int
+	depth       int
+	size        int     // 子树节点数
+	score       float64 // 叶子得分
+	estimate    float64 // 内部节点的静态估值，仅用于走法排序
+	hasEstimate bool
+}
+
+// gameTree 预处理后的博弈树
+type gameTree struct {
+	data   *models.TreeData
+	nodes  []gameNode
+	leaves int
+}
+
+// gameNodeState 节点的可视化状态
+type gameNodeState struct {
+	Index  int         `json:"index"` // 先序编号，与高亮下标一致
+	ID     string      `json:"id"`
+	Player string      `json:"player"`          // max 或 min
+	Depth  int         `json:"depth"`           // 深度，根为0
+	Alpha  interface{} `json:"alpha,omitempty"` // 当前 α（无穷大以 "-inf"/"+inf" 表示）
+	Beta   interface{} `json:"beta,omitempty"`  // 当前 β
+	Value  interface{} `json:"value,omitempty"` // 当前最优值（剪枝时为界）
+	Best   string      `json:"best,omitempty"`  // 当前最优子节点
+	Status string      `json:"status"`          // pending/active/done/pruned
+}
+
+// gameTreeState 博弈树搜索的步骤快照
+// 为了让追踪大小与节点数成线性关系，树和全部节点的初始状态只在第一步给出，之后每步只记录发生变化的节点
+type gameTreeState struct {
+	Tree    *models.TreeData `json:"tree,omitempty"`  // 输入的博弈树，只在第一步给出
+	Nodes   []gameNodeState  `json:"nodes,omitempty"` // 各节点的初始状态（按先序编号），只在第一步给出
+	Changed []gameNodeState  `json:"changed"`         // 自上一步以来状态发生变化的节点
+	Current int              `json:"current"`         // 当前节点
+	Path    []string         `json:"path"`            // 根到当前节点的路径
+}
+
+// gameSearch 一次博弈树搜索
+type gameSearch struct {
+	tree     *gameTree
+	pruning  bool
+	ordering bool
+	tracker  models.StepTracker
+
+	states      []gameNodeState
+	dirty       []int  // 自上一步以来状态发生变化的节点
+	isDirty     []bool // 节点是否已在 dirty 中
+	started     bool   // 是否已经输出过第一步
+	best        []int
+	path        []int
+	visited     int
+	evaluated   int
+	cutoffs     int
+	prunedNodes int
+	prunedRoots []string
+}
+
+// searchGameTree 在博弈树上执行极小化极大搜索，pruning 开启 α-β 剪枝，ordering 开启走法排序
+func searchGameTree(data *models.TreeData, rootPlayer string, pruning, ordering bool, tracker models.StepTracker) (map[string]interface{}, error) {
+	tree, err := buildGameTree(data)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &gameSearch{
+		tree:        tree,
+		pruning:     pruning,
+		ordering:    ordering,
+		tracker:     tracker,
+		states:      make([]gameNodeState, len(tree.nodes)),
+		isDirty:     make([]bool, len(tree.nodes)),
+		best:        make([]int, len(tree.nodes)),
+		prunedRoots: make([]string, 0),
+	}
+	maximizing := rootPlayer != "min"
+	for i, node := range tree.nodes {
+		s.states[i] = gameNodeState{Index: i, ID: node.id, Player: playerName(maximizing == (node.depth%2 == 0)), Depth: node.depth, Status: statusPending}
+		s.best[i] = -1
+	}
+
+	tracker.SetPhase("搜索")
+	tracker.AddStep(fmt.Sprintf("博弈树共 %d 个节点、%d 个叶子，根节点为 %s 方", len(tree.nodes), tree.leaves, strings.ToUpper(playerName(maximizing))),
+		s.state(-1), []int{})
+	value := s.search(0, maximizing, math.Inf(-1), math.Inf(1))
+
+	// 沿最优子节点得到主变例
+	pv := []string{tree.nodes[0].id}
+	for i := s.best[0]; i >= 0; i = s.best[i] {
+		pv = append(pv, tree.nodes[i].id)
+	}
+	bestMove := ""
+	if len(pv) > 1 {
+		bestMove = pv[1]
+	}
+
+	values := make(map[string]float64)
+	for _, st := range s.states {
+		if v, ok := st.Value.(float64); ok && st.Status == statusDone {
+			values[st.ID] = v
+		}
+	}
+
+	tracker.SetPhase("完成")
+	tracker.AddStep(fmt.Sprintf("根节点的值为 %s，最优走法 %s；评估了 %d/%d 个叶子", formatScore(value), bestMove, s.evaluated, tree.leaves),
+		s.state(0), pvIndices(s))
+
+	return map[string]interface{}{
+		"value":              value,
+		"bestMove":           bestMove,
+		"principalVariation": pv,
+		"values":             values,
+		"visitedNodes":       s.visited,
+		"leavesEvaluated":    s.evaluated,
+		"totalNodes":         len(tree.nodes),
+		"totalLeaves":        tree.leaves,
+		"cutoffs":            s.cutoffs,
+		"prunedSubtrees":     s.prunedRoots,
+		"prunedNodes":        s.prunedNodes,
+	}, nil
+}
+
+// search 搜索以i为根的子树，返回其极小化极大值（剪枝时为窗口外的界）
+func (s *gameSearch) search(i int, maximizing bool, alpha, beta float64) float64 {
+	node := &s.tree.nodes[i]
+	st := &s.states[i]
+	s.visited++
+	s.path = append(s.path, i)
+	defer func() { s.path = s.path[:len(s.path)-1] }()
+
+	st.Status = statusActive
+	if s.pruning {
+		st.Alpha, st.Beta = bound(alpha), bound(beta)
+	}
+	s.touch(i)
+
+	if len(node.children) == 0 {
+		s.evaluated++
+		st.Value, st.Status = node.score, statusDone
+		s.tracker.AddStep(fmt.Sprintf("评估叶子 %s，得分 %s", node.id, formatScore(node.score)), s.state(i), []int{i})
+		s.tracker.AddOperation(models.OpTypeAccess, []int{i}, []interface{}{node.score}, "读取叶子得分")
+		return node.score
+	}
+
+	children, orderNote := s.order(i, maximizing)
+	description := fmt.Sprintf("进入 %s 节点 %s", strings.ToUpper(st.Player), node.id)
+	if s.pruning {
+		description += fmt.Sprintf("，窗口 (α=%s, β=%s)", formatScore(alpha), formatScore(beta))
+	}
+	s.tracker.AddStep(description+orderNote, s.state(i), []int{i})
+
+	best := math.Inf(1)
+	if maximizing {
+		best = math.Inf(-1)
+	}
+	for k, c := range children {
+		v := s.search(c, !maximizing, alpha, beta)
+		child := s.tree.nodes[c].id
+
+		if (maximizing && v > best) || (!maximizing && v < best) {
+			best = v
+			s.best[i] = c
+			st.Value, st.Best = v, child
+		}
+		description := fmt.Sprintf("%s 返回 %s，%s 的当前值为 %s", child, formatScore(v), node.id, formatScore(best))
+		if s.pruning {
+			if maximizing {
+				alpha = math.Max(alpha, v)
+			} else {
+				beta = math.Min(beta, v)
+			}
+			st.Alpha, st.Beta = bound(alpha), bound(beta)
+			description += fmt.Sprintf("，窗口 (α=%s, β=%s)", formatScore(alpha), formatScore(beta))
+		}
+		s.touch(i)
+		s.tracker.AddStep(description, s.state(i), []int{i, c})
+		s.tracker.AddOperation(models.OpTypeCompare, []int{i, c}, []interface{}{v, best}, "用子节点的值更新当前值")
+
+		if s.pruning && alpha >= beta && k < len(children)-1 {
+			rest := children[k+1:]
+			s.prune(i, maximizing, alpha, beta, rest)
+			break
+		}
+	}
+
+	st.Status = statusDone
+	s.touch(i)
+	s.tracker.AddStep(fmt.Sprintf("%s 节点 %s 搜索完毕，返回 %s", strings.ToUpper(st.Player), node.id, formatScore(best)), s.state(i), []int{i})
+	return best
+}
+
+// prune 剪去节点i剩余的子树
+func (s *gameSearch) prune(i int, maximizing bool, alpha, beta float64, rest []int) {
+	s.cutoffs++
+	ids := make([]interface{}, len(rest))
+	names := make([]string, len(rest))
+	count := 0
+	for k, c := range rest {
+		ids[k] = s.tree.nodes[c].id
+		names[k] = s.tree.nodes[c].id
+		s.prunedRoots = append(s.prunedRoots, s.tree.nodes[c].id)
+		count += s.tree.nodes[c].size
+		for j := c; j < c+s.tree.nodes[c].size; j++ {
+			s.states[j].Status = statusPruned
+			s.touch(j)
+		}
+	}
+	s.prunedNodes += count
+
+	// MAX 节点的 α 超过祖先 MIN 节点给出的 β 时为 β 剪枝，反之为 α 剪枝
+	kind := "α剪枝"
+	if maximizing {
+		kind = "β剪枝"
+	}
+	description := fmt.Sprintf("%s：α=%s ≥ β=%s，剪去 %s 的子树 %s（共 %d 个节点）",
+		kind, formatScore(alpha), formatScore(beta), s.tree.nodes[i].id, strings.Join(names, ", "), count)
+	s.tracker.AddStep(description, s.state(i), rest)
+	s.tracker.AddOperation(models.OpTypePrune, rest, ids, description)
+}
+
+// order 返回子节点的搜索顺序
+// 开启走法排序且所有子节点都带静态估值时，MAX 节点按估值降序、MIN 节点按估值升序搜索
+func (s *gameSearch) order(i int, maximizing bool) ([]int, string) {
+	children := append([]int{}, s.tree.nodes[i].children...)
+	if !s.ordering || len(children) < 2 {
+		return children, ""
+	}
+	for _, c := range children {
+		if !s.tree.nodes[c].hasEstimate {
+			return children, "（子节点没有静态估值，保持原顺序）"
+		}
+	}
+
+	sort.SliceStable(children, func(a, b int) bool {
+		ea, eb := s.tree.nodes[children[a]].estimate, s.tree.nodes[children[b]].estimate
+		if maximizing {
+			return ea > eb
+		}
+		return ea < eb
+	})
+	parts := make([]string, len(children))
+	for k, c := range children {
+		parts[k] = fmt.Sprintf("%s(%s)", s.tree.nodes[c].id, formatScore(s.tree.nodes[c].estimate))
+	}
+	return children, "，按静态估值排序子节点: " + strings.Join(parts, " ")
+}
+
+// touch 记录节点i的状态在本步发生了变化
+func (s *gameSearch) touch(i int) {
+	if !s.isDirty[i] {
+		s.isDirty[i] = true
+		s.dirty = append(s.dirty, i)
+	}
+}
+
+// state 生成当前搜索状态的快照：第一步带上树和全部节点，之后只带变化的节点
+func (s *gameSearch) state(current int) *gameTreeState {
+	path := make([]string, len(s.path))
+	for k, index := range s.path {
+		path[k] = s.tree.nodes[index].id
+	}
+	state := &gameTreeState{Changed: make([]gameNodeState, 0, len(s.dirty)), Current: current, Path: path}
+	if !s.started {
+		s.started = true
+		state.Tree = s.tree.data
+		state.Nodes = append([]gameNodeState{}, s.states...)
+	}
+	for _, i := range s.dirty {
+		state.Changed = append(state.Changed, s.states[i])
+		s.isDirty[i] = false
+	}
+	s.dirty = s.dirty[:0]
+	return state
+}
+
+// pvIndices 主变例上的节点编号
+func pvIndices(s *gameSearch) []int {
+	indices := []int{0}
+	for i := s.best[0]; i >= 0; i = s.best[i] {
+		indices = append(indices, i)
+	}
+	return indices
+}
+
+// buildGameTree 按先序遍历预处理博弈树
+// 叶子的 value 为得分；内部节点若带数值 value，则视为静态估值，仅用于走法排序
+func buildGameTree(data *models.TreeData) (*gameTree, error) {
+	if data == nil || data.Root == nil {
+		return nil, errors.New("博弈树为空")
+	}
+
+	g := &gameTree{data: data}
+	var visit func(node *models.TreeNode, depth int) (int, error)
+	visit = func(node *models.TreeNode, depth int) (int, error) {
+		if len(g.nodes) >= maxGameTreeNodes {
+			return 0, fmt.Errorf("博弈树节点数不能超过%d", maxGameTreeNodes)
+		}
+		index := len(g.nodes)
+		id := node.ID
+		if id == "" {
+			id = "node_" + strconv.Itoa(index)
+		}
+		g.nodes = append(g.nodes, gameNode{id: id, depth: depth, size: 1})
+
+		value, numeric := leafScore(node.Value)
+		children := childNodes(node)
+		if len(children) == 0 {
+			if !numeric {
+				return 0, fmt.Errorf("叶子节点 %s 缺少数值得分", id)
+			}
+			g.nodes[index].score = value
+			g.leaves++
+			return index, nil
+		}
+
+		g.nodes[index].estimate, g.nodes[index].hasEstimate = value, numeric
+		for _, child := range children {
+			c, err := visit(child, depth+1)
+			if err != nil {
+				return 0, err
+			}
+			g.nodes[index].children = append(g.nodes[index].children, c)
+			g.nodes[index].size += g.nodes[c].size
+		}
+		return index, nil
+	}
+
+	if _, err := visit(data.Root, 0); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// childNodes 节点的子节点，二叉树可以只填写 left/right
+func childNodes(node *models.TreeNode) []*models.TreeNode {
+	if len(node.Children) > 0 {
+		return node.Children
+	}
+	children := make([]*models.TreeNode, 0, 2)
+	for _, child := range []*models.TreeNode{node.Left, node.Right} {
+		if child != nil {
+			children = append(children, child)
+		}
+	}
+	return children
+}
+
+// leafScore 将节点值转换为得分，除数值外还接受 json.Number 和数字字符串
+func leafScore(value interface{}) (float64, bool) {
+	if f, ok := algorithms.ToFloat(value); ok {
+		return f, true
+	}
+	switch v := value.(type) {
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// toTreeData 将输入转换为树数据
+func toTreeData(data interface{}) (*models.TreeData, error) {
+	switch t := data.(type) {
+	case *models.TreeData:
+		return t, nil
+	case models.TreeData:
+		return &t, nil
+	case *models.TreeNode:
+		return &models.TreeData{Root: t, Type: "n-ary"}, nil
+	}
+	return nil, algorithms.ErrInvalidInput
+}
+
+// validateGameTree 验证博弈树输入
+func validateGameTree(data interface{}) error {
+	tree, err := toTreeData(data)
+	if err != nil {
+		return err
+	}
+	if _, err := buildGameTree(tree); err != nil {
+		return algorithms.ErrInvalidInput
+	}
+	return nil
+}
+
+// rootPlayerParameter 根节点玩家参数定义
+func rootPlayerParameter() models.Parameter {
+	return models.Parameter{
+		Name:         "root_player",
+		Type:         "string",
+		Description:  "根节点由哪一方行动，层与层之间 MAX/MIN 交替",
+		DefaultValue: "max",
+		Required:     false,
+		Options:      []string{"max", "min"},
+	}
+}
+
+// playerName 玩家名称
+func playerName(maximizing bool) string {
+	if maximizing {
+		return "max"
+	}
+	return "min"
+}
+
+// bound 窗口边界的JSON表示，JSON无法表示无穷大
+func bound(v float64) interface{} {
+	switch {
+	case math.IsInf(v, 1):
+		return "+inf"
+	case math.IsInf(v, -1):
+		return "-inf"
+	}
+	return v
+}
+
+// formatScore 格式化得分
+func formatScore(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+∞"
+	case math.IsInf(v, -1):
+		return "-∞"
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
@@ -0,0 +1,211 @@
+package tree
+
+import (
+	"encoding/json"
+	"gin/models"
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+// nestedTree 由嵌套数组构建博弈树，数值为叶子
+func nestedTree(value interface{}) *models.TreeData {
+	count := 0
+	var build func(v interface{}) *models.TreeNode
+	build = func(v interface{}) *models.TreeNode {
+		node := &models.TreeNode{ID: "n" + strconv.Itoa(count)}
+		count++
+		if children, ok := v.([]interface{}); ok {
+			for _, c := range children {
+				node.Children = append(node.Children, build(c))
+			}
+		} else {
+			node.Value = v
+		}
+		return node
+	}
+	return &models.TreeData{Root: build(value), Type: "n-ary"}
+}
+
+// randomGameTree 随机完全博弈树，内部节点带有以根为 MAX 方的极小化极大值加扰动作为估值
+func randomGameTree(rng *rand.Rand, branching, depth, noise int) *models.TreeData {
+	count := 0
+	var build func(level int) (*models.TreeNode, int)
+	build = func(level int) (*models.TreeNode, int) {
+		node := &models.TreeNode{ID: "n" + strconv.Itoa(count)}
+		count++
+		if level == depth {
+			score := rng.Intn(41) - 20
+			node.Value = float64(score)
+			return node, score
+		}
+		value := 0
+		for i := 0; i < branching; i++ {
+			child, v := build(level + 1)
+			node.Children = append(node.Children, child)
+			if i == 0 || (level%2 == 0 && v > value) || (level%2 == 1 && v < value) {
+				value = v
+			}
+		}
+		node.Value = float64(value + rng.Intn(2*noise+1) - noise)
+		return node, value
+	}
+	root, _ := build(0)
+	return &models.TreeData{Root: root, Type: "n-ary"}
+}
+
+func TestAlphaBeta_TextbookExample(t *testing.T) {
+	// MAX 根下三个 MIN 节点：B=min(3,12,8)=3，C 的第一个叶子 2 ≤ 3 后剪去其余两个叶子
+	data := nestedTree([]interface{}{
+		[]interface{}{3.0, 12.0, 8.0},
+		[]interface{}{2.0, 4.0, 6.0},
+		[]interface{}{14.0, 5.0, 2.0},
+	})
+
+	tracker := models.NewStepTracker()
+	result, err := NewAlphaBeta().Execute(data, tracker)
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	output := result.(map[string]interface{})
+	if output["value"] != 3.0 || output["bestMove"] != "n1" {
+		t.Errorf("value/bestMove = %v/%v, expected 3/n1", output["value"], output["bestMove"])
+	}
+	if output["leavesEvaluated"] != 7 || output["prunedNodes"] != 2 || output["cutoffs"] != 1 {
+		t.Errorf("leaves/pruned/cutoffs = %v/%v/%v, expected 7/2/1",
+			output["leavesEvaluated"], output["prunedNodes"], output["cutoffs"])
+	}
+	if pruned := output["prunedSubtrees"].([]string); len(pruned) != 2 || pruned[0] != "n7" || pruned[1] != "n8" {
+		t.Errorf("prunedSubtrees = %v, expected [n7 n8]", pruned)
+	}
+
+	// 剪枝步骤带有剪枝操作，所有步骤都能序列化（无穷大窗口以字符串表示）
+	pruneOps := 0
+	for _, step := range tracker.GetSteps() {
+		for _, op := range step.Operations {
+			if op.Type == models.OpTypePrune {
+				pruneOps++
+			}
+		}
+	}
+	if pruneOps != 1 {
+		t.Errorf("prune operations = %d, expected 1", pruneOps)
+	}
+	if _, err := json.Marshal(tracker.GetSteps()); err != nil {
+		t.Errorf("steps are not serializable: %v", err)
+	}
+
+	minimax, err := NewMinimax().Execute(data, models.NewStepTracker())
+	if err != nil {
+		t.Fatalf("Minimax Execute() error = %v", err)
+	}
+	if m := minimax.(map[string]interface{}); m["value"] != 3.0 || m["leavesEvaluated"] != 9 || m["prunedNodes"] != 0 {
+		t.Errorf("minimax value/leaves/pruned = %v/%v/%v", m["value"], m["leavesEvaluated"], m["prunedNodes"])
+	}
+}
+
+func TestAlphaBeta_MatchesMinimax(t *testing.T) {
+	rng := rand.New(rand.NewSource(37))
+	minimax, alphaBeta := NewMinimax(), NewAlphaBeta()
+	plainLeaves, orderedLeaves := 0, 0
+
+	for trial := 0; trial < 30; trial++ {
+		branching, depth := 2+rng.Intn(3), 2+rng.Intn(3)
+		data := randomGameTree(rng, branching, depth, 3)
+
+		for _, player := range []string{"max", "min"} {
+			expected, err := minimax.ExecuteWithParams(data, map[string]interface{}{"root_player": player}, models.NewStepTracker())
+			if err != nil {
+				t.Fatalf("minimax error = %v", err)
+			}
+			want := expected.(map[string]interface{})
+
+			for _, ordering := range []bool{false, true} {
+				params := map[string]interface{}{"root_player": player, "move_ordering": ordering}
+				result, err := alphaBeta.ExecuteWithParams(data, params, models.NewStepTracker())
+				if err != nil {
+					t.Fatalf("alpha-beta error = %v", err)
+				}
+				got := result.(map[string]interface{})
+				if got["value"] != want["value"] {
+					t.Fatalf("trial %d %s ordering=%v: value = %v, minimax = %v", trial, player, ordering, got["value"], want["value"])
+				}
+				// 每个节点要么被访问，要么位于某棵被剪去的子树中
+				if got["visitedNodes"].(int)+got["prunedNodes"].(int) != got["totalNodes"].(int) {
+					t.Errorf("visited %v + pruned %v != total %v", got["visitedNodes"], got["prunedNodes"], got["totalNodes"])
+				}
+				if player == "max" {
+					if ordering {
+						orderedLeaves += got["leavesEvaluated"].(int)
+					} else {
+						plainLeaves += got["leavesEvaluated"].(int)
+					}
+				}
+			}
+		}
+	}
+
+	// 估值与真实值接近时，走法排序应当减少评估的叶子数
+	if orderedLeaves >= plainLeaves {
+		t.Errorf("move ordering evaluated %d leaves, without ordering %d", orderedLeaves, plainLeaves)
+	}
+}
+
+func TestGameTree_InvalidInput(t *testing.T) {
+	tests := []struct {
+		name string
+		data interface{}
+	}{
+		{"Nil tree", &models.TreeData{}},
+		{"Leaf without score", nestedTree([]interface{}{1.0, "x"})},
+		{"Not a tree", []interface{}{1, 2, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := NewAlphaBeta().ValidateInput(tt.data); err == nil {
+				t.Error("expected validation error")
+			}
+			if _, err := NewMinimax().Execute(tt.data, models.NewStepTracker()); err == nil {
+				t.Error("expected execution error")
+			}
+		})
+	}
+}
+
+func TestAlphaBeta_StepsOnlyCarryChanges(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	data := randomGameTree(rng, 3, 6, 3)
+	tracker := models.NewStepTracker()
+	if _, err := NewAlphaBeta().Execute(data, tracker); err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	steps := tracker.GetSteps()
+	first := steps[0].Data.(*gameTreeState)
+	if first.Tree == nil || len(first.Nodes) == 0 {
+		t.Fatal("first step should carry the tree and all nodes")
+	}
+	// 依次应用每步的变化，最终每个节点都已搜索完毕或被剪枝
+	nodes := append([]gameNodeState{}, first.Nodes...)
+	changed := 0
+	for _, step := range steps[1:] {
+		state := step.Data.(*gameTreeState)
+		if state.Tree != nil || state.Nodes != nil {
+			t.Fatal("later steps should not repeat the tree")
+		}
+		for _, node := range state.Changed {
+			nodes[node.Index] = node
+		}
+		changed += len(state.Changed)
+	}
+	for _, node := range nodes {
+		if node.Status != statusDone && node.Status != statusPruned {
+			t.Errorf("node %s ends as %s", node.ID, node.Status)
+		}
+	}
+	// 每个节点只会被激活、更新若干次后结束，变化总数与节点数成线性关系
+	if limit := 2*len(steps) + len(nodes); changed > limit {
+		t.Errorf("%d node changes over %d steps", changed, len(steps))
+	}
+}
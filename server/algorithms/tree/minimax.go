@@ -0,0 +1,74 @@
+package tree
+
+import (
+	"gin/algorithms"
+	"gin/models"
+)
+
+// Minimax 极小化极大搜索
+type Minimax struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewMinimax 创建极小化极大搜索算法实例
+func NewMinimax() *Minimax {
+	return &Minimax{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "minimax",
+			Name:            "极小化极大搜索",
+			Category:        models.CategoryTree,
+			Description:     "在叶子带得分的博弈树上做深度优先搜索：MAX 层取子节点的最大值，MIN 层取最小值，自底向上得到根节点的值和最优走法。不做剪枝，会访问全部节点，可作为 α-β 剪枝的对照。",
+			TimeComplexity:  "O(b^d)",
+			SpaceComplexity: "O(d)",
+			Parameters: []models.Parameter{
+				rootPlayerParameter(),
+			},
+		},
+	}
+}
+
+// Execute 使用默认参数执行极小化极大搜索
+func (m *Minimax) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return m.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 按参数执行极小化极大搜索
+func (m *Minimax) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	tree, err := toTreeData(data)
+	if err != nil {
+		return nil, err
+	}
+	rootPlayer := algorithms.OptionParam(params, "root_player", []string{"max", "min"}, "max")
+	return searchGameTree(tree, rootPlayer, false, false, tracker)
+}
+
+// ProcessTree 处理博弈树
+func (m *Minimax) ProcessTree(tree *models.TreeData, tracker models.StepTracker) (interface{}, error) {
+	return searchGameTree(tree, "max", false, false, tracker)
+}
+
+// GetTreeType 获取支持的树类型
+func (m *Minimax) GetTreeType() string {
+	return "both"
+}
+
+// ValidateInput 验证输入数据
+func (m *Minimax) ValidateInput(data interface{}) error {
+	return validateGameTree(data)
+}
+
+// GetComplexity 获取复杂度信息
+func (m *Minimax) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(b^d)", // 总是访问全部节点
+			Average: "O(b^d)",
+			Worst:   "O(b^d)",
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(d)",
+			Average: "O(d)",
+			Worst:   "O(d)",
+		},
+	}
+}
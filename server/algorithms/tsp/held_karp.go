@@ -0,0 +1,194 @@
+package tsp
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"math"
+	"math/bits"
+	"strings"
+)
+
+// HeldKarp Held-Karp 动态规划求解旅行商问题
+type HeldKarp struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewHeldKarp 创建Held-Karp实例
+func NewHeldKarp() *HeldKarp {
+	return &HeldKarp{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "tsp_held_karp",
+			Name:            "Held-Karp旅行商算法",
+			Category:        models.CategoryGraph,
+			Description:     fmt.Sprintf("状态压缩动态规划求旅行商问题的精确解：dp[S][j] 表示从起点出发、恰好经过集合 S 中的节点并停在 j 的最短路径长度，按集合大小逐层转移 dp[S][j] = min dp[S−{j}][i] + d(i, j)，最后补上回到起点的边。状态数随节点数指数增长，最多支持 %d 个节点。", maxHeldKarpNodes),
+			TimeComplexity:  "O(n²·2ⁿ)",
+			SpaceComplexity: "O(n·2ⁿ)",
+		},
+	}
+}
+
+// Execute 执行Held-Karp
+func (hk *HeldKarp) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	if err := hk.ValidateInput(data); err != nil {
+		return nil, err
+	}
+	inst, err := newInstance(data)
+	if err != nil {
+		return nil, err
+	}
+
+	tracker.SetPhase("初始化")
+	tracker.AddStep(fmt.Sprintf("Held-Karp：%d 个节点，以 %s 为起点，共 %d 个状态", inst.size(), inst.names[0], stateCount(inst.size())),
+		inst.state([]int{0}, false, nil), []int{0})
+	tour, cost, states := heldKarp(inst, tracker)
+
+	tracker.SetPhase("完成")
+	tracker.AddStep(fmt.Sprintf("最优回路 %s，代价 %.4g", inst.describe(tour), cost), inst.state(tour, true, nil), tour)
+	return inst.result(tour, map[string]interface{}{"states": states}), nil
+}
+
+// ProcessGraph 处理完全图
+func (hk *HeldKarp) ProcessGraph(graph *models.GraphData, tracker models.StepTracker) (interface{}, error) {
+	return hk.Execute(graph, tracker)
+}
+
+// ProcessPoints 处理点集
+func (hk *HeldKarp) ProcessPoints(points *models.PointSetData, tracker models.StepTracker) (interface{}, error) {
+	return hk.Execute(points, tracker)
+}
+
+// GetGraphType 图类型
+func (hk *HeldKarp) GetGraphType() string { return "undirected_weighted" }
+
+// stateCount 以节点0为起点时的状态数 (n-1)·2^(n-2)
+func stateCount(n int) int {
+	if n < 2 {
+		return 0
+	}
+	return (n - 1) << (n - 2)
+}
+
+// heldKarp 求最优回路，tracker 为 nil 时不记录步骤（用于为启发式算法计算最优性差距）
+// 以节点0为起点，第 j-1 位表示节点 j 是否在集合中
+func heldKarp(inst *tspInstance, tracker models.StepTracker) ([]int, float64, int) {
+	n := inst.size()
+	if n == 1 {
+		return []int{0}, 0, 0
+	}
+	m := n - 1
+	full := 1<<m - 1
+	dp := make([][]float64, 1<<m)
+	parent := make([][]int8, 1<<m)
+	for mask := range dp {
+		dp[mask] = make([]float64, m)
+		parent[mask] = make([]int8, m)
+		for j := range dp[mask] {
+			dp[mask][j] = math.Inf(1)
+			parent[mask][j] = -1
+		}
+	}
+	for j := 0; j < m; j++ {
+		dp[1<<j][j] = inst.dist[0][j+1]
+	}
+
+	states := 0
+	for size := 1; size <= m; size++ {
+		layerStates := 0
+		bestMask, bestEnd := -1, -1
+		for mask := 1; mask <= full; mask++ {
+			if bits.OnesCount(uint(mask)) != size {
+				continue
+			}
+			for j := 0; j < m; j++ {
+				if mask&(1<<j) == 0 {
+					continue
+				}
+				if size > 1 {
+					prev := mask &^ (1 << j)
+					for i := 0; i < m; i++ {
+						if prev&(1<<i) == 0 {
+							continue
+						}
+						if c := dp[prev][i] + inst.dist[i+1][j+1]; c < dp[mask][j] {
+							dp[mask][j] = c
+							parent[mask][j] = int8(i)
+						}
+					}
+				}
+				layerStates++
+				if bestMask < 0 || dp[mask][j] < dp[bestMask][bestEnd] {
+					bestMask, bestEnd = mask, j
+				}
+			}
+		}
+		states += layerStates
+		if tracker != nil {
+			path := reconstruct(parent, bestMask, bestEnd)
+			tracker.SetPhase("动态规划")
+			tracker.AddStep(fmt.Sprintf("计算经过 %d 个节点的 %d 个状态，其中最短的部分路径为 %s，长度 %.4g",
+				size, layerStates, describePath(inst, path), dp[bestMask][bestEnd]), inst.state(path, false, nil), []int{path[len(path)-1]})
+			tracker.AddOperation(models.OpTypeUpdate, []int{}, []interface{}{size, layerStates}, "填充一层状态")
+		}
+	}
+
+	best, bestCost := 0, math.Inf(1)
+	for j := 0; j < m; j++ {
+		if c := dp[full][j] + inst.dist[j+1][0]; c < bestCost {
+			best, bestCost = j, c
+		}
+	}
+	return reconstruct(parent, full, best), bestCost, states
+}
+
+// reconstruct 沿 parent 回溯出从起点到 end 的路径（节点下标）
+func reconstruct(parent [][]int8, mask, end int) []int {
+	path := make([]int, 0, bits.OnesCount(uint(mask))+1)
+	for end >= 0 {
+		path = append(path, end+1)
+		prev := int(parent[mask][end])
+		mask &^= 1 << end
+		end = prev
+	}
+	path = append(path, 0)
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+// describePath 不闭合路径的文字描述
+func describePath(inst *tspInstance, path []int) string {
+	names := make([]string, len(path))
+	for k, i := range path {
+		names[k] = inst.names[i]
+	}
+	return strings.Join(names, " → ")
+}
+
+// ValidateInput 验证输入，节点数不能超过 Held-Karp 的上限
+func (hk *HeldKarp) ValidateInput(data interface{}) error {
+	if err := validateTSPInput(data); err != nil {
+		return err
+	}
+	if inst, _ := newInstance(data); inst.size() > maxHeldKarpNodes {
+		return fmt.Errorf("Held-Karp 最多支持 %d 个节点，当前 %d 个", maxHeldKarpNodes, inst.size())
+	}
+	return nil
+}
+
+// GetComplexity 获取复杂度信息
+func (hk *HeldKarp) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(n²·2ⁿ)",
+			Average: "O(n²·2ⁿ)",
+			Worst:   "O(n²·2ⁿ)",
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(n·2ⁿ)",
+			Average: "O(n·2ⁿ)",
+			Worst:   "O(n·2ⁿ)",
+		},
+	}
+}
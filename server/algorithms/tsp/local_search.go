@@ -0,0 +1,292 @@
+package tsp
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"math/rand"
+	"time"
+)
+
+// 局部搜索使用的邻域
+const (
+	Method2Opt  = "2opt"  // 2-opt：反转一段路径，替换两条边
+	MethodOrOpt = "oropt" // Or-opt：把长度1到3的一段移到别处（可反向）
+	MethodBoth  = "both"  // 先尝试 2-opt，没有改进时再尝试 Or-opt
+)
+
+// 初始回路的构造方式
+const (
+	InitialNearestNeighbor = "nearest_neighbor"
+	InitialInput           = "input"  // 按输入顺序
+	InitialRandom          = "random" // 随机排列
+)
+
+// improvementEpsilon 只接受代价下降超过该值的移动，避免浮点误差导致的死循环
+const improvementEpsilon = 1e-9
+
+// LocalSearch 2-opt / Or-opt 局部搜索
+type LocalSearch struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewLocalSearch 创建局部搜索实例
+func NewLocalSearch() *LocalSearch {
+	return &LocalSearch{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "tsp_local_search",
+			Name:            "2-opt/Or-opt局部搜索",
+			Category:        models.CategoryGraph,
+			Description:     "从初始回路出发反复执行能缩短回路的移动，直到达到局部最优。2-opt 删除两条边 (a,b)、(c,d) 并反转其间的路径，改为连接 (a,c)、(b,d)；Or-opt 把连续1到3个节点组成的一段取出，正向或反向插入到另一条边中间。采用首次改进策略，每次移动后从头扫描。",
+			TimeComplexity:  "O(n²·k)",
+			SpaceComplexity: "O(n)",
+			Parameters: []models.Parameter{
+				{
+					Name:         "method",
+					Type:         "string",
+					Description:  "邻域 (2opt: 只用2-opt, oropt: 只用Or-opt, both: 两者结合)",
+					DefaultValue: MethodBoth,
+					Required:     false,
+					Options:      []string{Method2Opt, MethodOrOpt, MethodBoth},
+				},
+				{
+					Name:         "initial",
+					Type:         "string",
+					Description:  "初始回路 (nearest_neighbor: 最近邻, input: 输入顺序, random: 随机排列)",
+					DefaultValue: InitialNearestNeighbor,
+					Required:     false,
+					Options:      []string{InitialNearestNeighbor, InitialInput, InitialRandom},
+				},
+				{
+					Name:         "max_moves",
+					Type:         "int",
+					Description:  "最多执行的改进移动次数",
+					DefaultValue: 1000,
+					Required:     false,
+					Min:          1,
+					Max:          10000,
+				},
+				{
+					Name:         "seed",
+					Type:         "int",
+					Description:  "随机初始回路的种子；为0时使用当前时间",
+					DefaultValue: 0,
+					Required:     false,
+				},
+			},
+		},
+	}
+}
+
+// localMove 一次改进移动
+type localMove struct {
+	kind  string
+	delta float64
+	tour  []int
+	edges []int // 被替换的边的端点，用于高亮
+	about string
+}
+
+// Execute 使用默认参数执行
+func (ls *LocalSearch) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return ls.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 按参数执行局部搜索
+func (ls *LocalSearch) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	if err := ls.ValidateInput(data); err != nil {
+		return nil, err
+	}
+	inst, err := newInstance(data)
+	if err != nil {
+		return nil, err
+	}
+	method := algorithms.OptionParam(params, "method", []string{Method2Opt, MethodOrOpt, MethodBoth}, MethodBoth)
+	initial := algorithms.OptionParam(params, "initial", []string{InitialNearestNeighbor, InitialInput, InitialRandom}, InitialNearestNeighbor)
+	maxMoves := algorithms.IntParam(params, "max_moves", 1000)
+	if maxMoves < 1 || maxMoves > 10000 {
+		return nil, fmt.Errorf("最大移动次数必须在1到10000之间")
+	}
+
+	n := inst.size()
+	tracker.SetPhase("初始回路")
+	var tour []int
+	output := map[string]interface{}{"method": method, "initial": initial}
+	switch initial {
+	case InitialInput:
+		tour = make([]int, n)
+		for i := range tour {
+			tour[i] = i
+		}
+	case InitialRandom:
+		seed := int64(algorithms.IntParam(params, "seed", 0))
+		if seed == 0 {
+			seed = time.Now().UnixNano()
+		}
+		tour = rand.New(rand.NewSource(seed)).Perm(n)
+		output["seed"] = seed
+	default:
+		tour = nearestNeighborTour(inst, 0, nil)
+	}
+	initialCost := inst.tourCost(tour)
+	tracker.AddStep(fmt.Sprintf("初始回路（%s）代价 %.4g", initial, initialCost), inst.state(tour, true, nil), []int{})
+
+	tracker.SetPhase("局部搜索")
+	moves := map[string]int{Method2Opt: 0, MethodOrOpt: 0}
+	costHistory := []float64{algorithms.Round4(initialCost)}
+	localOptimum := false
+	for total := 0; total < maxMoves; total++ {
+		var move *localMove
+		if method != MethodOrOpt {
+			move = twoOptMove(inst, tour)
+		}
+		if move == nil && method != Method2Opt {
+			move = orOptMove(inst, tour)
+		}
+		if move == nil {
+			localOptimum = true
+			break
+		}
+		tour = move.tour
+		moves[move.kind]++
+		cost := inst.tourCost(tour)
+		costHistory = append(costHistory, algorithms.Round4(cost))
+		tracker.AddStep(fmt.Sprintf("%s：%s，代价减少 %.4g，当前代价 %.4g", move.kind, move.about, -move.delta, cost),
+			inst.state(tour, true, move.edges), move.edges)
+		tracker.AddOperation(models.OpTypeSwap, move.edges, []interface{}{algorithms.Round4(move.delta)}, move.kind+" 移动")
+	}
+	if !localOptimum {
+		// 达到移动次数上限时再检查一次是否已是局部最优
+		localOptimum = (method == MethodOrOpt || twoOptMove(inst, tour) == nil) && (method == Method2Opt || orOptMove(inst, tour) == nil)
+	}
+
+	cost := inst.tourCost(tour)
+	tracker.SetPhase("完成")
+	status := "达到局部最优"
+	if !localOptimum {
+		status = fmt.Sprintf("达到移动次数上限 %d", maxMoves)
+	}
+	tracker.AddStep(fmt.Sprintf("局部搜索结束（%s）：回路 %s，代价从 %.4g 降到 %.4g", status, inst.describe(tour), initialCost, cost),
+		inst.state(tour, true, nil), []int{})
+
+	output["initialCost"] = algorithms.Round4(initialCost)
+	output["improvement"] = algorithms.Round4(initialCost - cost)
+	output["moves"] = moves
+	output["costHistory"] = costHistory
+	output["localOptimum"] = localOptimum
+	return inst.result(tour, output), nil
+}
+
+// twoOptMove 找到第一个能缩短回路的 2-opt 移动：删除边 (t[i],t[i+1]) 与 (t[j],t[j+1])，反转 t[i+1..j]
+func twoOptMove(inst *tspInstance, tour []int) *localMove {
+	n := len(tour)
+	if n < 4 {
+		return nil
+	}
+	for i := 0; i < n-2; i++ {
+		for j := i + 2; j < n; j++ {
+			if i == 0 && j == n-1 {
+				continue // 两条边相邻
+			}
+			a, b, c, d := tour[i], tour[i+1], tour[j], tour[(j+1)%n]
+			delta := inst.dist[a][c] + inst.dist[b][d] - inst.dist[a][b] - inst.dist[c][d]
+			if delta < -improvementEpsilon {
+				next := append([]int{}, tour...)
+				for l, r := i+1, j; l < r; l, r = l+1, r-1 {
+					next[l], next[r] = next[r], next[l]
+				}
+				return &localMove{kind: Method2Opt, delta: delta, tour: next, edges: []int{a, b, c, d},
+					about: fmt.Sprintf("删除边 %s-%s 与 %s-%s，反转其间的路径", inst.names[a], inst.names[b], inst.names[c], inst.names[d])}
+			}
+		}
+	}
+	return nil
+}
+
+// orOptMove 找到第一个能缩短回路的 Or-opt 移动：把 t[i..i+l-1] 移到边 (t[p],t[p+1]) 之间，可反向插入
+func orOptMove(inst *tspInstance, tour []int) *localMove {
+	n := len(tour)
+	if n < 4 {
+		return nil
+	}
+	for length := 1; length <= 3 && length <= n-3; length++ {
+		for i := 0; i < n; i++ {
+			// 段为 tour[i], ..., tour[i+length-1]（按环取下标）
+			first, last := tour[i], tour[(i+length-1)%n]
+			prev, next := tour[(i-1+n)%n], tour[(i+length)%n]
+			removeGain := inst.dist[prev][first] + inst.dist[last][next] - inst.dist[prev][next]
+			// 其余节点按环上的顺序排列，从 next 开始到 prev 结束；(prev, next) 是段原来的位置，不在候选边中
+			rest := make([]int, 0, n-length)
+			for k := 0; k < n-length; k++ {
+				rest = append(rest, tour[(i+length+k)%n])
+			}
+			for p := 0; p < len(rest)-1; p++ {
+				u, v := rest[p], rest[p+1]
+				for _, reversed := range []bool{false, true} {
+					head, tail := first, last
+					if reversed {
+						head, tail = last, first
+					}
+					delta := inst.dist[u][head] + inst.dist[tail][v] - inst.dist[u][v] - removeGain
+					if delta >= -improvementEpsilon {
+						continue
+					}
+					segment := make([]int, length)
+					for k := range segment {
+						segment[k] = tour[(i+k)%n]
+					}
+					if reversed {
+						for l, r := 0, length-1; l < r; l, r = l+1, r-1 {
+							segment[l], segment[r] = segment[r], segment[l]
+						}
+					}
+					result := make([]int, 0, n)
+					result = append(result, rest[:p+1]...)
+					result = append(result, segment...)
+					result = append(result, rest[p+1:]...)
+					direction := ""
+					if reversed {
+						direction = "反向"
+					}
+					return &localMove{kind: MethodOrOpt, delta: delta, tour: result, edges: []int{first, last, u, v},
+						about: fmt.Sprintf("把 %d 个节点 %s..%s %s插入到 %s 与 %s 之间", length, inst.names[first], inst.names[last], direction, inst.names[u], inst.names[v])}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// ProcessGraph 处理完全图
+func (ls *LocalSearch) ProcessGraph(graph *models.GraphData, tracker models.StepTracker) (interface{}, error) {
+	return ls.Execute(graph, tracker)
+}
+
+// ProcessPoints 处理点集
+func (ls *LocalSearch) ProcessPoints(points *models.PointSetData, tracker models.StepTracker) (interface{}, error) {
+	return ls.Execute(points, tracker)
+}
+
+// GetGraphType 图类型
+func (ls *LocalSearch) GetGraphType() string { return "undirected_weighted" }
+
+// ValidateInput 验证输入
+func (ls *LocalSearch) ValidateInput(data interface{}) error {
+	return validateTSPInput(data)
+}
+
+// GetComplexity 获取复杂度信息
+func (ls *LocalSearch) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(n²)", // 初始回路已是局部最优，扫描一遍邻域
+			Average: "O(n²·k)",
+			Worst:   "O(n²·k)", // k 为改进移动次数，最坏情况下可达指数级
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(n)",
+			Average: "O(n)",
+			Worst:   "O(n)",
+		},
+	}
+}
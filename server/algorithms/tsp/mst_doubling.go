@@ -0,0 +1,171 @@
+package tsp
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"math"
+)
+
+// MSTDoubling 最小生成树加倍启发式
+type MSTDoubling struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewMSTDoubling 创建最小生成树加倍启发式实例
+func NewMSTDoubling() *MSTDoubling {
+	return &MSTDoubling{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "tsp_mst_doubling",
+			Name:            "最小生成树加倍旅行商启发式",
+			Category:        models.CategoryGraph,
+			Description:     "用Prim算法求最小生成树，把每条树边加倍得到欧拉回路，再按先序遍历跳过已访问的节点（抄近路）得到哈密顿回路。距离满足三角不等式时回路长度不超过最小生成树的两倍，因而不超过最优解的两倍；Christofides 算法把加倍改为最小权完美匹配，可将比值降到1.5。",
+			TimeComplexity:  "O(n²)",
+			SpaceComplexity: "O(n)",
+			Parameters:      []models.Parameter{startParameter()},
+		},
+	}
+}
+
+// Execute 使用默认参数执行
+func (md *MSTDoubling) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return md.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 按参数执行最小生成树加倍启发式
+func (md *MSTDoubling) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	if err := md.ValidateInput(data); err != nil {
+		return nil, err
+	}
+	inst, err := newInstance(data)
+	if err != nil {
+		return nil, err
+	}
+	root, err := startNode(inst, params)
+	if err != nil {
+		return nil, err
+	}
+	n := inst.size()
+
+	// 稠密图上的 O(n²) Prim
+	tracker.SetPhase("最小生成树")
+	tracker.AddStep(fmt.Sprintf("从 %s 开始用Prim算法构建最小生成树", inst.names[root]), inst.state(nil, false, []int{root}), []int{root})
+	inTree := make([]bool, n)
+	key := make([]float64, n)
+	parent := make([]int, n)
+	for v := range key {
+		key[v], parent[v] = math.Inf(1), -1
+	}
+	key[root] = 0
+	children := make([][]int, n)
+	treeEdges := make([][2]int, 0, n-1)
+	mstCost := 0.0
+	for added := 0; added < n; added++ {
+		u := -1
+		for v := 0; v < n; v++ {
+			if !inTree[v] && (u < 0 || key[v] < key[u]) {
+				u = v
+			}
+		}
+		inTree[u] = true
+		if parent[u] >= 0 {
+			children[parent[u]] = append(children[parent[u]], u)
+			treeEdges = append(treeEdges, [2]int{parent[u], u})
+			mstCost += key[u]
+			state := inst.state(nil, false, []int{parent[u], u})
+			state.Tree = append([][2]int{}, treeEdges...)
+			tracker.AddStep(fmt.Sprintf("加入树边 %s-%s，权重 %.4g，树的总权重 %.4g", inst.names[parent[u]], inst.names[u], key[u], mstCost),
+				state, []int{parent[u], u})
+			tracker.AddOperation(models.OpTypeInsert, []int{parent[u], u}, []interface{}{algorithms.Round4(key[u])}, "加入最小生成树")
+		}
+		for v := 0; v < n; v++ {
+			if !inTree[v] && inst.dist[u][v] < key[v] {
+				key[v], parent[v] = inst.dist[u][v], u
+			}
+		}
+	}
+
+	// 先序遍历等价于沿加倍后的欧拉回路行走并跳过已访问的节点
+	tracker.SetPhase("先序遍历抄近路")
+	tour := make([]int, 0, n)
+	stack := []int{root}
+	for len(stack) > 0 {
+		u := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		tour = append(tour, u)
+		if len(tour) > 1 {
+			prev := tour[len(tour)-2]
+			description := fmt.Sprintf("先序访问 %s", inst.names[u])
+			if parent[u] != prev {
+				description += fmt.Sprintf("：欧拉回路经过已访问的节点回到 %s，抄近路直接从 %s 走到 %s",
+					inst.names[parent[u]], inst.names[prev], inst.names[u])
+			}
+			state := inst.state(tour, false, []int{prev, u})
+			state.Tree = treeEdges
+			tracker.AddStep(description, state, []int{u})
+		}
+		for c := len(children[u]) - 1; c >= 0; c-- {
+			stack = append(stack, children[u][c])
+		}
+	}
+
+	cost := inst.tourCost(tour)
+	tracker.SetPhase("完成")
+	final := inst.state(tour, true, nil)
+	final.Tree = treeEdges
+	tracker.AddStep(fmt.Sprintf("回到起点，回路 %s，代价 %.4g，为最小生成树权重的 %.2f 倍", inst.describe(tour), cost, ratio(cost, mstCost)),
+		final, tour)
+
+	tree := make([]map[string]interface{}, len(treeEdges))
+	for k, e := range treeEdges {
+		tree[k] = map[string]interface{}{"from": inst.ids[e[0]], "to": inst.ids[e[1]], "weight": algorithms.Round4(inst.dist[e[0]][e[1]])}
+	}
+	return inst.result(tour, map[string]interface{}{
+		"start":    inst.ids[root],
+		"mstCost":  algorithms.Round4(mstCost),
+		"mstEdges": tree,
+		"mstRatio": algorithms.Round4(ratio(cost, mstCost)),
+	}), nil
+}
+
+// ratio a/b，b 为0时返回0
+func ratio(a, b float64) float64 {
+	if b == 0 {
+		return 0
+	}
+	return a / b
+}
+
+// ProcessGraph 处理完全图
+func (md *MSTDoubling) ProcessGraph(graph *models.GraphData, tracker models.StepTracker) (interface{}, error) {
+	return md.Execute(graph, tracker)
+}
+
+// ProcessPoints 处理点集
+func (md *MSTDoubling) ProcessPoints(points *models.PointSetData, tracker models.StepTracker) (interface{}, error) {
+	return md.Execute(points, tracker)
+}
+
+// GetGraphType 图类型
+func (md *MSTDoubling) GetGraphType() string { return "undirected_weighted" }
+
+// ValidateInput 验证输入
+func (md *MSTDoubling) ValidateInput(data interface{}) error {
+	return validateTSPInput(data)
+}
+
+// GetComplexity 获取复杂度信息
+func (md *MSTDoubling) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(n²)",
+			Average: "O(n²)",
+			Worst:   "O(n²)",
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(n)",
+			Average: "O(n)",
+			Worst:   "O(n)",
+		},
+	}
+}
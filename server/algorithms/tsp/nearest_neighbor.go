@@ -0,0 +1,140 @@
+package tsp
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"math"
+)
+
+// NearestNeighbor 最近邻启发式
+type NearestNeighbor struct {
+	algorithms.BaseAlgorithm
+}
+
+// NewNearestNeighbor 创建最近邻启发式实例
+func NewNearestNeighbor() *NearestNeighbor {
+	return &NearestNeighbor{
+		BaseAlgorithm: algorithms.BaseAlgorithm{
+			ID:              "tsp_nearest_neighbor",
+			Name:            "最近邻旅行商启发式",
+			Category:        models.CategoryGraph,
+			Description:     "从起点出发，每次走向距离当前节点最近的未访问节点，全部访问后回到起点。实现简单，但后期常被迫走很长的边，欧氏实例上通常比最优解长约25%。",
+			TimeComplexity:  "O(n²)",
+			SpaceComplexity: "O(n)",
+			Parameters:      []models.Parameter{startParameter()},
+		},
+	}
+}
+
+// startParameter 起点参数定义
+func startParameter() models.Parameter {
+	return models.Parameter{
+		Name:         "start",
+		Type:         "string",
+		Description:  "起点的节点ID、标签或下标，默认为第一个节点",
+		DefaultValue: "",
+		Required:     false,
+	}
+}
+
+// Execute 使用默认参数执行
+func (nn *NearestNeighbor) Execute(data interface{}, tracker models.StepTracker) (interface{}, error) {
+	return nn.ExecuteWithParams(data, nil, tracker)
+}
+
+// ExecuteWithParams 按参数执行最近邻启发式
+func (nn *NearestNeighbor) ExecuteWithParams(data interface{}, params map[string]interface{}, tracker models.StepTracker) (interface{}, error) {
+	if err := nn.ValidateInput(data); err != nil {
+		return nil, err
+	}
+	inst, err := newInstance(data)
+	if err != nil {
+		return nil, err
+	}
+	start, err := startNode(inst, params)
+	if err != nil {
+		return nil, err
+	}
+
+	tracker.SetPhase("构造回路")
+	tour := nearestNeighborTour(inst, start, tracker)
+	tracker.SetPhase("完成")
+	tracker.AddStep(fmt.Sprintf("回到起点 %s，回路 %s，代价 %.4g", inst.names[start], inst.describe(tour), inst.tourCost(tour)),
+		inst.state(tour, true, nil), []int{tour[len(tour)-1], start})
+	return inst.result(tour, map[string]interface{}{"start": inst.ids[start]}), nil
+}
+
+// startNode 读取起点参数
+func startNode(inst *tspInstance, params map[string]interface{}) (int, error) {
+	name := algorithms.StringParam(params, "start", "")
+	if name == "" {
+		if i := algorithms.IntParam(params, "start", -1); i >= 0 && i < inst.size() {
+			return i, nil
+		}
+		return 0, nil
+	}
+	return inst.find(name)
+}
+
+// nearestNeighborTour 从 start 出发构造最近邻回路，tracker 为 nil 时不记录步骤
+func nearestNeighborTour(inst *tspInstance, start int, tracker models.StepTracker) []int {
+	n := inst.size()
+	visited := make([]bool, n)
+	visited[start] = true
+	tour := []int{start}
+	if tracker != nil {
+		tracker.AddStep(fmt.Sprintf("从 %s 出发", inst.names[start]), inst.state(tour, false, nil), []int{start})
+	}
+	for len(tour) < n {
+		current := tour[len(tour)-1]
+		next, best := -1, math.Inf(1)
+		for v := 0; v < n; v++ {
+			if !visited[v] && inst.dist[current][v] < best {
+				next, best = v, inst.dist[current][v]
+			}
+		}
+		visited[next] = true
+		tour = append(tour, next)
+		if tracker != nil {
+			tracker.AddStep(fmt.Sprintf("距离 %s 最近的未访问节点是 %s，距离 %.4g", inst.names[current], inst.names[next], best),
+				inst.state(tour, false, []int{current, next}), []int{next})
+			tracker.AddOperation(models.OpTypeInsert, []int{next}, []interface{}{algorithms.Round4(best)}, "加入回路")
+		}
+	}
+	return tour
+}
+
+// ProcessGraph 处理完全图
+func (nn *NearestNeighbor) ProcessGraph(graph *models.GraphData, tracker models.StepTracker) (interface{}, error) {
+	return nn.Execute(graph, tracker)
+}
+
+// ProcessPoints 处理点集
+func (nn *NearestNeighbor) ProcessPoints(points *models.PointSetData, tracker models.StepTracker) (interface{}, error) {
+	return nn.Execute(points, tracker)
+}
+
+// GetGraphType 图类型
+func (nn *NearestNeighbor) GetGraphType() string { return "undirected_weighted" }
+
+// ValidateInput 验证输入
+func (nn *NearestNeighbor) ValidateInput(data interface{}) error {
+	return validateTSPInput(data)
+}
+
+// GetComplexity 获取复杂度信息
+func (nn *NearestNeighbor) GetComplexity() algorithms.ComplexityInfo {
+	return algorithms.ComplexityInfo{
+		TimeComplexity: algorithms.ComplexityCase{
+			Best:    "O(n²)",
+			Average: "O(n²)",
+			Worst:   "O(n²)",
+		},
+		SpaceComplexity: algorithms.ComplexityCase{
+			Best:    "O(n)",
+			Average: "O(n)",
+			Worst:   "O(n)",
+		},
+	}
+}
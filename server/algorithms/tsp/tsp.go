@@ -0,0 +1,262 @@
+package tsp
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// 节点数上限
+const (
+	maxTSPNodes      = 200 // 启发式算法的节点数上限
+	maxHeldKarpNodes = 15  // Held-Karp 精确算法的节点数上限，状态数为 2^(n-1)·(n-1)
+)
+
+// 输入来源
+const (
+	sourceGraph  = "graph"  // 完全图，距离为边权
+	sourcePoints = "points" // 平面点集，距离为欧氏距离
+)
+
+// tspInstance 旅行商问题实例
+type tspInstance struct {
+	source string
+	graph  *models.GraphData
+	points []models.Point2D
+	ids    []string
+	names  []string
+	dist   [][]float64
+}
+
+// tourState 旅行商问题的步骤快照
+type tourState struct {
+	Graph     *models.GraphData `json:"graph,omitempty"`     // 图输入
+	Points    []models.Point2D  `json:"points,omitempty"`    // 点集输入
+	Tour      []int             `json:"tour"`                // 当前（部分）回路上的节点下标，首尾相连
+	Cost      float64           `json:"cost"`                // 当前回路的代价；部分回路不含回到起点的边
+	Closed    bool              `json:"closed"`              // 是否为完整的闭合回路
+	Candidate []int             `json:"candidate,omitempty"` // 正在考虑的节点或边
+	Tree      [][2]int          `json:"tree,omitempty"`      // 最小生成树的边
+}
+
+// tourStop 结果中回路上的一个节点
+type tourStop struct {
+	Index int    `json:"index"`
+	ID    string `json:"id"`
+	Label string `json:"label"`
+}
+
+// newInstance 从完全图或点集构建实例
+func newInstance(data interface{}) (*tspInstance, error) {
+	switch d := data.(type) {
+	case *models.GraphData:
+		return graphInstance(d)
+	case models.GraphData:
+		return graphInstance(&d)
+	case *models.PointSetData:
+		return pointInstance(d.Points)
+	case models.PointSetData:
+		return pointInstance(d.Points)
+	default:
+		return nil, algorithms.ErrInvalidInput
+	}
+}
+
+// graphInstance 图输入：边视为无向边，同一对节点之间有多条边时取最小权重，要求是完全图
+func graphInstance(g *models.GraphData) (*tspInstance, error) {
+	n := len(g.Nodes)
+	if n == 0 || n > maxTSPNodes {
+		return nil, algorithms.ErrInvalidInput
+	}
+	inst := &tspInstance{source: sourceGraph, graph: g, ids: make([]string, n), names: make([]string, n), dist: newMatrix(n, math.Inf(1))}
+	index := make(map[string]int, n)
+	for i, node := range g.Nodes {
+		index[node.ID] = i
+		inst.ids[i] = node.ID
+		inst.names[i] = node.Label
+		if inst.names[i] == "" {
+			inst.names[i] = node.ID
+		}
+		inst.dist[i][i] = 0
+	}
+	for _, e := range g.Edges {
+		u, ok1 := index[e.From]
+		v, ok2 := index[e.To]
+		if !ok1 || !ok2 {
+			return nil, fmt.Errorf("边 %s-%s 的端点不存在", e.From, e.To)
+		}
+		if u == v {
+			continue
+		}
+		w := algorithms.EdgeWeight(e)
+		if w < 0 || math.IsNaN(w) || math.IsInf(w, 0) {
+			return nil, fmt.Errorf("边 %s-%s 的权重必须为非负数", e.From, e.To)
+		}
+		if w < inst.dist[u][v] {
+			inst.dist[u][v], inst.dist[v][u] = w, w
+		}
+	}
+	for u := 0; u < n; u++ {
+		for v := u + 1; v < n; v++ {
+			if math.IsInf(inst.dist[u][v], 1) {
+				return nil, fmt.Errorf("旅行商问题需要完全图：节点 %s 与 %s 之间没有边", inst.names[u], inst.names[v])
+			}
+		}
+	}
+	return inst, nil
+}
+
+// pointInstance 点集输入：任意两点之间的距离为欧氏距离
+func pointInstance(points []models.Point2D) (*tspInstance, error) {
+	n := len(points)
+	if n == 0 || n > maxTSPNodes {
+		return nil, algorithms.ErrInvalidInput
+	}
+	if err := algorithms.ValidateCoordinates(points); err != nil {
+		return nil, err
+	}
+	inst := &tspInstance{source: sourcePoints, points: points, ids: make([]string, n), names: make([]string, n), dist: newMatrix(n, 0)}
+	for i, p := range points {
+		inst.ids[i] = p.ID
+		if inst.ids[i] == "" {
+			inst.ids[i] = "p_" + strconv.Itoa(i)
+		}
+		inst.names[i] = p.Label
+		if inst.names[i] == "" {
+			inst.names[i] = inst.ids[i]
+		}
+	}
+	for u := 0; u < n; u++ {
+		for v := u + 1; v < n; v++ {
+			d := math.Hypot(points[u].X-points[v].X, points[u].Y-points[v].Y)
+			inst.dist[u][v], inst.dist[v][u] = d, d
+		}
+	}
+	return inst, nil
+}
+
+// validateTSPInput 旅行商问题的输入验证
+func validateTSPInput(data interface{}) error {
+	if data == nil {
+		return algorithms.ErrInvalidInput
+	}
+	_, err := newInstance(data)
+	return err
+}
+
+// newMatrix n×n 矩阵，元素初始化为 value
+func newMatrix(n int, value float64) [][]float64 {
+	m := make([][]float64, n)
+	for i := range m {
+		m[i] = make([]float64, n)
+		for j := range m[i] {
+			m[i][j] = value
+		}
+	}
+	return m
+}
+
+// size 节点数
+func (inst *tspInstance) size() int {
+	return len(inst.ids)
+}
+
+// tourCost 闭合回路的代价
+func (inst *tspInstance) tourCost(tour []int) float64 {
+	if len(tour) < 2 {
+		return 0
+	}
+	cost := 0.0
+	for i := range tour {
+		cost += inst.dist[tour[i]][tour[(i+1)%len(tour)]]
+	}
+	return cost
+}
+
+// pathCost 不闭合的路径代价
+func (inst *tspInstance) pathCost(path []int) float64 {
+	cost := 0.0
+	for i := 1; i < len(path); i++ {
+		cost += inst.dist[path[i-1]][path[i]]
+	}
+	return cost
+}
+
+// state 步骤快照
+func (inst *tspInstance) state(tour []int, closed bool, candidate []int) tourState {
+	cost := inst.pathCost(tour)
+	if closed {
+		cost = inst.tourCost(tour)
+	}
+	return tourState{
+		Graph:     inst.graph,
+		Points:    inst.points,
+		Tour:      append([]int{}, tour...),
+		Cost:      algorithms.Round4(cost),
+		Closed:    closed,
+		Candidate: candidate,
+	}
+}
+
+// describe 回路的文字描述，例如 A → B → C → A
+func (inst *tspInstance) describe(tour []int) string {
+	if len(tour) == 0 {
+		return ""
+	}
+	names := make([]string, 0, len(tour)+1)
+	for _, i := range tour {
+		names = append(names, inst.names[i])
+	}
+	names = append(names, inst.names[tour[0]])
+	return strings.Join(names, " → ")
+}
+
+// find 按节点ID、标签或下标查找节点
+func (inst *tspInstance) find(name string) (int, error) {
+	for i := range inst.ids {
+		if inst.ids[i] == name || inst.names[i] == name {
+			return i, nil
+		}
+	}
+	if i, err := strconv.Atoi(name); err == nil && i >= 0 && i < inst.size() {
+		return i, nil
+	}
+	return -1, fmt.Errorf("起点 %s 不存在", name)
+}
+
+// result 构造输出：回路、代价，以及节点数不超过 Held-Karp 上限时的最优代价与最优性差距
+func (inst *tspInstance) result(tour []int, extra map[string]interface{}) map[string]interface{} {
+	stops := make([]tourStop, len(tour))
+	for k, i := range tour {
+		stops[k] = tourStop{Index: i, ID: inst.ids[i], Label: inst.names[i]}
+	}
+	cost := inst.tourCost(tour)
+	output := map[string]interface{}{
+		"tour":      stops,
+		"cost":      algorithms.Round4(cost),
+		"nodeCount": inst.size(),
+		"source":    inst.source,
+	}
+	if inst.size() <= maxHeldKarpNodes {
+		_, optimal, _ := heldKarp(inst, nil)
+		output["optimalCost"] = algorithms.Round4(optimal)
+		if gap, ok := optimalityGap(cost, optimal); ok {
+			output["optimalityGap"] = gap
+		}
+	}
+	for k, v := range extra {
+		output[k] = v
+	}
+	return output
+}
+
+// optimalityGap 相对最优解的差距 (cost - optimal) / optimal；最优代价为0而回路代价不为0时无法定义
+func optimalityGap(cost, optimal float64) (float64, bool) {
+	if optimal == 0 {
+		return 0, cost == 0
+	}
+	return algorithms.Round4((cost - optimal) / optimal), true
+}
@@ -0,0 +1,250 @@
+package tsp
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+// randomPoints 坐标范围 [0, 100) 的随机点集
+func randomPoints(rng *rand.Rand, n int) *models.PointSetData {
+	points := make([]models.Point2D, n)
+	for i := range points {
+		id := fmt.Sprintf("p%d", i)
+		points[i] = models.Point2D{ID: id, Label: id, X: rng.Float64() * 100, Y: rng.Float64() * 100}
+	}
+	return &models.PointSetData{Points: points}
+}
+
+// completeGraph 与 generateGraphData 的 complete 模式相同：无向完全图，权重为1到10的整数
+func completeGraph(rng *rand.Rand, n int) *models.GraphData {
+	g := &models.GraphData{Type: "undirected"}
+	for i := 0; i < n; i++ {
+		g.Nodes = append(g.Nodes, models.GraphNode{ID: fmt.Sprintf("node_%d", i), Label: fmt.Sprintf("节点%d", i)})
+		for j := 0; j < i; j++ {
+			g.Edges = append(g.Edges, models.GraphEdge{From: g.Nodes[j].ID, To: g.Nodes[i].ID, Weight: rng.Intn(10) + 1})
+		}
+	}
+	return g
+}
+
+// bruteForce 枚举所有以节点0开头的排列求最优回路代价
+func bruteForce(inst *tspInstance) float64 {
+	n := inst.size()
+	rest := make([]int, n-1)
+	for i := range rest {
+		rest[i] = i + 1
+	}
+	best := math.Inf(1)
+	var permute func(k int)
+	permute = func(k int) {
+		if k == len(rest) {
+			best = math.Min(best, inst.tourCost(append([]int{0}, rest...)))
+			return
+		}
+		for i := k; i < len(rest); i++ {
+			rest[k], rest[i] = rest[i], rest[k]
+			permute(k + 1)
+			rest[k], rest[i] = rest[i], rest[k]
+		}
+	}
+	permute(0)
+	return best
+}
+
+// tourIndices 结果中回路的节点下标，并检查它是 0..n-1 的排列
+func tourIndices(t *testing.T, output map[string]interface{}, n int) []int {
+	t.Helper()
+	stops := output["tour"].([]tourStop)
+	indices := make([]int, len(stops))
+	for k, s := range stops {
+		indices[k] = s.Index
+	}
+	sorted := append([]int{}, indices...)
+	sort.Ints(sorted)
+	for i, v := range sorted {
+		if v != i || len(sorted) != n {
+			t.Fatalf("回路 %v 不是 %d 个节点的排列", indices, n)
+		}
+	}
+	return indices
+}
+
+func TestHeldKarp_MatchesBruteForce(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 20; trial++ {
+		n := 1 + rng.Intn(8)
+		var data interface{} = randomPoints(rng, n)
+		if trial%2 == 1 {
+			data = completeGraph(rng, n)
+		}
+		inst, err := newInstance(data)
+		if err != nil {
+			t.Fatalf("newInstance() error = %v", err)
+		}
+		tracker := models.NewStepTracker()
+		result, err := NewHeldKarp().Execute(data, tracker)
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		output := result.(map[string]interface{})
+		tour := tourIndices(t, output, n)
+		expected := 0.0
+		if n > 1 {
+			expected = bruteForce(inst)
+		}
+		if math.Abs(output["cost"].(float64)-algorithms.Round4(expected)) > 1e-3 || math.Abs(inst.tourCost(tour)-expected) > 1e-6 {
+			t.Errorf("n=%d: cost = %v, expected %v", n, output["cost"], expected)
+		}
+		if output["optimalityGap"] != 0.0 || output["states"] != stateCount(n) {
+			t.Errorf("n=%d: gap = %v, states = %v", n, output["optimalityGap"], output["states"])
+		}
+		// 初始化、每层一步、完成
+		if steps := len(tracker.GetSteps()); n > 1 && steps != n+1 {
+			t.Errorf("n=%d: steps = %d", n, steps)
+		}
+	}
+}
+
+func TestHeuristics_Gap(t *testing.T) {
+	heuristics := map[string]interface {
+		ExecuteWithParams(interface{}, map[string]interface{}, models.StepTracker) (interface{}, error)
+	}{
+		"nearest_neighbor": NewNearestNeighbor(),
+		"mst_doubling":     NewMSTDoubling(),
+		"local_search":     NewLocalSearch(),
+	}
+	rng := rand.New(rand.NewSource(2))
+	for name, algorithm := range heuristics {
+		t.Run(name, func(t *testing.T) {
+			for trial := 0; trial < 10; trial++ {
+				n := 5 + rng.Intn(8)
+				var data interface{} = randomPoints(rng, n)
+				if trial%2 == 1 {
+					data = completeGraph(rng, n)
+				}
+				result, err := algorithm.ExecuteWithParams(data, nil, models.NewStepTracker())
+				if err != nil {
+					t.Fatalf("ExecuteWithParams() error = %v", err)
+				}
+				output := result.(map[string]interface{})
+				tourIndices(t, output, n)
+				gap := output["optimalityGap"].(float64)
+				if gap < 0 || output["cost"].(float64) < output["optimalCost"].(float64)-1e-3 {
+					t.Errorf("cost = %v, optimal = %v, gap = %v", output["cost"], output["optimalCost"], gap)
+				}
+				if name == "mst_doubling" && trial%2 == 0 {
+					// 欧氏实例满足三角不等式：MST ≤ 最优 ≤ 回路 ≤ 2·MST
+					mst := output["mstCost"].(float64)
+					if mst > output["optimalCost"].(float64)+1e-3 || output["mstRatio"].(float64) > 2 {
+						t.Errorf("mstCost = %v, optimal = %v, ratio = %v", mst, output["optimalCost"], output["mstRatio"])
+					}
+				}
+			}
+		})
+	}
+
+	// 超过 Held-Karp 上限时不输出最优性差距
+	result, err := NewNearestNeighbor().Execute(randomPoints(rng, maxHeldKarpNodes+1), models.NewStepTracker())
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if _, ok := result.(map[string]interface{})["optimalityGap"]; ok {
+		t.Error("节点数超过上限时不应计算最优性差距")
+	}
+}
+
+func TestNearestNeighbor_Start(t *testing.T) {
+	// 一条直线上的点，从最左端出发依次向右
+	points := &models.PointSetData{Points: []models.Point2D{
+		{Label: "C", X: 2}, {Label: "A", X: 0}, {Label: "D", X: 7}, {Label: "B", X: 1},
+	}}
+	result, err := NewNearestNeighbor().ExecuteWithParams(points, map[string]interface{}{"start": "A"}, models.NewStepTracker())
+	if err != nil {
+		t.Fatalf("ExecuteWithParams() error = %v", err)
+	}
+	output := result.(map[string]interface{})
+	if tour := tourIndices(t, output, 4); fmt.Sprint(tour) != "[1 3 0 2]" || output["cost"] != 14.0 {
+		t.Errorf("tour = %v, cost = %v", tour, output["cost"])
+	}
+	if _, err := NewNearestNeighbor().ExecuteWithParams(points, map[string]interface{}{"start": "Z"}, models.NewStepTracker()); err == nil {
+		t.Error("不存在的起点应返回错误")
+	}
+}
+
+func TestLocalSearch_ReachesLocalOptimum(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	points := randomPoints(rng, 60)
+	inst, _ := newInstance(points)
+	for _, method := range []string{Method2Opt, MethodOrOpt, MethodBoth} {
+		t.Run(method, func(t *testing.T) {
+			tracker := models.NewStepTracker()
+			result, err := NewLocalSearch().ExecuteWithParams(points, map[string]interface{}{"method": method, "initial": InitialRandom, "seed": 9, "max_moves": 10000}, tracker)
+			if err != nil {
+				t.Fatalf("ExecuteWithParams() error = %v", err)
+			}
+			output := result.(map[string]interface{})
+			tour := tourIndices(t, output, 60)
+			if !output["localOptimum"].(bool) {
+				t.Fatal("未达到局部最优")
+			}
+			if method != MethodOrOpt && twoOptMove(inst, tour) != nil {
+				t.Error("结果仍存在改进的 2-opt 移动")
+			}
+			if method != Method2Opt && orOptMove(inst, tour) != nil {
+				t.Error("结果仍存在改进的 Or-opt 移动")
+			}
+			// 每次移动代价严格下降，且步骤数为移动次数加2
+			history := output["costHistory"].([]float64)
+			for i := 1; i < len(history); i++ {
+				if history[i] >= history[i-1] {
+					t.Fatalf("costHistory 没有严格下降：%v", history[i-1:i+1])
+				}
+			}
+			if len(tracker.GetSteps()) != len(history)+1 {
+				t.Errorf("steps = %d, moves = %d", len(tracker.GetSteps()), len(history)-1)
+			}
+			if improvement := output["improvement"].(float64); improvement <= 0 {
+				t.Errorf("improvement = %v", improvement)
+			}
+		})
+	}
+}
+
+func TestLocalSearch_MoveDeltas(t *testing.T) {
+	// 移动报告的代价变化与实际回路代价的变化一致
+	rng := rand.New(rand.NewSource(4))
+	for trial := 0; trial < 50; trial++ {
+		inst, _ := newInstance(randomPoints(rng, 4+rng.Intn(10)))
+		tour := rng.Perm(inst.size())
+		for _, move := range []*localMove{twoOptMove(inst, tour), orOptMove(inst, tour)} {
+			if move == nil {
+				continue
+			}
+			if diff := inst.tourCost(move.tour) - inst.tourCost(tour); math.Abs(diff-move.delta) > 1e-9 {
+				t.Fatalf("%s: delta = %v, actual %v", move.kind, move.delta, diff)
+			}
+		}
+	}
+}
+
+func TestTSP_InvalidInput(t *testing.T) {
+	incomplete := &models.GraphData{Type: "undirected",
+		Nodes: []models.GraphNode{{ID: "a"}, {ID: "b"}, {ID: "c"}},
+		Edges: []models.GraphEdge{{From: "a", To: "b", Weight: 1}, {From: "b", To: "c", Weight: 1}}}
+	for _, data := range []interface{}{nil, []int{1}, incomplete, &models.PointSetData{}, &models.GraphData{}} {
+		if NewNearestNeighbor().ValidateInput(data) == nil {
+			t.Errorf("ValidateInput(%v) 应返回错误", data)
+		}
+	}
+	if err := NewHeldKarp().ValidateInput(randomPoints(rand.New(rand.NewSource(5)), maxHeldKarpNodes+1)); err == nil {
+		t.Error("Held-Karp 超过节点上限时应返回错误")
+	}
+	if err := NewLocalSearch().ValidateInput(randomPoints(rand.New(rand.NewSource(5)), maxHeldKarpNodes+1)); err != nil {
+		t.Errorf("启发式算法不受 Held-Karp 上限限制：%v", err)
+	}
+}
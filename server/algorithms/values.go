@@ -0,0 +1,50 @@
+package algorithms
+
+import (
+	"gin/models"
+	"math"
+)
+
+// 数值与下标辅助函数
+
+// Round4 保留4位小数，用于输出统计量
+func Round4(x float64) float64 {
+	return math.Round(x*10000) / 10000
+}
+
+// ToFloat 将JSON数值或单元格值转换为float64
+func ToFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	}
+	return 0, false
+}
+
+// EdgeWeight 边的权重，缺省为1
+func EdgeWeight(e models.GraphEdge) float64 {
+	switch w := e.Weight.(type) {
+	case float64:
+		return w
+	case int:
+		return float64(w)
+	case int64:
+		return float64(w)
+	}
+	return 1
+}
+
+// IndexRange 返回 [low, high] 范围内的所有下标，用于高亮子数组
+func IndexRange(low, high int) []int {
+	indices := make([]int, 0, high-low+1)
+	for i := low; i <= high; i++ {
+		indices = append(indices, i)
+	}
+	return indices
+}
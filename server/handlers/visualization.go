@@ -1,8 +1,10 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
 
+	"gin/algorithms"
 	"gin/services"
 
 	"github.com/gin-gonic/gin"
@@ -45,6 +47,17 @@ func ExecuteVisualization(c *gin.Context) {
 			return
 		}
 
+		// 携带结构化信息的错误（如拓扑排序发现的环）说明输入不满足算法要求，附带 details 字段
+		var detailed algorithms.DetailedError
+		if errors.As(err, &detailed) {
+			c.JSON(http.StatusUnprocessableEntity, gin.H{
+				"error":   "输入数据不满足算法要求",
+				"message": err.Error(),
+				"details": detailed.Details(),
+			})
+			return
+		}
+
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   "算法执行失败",
 			"message": err.Error(),
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupVisualizationRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.POST("/api/visualize/execute", ExecuteVisualization)
+	return router
+}
+
+func TestExecuteVisualization_CycleDetails(t *testing.T) {
+	router := setupVisualizationRouter()
+
+	// a → b → c → a 构成环，拓扑排序失败并返回环的结构化信息
+	body, _ := json.Marshal(map[string]interface{}{
+		"algorithmId": "graph_topological_sort",
+		"data": map[string]interface{}{
+			"type":  "directed",
+			"nodes": []map[string]interface{}{{"id": "a"}, {"id": "b"}, {"id": "c"}, {"id": "d"}},
+			"edges": []map[string]interface{}{
+				{"from": "a", "to": "b"},
+				{"from": "b", "to": "c"},
+				{"from": "c", "to": "a"},
+				{"from": "c", "to": "d"},
+			},
+		},
+	})
+	req, _ := http.NewRequest("POST", "/api/visualize/execute", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+
+	var response map[string]interface{}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.NotEmpty(t, response["message"])
+
+	details, ok := response["details"].(map[string]interface{})
+	if !assert.True(t, ok, "response should carry details") {
+		return
+	}
+	assert.Equal(t, true, details["hasCycle"])
+	assert.Equal(t, float64(3), details["length"])
+	assert.ElementsMatch(t, []interface{}{"a", "b", "c"}, details["cycle"])
+}
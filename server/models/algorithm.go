@@ -36,6 +36,11 @@ const (
 	CategoryGreedy        = "greedy"
 	CategoryBacktracking  = "backtracking"
 	CategoryDivideConquer = "divide_conquer"
+	CategoryGeometry      = "geometry"
+	CategoryDataStructure = "data_structure"
+	CategoryMath          = "math"
+	CategoryOperatingSys  = "operating_system"
+	CategoryClustering    = "clustering"
 )
 
 // GetAlgorithmCategories 获取所有算法类别
@@ -49,6 +54,11 @@ func GetAlgorithmCategories() []string {
 		CategoryGreedy,
 		CategoryBacktracking,
 		CategoryDivideConquer,
+		CategoryGeometry,
+		CategoryDataStructure,
+		CategoryMath,
+		CategoryOperatingSys,
+		CategoryClustering,
 	}
 }
 
@@ -34,6 +34,8 @@ type BenchmarkResult struct {
 	Operations    int64         `json:"operations"`    // 操作次数
 	Comparisons   int64         `json:"comparisons"`   // 比较次数
 	Swaps         int64         `json:"swaps"`         // 交换次数
+	DiskReads     int64         `json:"diskReads,omitempty"`  // 磁盘读次数（外部排序）
+	DiskWrites    int64         `json:"diskWrites,omitempty"` // 磁盘写次数（外部排序）
 	Success       bool          `json:"success"`       // 是否成功
 	Error         string        `json:"error,omitempty"` // 错误信息
 	Timestamp     time.Time     `json:"timestamp"`     // 时间戳
@@ -122,6 +124,8 @@ const (
 	DataTypeTree   = "tree"
 	DataTypeString = "string"
 	DataTypeMatrix = "matrix"
+	DataTypePoints = "points"
+	DataTypeProcesses = "processes"
 )
 
 // BenchmarkConfig 性能测试配置
@@ -0,0 +1,245 @@
+package models
+
+import "sync"
+
+// WorkerStepTracker 支持按工作者记录步骤的追踪器
+// ForWorker 返回的追踪器可以在各自的goroutine中并发使用
+type WorkerStepTracker interface {
+	StepTracker
+
+	// ForWorker 获取指定工作者的追踪器，工作者ID从1开始，0表示主goroutine
+	ForWorker(workerID int) StepTracker
+}
+
+// ConcurrentStepTracker 并发安全的步骤追踪器
+// 每个工作者维护自己的当前阶段和最近步骤，比较、操作与备注总是追加到该工作者自己的最近步骤上
+type ConcurrentStepTracker struct {
+	mutex   sync.Mutex
+	steps   []VisualizationStep
+	stats   ExecutionStats
+	workers map[int]*workerCursor
+}
+
+// workerCursor 工作者的追踪状态
+type workerCursor struct {
+	phase    string
+	lastStep int // 该工作者最近一个步骤的下标，-1 表示尚无步骤
+}
+
+// workerStepTracker 绑定到单个工作者的追踪器视图
+type workerStepTracker struct {
+	parent   *ConcurrentStepTracker
+	workerID int
+}
+
+// NewConcurrentStepTracker 创建并发安全的步骤追踪器
+func NewConcurrentStepTracker() *ConcurrentStepTracker {
+	return &ConcurrentStepTracker{
+		steps:   make([]VisualizationStep, 0),
+		stats:   ExecutionStats{},
+		workers: make(map[int]*workerCursor),
+	}
+}
+
+// ForWorker 获取指定工作者的追踪器
+func (t *ConcurrentStepTracker) ForWorker(workerID int) StepTracker {
+	return &workerStepTracker{parent: t, workerID: workerID}
+}
+
+// AddStep 以主goroutine身份添加步骤
+func (t *ConcurrentStepTracker) AddStep(description string, data interface{}, highlights []int) {
+	t.addStep(0, description, data, highlights)
+}
+
+// AddComparison 以主goroutine身份添加比较操作
+func (t *ConcurrentStepTracker) AddComparison(index1, index2 int, result int) {
+	t.addComparison(0, index1, index2, result)
+}
+
+// AddOperation 以主goroutine身份添加操作
+func (t *ConcurrentStepTracker) AddOperation(opType string, indices []int, values []interface{}, description string) {
+	t.addOperation(0, opType, indices, values, description)
+}
+
+// SetPhase 设置主goroutine的当前阶段
+func (t *ConcurrentStepTracker) SetPhase(phase string) {
+	t.setPhase(0, phase)
+}
+
+// AddNote 以主goroutine身份添加备注
+func (t *ConcurrentStepTracker) AddNote(note string) {
+	t.addNote(0, note)
+}
+
+// GetSteps 获取所有步骤的副本
+func (t *ConcurrentStepTracker) GetSteps() []VisualizationStep {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	steps := make([]VisualizationStep, len(t.steps))
+	copy(steps, t.steps)
+	return steps
+}
+
+// GetStats 获取统计信息
+func (t *ConcurrentStepTracker) GetStats() ExecutionStats {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.stats
+}
+
+// ReplayInto 按记录顺序将步骤重放到另一个追踪器（工作者ID会丢失）
+func (t *ConcurrentStepTracker) ReplayInto(target StepTracker) {
+	for _, step := range t.GetSteps() {
+		target.SetPhase(step.Metadata.Phase)
+		target.AddStep(step.Description, step.Data, step.Highlights)
+		for _, c := range step.Comparisons {
+			target.AddComparison(c.Index1, c.Index2, c.Result)
+		}
+		for _, op := range step.Operations {
+			target.AddOperation(op.Type, op.Indices, op.Values, op.Description)
+		}
+		for _, note := range step.Metadata.Notes {
+			target.AddNote(note)
+		}
+	}
+}
+
+// cursor 获取工作者状态，调用方需持有锁
+func (t *ConcurrentStepTracker) cursor(workerID int) *workerCursor {
+	c, exists := t.workers[workerID]
+	if !exists {
+		// 新工作者继承主goroutine的阶段
+		phase := ""
+		if main, ok := t.workers[0]; ok {
+			phase = main.phase
+		}
+		c = &workerCursor{phase: phase, lastStep: -1}
+		t.workers[workerID] = c
+	}
+	return c
+}
+
+func (t *ConcurrentStepTracker) addStep(workerID int, description string, data interface{}, highlights []int) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	c := t.cursor(workerID)
+	step := VisualizationStep{
+		StepID:      len(t.steps),
+		WorkerID:    workerID,
+		Description: description,
+		Data:        data,
+		Highlights:  highlights,
+		Comparisons: make([]Comparison, 0),
+		Operations:  make([]Operation, 0),
+		Metadata: StepMetadata{
+			Phase: c.phase,
+			Notes: make([]string, 0),
+		},
+	}
+	c.lastStep = len(t.steps)
+	t.steps = append(t.steps, step)
+}
+
+func (t *ConcurrentStepTracker) addComparison(workerID int, index1, index2 int, result int) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	c := t.cursor(workerID)
+	if c.lastStep < 0 {
+		return
+	}
+	step := &t.steps[c.lastStep]
+	step.Comparisons = append(step.Comparisons, Comparison{
+		Index1: index1,
+		Index2: index2,
+		Result: result,
+		Type:   "value",
+	})
+	t.stats.Comparisons++
+}
+
+func (t *ConcurrentStepTracker) addOperation(workerID int, opType string, indices []int, values []interface{}, description string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	c := t.cursor(workerID)
+	if c.lastStep < 0 {
+		return
+	}
+	step := &t.steps[c.lastStep]
+	step.Operations = append(step.Operations, Operation{
+		Type:        opType,
+		Indices:     indices,
+		Values:      values,
+		Description: description,
+	})
+
+	// 更新统计
+	switch opType {
+	case OpTypeSwap:
+		t.stats.Swaps++
+	case OpTypeMove:
+		t.stats.Moves++
+	case OpTypeAccess:
+		t.stats.Accesses++
+	case OpTypeDiskRead:
+		t.stats.DiskReads++
+	case OpTypeDiskWrite:
+		t.stats.DiskWrites++
+	}
+}
+
+func (t *ConcurrentStepTracker) setPhase(workerID int, phase string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.cursor(workerID).phase = phase
+}
+
+func (t *ConcurrentStepTracker) addNote(workerID int, note string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	c := t.cursor(workerID)
+	if c.lastStep < 0 {
+		return
+	}
+	step := &t.steps[c.lastStep]
+	step.Metadata.Notes = append(step.Metadata.Notes, note)
+}
+
+// AddStep 添加步骤
+func (w *workerStepTracker) AddStep(description string, data interface{}, highlights []int) {
+	w.parent.addStep(w.workerID, description, data, highlights)
+}
+
+// AddComparison 添加比较操作
+func (w *workerStepTracker) AddComparison(index1, index2 int, result int) {
+	w.parent.addComparison(w.workerID, index1, index2, result)
+}
+
+// AddOperation 添加操作
+func (w *workerStepTracker) AddOperation(opType string, indices []int, values []interface{}, description string) {
+	w.parent.addOperation(w.workerID, opType, indices, values, description)
+}
+
+// SetPhase 设置当前阶段
+func (w *workerStepTracker) SetPhase(phase string) {
+	w.parent.setPhase(w.workerID, phase)
+}
+
+// AddNote 添加备注
+func (w *workerStepTracker) AddNote(note string) {
+	w.parent.addNote(w.workerID, note)
+}
+
+// GetSteps 获取所有工作者的步骤
+func (w *workerStepTracker) GetSteps() []VisualizationStep {
+	return w.parent.GetSteps()
+}
+
+// GetStats 获取统计信息
+func (w *workerStepTracker) GetStats() ExecutionStats {
+	return w.parent.GetStats()
+}
@@ -102,6 +102,32 @@ type MatrixData struct {
 	Type   string          `json:"type"`   // 元素类型
 }
 
+// PointSetData 二维点集数据结构
+type PointSetData struct {
+	Points []Point2D `json:"points"` // 点列表
+}
+
+// Point2D 二维点
+type Point2D struct {
+	ID    string  `json:"id"`    // 点ID
+	Label string  `json:"label"` // 点标签
+	X     float64 `json:"x"`     // X坐标
+	Y     float64 `json:"y"`     // Y坐标
+}
+
+// ProcessData 进程集合数据结构
+type ProcessData struct {
+	Processes []Process `json:"processes"` // 进程列表
+}
+
+// Process 进程
+type Process struct {
+	ID       string `json:"id"`       // 进程ID
+	Arrival  int    `json:"arrival"`  // 到达时间
+	Burst    int    `json:"burst"`    // CPU执行时间
+	Priority int    `json:"priority"` // 优先级，数值越小优先级越高
+}
+
 // DataPattern 数据模式常量
 const (
 	PatternRandom       = "random"        // 随机数据
@@ -113,6 +139,17 @@ const (
 	PatternWorstCase    = "worst_case"    // 最坏情况
 	PatternBestCase     = "best_case"     // 最佳情况
 	PatternAverageCase  = "average_case"  // 平均情况
+	PatternUniformSquare = "uniform_square" // 正方形内均匀分布的点
+	PatternInCircle      = "in_circle"      // 圆内均匀分布的点
+	PatternOnCircle      = "on_circle"      // 圆周上的点（所有点都在凸包上）
+	PatternGameTree      = "game_tree"      // 叶子带得分的随机博弈树
+	PatternObstacles     = "obstacles"      // 随机障碍网格
+	PatternMazeBacktracker = "maze_backtracker" // 递归回溯生成的迷宫
+	PatternMazePrim        = "maze_prim"        // 随机Prim生成的迷宫
+	PatternMazeKruskal     = "maze_kruskal"     // 随机Kruskal生成的迷宫
+	PatternLocality        = "locality"         // 具有访问局部性的页面引用串
+	PatternConvoy          = "convoy"           // 长作业先到、短作业随后的进程集合（护航效应）
+	PatternBlobs           = "blobs"            // 围绕若干中心正态分布的点（聚类）
 )
 
 // GetDataPatterns 获取所有数据模式
@@ -127,6 +164,17 @@ func GetDataPatterns() []string {
 		PatternWorstCase,
 		PatternBestCase,
 		PatternAverageCase,
+		PatternUniformSquare,
+		PatternInCircle,
+		PatternOnCircle,
+		PatternGameTree,
+		PatternObstacles,
+		PatternMazeBacktracker,
+		PatternMazePrim,
+		PatternMazeKruskal,
+		PatternLocality,
+		PatternConvoy,
+		PatternBlobs,
 	}
 }
 
@@ -138,6 +186,8 @@ func GetDataTypes() []string {
 		DataTypeTree,
 		DataTypeString,
 		DataTypeMatrix,
+		DataTypePoints,
+		DataTypeProcesses,
 	}
 }
 
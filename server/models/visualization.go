@@ -19,13 +19,14 @@ type VisualizationSession struct {
 
 // VisualizationStep 可视化步骤
 type VisualizationStep struct {
-	StepID      int          `json:"stepId"`      // 步骤ID
-	Description string       `json:"description"` // 步骤描述
-	Data        interface{}  `json:"data"`        // 当前数据状态
-	Highlights  []int        `json:"highlights"`  // 高亮元素索引
-	Comparisons []Comparison `json:"comparisons"` // 比较操作
-	Operations  []Operation  `json:"operations"`  // 执行的操作
-	Metadata    StepMetadata `json:"metadata"`    // 步骤元数据
+	StepID      int          `json:"stepId"`             // 步骤ID
+	WorkerID    int          `json:"workerId,omitempty"` // 产生该步骤的工作者ID（0 表示主goroutine）
+	Description string       `json:"description"`        // 步骤描述
+	Data        interface{}  `json:"data"`               // 当前数据状态
+	Highlights  []int        `json:"highlights"`         // 高亮元素索引
+	Comparisons []Comparison `json:"comparisons"`        // 比较操作
+	Operations  []Operation  `json:"operations"`         // 执行的操作
+	Metadata    StepMetadata `json:"metadata"`           // 步骤元数据
 }
 
 // Comparison 比较操作
@@ -72,6 +73,8 @@ type ExecutionStats struct {
 	Swaps       int `json:"swaps"`       // 交换次数
 	Moves       int `json:"moves"`       // 移动次数
 	Accesses    int `json:"accesses"`    // 访问次数
+	DiskReads   int `json:"diskReads"`   // 磁盘读次数（按块计）
+	DiskWrites  int `json:"diskWrites"`  // 磁盘写次数（按块计）
 }
 
 // SessionStatus 会话状态常量
@@ -94,8 +97,14 @@ const (
 	OpTypeMerge     = "merge"
 	OpTypeSplit     = "split"
 	OpTypePartition = "partition"
-	OpTypeAssign    = "assign" // 赋值操作
-	OpTypeCall      = "call"   // 函数调用操作
+	OpTypeAssign    = "assign"     // 赋值操作
+	OpTypeCall      = "call"       // 函数调用操作
+	OpTypeProbe     = "probe"      // 哈希探测操作
+	OpTypeDiskRead  = "disk_read"  // 外存读块操作
+	OpTypeDiskWrite = "disk_write" // 外存写块操作
+	OpTypeCompress  = "compress"   // 路径压缩操作
+	OpTypeRank      = "rank"       // 秩更新操作
+	OpTypePrune     = "prune"      // 剪枝操作
 )
 
 // StepTracker 步骤追踪器接口
@@ -176,6 +185,10 @@ func (t *DefaultStepTracker) AddOperation(opType string, indices []int, values [
 			t.stats.Moves++
 		case OpTypeAccess:
 			t.stats.Accesses++
+		case OpTypeDiskRead:
+			t.stats.DiskReads++
+		case OpTypeDiskWrite:
+			t.stats.DiskWrites++
 		}
 	}
 }
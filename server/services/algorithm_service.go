@@ -2,9 +2,18 @@ package services
 
 import (
 	"gin/algorithms"
+	"gin/algorithms/clustering"
+	"gin/algorithms/datastructure"
+	"gin/algorithms/divideconquer"
+	"gin/algorithms/geometry"
 	"gin/algorithms/graph"
+	"gin/algorithms/grid"
+	"gin/algorithms/numbertheory"
+	"gin/algorithms/operatingsystem"
 	"gin/algorithms/searching"
 	"gin/algorithms/sorting"
+	"gin/algorithms/tree"
+	"gin/algorithms/tsp"
 	"gin/models"
 )
 
@@ -35,19 +44,102 @@ func (s *AlgorithmService) registerAlgorithms() {
 	s.registry.Register(sorting.NewInsertionSort())
 	s.registry.Register(sorting.NewSelectionSort())
 	s.registry.Register(sorting.NewShellSort())
+	s.registry.Register(sorting.NewParallelMergeSort())
+	s.registry.Register(sorting.NewParallelQuickSort())
+	s.registry.Register(sorting.NewSampleSort())
+	s.registry.Register(sorting.NewExternalMergeSort())
+	s.registry.Register(sorting.NewBitonicSort())
+	s.registry.Register(sorting.NewOddEvenMergeSort())
 
 	// 注册搜索算法
 	s.registry.Register(searching.NewBinarySearch())
 	s.registry.Register(searching.NewLinearSearch())
 	s.registry.Register(searching.NewHashSearch())
+	s.registry.Register(searching.NewQuickSelect())
+	s.registry.Register(searching.NewIntroSelect())
+	s.registry.Register(searching.NewMedianOfMedians())
 
 	// 图算法
 	s.registry.Register(graph.NewBFS())
 	s.registry.Register(graph.NewDFS())
 	s.registry.Register(graph.NewDijkstra())
+	s.registry.Register(graph.NewBidirectionalDijkstra())
+	s.registry.Register(graph.NewYenKShortestPaths())
 	s.registry.Register(graph.NewKruskal())
 	s.registry.Register(graph.NewPrim())
+	s.registry.Register(graph.NewBoruvka())
 	s.registry.Register(graph.NewTopologicalSort())
+	s.registry.Register(graph.NewPageRank())
+	s.registry.Register(graph.NewLabelPropagation())
+	s.registry.Register(graph.NewLouvain())
+	s.registry.Register(graph.NewEulerianPath())
+	s.registry.Register(graph.NewGraphColoring())
+	s.registry.Register(graph.NewCycleDetection())
+	s.registry.Register(tsp.NewHeldKarp())
+	s.registry.Register(tsp.NewNearestNeighbor())
+	s.registry.Register(tsp.NewMSTDoubling())
+	s.registry.Register(tsp.NewLocalSearch())
+
+	// 迷宫生成与网格寻路
+	s.registry.Register(grid.NewMazeRecursiveBacktracker())
+	s.registry.Register(grid.NewMazeRandomizedPrim())
+	s.registry.Register(grid.NewMazeRandomizedKruskal())
+	s.registry.Register(grid.NewGridBFS())
+	s.registry.Register(grid.NewGridDijkstra())
+	s.registry.Register(grid.NewGridAStar())
+	s.registry.Register(grid.NewJumpPointSearch())
+
+	// 分治算法
+	s.registry.Register(divideconquer.NewClosestPair())
+	s.registry.Register(divideconquer.NewKaratsuba())
+	s.registry.Register(divideconquer.NewStrassen())
+
+	// 计算几何
+	s.registry.Register(geometry.NewGrahamScan())
+	s.registry.Register(geometry.NewJarvisMarch())
+	s.registry.Register(geometry.NewMonotoneChain())
+	s.registry.Register(geometry.NewKDTree())
+	s.registry.Register(geometry.NewQuadtree())
+
+	// 数据结构
+	s.registry.Register(datastructure.NewUnionFind())
+	s.registry.Register(datastructure.NewBinaryHeap())
+	s.registry.Register(datastructure.NewTrie())
+	s.registry.Register(datastructure.NewSuffixArray())
+	s.registry.Register(datastructure.NewSuffixAutomaton())
+	s.registry.Register(datastructure.NewSegmentTree())
+	s.registry.Register(datastructure.NewFenwickTree())
+	s.registry.Register(datastructure.NewBloomFilter())
+	s.registry.Register(datastructure.NewCountMinSketch())
+	s.registry.Register(datastructure.NewHyperLogLog())
+
+	// 树算法
+	s.registry.Register(tree.NewMinimax())
+	s.registry.Register(tree.NewAlphaBeta())
+
+	// 数学
+	s.registry.Register(numbertheory.NewEuclidGCD())
+	s.registry.Register(numbertheory.NewExtendedGCD())
+	s.registry.Register(numbertheory.NewSieveOfEratosthenes())
+	s.registry.Register(numbertheory.NewLinearSieve())
+	s.registry.Register(numbertheory.NewModPow())
+	s.registry.Register(numbertheory.NewMillerRabin())
+
+	// 操作系统
+	s.registry.Register(operatingsystem.NewFIFOPageReplacement())
+	s.registry.Register(operatingsystem.NewLRUPageReplacement())
+	s.registry.Register(operatingsystem.NewLFUPageReplacement())
+	s.registry.Register(operatingsystem.NewClockPageReplacement())
+	s.registry.Register(operatingsystem.NewOptimalPageReplacement())
+	s.registry.Register(operatingsystem.NewFCFSScheduling())
+	s.registry.Register(operatingsystem.NewSJFScheduling())
+	s.registry.Register(operatingsystem.NewSRTFScheduling())
+	s.registry.Register(operatingsystem.NewRoundRobinScheduling())
+	s.registry.Register(operatingsystem.NewPriorityScheduling())
+
+	// 聚类
+	s.registry.Register(clustering.NewKMeans())
+	s.registry.Register(clustering.NewDBSCAN())
 
 	// 可以继续注册更多算法...
 }
@@ -96,6 +188,30 @@ func (s *AlgorithmService) GetAlgorithm(algorithmID string) (algorithms.Algorith
 	return algorithm, nil
 }
 
+// runAlgorithm 执行算法，支持参数的算法会收到请求中的参数
+func runAlgorithm(algorithm algorithms.Algorithm, data interface{}, parameters interface{}, tracker models.StepTracker) (interface{}, error) {
+	if parameterized, ok := algorithm.(algorithms.ParameterizedAlgorithm); ok {
+		return parameterized.ExecuteWithParams(data, toParamMap(parameters), tracker)
+	}
+	return algorithm.Execute(data, tracker)
+}
+
+// newStepTracker 为算法创建步骤追踪器，并发算法使用并发安全的实现
+func newStepTracker(algorithm algorithms.Algorithm) models.StepTracker {
+	if concurrent, ok := algorithm.(algorithms.ConcurrentAlgorithm); ok && concurrent.IsConcurrent() {
+		return models.NewConcurrentStepTracker()
+	}
+	return models.NewStepTracker()
+}
+
+// toParamMap 将请求参数转换为键值映射
+func toParamMap(parameters interface{}) map[string]interface{} {
+	if p, ok := parameters.(map[string]interface{}); ok {
+		return p
+	}
+	return map[string]interface{}{}
+}
+
 // 全局算法服务实例
 var algorithmService *AlgorithmService
 
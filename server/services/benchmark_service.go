@@ -4,7 +4,10 @@ import (
 	"crypto/rand"
 	"encoding/hex"
 	"fmt"
+	"gin/algorithms"
+	"gin/algorithms/grid"
 	"gin/models"
+	mathrand "math/rand"
 	"sync"
 	"time"
 )
@@ -50,7 +53,7 @@ func (s *BenchmarkService) RunBenchmarkTest(algorithmIDs []string, dataSizes []i
 		DataSizes:    dataSizes,
 		DataType:     dataType,
 		TestCount:    testCount,
-		Parameters:   make(map[string]interface{}),
+		Parameters:   toParamMap(parameters),
 		Status:       models.TestStatusPending,
 		CreatedAt:    time.Now(),
 		Results:      make([]models.BenchmarkResult, 0),
@@ -94,11 +97,11 @@ func (s *BenchmarkService) executeBenchmarkTest(test *models.BenchmarkTest) {
 
 		for _, dataSize := range test.DataSizes {
 			// 生成测试数据
-			testData := s.generateTestData(test.DataType, dataSize)
+			testData := s.generateTestData(algorithm, test.DataType, dataSize)
 
 			// 运行多次测试
 			for i := 0; i < test.TestCount; i++ {
-				result := s.runSingleTest(test.ID, algorithm, testData, test.DataType, dataSize, i)
+				result := s.runSingleTest(test.ID, algorithm, testData, test.Parameters, test.DataType, dataSize, i)
 
 				s.mutex.Lock()
 				test.Results = append(test.Results, result)
@@ -109,9 +112,9 @@ func (s *BenchmarkService) executeBenchmarkTest(test *models.BenchmarkTest) {
 }
 
 // runSingleTest 运行单次测试
-func (s *BenchmarkService) runSingleTest(testID string, algorithm interface{}, data interface{}, dataType string, dataSize int, runIndex int) models.BenchmarkResult {
+func (s *BenchmarkService) runSingleTest(testID string, algorithm algorithms.Algorithm, data interface{}, parameters map[string]interface{}, dataType string, dataSize int, runIndex int) models.BenchmarkResult {
 	// 创建步骤追踪器（用于统计）
-	tracker := models.NewStepTracker()
+	tracker := newStepTracker(algorithm)
 
 	// 记录开始时间
 	startTime := time.Now()
@@ -120,13 +123,9 @@ func (s *BenchmarkService) runSingleTest(testID string, algorithm interface{}, d
 	var err error
 	var algorithmInfo *models.Algorithm
 
-	// 类型断言获取算法接口
-	if alg, ok := algorithm.(interface {
-		Execute(data interface{}, tracker models.StepTracker) (interface{}, error)
-		GetInfo() *models.Algorithm
-	}); ok {
-		_, err = alg.Execute(data, tracker)
-		algorithmInfo = alg.GetInfo()
+	if algorithm != nil {
+		_, err = runAlgorithm(algorithm, data, parameters, tracker)
+		algorithmInfo = algorithm.GetInfo()
 	} else {
 		err = ErrInvalidAlgorithm
 		algorithmInfo = &models.Algorithm{ID: "unknown", Name: "Unknown"}
@@ -150,6 +149,8 @@ func (s *BenchmarkService) runSingleTest(testID string, algorithm interface{}, d
 		Operations:    int64(stats.Comparisons + stats.Swaps + stats.Moves),
 		Comparisons:   int64(stats.Comparisons),
 		Swaps:         int64(stats.Swaps),
+		DiskReads:     int64(stats.DiskReads),
+		DiskWrites:    int64(stats.DiskWrites),
 		Success:       err == nil,
 		Timestamp:     time.Now(),
 		Metadata:      models.ResultMetadata{},
@@ -162,8 +163,8 @@ func (s *BenchmarkService) runSingleTest(testID string, algorithm interface{}, d
 	return result
 }
 
-// generateTestData 生成测试数据
-func (s *BenchmarkService) generateTestData(dataType string, size int) interface{} {
+// generateTestData 生成测试数据，矩阵和数组类型按算法区分普通数据和乘法操作数
+func (s *BenchmarkService) generateTestData(algorithm algorithms.Algorithm, dataType string, size int) interface{} {
 	// 根据数据类型生成简单的数据
 	switch dataType {
 	case models.DataTypeGraph:
@@ -179,7 +180,59 @@ func (s *BenchmarkService) generateTestData(dataType string, size int) interface
 		}
 		g := &models.GraphData{Nodes: nodes, Edges: edges, Type: "directed"}
 		return g
+	case models.DataTypePoints:
+		points := make([]models.Point2D, size)
+		for i := 0; i < size; i++ {
+			id := fmt.Sprintf("p_%d", i)
+			// 确定性的伪随机分布，保证多次运行数据一致
+			points[i] = models.Point2D{ID: id, Label: id, X: float64((i * 37) % 101), Y: float64((i * 61) % 103)}
+		}
+		return &models.PointSetData{Points: points}
+	case models.DataTypeTree:
+		// 叶子数约为size的二叉博弈树，固定种子保证多次运行数据一致
+		depth := 1
+		for 1<<depth < size && depth < 9 {
+			depth++
+		}
+		tree, _ := generateGameTree(2, depth, 3, mathrand.New(mathrand.NewSource(1)))
+		return tree
+	case models.DataTypeMatrix:
+		if algorithm.GetCategory() == models.CategoryDivideConquer {
+			// 矩阵乘法需要两个操作数：元素数约为size的方阵，确定性取值保证多次运行数据一致
+			side := 1
+			for side*side < size && side < 64 {
+				side++
+			}
+			return []*models.MatrixData{operandMatrix(side, 7), operandMatrix(side, 11)}
+		}
+		// 房间数约为size的迷宫，固定种子保证多次运行数据一致
+		side := 1
+		for side*side < size && side < 31 {
+			side++
+		}
+		maze, _ := grid.GenerateMaze(grid.MazeBacktracker, side, side, mathrand.New(mathrand.NewSource(1)))
+		return []*models.MatrixData{maze}
+	case models.DataTypeProcesses:
+		// 确定性的到达时间、执行时间和优先级，保证多次运行数据一致
+		processes := make([]models.Process, size)
+		for i := 0; i < size; i++ {
+			processes[i] = models.Process{
+				ID:       fmt.Sprintf("P%d", i+1),
+				Arrival:  i * 2,
+				Burst:    1 + (i*61)%10,
+				Priority: 1 + (i*13)%5,
+			}
+		}
+		return &models.ProcessData{Processes: processes}
 	default:
+		if algorithm.GetCategory() == models.CategoryDivideConquer {
+			// 整数乘法需要两个操作数：位数约为size的数字串，不超过Karatsuba的200位上限
+			digits := size
+			if digits > 200 {
+				digits = 200
+			}
+			return []interface{}{operandDigits(digits, 7), operandDigits(digits, 11)}
+		}
 		data := make([]interface{}, size)
 		for i := 0; i < size; i++ {
 			data[i] = i // 简单的递增序列
@@ -188,6 +241,33 @@ func (s *BenchmarkService) generateTestData(dataType string, size int) interface
 	}
 }
 
+// operandMatrix 生成 side×side 的整数矩阵，元素取 -9..9 的确定性伪随机值
+func operandMatrix(side, seed int) *models.MatrixData {
+	values := make([][]interface{}, side)
+	for r := range values {
+		values[r] = make([]interface{}, side)
+		for c := range values[r] {
+			values[r][c] = (r*side+c)*seed%19 - 9
+		}
+	}
+	return &models.MatrixData{Values: values, Rows: side, Cols: side, Type: "int"}
+}
+
+// operandDigits 生成 n 位的十进制数字串，首位非零，其余取 0..9 的确定性伪随机值
+func operandDigits(n, seed int) string {
+	if n < 1 {
+		n = 1
+	}
+	digits := make([]byte, n)
+	for i := range digits {
+		digits[i] = byte('0' + (i+1)*seed%10)
+	}
+	if digits[0] == '0' {
+		digits[0] = '1'
+	}
+	return string(digits)
+}
+
 // GetBenchmarkResults 获取测试结果
 func (s *BenchmarkService) GetBenchmarkResults(testID string) (*models.BenchmarkTest, error) {
 	s.mutex.RLock()
@@ -1,6 +1,8 @@
 package services
 
 import (
+	"gin/algorithms"
+	"gin/algorithms/grid"
 	"gin/models"
 	"math"
 	"math/rand"
@@ -120,6 +122,30 @@ func (s *DataService) initializePresets() {
 		Tags:        []string{"graph", "directed", "weighted"},
 		CreatedAt:   time.Now(),
 	})
+
+	// 添加点集数据预设
+	s.presets = append(s.presets, models.DataPreset{
+		ID:          "small_points",
+		Name:        "小型点集",
+		Description: "包含8个点的二维点集",
+		DataType:    models.DataTypePoints,
+		Size:        8,
+		Pattern:     models.PatternRandom,
+		Data: models.PointSetData{
+			Points: []models.Point2D{
+				{ID: "p_0", Label: "P0", X: 2, Y: 3},
+				{ID: "p_1", Label: "P1", X: 12, Y: 30},
+				{ID: "p_2", Label: "P2", X: 40, Y: 50},
+				{ID: "p_3", Label: "P3", X: 5, Y: 1},
+				{ID: "p_4", Label: "P4", X: 12, Y: 10},
+				{ID: "p_5", Label: "P5", X: 3, Y: 4},
+				{ID: "p_6", Label: "P6", X: 27, Y: 18},
+				{ID: "p_7", Label: "P7", X: 33, Y: 44},
+			},
+		},
+		Tags:      []string{"points", "geometry", "small"},
+		CreatedAt: time.Now(),
+	})
 }
 
 // GenerateTestData 生成测试数据
@@ -142,6 +168,12 @@ func (s *DataService) GenerateTestData(dataType string, size int, pattern string
 		return s.generateGraphData(size, pattern, parameters)
 	case models.DataTypeTree:
 		return s.generateTreeData(size, pattern, parameters)
+	case models.DataTypePoints:
+		return s.generatePointSetData(size, pattern, parameters)
+	case models.DataTypeMatrix:
+		return s.generateGridData(size, pattern, parameters)
+	case models.DataTypeProcesses:
+		return s.generateProcessData(size, pattern, parameters)
 	default:
 		return nil, ErrUnsupportedDataType
 	}
@@ -188,6 +220,20 @@ func (s *DataService) generateArrayData(size int, pattern string, parameters int
 		for i := 0; i < size; i++ {
 			values[i] = uniqueValues[rand.Intn(len(uniqueValues))]
 		}
+	case models.PatternLocality:
+		// 页面引用串：大部分引用落在一个缓慢漂移的工作集内，少量随机跳转
+		rand.Seed(time.Now().UnixNano())
+		base := 0
+		for i := 0; i < size; i++ {
+			if i > 0 && i%8 == 0 {
+				base += rand.Intn(3)
+			}
+			if rand.Intn(10) == 0 {
+				values[i] = rand.Intn(base + 10)
+			} else {
+				values[i] = base + rand.Intn(4)
+			}
+		}
 	default:
 		// 默认生成随机数据
 		rand.Seed(time.Now().UnixNano())
@@ -430,6 +476,17 @@ func (s *DataService) generateGraphData(size int, pattern string, parameters int
 
 // generateTreeData 生成树数据
 func (s *DataService) generateTreeData(size int, pattern string, parameters interface{}) (*models.TreeData, error) {
+	if pattern == models.PatternGameTree {
+		params := toParamMap(parameters)
+		rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+		return generateGameTree(
+			algorithms.IntParam(params, "branching", 3),
+			algorithms.IntParam(params, "depth", 3),
+			algorithms.IntParam(params, "noise", 3),
+			rng,
+		)
+	}
+
 	if size == 0 {
 		return &models.TreeData{
 			Root: nil,
@@ -498,6 +555,187 @@ func (s *DataService) addTreeNodes(parent *models.TreeNode, currentCount int, ma
 	return currentCount
 }
 
+// maxGeneratedGameTreeNodes 生成博弈树的节点数上限
+const maxGeneratedGameTreeNodes = 2000
+
+// generateGameTree 生成分支因子为 branching、深度为 depth 的完全博弈树
+// 叶子得分为 [-20, 20] 内的随机整数；内部节点的 value 是以根为 MAX 方计算的极小化极大值
+// 加上 [-noise, noise] 内的随机扰动，作为走法排序用的静态估值；noise 为负数时内部节点不带估值
+func generateGameTree(branching, depth, noise int, rng *rand.Rand) (*models.TreeData, error) {
+	if branching < 1 || depth < 1 {
+		return nil, ErrInvalidInput
+	}
+	total, width := 1, 1
+	for level := 1; level <= depth; level++ {
+		width *= branching
+		total += width
+		if total > maxGeneratedGameTreeNodes {
+			return nil, ErrDataSizeTooLarge
+		}
+	}
+
+	count, leaves := 0, 0
+	var build func(level int) (*models.TreeNode, int)
+	build = func(level int) (*models.TreeNode, int) {
+		node := &models.TreeNode{
+			ID:       "node_" + strconv.Itoa(count),
+			Children: make([]*models.TreeNode, 0, branching),
+			Y:        float64(level * 60),
+			Level:    level,
+		}
+		count++
+
+		if level == depth {
+			score := rng.Intn(41) - 20
+			node.Value = score
+			node.X = float64(leaves * 40)
+			leaves++
+			return node, score
+		}
+
+		// 偶数层为 MAX 方，奇数层为 MIN 方
+		value := 0
+		for i := 0; i < branching; i++ {
+			child, childValue := build(level + 1)
+			node.Children = append(node.Children, child)
+			if i == 0 || (level%2 == 0 && childValue > value) || (level%2 == 1 && childValue < value) {
+				value = childValue
+			}
+		}
+		node.X = (node.Children[0].X + node.Children[len(node.Children)-1].X) / 2
+		if noise >= 0 {
+			node.Value = value + rng.Intn(2*noise+1) - noise
+		}
+		return node, value
+	}
+
+	root, _ := build(0)
+	return &models.TreeData{
+		Root: root,
+		Type: "n-ary",
+	}, nil
+}
+
+// generateGridData 生成网格地图（0 为通路，1 为墙）
+// 迷宫模式下 size 为每边的房间数，网格边长为 2*size+1；其余模式生成 size×size 的随机障碍网格，
+// 障碍密度由参数 density 指定（默认0.25），左上角和右下角保持为通路
+func (s *DataService) generateGridData(size int, pattern string, parameters interface{}) (*models.MatrixData, error) {
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	switch pattern {
+	case models.PatternMazeBacktracker:
+		return grid.GenerateMaze(grid.MazeBacktracker, size, size, rng)
+	case models.PatternMazePrim:
+		return grid.GenerateMaze(grid.MazePrim, size, size, rng)
+	case models.PatternMazeKruskal:
+		return grid.GenerateMaze(grid.MazeKruskal, size, size, rng)
+	}
+
+	if size < 2 || size > 64 {
+		return nil, ErrDataSizeTooLarge
+	}
+	density := algorithms.FloatParam(toParamMap(parameters), "density", 0.25)
+	values := make([][]interface{}, size)
+	for r := range values {
+		values[r] = make([]interface{}, size)
+		for c := range values[r] {
+			if rng.Float64() < density {
+				values[r][c] = 1
+			} else {
+				values[r][c] = 0
+			}
+		}
+	}
+	values[0][0], values[size-1][size-1] = 0, 0
+
+	return &models.MatrixData{
+		Values: values,
+		Rows:   size,
+		Cols:   size,
+		Type:   "int",
+	}, nil
+}
+
+// generateProcessData 生成进程集合：到达时间随机递增，执行时间1-10，优先级1-5
+// convoy 模式下第一个进程执行时间很长，其余为短进程，用于演示护航效应
+func (s *DataService) generateProcessData(size int, pattern string, parameters interface{}) (*models.ProcessData, error) {
+	if size < 1 || size > maxProcesses {
+		return nil, ErrDataSizeTooLarge
+	}
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	processes := make([]models.Process, size)
+	arrival := 0
+	for i := range processes {
+		processes[i] = models.Process{
+			ID:       fmt.Sprintf("P%d", i+1),
+			Arrival:  arrival,
+			Burst:    1 + rng.Intn(10),
+			Priority: 1 + rng.Intn(5),
+		}
+		if pattern == models.PatternConvoy {
+			processes[i].Arrival = i
+			processes[i].Burst = 1 + rng.Intn(3)
+		}
+		arrival += rng.Intn(4)
+	}
+	if pattern == models.PatternConvoy {
+		processes[0].Burst = 20 + 2*size
+	}
+
+	return &models.ProcessData{Processes: processes}, nil
+}
+
+// generatePointSetData 生成二维点集数据
+func (s *DataService) generatePointSetData(size int, pattern string, parameters interface{}) (*models.PointSetData, error) {
+	points := make([]models.Point2D, size)
+
+	rand.Seed(time.Now().UnixNano())
+
+	const center, radius = 50.0, 50.0
+	// 高斯簇：先在 [15, 85) 内选取3到5个簇中心，再围绕中心按正态分布撒点
+	var blobs [][2]float64
+	if pattern == models.PatternBlobs {
+		blobs = make([][2]float64, 3+rand.Intn(3))
+		for c := range blobs {
+			blobs[c] = [2]float64{15 + rand.Float64()*70, 15 + rand.Float64()*70}
+		}
+	}
+	for i := 0; i < size; i++ {
+		var x, y float64
+		switch pattern {
+		case models.PatternInCircle:
+			// 圆内均匀分布：半径取均匀随机数的平方根，避免点聚集在圆心
+			r := radius * math.Sqrt(rand.Float64())
+			theta := 2 * math.Pi * rand.Float64()
+			x, y = center+r*math.Cos(theta), center+r*math.Sin(theta)
+		case models.PatternOnCircle:
+			// 圆周上的点，保留更多小数位以免舍入后落到凸包内部
+			theta := 2 * math.Pi * rand.Float64()
+			x = math.Round((center+radius*math.Cos(theta))*10000) / 10000
+			y = math.Round((center+radius*math.Sin(theta))*10000) / 10000
+		case models.PatternBlobs:
+			c := blobs[rand.Intn(len(blobs))]
+			x, y = c[0]+rand.NormFloat64()*6, c[1]+rand.NormFloat64()*6
+		default:
+			// 在 [0, 100) × [0, 100) 的正方形内均匀分布
+			x, y = rand.Float64()*100, rand.Float64()*100
+		}
+		if pattern != models.PatternOnCircle {
+			x, y = math.Round(x*100)/100, math.Round(y*100)/100
+		}
+
+		points[i] = models.Point2D{
+			ID:    "p_" + strconv.Itoa(i),
+			Label: "P" + strconv.Itoa(i),
+			X:     x,
+			Y:     y,
+		}
+	}
+
+	return &models.PointSetData{
+		Points: points,
+	}, nil
+}
+
 // GetDataPresets 获取预设数据
 func (s *DataService) GetDataPresets(dataType string) ([]models.DataPreset, error) {
 	if dataType == "" {
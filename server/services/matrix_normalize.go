@@ -0,0 +1,168 @@
+package services
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+)
+
+// normalizeMatrixOperands 将任意输入尝试转换为矩阵操作数列表
+// 支持 {"a": 矩阵, "b": 矩阵}、[矩阵, 矩阵] 以及已解析的 []*models.MatrixData；
+// 单个矩阵（二维数组或 {"values": ...}）视为只有一个操作数，例如网格寻路的地图
+func normalizeMatrixOperands(data interface{}) ([]*models.MatrixData, error) {
+	switch m := data.(type) {
+	case []*models.MatrixData:
+		for _, matrix := range m {
+			if _, err := validateAndNormalizeMatrix(matrix); err != nil {
+				return nil, err
+			}
+		}
+		return m, nil
+	case *models.MatrixData, models.MatrixData:
+		matrix, err := normalizeMatrixData(m)
+		if err != nil {
+			return nil, err
+		}
+		return []*models.MatrixData{matrix}, nil
+	case map[string]interface{}:
+		a, okA := m["a"]
+		b, okB := m["b"]
+		if _, ok := m["values"]; ok && !okA && !okB {
+			return normalizeMatrixOperands([]interface{}{m})
+		}
+		if !okA || !okB {
+			return nil, fmt.Errorf("矩阵操作数缺少a或b字段")
+		}
+		return normalizeMatrixOperands([]interface{}{a, b})
+	case []interface{}:
+		if isMatrixRows(m) {
+			return normalizeMatrixOperands([]interface{}{m})
+		}
+		matrices := make([]*models.MatrixData, 0, len(m))
+		for i, v := range m {
+			matrix, err := normalizeMatrixData(v)
+			if err != nil {
+				return nil, fmt.Errorf("矩阵%d: %v", i, err)
+			}
+			matrices = append(matrices, matrix)
+		}
+		return matrices, nil
+	default:
+		return nil, fmt.Errorf("无效的矩阵操作数格式")
+	}
+}
+
+// isMatrixRows 判断数组本身是否为一个二维矩阵（首行是由标量组成的数组）
+func isMatrixRows(values []interface{}) bool {
+	if len(values) == 0 {
+		return false
+	}
+	row, ok := values[0].([]interface{})
+	if !ok || len(row) == 0 {
+		return false
+	}
+	switch row[0].(type) {
+	case []interface{}, map[string]interface{}:
+		return false
+	}
+	return true
+}
+
+// normalizeMatrixData 将任意输入尝试转换为 *models.MatrixData
+// 支持 {"values": [[...]], "type": ...} 以及直接的二维数组
+func normalizeMatrixData(data interface{}) (*models.MatrixData, error) {
+	switch m := data.(type) {
+	case *models.MatrixData:
+		return validateAndNormalizeMatrix(m)
+	case models.MatrixData:
+		return validateAndNormalizeMatrix(&m)
+	case map[string]interface{}:
+		rowsVal, ok := m["values"].([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("矩阵数据缺少values字段")
+		}
+		matrix, err := rowsToMatrixData(rowsVal)
+		if err != nil {
+			return nil, err
+		}
+		if t, ok := m["type"].(string); ok && t != "" {
+			matrix.Type = t
+		}
+		return validateAndNormalizeMatrix(matrix)
+	case []interface{}:
+		matrix, err := rowsToMatrixData(m)
+		if err != nil {
+			return nil, err
+		}
+		return validateAndNormalizeMatrix(matrix)
+	default:
+		return nil, fmt.Errorf("无效的矩阵数据格式")
+	}
+}
+
+// rowsToMatrixData 将二维数组转换为矩阵
+func rowsToMatrixData(rows []interface{}) (*models.MatrixData, error) {
+	values := make([][]interface{}, 0, len(rows))
+	for i, r := range rows {
+		row, ok := r.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("矩阵第%d行不是数组", i)
+		}
+		values = append(values, row)
+	}
+
+	matrix := &models.MatrixData{Values: values, Rows: len(values)}
+	if len(values) > 0 {
+		matrix.Cols = len(values[0])
+	}
+	return matrix, nil
+}
+
+// validateAndNormalizeMatrix 验证和标准化矩阵
+func validateAndNormalizeMatrix(matrix *models.MatrixData) (*models.MatrixData, error) {
+	if matrix == nil {
+		return nil, fmt.Errorf("矩阵数据为空")
+	}
+	if len(matrix.Values) == 0 {
+		return nil, fmt.Errorf("矩阵必须至少包含一行")
+	}
+
+	// 行列数以实际数据为准
+	matrix.Rows = len(matrix.Values)
+	matrix.Cols = len(matrix.Values[0])
+
+	allInt := true
+	for i, row := range matrix.Values {
+		if len(row) != matrix.Cols {
+			return nil, fmt.Errorf("矩阵第%d行长度为%d，应为%d", i, len(row), matrix.Cols)
+		}
+		for j, v := range row {
+			f, ok := algorithms.ToFloat(v)
+			if !ok {
+				return nil, fmt.Errorf("矩阵元素(%d,%d)必须为数值", i, j)
+			}
+			if f != float64(int(f)) {
+				allInt = false
+			}
+		}
+	}
+
+	// 推断元素类型，整数矩阵统一转为int
+	if matrix.Type == "" {
+		if allInt {
+			matrix.Type = "int"
+		} else {
+			matrix.Type = "float"
+		}
+	}
+	if matrix.Type == "int" {
+		for _, row := range matrix.Values {
+			for j, v := range row {
+				f, _ := algorithms.ToFloat(v)
+				row[j] = int(f)
+			}
+		}
+	}
+
+	return matrix, nil
+}
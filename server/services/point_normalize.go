@@ -0,0 +1,105 @@
+package services
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"strings"
+)
+
+// normalizePointSetData 将任意输入尝试转换为 *models.PointSetData
+func normalizePointSetData(data interface{}) (*models.PointSetData, error) {
+	switch p := data.(type) {
+	case *models.PointSetData:
+		return validateAndNormalizePoints(p)
+	case models.PointSetData:
+		return validateAndNormalizePoints(&p)
+	case map[string]interface{}:
+		// {"points": [...]} 形式
+		pointsVal, ok := p["points"].([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("点集数据缺少points字段")
+		}
+		return sliceToPointSetData(pointsVal)
+	case []interface{}:
+		// 直接传入点数组
+		return sliceToPointSetData(p)
+	default:
+		return nil, fmt.Errorf("无效的点集数据格式")
+	}
+}
+
+// sliceToPointSetData 解析点数组，元素可以是 {"x":..,"y":..} 或 [x, y]
+func sliceToPointSetData(values []interface{}) (*models.PointSetData, error) {
+	points := make([]models.Point2D, 0, len(values))
+
+	for i, v := range values {
+		point := models.Point2D{}
+
+		switch pv := v.(type) {
+		case map[string]interface{}:
+			x, okX := algorithms.ToFloat(pv["x"])
+			y, okY := algorithms.ToFloat(pv["y"])
+			if !okX || !okY {
+				return nil, fmt.Errorf("点%d: 坐标必须为数值", i)
+			}
+			point.X, point.Y = x, y
+
+			if id, ok := pv["id"].(string); ok {
+				point.ID = strings.TrimSpace(id)
+			}
+			if label, ok := pv["label"].(string); ok {
+				point.Label = strings.TrimSpace(label)
+			}
+		case []interface{}:
+			if len(pv) != 2 {
+				return nil, fmt.Errorf("点%d: 坐标数组必须包含2个元素", i)
+			}
+			x, okX := algorithms.ToFloat(pv[0])
+			y, okY := algorithms.ToFloat(pv[1])
+			if !okX || !okY {
+				return nil, fmt.Errorf("点%d: 坐标必须为数值", i)
+			}
+			point.X, point.Y = x, y
+		default:
+			return nil, fmt.Errorf("点%d: 无效的点格式", i)
+		}
+
+		points = append(points, point)
+	}
+
+	return validateAndNormalizePoints(&models.PointSetData{Points: points})
+}
+
+// validateAndNormalizePoints 验证和标准化点集
+func validateAndNormalizePoints(points *models.PointSetData) (*models.PointSetData, error) {
+	if points == nil {
+		return nil, fmt.Errorf("点集数据为空")
+	}
+	if len(points.Points) == 0 {
+		return nil, fmt.Errorf("点集必须至少包含一个点")
+	}
+
+	ids := make(map[string]bool)
+	for i := range points.Points {
+		point := &points.Points[i]
+
+		// 确保点ID不为空
+		if strings.TrimSpace(point.ID) == "" {
+			point.ID = fmt.Sprintf("p_%d", i)
+		}
+
+		// 检查点ID是否重复
+		if ids[point.ID] {
+			return nil, fmt.Errorf("点ID重复: %s", point.ID)
+		}
+		ids[point.ID] = true
+
+		// 确保标签不为空
+		if strings.TrimSpace(point.Label) == "" {
+			point.Label = point.ID
+		}
+	}
+
+	return points, nil
+}
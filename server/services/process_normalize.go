@@ -0,0 +1,127 @@
+package services
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"math"
+	"strings"
+)
+
+// 进程集合的进程数上限，以及所有进程执行时间之和的上限（与调度模拟的限制一致）
+const (
+	maxProcesses  = 10000
+	maxTotalBurst = 100000
+)
+
+// normalizeProcessData 将任意输入尝试转换为 *models.ProcessData
+func normalizeProcessData(data interface{}) (*models.ProcessData, error) {
+	switch p := data.(type) {
+	case *models.ProcessData:
+		return validateAndNormalizeProcesses(p)
+	case models.ProcessData:
+		return validateAndNormalizeProcesses(&p)
+	case map[string]interface{}:
+		// {"processes": [...]} 形式
+		values, ok := p["processes"].([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("进程数据缺少processes字段")
+		}
+		return sliceToProcessData(values)
+	case []interface{}:
+		// 直接传入进程数组
+		return sliceToProcessData(p)
+	default:
+		return nil, fmt.Errorf("无效的进程数据格式")
+	}
+}
+
+// sliceToProcessData 解析进程数组，元素可以是 {"id","arrival","burst","priority"} 或 [到达时间, 执行时间, 优先级]
+func sliceToProcessData(values []interface{}) (*models.ProcessData, error) {
+	processes := make([]models.Process, 0, len(values))
+
+	for i, v := range values {
+		process := models.Process{}
+		var fields []interface{}
+
+		switch pv := v.(type) {
+		case map[string]interface{}:
+			if id, ok := pv["id"].(string); ok {
+				process.ID = strings.TrimSpace(id)
+			}
+			fields = []interface{}{pv["arrival"], pv["burst"], pv["priority"]}
+			if pv["arrival"] == nil {
+				fields[0] = 0.0
+			}
+			if pv["priority"] == nil {
+				fields[2] = 0.0
+			}
+		case []interface{}:
+			if len(pv) < 2 || len(pv) > 3 {
+				return nil, fmt.Errorf("进程%d: 数组形式应为 [到达时间, 执行时间] 或 [到达时间, 执行时间, 优先级]", i)
+			}
+			fields = append([]interface{}{}, pv...)
+			if len(fields) == 2 {
+				fields = append(fields, 0.0)
+			}
+		default:
+			return nil, fmt.Errorf("进程%d: 无效的进程格式", i)
+		}
+
+		numbers := make([]int, 3)
+		for k, f := range fields {
+			n, ok := algorithms.ToFloat(f)
+			if !ok || n != math.Trunc(n) {
+				return nil, fmt.Errorf("进程%d: 到达时间、执行时间和优先级必须为整数", i)
+			}
+			numbers[k] = int(n)
+		}
+		process.Arrival, process.Burst, process.Priority = numbers[0], numbers[1], numbers[2]
+		processes = append(processes, process)
+	}
+
+	return validateAndNormalizeProcesses(&models.ProcessData{Processes: processes})
+}
+
+// validateAndNormalizeProcesses 验证和标准化进程集合
+func validateAndNormalizeProcesses(processes *models.ProcessData) (*models.ProcessData, error) {
+	if processes == nil {
+		return nil, fmt.Errorf("进程数据为空")
+	}
+	if len(processes.Processes) == 0 {
+		return nil, fmt.Errorf("进程集合必须至少包含一个进程")
+	}
+	if len(processes.Processes) > maxProcesses {
+		return nil, fmt.Errorf("进程数不能超过%d", maxProcesses)
+	}
+
+	ids := make(map[string]bool)
+	totalBurst := 0
+	for i := range processes.Processes {
+		process := &processes.Processes[i]
+
+		// 确保进程ID不为空
+		if strings.TrimSpace(process.ID) == "" {
+			process.ID = fmt.Sprintf("P%d", i+1)
+		}
+
+		// 检查进程ID是否重复
+		if ids[process.ID] {
+			return nil, fmt.Errorf("进程ID重复: %s", process.ID)
+		}
+		ids[process.ID] = true
+
+		if process.Arrival < 0 {
+			return nil, fmt.Errorf("进程 %s 的到达时间不能为负数", process.ID)
+		}
+		if process.Burst <= 0 {
+			return nil, fmt.Errorf("进程 %s 的执行时间必须为正整数", process.ID)
+		}
+		if process.Burst > maxTotalBurst-totalBurst {
+			return nil, fmt.Errorf("所有进程的执行时间之和不能超过%d", maxTotalBurst)
+		}
+		totalBurst += process.Burst
+	}
+
+	return processes, nil
+}
@@ -0,0 +1,128 @@
+package services
+
+import (
+	"fmt"
+	"gin/algorithms"
+	"gin/models"
+	"strconv"
+	"strings"
+)
+
+// maxTreeNodes 规范化时允许的树节点数上限
+const maxTreeNodes = 5000
+
+// normalizeTreeData 将任意输入尝试转换为 *models.TreeData
+// 支持 {"root": 节点, "type": ...}、单个节点对象，以及嵌套数组的简写形式（如 [[3, 5], [2, [9, 1]]]，数值为叶子）
+func normalizeTreeData(data interface{}) (*models.TreeData, error) {
+	var root *models.TreeNode
+	treeType := "n-ary"
+
+	switch t := data.(type) {
+	case *models.TreeData:
+		if t == nil || t.Root == nil {
+			return nil, fmt.Errorf("树数据为空")
+		}
+		return t, nil
+	case models.TreeData:
+		if t.Root == nil {
+			return nil, fmt.Errorf("树数据为空")
+		}
+		return &t, nil
+	case map[string]interface{}:
+		if typ, ok := t["type"].(string); ok && strings.TrimSpace(typ) != "" {
+			treeType = strings.TrimSpace(typ)
+		}
+		rootValue, ok := t["root"]
+		if !ok {
+			// 没有root字段时把整个对象当作根节点
+			rootValue = t
+		}
+		count := 0
+		node, err := toTreeNode(rootValue, 0, &count)
+		if err != nil {
+			return nil, err
+		}
+		root = node
+	case []interface{}:
+		count := 0
+		node, err := toTreeNode(t, 0, &count)
+		if err != nil {
+			return nil, err
+		}
+		root = node
+	default:
+		return nil, fmt.Errorf("无效的树数据格式")
+	}
+
+	return &models.TreeData{Root: root, Type: treeType}, nil
+}
+
+// toTreeNode 递归解析节点，缺少ID的节点按先序编号命名为 node_<i>
+func toTreeNode(value interface{}, level int, count *int) (*models.TreeNode, error) {
+	if *count >= maxTreeNodes {
+		return nil, fmt.Errorf("树节点数不能超过%d", maxTreeNodes)
+	}
+	node := &models.TreeNode{
+		ID:       "node_" + strconv.Itoa(*count),
+		Children: make([]*models.TreeNode, 0),
+		Level:    level,
+	}
+	*count++
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if id, ok := v["id"].(string); ok && strings.TrimSpace(id) != "" {
+			node.ID = strings.TrimSpace(id)
+		}
+		node.Value = v["value"]
+		if x, ok := algorithms.ToFloat(v["x"]); ok {
+			node.X = x
+		}
+		if y, ok := algorithms.ToFloat(v["y"]); ok {
+			node.Y = y
+		}
+
+		if children, ok := v["children"].([]interface{}); ok && len(children) > 0 {
+			for _, c := range children {
+				child, err := toTreeNode(c, level+1, count)
+				if err != nil {
+					return nil, err
+				}
+				node.Children = append(node.Children, child)
+			}
+			return node, nil
+		}
+		// 二叉树形式：left/right
+		for _, key := range []string{"left", "right"} {
+			c, ok := v[key]
+			if !ok || c == nil {
+				continue
+			}
+			child, err := toTreeNode(c, level+1, count)
+			if err != nil {
+				return nil, err
+			}
+			if key == "left" {
+				node.Left = child
+			} else {
+				node.Right = child
+			}
+			node.Children = append(node.Children, child)
+		}
+	case []interface{}:
+		// 嵌套数组：数组为内部节点，其余值为叶子
+		if len(v) == 0 {
+			return nil, fmt.Errorf("节点 %s 的子节点数组为空", node.ID)
+		}
+		for _, c := range v {
+			child, err := toTreeNode(c, level+1, count)
+			if err != nil {
+				return nil, err
+			}
+			node.Children = append(node.Children, child)
+		}
+	default:
+		node.Value = v
+	}
+	return node, nil
+}
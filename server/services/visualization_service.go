@@ -34,13 +34,48 @@ func (s *VisualizationService) ExecuteAlgorithmVisualization(algorithmID string,
 
 	// 根据算法类型规范化输入数据
 	var normalized interface{} = data
-	// 如果是图算法，尝试将通用JSON映射转换为GraphData
+	// 点集算法：将JSON点数组转换为PointSetData
+	_, pointSet := algorithm.(algorithms.PointSetAlgorithm)
+	// 如果是图算法，尝试将通用JSON映射转换为GraphData；同时支持点集的算法（如旅行商）转换失败时按点集处理
 	if _, ok := algorithm.(algorithms.GraphAlgorithm); ok {
 		g, err := normalizeGraphData(data)
+		if err == nil {
+			normalized = g
+			pointSet = false
+		} else if !pointSet {
+			return nil, ErrInvalidInput
+		}
+	}
+	if pointSet {
+		p, err := normalizePointSetData(data)
+		if err != nil {
+			return nil, ErrInvalidInput
+		}
+		normalized = p
+	}
+	// 矩阵算法：将JSON矩阵转换为MatrixData操作数
+	if _, ok := algorithm.(algorithms.MatrixAlgorithm); ok {
+		m, err := normalizeMatrixOperands(data)
+		if err != nil {
+			return nil, ErrInvalidInput
+		}
+		normalized = m
+	}
+	// 树算法：将JSON节点或嵌套数组转换为TreeData
+	if _, ok := algorithm.(algorithms.TreeAlgorithm); ok {
+		t, err := normalizeTreeData(data)
+		if err != nil {
+			return nil, ErrInvalidInput
+		}
+		normalized = t
+	}
+	// 调度算法：将JSON进程数组转换为ProcessData
+	if _, ok := algorithm.(algorithms.SchedulingAlgorithm); ok {
+		p, err := normalizeProcessData(data)
 		if err != nil {
 			return nil, ErrInvalidInput
 		}
-		normalized = g
+		normalized = p
 	}
 
 	// 验证输入数据
@@ -66,11 +101,11 @@ func (s *VisualizationService) ExecuteAlgorithmVisualization(algorithmID string,
 	s.mutex.Unlock()
 
 	// 创建步骤追踪器
-	tracker := models.NewStepTracker()
+	tracker := newStepTracker(algorithm)
 
 	// 执行算法
 	startTime := time.Now()
-	outputData, err := algorithm.Execute(normalized, tracker)
+	outputData, err := runAlgorithm(algorithm, normalized, parameters, tracker)
 	executionTime := time.Since(startTime)
 
 	// 更新会话状态
@@ -40,7 +40,12 @@ export const ALGORITHM_CATEGORIES = {
   DYNAMIC_PROGRAMMING: 'dynamic_programming',
   GREEDY: 'greedy',
   BACKTRACKING: 'backtracking',
-  DIVIDE_CONQUER: 'divide_conquer'
+  DIVIDE_CONQUER: 'divide_conquer',
+  GEOMETRY: 'geometry',
+  DATA_STRUCTURE: 'data_structure',
+  MATH: 'math',
+  OPERATING_SYSTEM: 'operating_system',
+  CLUSTERING: 'clustering'
 } as const;
 
 export type AlgorithmCategoryType = typeof ALGORITHM_CATEGORIES[keyof typeof ALGORITHM_CATEGORIES];
@@ -54,7 +59,12 @@ export const CATEGORY_NAMES: Record<AlgorithmCategoryType, string> = {
   [ALGORITHM_CATEGORIES.DYNAMIC_PROGRAMMING]: '动态规划',
   [ALGORITHM_CATEGORIES.GREEDY]: '贪心算法',
   [ALGORITHM_CATEGORIES.BACKTRACKING]: '回溯算法',
-  [ALGORITHM_CATEGORIES.DIVIDE_CONQUER]: '分治算法'
+  [ALGORITHM_CATEGORIES.DIVIDE_CONQUER]: '分治算法',
+  [ALGORITHM_CATEGORIES.GEOMETRY]: '计算几何',
+  [ALGORITHM_CATEGORIES.DATA_STRUCTURE]: '数据结构',
+  [ALGORITHM_CATEGORIES.MATH]: '数学',
+  [ALGORITHM_CATEGORIES.OPERATING_SYSTEM]: '操作系统',
+  [ALGORITHM_CATEGORIES.CLUSTERING]: '聚类'
 };
 
 // API响应类型
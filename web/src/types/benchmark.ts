@@ -28,6 +28,8 @@ export interface BenchmarkResult {
   operations: number;
   comparisons: number;
   swaps: number;
+  diskReads?: number;
+  diskWrites?: number;
   success: boolean;
   error?: string;
   timestamp: string;
@@ -93,6 +93,31 @@ export interface MatrixData {
   type: string;
 }
 
+// 二维点
+export interface Point2D {
+  id: string;
+  label: string;
+  x: number;
+  y: number;
+}
+
+// 二维点集数据结构
+export interface PointSetData {
+  points: Point2D[];
+}
+
+// 进程集合数据结构（priority 数值越小优先级越高）
+export interface Process {
+  id: string;
+  arrival: number;
+  burst: number;
+  priority: number;
+}
+
+export interface ProcessData {
+  processes: Process[];
+}
+
 // 数据模式常量
 export const DATA_PATTERNS = {
   RANDOM: 'random',
@@ -103,7 +128,18 @@ export const DATA_PATTERNS = {
   MANY_DUPLICATES: 'many_duplicates',
   WORST_CASE: 'worst_case',
   BEST_CASE: 'best_case',
-  AVERAGE_CASE: 'average_case'
+  AVERAGE_CASE: 'average_case',
+  UNIFORM_SQUARE: 'uniform_square',
+  IN_CIRCLE: 'in_circle',
+  ON_CIRCLE: 'on_circle',
+  GAME_TREE: 'game_tree',
+  OBSTACLES: 'obstacles',
+  MAZE_BACKTRACKER: 'maze_backtracker',
+  MAZE_PRIM: 'maze_prim',
+  MAZE_KRUSKAL: 'maze_kruskal',
+  LOCALITY: 'locality',
+  CONVOY: 'convoy',
+  BLOBS: 'blobs'
 } as const;
 
 export type DataPattern = typeof DATA_PATTERNS[keyof typeof DATA_PATTERNS];
@@ -114,7 +150,9 @@ export const DATA_TYPES = {
   GRAPH: 'graph',
   TREE: 'tree',
   STRING: 'string',
-  MATRIX: 'matrix'
+  MATRIX: 'matrix',
+  POINTS: 'points',
+  PROCESSES: 'processes'
 } as const;
 
 export type DataType = typeof DATA_TYPES[keyof typeof DATA_TYPES];
@@ -129,7 +167,18 @@ export const PATTERN_NAMES: Record<DataPattern, string> = {
   [DATA_PATTERNS.MANY_DUPLICATES]: '大量重复',
   [DATA_PATTERNS.WORST_CASE]: '最坏情况',
   [DATA_PATTERNS.BEST_CASE]: '最佳情况',
-  [DATA_PATTERNS.AVERAGE_CASE]: '平均情况'
+  [DATA_PATTERNS.AVERAGE_CASE]: '平均情况',
+  [DATA_PATTERNS.UNIFORM_SQUARE]: '正方形内均匀分布',
+  [DATA_PATTERNS.IN_CIRCLE]: '圆内均匀分布',
+  [DATA_PATTERNS.ON_CIRCLE]: '圆周上',
+  [DATA_PATTERNS.GAME_TREE]: '随机博弈树',
+  [DATA_PATTERNS.OBSTACLES]: '随机障碍网格',
+  [DATA_PATTERNS.MAZE_BACKTRACKER]: '递归回溯迷宫',
+  [DATA_PATTERNS.MAZE_PRIM]: '随机Prim迷宫',
+  [DATA_PATTERNS.MAZE_KRUSKAL]: '随机Kruskal迷宫',
+  [DATA_PATTERNS.LOCALITY]: '局部性访问',
+  [DATA_PATTERNS.CONVOY]: '护航效应',
+  [DATA_PATTERNS.BLOBS]: '高斯簇'
 };
 
 // 数据类型显示名称
@@ -138,7 +187,9 @@ export const DATA_TYPE_NAMES: Record<DataType, string> = {
   [DATA_TYPES.GRAPH]: '图',
   [DATA_TYPES.TREE]: '树',
   [DATA_TYPES.STRING]: '字符串',
-  [DATA_TYPES.MATRIX]: '矩阵'
+  [DATA_TYPES.MATRIX]: '矩阵',
+  [DATA_TYPES.POINTS]: '点集',
+  [DATA_TYPES.PROCESSES]: '进程集合'
 };
 
 // API响应类型
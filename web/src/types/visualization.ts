@@ -2,6 +2,7 @@
 
 export interface VisualizationStep {
   stepId: number;
+  workerId?: number; // 并发算法中产生该步骤的工作者ID
   description: string;
   data: any;
   highlights: Array<number | string>;
@@ -49,6 +50,8 @@ export interface ExecutionStats {
   swaps: number;
   moves: number;
   accesses: number;
+  diskReads: number;
+  diskWrites: number;
 }
 
 // 可视化请求类型